@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: audit/v1/audit.proto
+
+package auditv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Audit represents an internal review of a resource, currently only template versions
+type Audit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// template version id
+	ResourceId   int64  `protobuf:"varint,2,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// json content of the reviewed resource
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// 0 means not assigned, any reviewer can decide
+	ReviewerId int64 `protobuf:"varint,5,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// IN_REVIEW, APPROVED or REJECTED
+	Status       string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	RejectReason string `protobuf:"bytes,7,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	AuditTime    int64  `protobuf:"varint,8,opt,name=audit_time,json=auditTime,proto3" json:"audit_time,omitempty"`
+	Ctime        int64  `protobuf:"varint,9,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime        int64  `protobuf:"varint,10,opt,name=utime,proto3" json:"utime,omitempty"`
+	// oldest first
+	Comments      []*AuditComment `protobuf:"bytes,11,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Audit) Reset() {
+	*x = Audit{}
+	mi := &file_audit_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audit) ProtoMessage() {}
+
+func (x *Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audit.ProtoReflect.Descriptor instead.
+func (*Audit) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Audit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Audit) GetResourceId() int64 {
+	if x != nil {
+		return x.ResourceId
+	}
+	return 0
+}
+
+func (x *Audit) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *Audit) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Audit) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *Audit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Audit) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *Audit) GetAuditTime() int64 {
+	if x != nil {
+		return x.AuditTime
+	}
+	return 0
+}
+
+func (x *Audit) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Audit) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+func (x *Audit) GetComments() []*AuditComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// AuditComment represents a comment left by a reviewer
+type AuditComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Ctime         int64                  `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditComment) Reset() {
+	*x = AuditComment{}
+	mi := &file_audit_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditComment) ProtoMessage() {}
+
+func (x *AuditComment) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditComment.ProtoReflect.Descriptor instead.
+func (*AuditComment) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditComment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditComment) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *AuditComment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AuditComment) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// GetAuditRequest represents the request for GetAudit method
+type GetAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditRequest) Reset() {
+	*x = GetAuditRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditRequest) ProtoMessage() {}
+
+func (x *GetAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAuditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetAuditResponse represents the response for GetAudit method
+type GetAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audit         *Audit                 `protobuf:"bytes,1,opt,name=audit,proto3" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditResponse) Reset() {
+	*x = GetAuditResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditResponse) ProtoMessage() {}
+
+func (x *GetAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAuditResponse) GetAudit() *Audit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// ListAuditsRequest represents the request for ListAudits method
+type ListAuditsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId int64                  `protobuf:"varint,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// empty for all statuses
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Offset        int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditsRequest) Reset() {
+	*x = ListAuditsRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditsRequest) ProtoMessage() {}
+
+func (x *ListAuditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditsRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAuditsRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ListAuditsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListAuditsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListAuditsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListAuditsResponse represents the response for ListAudits method
+type ListAuditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audits        []*Audit               `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditsResponse) Reset() {
+	*x = ListAuditsResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditsResponse) ProtoMessage() {}
+
+func (x *ListAuditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditsResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAuditsResponse) GetAudits() []*Audit {
+	if x != nil {
+		return x.Audits
+	}
+	return nil
+}
+
+func (x *ListAuditsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// AssignAuditRequest represents the request for AssignAudit method
+type AssignAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignAuditRequest) Reset() {
+	*x = AssignAuditRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignAuditRequest) ProtoMessage() {}
+
+func (x *AssignAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignAuditRequest.ProtoReflect.Descriptor instead.
+func (*AssignAuditRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignAuditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignAuditRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+// AssignAuditResponse represents the response for AssignAudit method
+type AssignAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignAuditResponse) Reset() {
+	*x = AssignAuditResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignAuditResponse) ProtoMessage() {}
+
+func (x *AssignAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignAuditResponse.ProtoReflect.Descriptor instead.
+func (*AssignAuditResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{7}
+}
+
+// ApproveAuditRequest represents the request for ApproveAudit method
+type ApproveAuditRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// optional
+	Comment       string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAuditRequest) Reset() {
+	*x = ApproveAuditRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAuditRequest) ProtoMessage() {}
+
+func (x *ApproveAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAuditRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAuditRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ApproveAuditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveAuditRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ApproveAuditRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// ApproveAuditResponse represents the response for ApproveAudit method
+type ApproveAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAuditResponse) Reset() {
+	*x = ApproveAuditResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAuditResponse) ProtoMessage() {}
+
+func (x *ApproveAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAuditResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAuditResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{9}
+}
+
+// RejectAuditRequest represents the request for RejectAudit method
+type RejectAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectAuditRequest) Reset() {
+	*x = RejectAuditRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAuditRequest) ProtoMessage() {}
+
+func (x *RejectAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAuditRequest.ProtoReflect.Descriptor instead.
+func (*RejectAuditRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RejectAuditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectAuditRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *RejectAuditRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// RejectAuditResponse represents the response for RejectAudit method
+type RejectAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectAuditResponse) Reset() {
+	*x = RejectAuditResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAuditResponse) ProtoMessage() {}
+
+func (x *RejectAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAuditResponse.ProtoReflect.Descriptor instead.
+func (*RejectAuditResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{11}
+}
+
+// CommentAuditRequest represents the request for CommentAudit method
+type CommentAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewerId    int64                  `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentAuditRequest) Reset() {
+	*x = CommentAuditRequest{}
+	mi := &file_audit_v1_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAuditRequest) ProtoMessage() {}
+
+func (x *CommentAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAuditRequest.ProtoReflect.Descriptor instead.
+func (*CommentAuditRequest) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommentAuditRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommentAuditRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *CommentAuditRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CommentAuditResponse represents the response for CommentAudit method
+type CommentAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *AuditComment          `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentAuditResponse) Reset() {
+	*x = CommentAuditResponse{}
+	mi := &file_audit_v1_audit_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentAuditResponse) ProtoMessage() {}
+
+func (x *CommentAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_v1_audit_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentAuditResponse.ProtoReflect.Descriptor instead.
+func (*CommentAuditResponse) Descriptor() ([]byte, []int) {
+	return file_audit_v1_audit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CommentAuditResponse) GetComment() *AuditComment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+var File_audit_v1_audit_proto protoreflect.FileDescriptor
+
+const file_audit_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x14audit/v1/audit.proto\x12\baudit.v1\"\xd4\x02\n" +
+	"\x05Audit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vresource_id\x18\x02 \x01(\x03R\n" +
+	"resourceId\x12#\n" +
+	"\rresource_type\x18\x03 \x01(\tR\fresourceType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1f\n" +
+	"\vreviewer_id\x18\x05 \x01(\x03R\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12#\n" +
+	"\rreject_reason\x18\a \x01(\tR\frejectReason\x12\x1d\n" +
+	"\n" +
+	"audit_time\x18\b \x01(\x03R\tauditTime\x12\x14\n" +
+	"\x05ctime\x18\t \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\n" +
+	" \x01(\x03R\x05utime\x122\n" +
+	"\bcomments\x18\v \x03(\v2\x16.audit.v1.AuditCommentR\bcomments\"o\n" +
+	"\fAuditComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x14\n" +
+	"\x05ctime\x18\x04 \x01(\x03R\x05ctime\"!\n" +
+	"\x0fGetAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
+	"\x10GetAuditResponse\x12%\n" +
+	"\x05audit\x18\x01 \x01(\v2\x0f.audit.v1.AuditR\x05audit\"z\n" +
+	"\x11ListAuditsRequest\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\x03R\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"S\n" +
+	"\x12ListAuditsResponse\x12'\n" +
+	"\x06audits\x18\x01 \x03(\v2\x0f.audit.v1.AuditR\x06audits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"E\n" +
+	"\x12AssignAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\"\x15\n" +
+	"\x13AssignAuditResponse\"`\n" +
+	"\x13ApproveAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x16\n" +
+	"\x14ApproveAuditResponse\"]\n" +
+	"\x12RejectAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x15\n" +
+	"\x13RejectAuditResponse\"`\n" +
+	"\x13CommentAuditRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x03R\n" +
+	"reviewerId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"H\n" +
+	"\x14CommentAuditResponse\x120\n" +
+	"\acomment\x18\x01 \x01(\v2\x16.audit.v1.AuditCommentR\acomment2\xdc\x03\n" +
+	"\fAuditService\x12C\n" +
+	"\bGetAudit\x12\x19.audit.v1.GetAuditRequest\x1a\x1a.audit.v1.GetAuditResponse\"\x00\x12I\n" +
+	"\n" +
+	"ListAudits\x12\x1b.audit.v1.ListAuditsRequest\x1a\x1c.audit.v1.ListAuditsResponse\"\x00\x12L\n" +
+	"\vAssignAudit\x12\x1c.audit.v1.AssignAuditRequest\x1a\x1d.audit.v1.AssignAuditResponse\"\x00\x12O\n" +
+	"\fApproveAudit\x12\x1d.audit.v1.ApproveAuditRequest\x1a\x1e.audit.v1.ApproveAuditResponse\"\x00\x12L\n" +
+	"\vRejectAudit\x12\x1c.audit.v1.RejectAuditRequest\x1a\x1d.audit.v1.RejectAuditResponse\"\x00\x12O\n" +
+	"\fCommentAudit\x12\x1d.audit.v1.CommentAuditRequest\x1a\x1e.audit.v1.CommentAuditResponse\"\x00B\xa3\x01\n" +
+	"\fcom.audit.v1B\n" +
+	"AuditProtoP\x01ZFgitee.com/flycash/notification-platform/api/proto/gen/audit/v1;auditv1\xa2\x02\x03AXX\xaa\x02\bAudit.V1\xca\x02\bAudit\\V1\xe2\x02\x14Audit\\V1\\GPBMetadata\xea\x02\tAudit::V1b\x06proto3"
+
+var (
+	file_audit_v1_audit_proto_rawDescOnce sync.Once
+	file_audit_v1_audit_proto_rawDescData []byte
+)
+
+func file_audit_v1_audit_proto_rawDescGZIP() []byte {
+	file_audit_v1_audit_proto_rawDescOnce.Do(func() {
+		file_audit_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_v1_audit_proto_rawDesc), len(file_audit_v1_audit_proto_rawDesc)))
+	})
+	return file_audit_v1_audit_proto_rawDescData
+}
+
+var (
+	file_audit_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+	file_audit_v1_audit_proto_goTypes  = []any{
+		(*Audit)(nil),                // 0: audit.v1.Audit
+		(*AuditComment)(nil),         // 1: audit.v1.AuditComment
+		(*GetAuditRequest)(nil),      // 2: audit.v1.GetAuditRequest
+		(*GetAuditResponse)(nil),     // 3: audit.v1.GetAuditResponse
+		(*ListAuditsRequest)(nil),    // 4: audit.v1.ListAuditsRequest
+		(*ListAuditsResponse)(nil),   // 5: audit.v1.ListAuditsResponse
+		(*AssignAuditRequest)(nil),   // 6: audit.v1.AssignAuditRequest
+		(*AssignAuditResponse)(nil),  // 7: audit.v1.AssignAuditResponse
+		(*ApproveAuditRequest)(nil),  // 8: audit.v1.ApproveAuditRequest
+		(*ApproveAuditResponse)(nil), // 9: audit.v1.ApproveAuditResponse
+		(*RejectAuditRequest)(nil),   // 10: audit.v1.RejectAuditRequest
+		(*RejectAuditResponse)(nil),  // 11: audit.v1.RejectAuditResponse
+		(*CommentAuditRequest)(nil),  // 12: audit.v1.CommentAuditRequest
+		(*CommentAuditResponse)(nil), // 13: audit.v1.CommentAuditResponse
+	}
+)
+
+var file_audit_v1_audit_proto_depIdxs = []int32{
+	1,  // 0: audit.v1.Audit.comments:type_name -> audit.v1.AuditComment
+	0,  // 1: audit.v1.GetAuditResponse.audit:type_name -> audit.v1.Audit
+	0,  // 2: audit.v1.ListAuditsResponse.audits:type_name -> audit.v1.Audit
+	1,  // 3: audit.v1.CommentAuditResponse.comment:type_name -> audit.v1.AuditComment
+	2,  // 4: audit.v1.AuditService.GetAudit:input_type -> audit.v1.GetAuditRequest
+	4,  // 5: audit.v1.AuditService.ListAudits:input_type -> audit.v1.ListAuditsRequest
+	6,  // 6: audit.v1.AuditService.AssignAudit:input_type -> audit.v1.AssignAuditRequest
+	8,  // 7: audit.v1.AuditService.ApproveAudit:input_type -> audit.v1.ApproveAuditRequest
+	10, // 8: audit.v1.AuditService.RejectAudit:input_type -> audit.v1.RejectAuditRequest
+	12, // 9: audit.v1.AuditService.CommentAudit:input_type -> audit.v1.CommentAuditRequest
+	3,  // 10: audit.v1.AuditService.GetAudit:output_type -> audit.v1.GetAuditResponse
+	5,  // 11: audit.v1.AuditService.ListAudits:output_type -> audit.v1.ListAuditsResponse
+	7,  // 12: audit.v1.AuditService.AssignAudit:output_type -> audit.v1.AssignAuditResponse
+	9,  // 13: audit.v1.AuditService.ApproveAudit:output_type -> audit.v1.ApproveAuditResponse
+	11, // 14: audit.v1.AuditService.RejectAudit:output_type -> audit.v1.RejectAuditResponse
+	13, // 15: audit.v1.AuditService.CommentAudit:output_type -> audit.v1.CommentAuditResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_audit_v1_audit_proto_init() }
+func file_audit_v1_audit_proto_init() {
+	if File_audit_v1_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_v1_audit_proto_rawDesc), len(file_audit_v1_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_v1_audit_proto_goTypes,
+		DependencyIndexes: file_audit_v1_audit_proto_depIdxs,
+		MessageInfos:      file_audit_v1_audit_proto_msgTypes,
+	}.Build()
+	File_audit_v1_audit_proto = out.File
+	file_audit_v1_audit_proto_goTypes = nil
+	file_audit_v1_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,1641 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: audit/v1/audit.proto
+
+package auditv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Audit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Audit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Audit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuditMultiError, or nil if none found.
+func (m *Audit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Audit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ResourceId
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Content
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Status
+
+	// no validation rules for RejectReason
+
+	// no validation rules for AuditTime
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	for idx, item := range m.GetComments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditValidationError{
+						field:  fmt.Sprintf("Comments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditValidationError{
+					field:  fmt.Sprintf("Comments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditMultiError is an error wrapping multiple validation errors returned by
+// Audit.ValidateAll() if the designated constraints aren't met.
+type AuditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditMultiError) AllErrors() []error { return m }
+
+// AuditValidationError is the validation error returned by Audit.Validate if
+// the designated constraints aren't met.
+type AuditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditValidationError) ErrorName() string { return "AuditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditValidationError{}
+
+// Validate checks the field values on AuditComment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditComment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditComment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditCommentMultiError, or
+// nil if none found.
+func (m *AuditComment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditComment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Content
+
+	// no validation rules for Ctime
+
+	if len(errors) > 0 {
+		return AuditCommentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditCommentMultiError is an error wrapping multiple validation errors
+// returned by AuditComment.ValidateAll() if the designated constraints aren't met.
+type AuditCommentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditCommentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditCommentMultiError) AllErrors() []error { return m }
+
+// AuditCommentValidationError is the validation error returned by
+// AuditComment.Validate if the designated constraints aren't met.
+type AuditCommentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditCommentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditCommentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditCommentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditCommentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditCommentValidationError) ErrorName() string { return "AuditCommentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditCommentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditComment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditCommentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditCommentValidationError{}
+
+// Validate checks the field values on GetAuditRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditRequestMultiError, or nil if none found.
+func (m *GetAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAuditRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditRequestMultiError) AllErrors() []error { return m }
+
+// GetAuditRequestValidationError is the validation error returned by
+// GetAuditRequest.Validate if the designated constraints aren't met.
+type GetAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditRequestValidationError) ErrorName() string { return "GetAuditRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditRequestValidationError{}
+
+// Validate checks the field values on GetAuditResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAuditResponseMultiError, or nil if none found.
+func (m *GetAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAudit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAuditResponseValidationError{
+					field:  "Audit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAuditResponseValidationError{
+					field:  "Audit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAuditResponseValidationError{
+				field:  "Audit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAuditResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAuditResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAuditResponseMultiError) AllErrors() []error { return m }
+
+// GetAuditResponseValidationError is the validation error returned by
+// GetAuditResponse.Validate if the designated constraints aren't met.
+type GetAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAuditResponseValidationError) ErrorName() string { return "GetAuditResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAuditResponseValidationError{}
+
+// Validate checks the field values on ListAuditsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditsRequestMultiError, or nil if none found.
+func (m *ListAuditsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Status
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListAuditsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAuditsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuditsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditsRequestValidationError is the validation error returned by
+// ListAuditsRequest.Validate if the designated constraints aren't met.
+type ListAuditsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditsRequestValidationError) ErrorName() string {
+	return "ListAuditsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditsRequestValidationError{}
+
+// Validate checks the field values on ListAuditsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditsResponseMultiError, or nil if none found.
+func (m *ListAuditsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAudits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditsResponseValidationError{
+						field:  fmt.Sprintf("Audits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditsResponseValidationError{
+						field:  fmt.Sprintf("Audits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditsResponseValidationError{
+					field:  fmt.Sprintf("Audits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListAuditsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListAuditsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListAuditsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditsResponseValidationError is the validation error returned by
+// ListAuditsResponse.Validate if the designated constraints aren't met.
+type ListAuditsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditsResponseValidationError) ErrorName() string {
+	return "ListAuditsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditsResponseValidationError{}
+
+// Validate checks the field values on AssignAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignAuditRequestMultiError, or nil if none found.
+func (m *AssignAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	if len(errors) > 0 {
+		return AssignAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignAuditRequestMultiError is an error wrapping multiple validation errors
+// returned by AssignAuditRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AssignAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignAuditRequestMultiError) AllErrors() []error { return m }
+
+// AssignAuditRequestValidationError is the validation error returned by
+// AssignAuditRequest.Validate if the designated constraints aren't met.
+type AssignAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignAuditRequestValidationError) ErrorName() string {
+	return "AssignAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignAuditRequestValidationError{}
+
+// Validate checks the field values on AssignAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AssignAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssignAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssignAuditResponseMultiError, or nil if none found.
+func (m *AssignAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssignAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AssignAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssignAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by AssignAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AssignAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssignAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssignAuditResponseMultiError) AllErrors() []error { return m }
+
+// AssignAuditResponseValidationError is the validation error returned by
+// AssignAuditResponse.Validate if the designated constraints aren't met.
+type AssignAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssignAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssignAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssignAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssignAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssignAuditResponseValidationError) ErrorName() string {
+	return "AssignAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AssignAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssignAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssignAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssignAuditResponseValidationError{}
+
+// Validate checks the field values on ApproveAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveAuditRequestMultiError, or nil if none found.
+func (m *ApproveAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Comment
+
+	if len(errors) > 0 {
+		return ApproveAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveAuditRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveAuditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveAuditRequestMultiError) AllErrors() []error { return m }
+
+// ApproveAuditRequestValidationError is the validation error returned by
+// ApproveAuditRequest.Validate if the designated constraints aren't met.
+type ApproveAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveAuditRequestValidationError) ErrorName() string {
+	return "ApproveAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveAuditRequestValidationError{}
+
+// Validate checks the field values on ApproveAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveAuditResponseMultiError, or nil if none found.
+func (m *ApproveAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ApproveAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveAuditResponseMultiError) AllErrors() []error { return m }
+
+// ApproveAuditResponseValidationError is the validation error returned by
+// ApproveAuditResponse.Validate if the designated constraints aren't met.
+type ApproveAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveAuditResponseValidationError) ErrorName() string {
+	return "ApproveAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveAuditResponseValidationError{}
+
+// Validate checks the field values on RejectAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectAuditRequestMultiError, or nil if none found.
+func (m *RejectAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return RejectAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectAuditRequestMultiError is an error wrapping multiple validation errors
+// returned by RejectAuditRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RejectAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectAuditRequestMultiError) AllErrors() []error { return m }
+
+// RejectAuditRequestValidationError is the validation error returned by
+// RejectAuditRequest.Validate if the designated constraints aren't met.
+type RejectAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectAuditRequestValidationError) ErrorName() string {
+	return "RejectAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectAuditRequestValidationError{}
+
+// Validate checks the field values on RejectAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectAuditResponseMultiError, or nil if none found.
+func (m *RejectAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RejectAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectAuditResponseMultiError) AllErrors() []error { return m }
+
+// RejectAuditResponseValidationError is the validation error returned by
+// RejectAuditResponse.Validate if the designated constraints aren't met.
+type RejectAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectAuditResponseValidationError) ErrorName() string {
+	return "RejectAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectAuditResponseValidationError{}
+
+// Validate checks the field values on CommentAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentAuditRequestMultiError, or nil if none found.
+func (m *CommentAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ReviewerId
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return CommentAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentAuditRequestMultiError is an error wrapping multiple validation
+// errors returned by CommentAuditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CommentAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentAuditRequestMultiError) AllErrors() []error { return m }
+
+// CommentAuditRequestValidationError is the validation error returned by
+// CommentAuditRequest.Validate if the designated constraints aren't met.
+type CommentAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentAuditRequestValidationError) ErrorName() string {
+	return "CommentAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommentAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentAuditRequestValidationError{}
+
+// Validate checks the field values on CommentAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CommentAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CommentAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CommentAuditResponseMultiError, or nil if none found.
+func (m *CommentAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CommentAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetComment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentAuditResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentAuditResponseValidationError{
+					field:  "Comment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetComment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentAuditResponseValidationError{
+				field:  "Comment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CommentAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CommentAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by CommentAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CommentAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CommentAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CommentAuditResponseMultiError) AllErrors() []error { return m }
+
+// CommentAuditResponseValidationError is the validation error returned by
+// CommentAuditResponse.Validate if the designated constraints aren't met.
+type CommentAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CommentAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CommentAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CommentAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CommentAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CommentAuditResponseValidationError) ErrorName() string {
+	return "CommentAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CommentAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCommentAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CommentAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CommentAuditResponseValidationError{}
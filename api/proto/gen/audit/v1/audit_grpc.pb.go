@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: audit/v1/audit.proto
+
+package auditv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AuditService_GetAudit_FullMethodName     = "/audit.v1.AuditService/GetAudit"
+	AuditService_ListAudits_FullMethodName   = "/audit.v1.AuditService/ListAudits"
+	AuditService_AssignAudit_FullMethodName  = "/audit.v1.AuditService/AssignAudit"
+	AuditService_ApproveAudit_FullMethodName = "/audit.v1.AuditService/ApproveAudit"
+	AuditService_RejectAudit_FullMethodName  = "/audit.v1.AuditService/RejectAudit"
+	AuditService_CommentAudit_FullMethodName = "/audit.v1.AuditService/CommentAudit"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditService is the built-in internal review workflow,
+// decisions are published to audit_result_events just like an external audit system
+type AuditServiceClient interface {
+	// GetAudit gets an audit with all its comments
+	GetAudit(ctx context.Context, in *GetAuditRequest, opts ...grpc.CallOption) (*GetAuditResponse, error)
+	// ListAudits lists the audits assigned to a reviewer, newest first
+	ListAudits(ctx context.Context, in *ListAuditsRequest, opts ...grpc.CallOption) (*ListAuditsResponse, error)
+	// AssignAudit reassigns an audit that is still in review
+	AssignAudit(ctx context.Context, in *AssignAuditRequest, opts ...grpc.CallOption) (*AssignAuditResponse, error)
+	// ApproveAudit approves an audit, resubmitting the same decision republishes the result event
+	ApproveAudit(ctx context.Context, in *ApproveAuditRequest, opts ...grpc.CallOption) (*ApproveAuditResponse, error)
+	// RejectAudit rejects an audit with a reason
+	RejectAudit(ctx context.Context, in *RejectAuditRequest, opts ...grpc.CallOption) (*RejectAuditResponse, error)
+	// CommentAudit leaves a comment without changing the status
+	CommentAudit(ctx context.Context, in *CommentAuditRequest, opts ...grpc.CallOption) (*CommentAuditResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) GetAudit(ctx context.Context, in *GetAuditRequest, opts ...grpc.CallOption) (*GetAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditResponse)
+	err := c.cc.Invoke(ctx, AuditService_GetAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ListAudits(ctx context.Context, in *ListAuditsRequest, opts ...grpc.CallOption) (*ListAuditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditsResponse)
+	err := c.cc.Invoke(ctx, AuditService_ListAudits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) AssignAudit(ctx context.Context, in *AssignAuditRequest, opts ...grpc.CallOption) (*AssignAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignAuditResponse)
+	err := c.cc.Invoke(ctx, AuditService_AssignAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ApproveAudit(ctx context.Context, in *ApproveAuditRequest, opts ...grpc.CallOption) (*ApproveAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAuditResponse)
+	err := c.cc.Invoke(ctx, AuditService_ApproveAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) RejectAudit(ctx context.Context, in *RejectAuditRequest, opts ...grpc.CallOption) (*RejectAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectAuditResponse)
+	err := c.cc.Invoke(ctx, AuditService_RejectAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) CommentAudit(ctx context.Context, in *CommentAuditRequest, opts ...grpc.CallOption) (*CommentAuditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommentAuditResponse)
+	err := c.cc.Invoke(ctx, AuditService_CommentAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations should embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// AuditService is the built-in internal review workflow,
+// decisions are published to audit_result_events just like an external audit system
+type AuditServiceServer interface {
+	// GetAudit gets an audit with all its comments
+	GetAudit(context.Context, *GetAuditRequest) (*GetAuditResponse, error)
+	// ListAudits lists the audits assigned to a reviewer, newest first
+	ListAudits(context.Context, *ListAuditsRequest) (*ListAuditsResponse, error)
+	// AssignAudit reassigns an audit that is still in review
+	AssignAudit(context.Context, *AssignAuditRequest) (*AssignAuditResponse, error)
+	// ApproveAudit approves an audit, resubmitting the same decision republishes the result event
+	ApproveAudit(context.Context, *ApproveAuditRequest) (*ApproveAuditResponse, error)
+	// RejectAudit rejects an audit with a reason
+	RejectAudit(context.Context, *RejectAuditRequest) (*RejectAuditResponse, error)
+	// CommentAudit leaves a comment without changing the status
+	CommentAudit(context.Context, *CommentAuditRequest) (*CommentAuditResponse, error)
+}
+
+// UnimplementedAuditServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) GetAudit(context.Context, *GetAuditRequest) (*GetAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAudit not implemented")
+}
+
+func (UnimplementedAuditServiceServer) ListAudits(context.Context, *ListAuditsRequest) (*ListAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
+}
+
+func (UnimplementedAuditServiceServer) AssignAudit(context.Context, *AssignAuditRequest) (*AssignAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignAudit not implemented")
+}
+
+func (UnimplementedAuditServiceServer) ApproveAudit(context.Context, *ApproveAuditRequest) (*ApproveAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAudit not implemented")
+}
+
+func (UnimplementedAuditServiceServer) RejectAudit(context.Context, *RejectAuditRequest) (*RejectAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAudit not implemented")
+}
+
+func (UnimplementedAuditServiceServer) CommentAudit(context.Context, *CommentAuditRequest) (*CommentAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentAudit not implemented")
+}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_GetAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetAudit(ctx, req.(*GetAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ListAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ListAudits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ListAudits(ctx, req.(*ListAuditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_AssignAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).AssignAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_AssignAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).AssignAudit(ctx, req.(*AssignAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ApproveAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).ApproveAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_ApproveAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).ApproveAudit(ctx, req.(*ApproveAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_RejectAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).RejectAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_RejectAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).RejectAudit(ctx, req.(*RejectAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_CommentAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).CommentAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_CommentAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).CommentAudit(ctx, req.(*CommentAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "audit.v1.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAudit",
+			Handler:    _AuditService_GetAudit_Handler,
+		},
+		{
+			MethodName: "ListAudits",
+			Handler:    _AuditService_ListAudits_Handler,
+		},
+		{
+			MethodName: "AssignAudit",
+			Handler:    _AuditService_AssignAudit_Handler,
+		},
+		{
+			MethodName: "ApproveAudit",
+			Handler:    _AuditService_ApproveAudit_Handler,
+		},
+		{
+			MethodName: "RejectAudit",
+			Handler:    _AuditService_RejectAudit_Handler,
+		},
+		{
+			MethodName: "CommentAudit",
+			Handler:    _AuditService_CommentAudit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit/v1/audit.proto",
+}
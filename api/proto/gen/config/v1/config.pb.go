@@ -93,10 +93,13 @@ func (x *RetryConfig) GetBackoffMultiplier() float64 {
 
 // ChannelItem represents a notification channel with priority settings
 type ChannelItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
-	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 数值越小优先级越高
+	Priority int32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	Enabled  bool  `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// 原渠道模版ID到本渠道模版ID的映射，降级到本渠道时使用
+	Templates     map[int64]int64 `protobuf:"bytes,4,rep,name=templates,proto3" json:"templates,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,11 +155,20 @@ func (x *ChannelItem) GetEnabled() bool {
 	return false
 }
 
+func (x *ChannelItem) GetTemplates() map[int64]int64 {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
 // ChannelConfig represents channel configuration
 type ChannelConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channels      []*ChannelItem         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
-	RetryPolicy   *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Channels    []*ChannelItem         `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	RetryPolicy *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// 是否开启跨渠道降级，原渠道发送失败时按优先级尝试其他启用的渠道
+	Failover      bool `protobuf:"varint,3,opt,name=failover,proto3" json:"failover,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +217,13 @@ func (x *ChannelConfig) GetRetryPolicy() *RetryConfig {
 	return nil
 }
 
+func (x *ChannelConfig) GetFailover() bool {
+	if x != nil {
+		return x.Failover
+	}
+	return false
+}
+
 // TxnConfig represents transaction configuration
 type TxnConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -266,29 +285,31 @@ func (x *TxnConfig) GetRetryPolicy() *RetryConfig {
 	return nil
 }
 
-// MonthlyConfig represents monthly quotas for different channels
-type MonthlyConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sms           int32                  `protobuf:"varint,1,opt,name=sms,proto3" json:"sms,omitempty"`
-	Email         int32                  `protobuf:"varint,2,opt,name=email,proto3" json:"email,omitempty"`
+// QuotaConfig represents quota configuration keyed by channel, e.g. SMS, EMAIL, IN_APP
+type QuotaConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// monthly quota, reset at the beginning of each month
+	Monthly map[string]int32 `protobuf:"bytes,2,rep,name=monthly,proto3" json:"monthly,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// daily sending cap, only applies to channels with a monthly quota
+	Daily         map[string]int32 `protobuf:"bytes,3,rep,name=daily,proto3" json:"daily,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MonthlyConfig) Reset() {
-	*x = MonthlyConfig{}
+func (x *QuotaConfig) Reset() {
+	*x = QuotaConfig{}
 	mi := &file_config_v1_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MonthlyConfig) String() string {
+func (x *QuotaConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MonthlyConfig) ProtoMessage() {}
+func (*QuotaConfig) ProtoMessage() {}
 
-func (x *MonthlyConfig) ProtoReflect() protoreflect.Message {
+func (x *QuotaConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_config_v1_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,47 +321,48 @@ func (x *MonthlyConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MonthlyConfig.ProtoReflect.Descriptor instead.
-func (*MonthlyConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuotaConfig.ProtoReflect.Descriptor instead.
+func (*QuotaConfig) Descriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *MonthlyConfig) GetSms() int32 {
+func (x *QuotaConfig) GetMonthly() map[string]int32 {
 	if x != nil {
-		return x.Sms
+		return x.Monthly
 	}
-	return 0
+	return nil
 }
 
-func (x *MonthlyConfig) GetEmail() int32 {
+func (x *QuotaConfig) GetDaily() map[string]int32 {
 	if x != nil {
-		return x.Email
+		return x.Daily
 	}
-	return 0
+	return nil
 }
 
-// QuotaConfig represents quota configuration
-type QuotaConfig struct {
+// CallbackConfig represents callback configuration
+type CallbackConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Monthly       *MonthlyConfig         `protobuf:"bytes,1,opt,name=monthly,proto3" json:"monthly,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	RetryPolicy   *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuotaConfig) Reset() {
-	*x = QuotaConfig{}
+func (x *CallbackConfig) Reset() {
+	*x = CallbackConfig{}
 	mi := &file_config_v1_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuotaConfig) String() string {
+func (x *CallbackConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuotaConfig) ProtoMessage() {}
+func (*CallbackConfig) ProtoMessage() {}
 
-func (x *QuotaConfig) ProtoReflect() protoreflect.Message {
+func (x *CallbackConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_config_v1_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,41 +374,48 @@ func (x *QuotaConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuotaConfig.ProtoReflect.Descriptor instead.
-func (*QuotaConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallbackConfig.ProtoReflect.Descriptor instead.
+func (*CallbackConfig) Descriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *QuotaConfig) GetMonthly() *MonthlyConfig {
+func (x *CallbackConfig) GetServiceName() string {
 	if x != nil {
-		return x.Monthly
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *CallbackConfig) GetRetryPolicy() *RetryConfig {
+	if x != nil {
+		return x.RetryPolicy
 	}
 	return nil
 }
 
-// CallbackConfig represents callback configuration
-type CallbackConfig struct {
+// QuietInterval 每天的免打扰时段，格式为 HH:MM，end 早于 start 表示跨过零点，例如 22:00 到 08:00
+type QuietInterval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	RetryPolicy   *RetryConfig           `protobuf:"bytes,2,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CallbackConfig) Reset() {
-	*x = CallbackConfig{}
+func (x *QuietInterval) Reset() {
+	*x = QuietInterval{}
 	mi := &file_config_v1_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CallbackConfig) String() string {
+func (x *QuietInterval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallbackConfig) ProtoMessage() {}
+func (*QuietInterval) ProtoMessage() {}
 
-func (x *CallbackConfig) ProtoReflect() protoreflect.Message {
+func (x *QuietInterval) ProtoReflect() protoreflect.Message {
 	mi := &file_config_v1_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -398,21 +427,209 @@ func (x *CallbackConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallbackConfig.ProtoReflect.Descriptor instead.
-func (*CallbackConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuietInterval.ProtoReflect.Descriptor instead.
+func (*QuietInterval) Descriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CallbackConfig) GetServiceName() string {
+func (x *QuietInterval) GetStart() string {
 	if x != nil {
-		return x.ServiceName
+		return x.Start
 	}
 	return ""
 }
 
-func (x *CallbackConfig) GetRetryPolicy() *RetryConfig {
+func (x *QuietInterval) GetEnd() string {
 	if x != nil {
-		return x.RetryPolicy
+		return x.End
+	}
+	return ""
+}
+
+// QuietHoursConfig 免打扰配置，落在免打扰时段内的通知推迟到时段结束之后再发送
+type QuietHoursConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 免打扰时段所在的时区，例如 Asia/Shanghai，为空时使用服务端本地时区
+	Timezone  string           `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Intervals []*QuietInterval `protobuf:"bytes,2,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	// 生效的业务类型：1-推广营销、2-通知、3-验证码，为空时对验证码以外的业务类型生效
+	BusinessTypes []int64 `protobuf:"varint,3,rep,packed,name=business_types,json=businessTypes,proto3" json:"business_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHoursConfig) Reset() {
+	*x = QuietHoursConfig{}
+	mi := &file_config_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHoursConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHoursConfig) ProtoMessage() {}
+
+func (x *QuietHoursConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHoursConfig.ProtoReflect.Descriptor instead.
+func (*QuietHoursConfig) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuietHoursConfig) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *QuietHoursConfig) GetIntervals() []*QuietInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *QuietHoursConfig) GetBusinessTypes() []int64 {
+	if x != nil {
+		return x.BusinessTypes
+	}
+	return nil
+}
+
+// FrequencyCapRule 每个接收者在统计窗口内最多收到的通知数量，例如 24 小时内最多 3 条营销短信
+type FrequencyCapRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 为空时匹配所有渠道
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 为 0 时匹配所有业务类型
+	BusinessType  int64 `protobuf:"varint,2,opt,name=business_type,json=businessType,proto3" json:"business_type,omitempty"`
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	WindowSeconds int64 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// DROP 不再发送给超过上限的接收者，DEFER 推迟整条通知，为空时为 DROP
+	Action        string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequencyCapRule) Reset() {
+	*x = FrequencyCapRule{}
+	mi := &file_config_v1_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequencyCapRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCapRule) ProtoMessage() {}
+
+func (x *FrequencyCapRule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCapRule.ProtoReflect.Descriptor instead.
+func (*FrequencyCapRule) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FrequencyCapRule) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *FrequencyCapRule) GetBusinessType() int64 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+func (x *FrequencyCapRule) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *FrequencyCapRule) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *FrequencyCapRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// FrequencyCapConfig 接收者发送频率上限，规则按顺序匹配，只使用第一条匹配的规则
+type FrequencyCapConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*FrequencyCapRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequencyCapConfig) Reset() {
+	*x = FrequencyCapConfig{}
+	mi := &file_config_v1_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequencyCapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCapConfig) ProtoMessage() {}
+
+func (x *FrequencyCapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCapConfig.ProtoReflect.Descriptor instead.
+func (*FrequencyCapConfig) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FrequencyCapConfig) GetRules() []*FrequencyCapRule {
+	if x != nil {
+		return x.Rules
 	}
 	return nil
 }
@@ -427,13 +644,15 @@ type BusinessConfig struct {
 	RateLimit      int32                  `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 	Quota          *QuotaConfig           `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
 	CallbackConfig *CallbackConfig        `protobuf:"bytes,7,opt,name=callback_config,json=callbackConfig,proto3" json:"callback_config,omitempty"`
+	QuietHours     *QuietHoursConfig      `protobuf:"bytes,8,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	FrequencyCap   *FrequencyCapConfig    `protobuf:"bytes,9,opt,name=frequency_cap,json=frequencyCap,proto3" json:"frequency_cap,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BusinessConfig) Reset() {
 	*x = BusinessConfig{}
-	mi := &file_config_v1_config_proto_msgTypes[7]
+	mi := &file_config_v1_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +664,7 @@ func (x *BusinessConfig) String() string {
 func (*BusinessConfig) ProtoMessage() {}
 
 func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[7]
+	mi := &file_config_v1_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +677,7 @@ func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessConfig.ProtoReflect.Descriptor instead.
 func (*BusinessConfig) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{7}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BusinessConfig) GetOwnerId() int64 {
@@ -510,6 +729,20 @@ func (x *BusinessConfig) GetCallbackConfig() *CallbackConfig {
 	return nil
 }
 
+func (x *BusinessConfig) GetQuietHours() *QuietHoursConfig {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *BusinessConfig) GetFrequencyCap() *FrequencyCapConfig {
+	if x != nil {
+		return x.FrequencyCap
+	}
+	return nil
+}
+
 // GetByIDsRequest represents the request for GetByIDs method
 type GetByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -520,7 +753,7 @@ type GetByIDsRequest struct {
 
 func (x *GetByIDsRequest) Reset() {
 	*x = GetByIDsRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[8]
+	mi := &file_config_v1_config_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +765,7 @@ func (x *GetByIDsRequest) String() string {
 func (*GetByIDsRequest) ProtoMessage() {}
 
 func (x *GetByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[8]
+	mi := &file_config_v1_config_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +778,7 @@ func (x *GetByIDsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDsRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{8}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetByIDsRequest) GetIds() []int64 {
@@ -565,7 +798,7 @@ type GetByIDsResponse struct {
 
 func (x *GetByIDsResponse) Reset() {
 	*x = GetByIDsResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[9]
+	mi := &file_config_v1_config_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +810,7 @@ func (x *GetByIDsResponse) String() string {
 func (*GetByIDsResponse) ProtoMessage() {}
 
 func (x *GetByIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[9]
+	mi := &file_config_v1_config_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +823,7 @@ func (x *GetByIDsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDsResponse.ProtoReflect.Descriptor instead.
 func (*GetByIDsResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{9}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetByIDsResponse) GetConfigs() map[int64]*BusinessConfig {
@@ -610,7 +843,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[10]
+	mi := &file_config_v1_config_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +855,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[10]
+	mi := &file_config_v1_config_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +868,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{10}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetByIDRequest) GetId() int64 {
@@ -655,7 +888,7 @@ type GetByIDResponse struct {
 
 func (x *GetByIDResponse) Reset() {
 	*x = GetByIDResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[11]
+	mi := &file_config_v1_config_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +900,7 @@ func (x *GetByIDResponse) String() string {
 func (*GetByIDResponse) ProtoMessage() {}
 
 func (x *GetByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[11]
+	mi := &file_config_v1_config_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +913,7 @@ func (x *GetByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetByIDResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{11}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetByIDResponse) GetConfig() *BusinessConfig {
@@ -700,7 +933,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[12]
+	mi := &file_config_v1_config_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +945,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[12]
+	mi := &file_config_v1_config_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +958,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{12}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteRequest) GetId() int64 {
@@ -745,7 +978,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[13]
+	mi := &file_config_v1_config_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +990,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[13]
+	mi := &file_config_v1_config_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1003,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{13}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteResponse) GetSuccess() bool {
@@ -790,7 +1023,7 @@ type SaveConfigRequest struct {
 
 func (x *SaveConfigRequest) Reset() {
 	*x = SaveConfigRequest{}
-	mi := &file_config_v1_config_proto_msgTypes[14]
+	mi := &file_config_v1_config_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +1035,7 @@ func (x *SaveConfigRequest) String() string {
 func (*SaveConfigRequest) ProtoMessage() {}
 
 func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[14]
+	mi := &file_config_v1_config_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1048,7 @@ func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{14}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SaveConfigRequest) GetConfig() *BusinessConfig {
@@ -835,7 +1068,7 @@ type SaveConfigResponse struct {
 
 func (x *SaveConfigResponse) Reset() {
 	*x = SaveConfigResponse{}
-	mi := &file_config_v1_config_proto_msgTypes[15]
+	mi := &file_config_v1_config_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +1080,7 @@ func (x *SaveConfigResponse) String() string {
 func (*SaveConfigResponse) ProtoMessage() {}
 
 func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[15]
+	mi := &file_config_v1_config_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1093,7 @@ func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
 func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{15}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SaveConfigResponse) GetSuccess() bool {
@@ -879,26 +1112,51 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12,\n" +
 	"\x12initial_backoff_ms\x18\x02 \x01(\x05R\x10initialBackoffMs\x12$\n" +
 	"\x0emax_backoff_ms\x18\x03 \x01(\x05R\fmaxBackoffMs\x12-\n" +
-	"\x12backoff_multiplier\x18\x04 \x01(\x01R\x11backoffMultiplier\"]\n" +
+	"\x12backoff_multiplier\x18\x04 \x01(\x01R\x11backoffMultiplier\"\xe0\x01\n" +
 	"\vChannelItem\x12\x18\n" +
 	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1a\n" +
 	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\"~\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12C\n" +
+	"\ttemplates\x18\x04 \x03(\v2%.config.v1.ChannelItem.TemplatesEntryR\ttemplates\x1a<\n" +
+	"\x0eTemplatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x9a\x01\n" +
 	"\rChannelConfig\x122\n" +
 	"\bchannels\x18\x01 \x03(\v2\x16.config.v1.ChannelItemR\bchannels\x129\n" +
-	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"\x8e\x01\n" +
+	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\x12\x1a\n" +
+	"\bfailover\x18\x03 \x01(\bR\bfailover\"\x8e\x01\n" +
 	"\tTxnConfig\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x12#\n" +
 	"\rinitial_delay\x18\x02 \x01(\x05R\finitialDelay\x129\n" +
-	"\fretry_policy\x18\x03 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"7\n" +
-	"\rMonthlyConfig\x12\x10\n" +
-	"\x03sms\x18\x01 \x01(\x05R\x03sms\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\x05R\x05email\"A\n" +
-	"\vQuotaConfig\x122\n" +
-	"\amonthly\x18\x01 \x01(\v2\x18.config.v1.MonthlyConfigR\amonthly\"n\n" +
+	"\fretry_policy\x18\x03 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"\x81\x02\n" +
+	"\vQuotaConfig\x12=\n" +
+	"\amonthly\x18\x02 \x03(\v2#.config.v1.QuotaConfig.MonthlyEntryR\amonthly\x127\n" +
+	"\x05daily\x18\x03 \x03(\v2!.config.v1.QuotaConfig.DailyEntryR\x05daily\x1a:\n" +
+	"\fMonthlyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"DailyEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01J\x04\b\x01\x10\x02\"n\n" +
 	"\x0eCallbackConfig\x12!\n" +
 	"\fservice_name\x18\x01 \x01(\tR\vserviceName\x129\n" +
-	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"\xd1\x02\n" +
+	"\fretry_policy\x18\x02 \x01(\v2\x16.config.v1.RetryConfigR\vretryPolicy\"7\n" +
+	"\rQuietInterval\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x8d\x01\n" +
+	"\x10QuietHoursConfig\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x126\n" +
+	"\tintervals\x18\x02 \x03(\v2\x18.config.v1.QuietIntervalR\tintervals\x12%\n" +
+	"\x0ebusiness_types\x18\x03 \x03(\x03R\rbusinessTypes\"\xa6\x01\n" +
+	"\x10FrequencyCapRule\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12#\n" +
+	"\rbusiness_type\x18\x02 \x01(\x03R\fbusinessType\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12%\n" +
+	"\x0ewindow_seconds\x18\x04 \x01(\x03R\rwindowSeconds\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\"G\n" +
+	"\x12FrequencyCapConfig\x121\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1b.config.v1.FrequencyCapRuleR\x05rules\"\xd3\x03\n" +
 	"\x0eBusinessConfig\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x1d\n" +
 	"\n" +
@@ -909,7 +1167,10 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\n" +
 	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x12,\n" +
 	"\x05quota\x18\x06 \x01(\v2\x16.config.v1.QuotaConfigR\x05quota\x12B\n" +
-	"\x0fcallback_config\x18\a \x01(\v2\x19.config.v1.CallbackConfigR\x0ecallbackConfig\"#\n" +
+	"\x0fcallback_config\x18\a \x01(\v2\x19.config.v1.CallbackConfigR\x0ecallbackConfig\x12<\n" +
+	"\vquiet_hours\x18\b \x01(\v2\x1b.config.v1.QuietHoursConfigR\n" +
+	"quietHours\x12B\n" +
+	"\rfrequency_cap\x18\t \x01(\v2\x1d.config.v1.FrequencyCapConfigR\ffrequencyCap\"#\n" +
 	"\x0fGetByIDsRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\x03R\x03ids\"\xad\x01\n" +
 	"\x10GetByIDsResponse\x12B\n" +
@@ -951,55 +1212,67 @@ func file_config_v1_config_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+	file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 	file_config_v1_config_proto_goTypes  = []any{
 		(*RetryConfig)(nil),        // 0: config.v1.RetryConfig
 		(*ChannelItem)(nil),        // 1: config.v1.ChannelItem
 		(*ChannelConfig)(nil),      // 2: config.v1.ChannelConfig
 		(*TxnConfig)(nil),          // 3: config.v1.TxnConfig
-		(*MonthlyConfig)(nil),      // 4: config.v1.MonthlyConfig
-		(*QuotaConfig)(nil),        // 5: config.v1.QuotaConfig
-		(*CallbackConfig)(nil),     // 6: config.v1.CallbackConfig
-		(*BusinessConfig)(nil),     // 7: config.v1.BusinessConfig
-		(*GetByIDsRequest)(nil),    // 8: config.v1.GetByIDsRequest
-		(*GetByIDsResponse)(nil),   // 9: config.v1.GetByIDsResponse
-		(*GetByIDRequest)(nil),     // 10: config.v1.GetByIDRequest
-		(*GetByIDResponse)(nil),    // 11: config.v1.GetByIDResponse
-		(*DeleteRequest)(nil),      // 12: config.v1.DeleteRequest
-		(*DeleteResponse)(nil),     // 13: config.v1.DeleteResponse
-		(*SaveConfigRequest)(nil),  // 14: config.v1.SaveConfigRequest
-		(*SaveConfigResponse)(nil), // 15: config.v1.SaveConfigResponse
-		nil,                        // 16: config.v1.GetByIDsResponse.ConfigsEntry
+		(*QuotaConfig)(nil),        // 4: config.v1.QuotaConfig
+		(*CallbackConfig)(nil),     // 5: config.v1.CallbackConfig
+		(*QuietInterval)(nil),      // 6: config.v1.QuietInterval
+		(*QuietHoursConfig)(nil),   // 7: config.v1.QuietHoursConfig
+		(*FrequencyCapRule)(nil),   // 8: config.v1.FrequencyCapRule
+		(*FrequencyCapConfig)(nil), // 9: config.v1.FrequencyCapConfig
+		(*BusinessConfig)(nil),     // 10: config.v1.BusinessConfig
+		(*GetByIDsRequest)(nil),    // 11: config.v1.GetByIDsRequest
+		(*GetByIDsResponse)(nil),   // 12: config.v1.GetByIDsResponse
+		(*GetByIDRequest)(nil),     // 13: config.v1.GetByIDRequest
+		(*GetByIDResponse)(nil),    // 14: config.v1.GetByIDResponse
+		(*DeleteRequest)(nil),      // 15: config.v1.DeleteRequest
+		(*DeleteResponse)(nil),     // 16: config.v1.DeleteResponse
+		(*SaveConfigRequest)(nil),  // 17: config.v1.SaveConfigRequest
+		(*SaveConfigResponse)(nil), // 18: config.v1.SaveConfigResponse
+		nil,                        // 19: config.v1.ChannelItem.TemplatesEntry
+		nil,                        // 20: config.v1.QuotaConfig.MonthlyEntry
+		nil,                        // 21: config.v1.QuotaConfig.DailyEntry
+		nil,                        // 22: config.v1.GetByIDsResponse.ConfigsEntry
 	}
 )
 
 var file_config_v1_config_proto_depIdxs = []int32{
-	1,  // 0: config.v1.ChannelConfig.channels:type_name -> config.v1.ChannelItem
-	0,  // 1: config.v1.ChannelConfig.retry_policy:type_name -> config.v1.RetryConfig
-	0,  // 2: config.v1.TxnConfig.retry_policy:type_name -> config.v1.RetryConfig
-	4,  // 3: config.v1.QuotaConfig.monthly:type_name -> config.v1.MonthlyConfig
-	0,  // 4: config.v1.CallbackConfig.retry_policy:type_name -> config.v1.RetryConfig
-	2,  // 5: config.v1.BusinessConfig.channel_config:type_name -> config.v1.ChannelConfig
-	3,  // 6: config.v1.BusinessConfig.txn_config:type_name -> config.v1.TxnConfig
-	5,  // 7: config.v1.BusinessConfig.quota:type_name -> config.v1.QuotaConfig
-	6,  // 8: config.v1.BusinessConfig.callback_config:type_name -> config.v1.CallbackConfig
-	16, // 9: config.v1.GetByIDsResponse.configs:type_name -> config.v1.GetByIDsResponse.ConfigsEntry
-	7,  // 10: config.v1.GetByIDResponse.config:type_name -> config.v1.BusinessConfig
-	7,  // 11: config.v1.SaveConfigRequest.config:type_name -> config.v1.BusinessConfig
-	7,  // 12: config.v1.GetByIDsResponse.ConfigsEntry.value:type_name -> config.v1.BusinessConfig
-	8,  // 13: config.v1.BusinessConfigService.GetByIDs:input_type -> config.v1.GetByIDsRequest
-	10, // 14: config.v1.BusinessConfigService.GetByID:input_type -> config.v1.GetByIDRequest
-	12, // 15: config.v1.BusinessConfigService.Delete:input_type -> config.v1.DeleteRequest
-	14, // 16: config.v1.BusinessConfigService.SaveConfig:input_type -> config.v1.SaveConfigRequest
-	9,  // 17: config.v1.BusinessConfigService.GetByIDs:output_type -> config.v1.GetByIDsResponse
-	11, // 18: config.v1.BusinessConfigService.GetByID:output_type -> config.v1.GetByIDResponse
-	13, // 19: config.v1.BusinessConfigService.Delete:output_type -> config.v1.DeleteResponse
-	15, // 20: config.v1.BusinessConfigService.SaveConfig:output_type -> config.v1.SaveConfigResponse
-	17, // [17:21] is the sub-list for method output_type
-	13, // [13:17] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	19, // 0: config.v1.ChannelItem.templates:type_name -> config.v1.ChannelItem.TemplatesEntry
+	1,  // 1: config.v1.ChannelConfig.channels:type_name -> config.v1.ChannelItem
+	0,  // 2: config.v1.ChannelConfig.retry_policy:type_name -> config.v1.RetryConfig
+	0,  // 3: config.v1.TxnConfig.retry_policy:type_name -> config.v1.RetryConfig
+	20, // 4: config.v1.QuotaConfig.monthly:type_name -> config.v1.QuotaConfig.MonthlyEntry
+	21, // 5: config.v1.QuotaConfig.daily:type_name -> config.v1.QuotaConfig.DailyEntry
+	0,  // 6: config.v1.CallbackConfig.retry_policy:type_name -> config.v1.RetryConfig
+	6,  // 7: config.v1.QuietHoursConfig.intervals:type_name -> config.v1.QuietInterval
+	8,  // 8: config.v1.FrequencyCapConfig.rules:type_name -> config.v1.FrequencyCapRule
+	2,  // 9: config.v1.BusinessConfig.channel_config:type_name -> config.v1.ChannelConfig
+	3,  // 10: config.v1.BusinessConfig.txn_config:type_name -> config.v1.TxnConfig
+	4,  // 11: config.v1.BusinessConfig.quota:type_name -> config.v1.QuotaConfig
+	5,  // 12: config.v1.BusinessConfig.callback_config:type_name -> config.v1.CallbackConfig
+	7,  // 13: config.v1.BusinessConfig.quiet_hours:type_name -> config.v1.QuietHoursConfig
+	9,  // 14: config.v1.BusinessConfig.frequency_cap:type_name -> config.v1.FrequencyCapConfig
+	22, // 15: config.v1.GetByIDsResponse.configs:type_name -> config.v1.GetByIDsResponse.ConfigsEntry
+	10, // 16: config.v1.GetByIDResponse.config:type_name -> config.v1.BusinessConfig
+	10, // 17: config.v1.SaveConfigRequest.config:type_name -> config.v1.BusinessConfig
+	10, // 18: config.v1.GetByIDsResponse.ConfigsEntry.value:type_name -> config.v1.BusinessConfig
+	11, // 19: config.v1.BusinessConfigService.GetByIDs:input_type -> config.v1.GetByIDsRequest
+	13, // 20: config.v1.BusinessConfigService.GetByID:input_type -> config.v1.GetByIDRequest
+	15, // 21: config.v1.BusinessConfigService.Delete:input_type -> config.v1.DeleteRequest
+	17, // 22: config.v1.BusinessConfigService.SaveConfig:input_type -> config.v1.SaveConfigRequest
+	12, // 23: config.v1.BusinessConfigService.GetByIDs:output_type -> config.v1.GetByIDsResponse
+	14, // 24: config.v1.BusinessConfigService.GetByID:output_type -> config.v1.GetByIDResponse
+	16, // 25: config.v1.BusinessConfigService.Delete:output_type -> config.v1.DeleteResponse
+	18, // 26: config.v1.BusinessConfigService.SaveConfig:output_type -> config.v1.SaveConfigResponse
+	23, // [23:27] is the sub-list for method output_type
+	19, // [19:23] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_config_proto_init() }
@@ -1013,7 +1286,7 @@ func file_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_config_proto_rawDesc), len(file_config_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -170,6 +170,8 @@ func (m *ChannelItem) validate(all bool) error {
 
 	// no validation rules for Enabled
 
+	// no validation rules for Templates
+
 	if len(errors) > 0 {
 		return ChannelItemMultiError(errors)
 	}
@@ -332,6 +334,8 @@ func (m *ChannelConfig) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Failover
+
 	if len(errors) > 0 {
 		return ChannelConfigMultiError(errors)
 	}
@@ -542,46 +546,45 @@ var _ interface {
 	ErrorName() string
 } = TxnConfigValidationError{}
 
-// Validate checks the field values on MonthlyConfig with the rules defined in
+// Validate checks the field values on QuotaConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MonthlyConfig) Validate() error {
+func (m *QuotaConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MonthlyConfig with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MonthlyConfigMultiError, or
+// ValidateAll checks the field values on QuotaConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaConfigMultiError, or
 // nil if none found.
-func (m *MonthlyConfig) ValidateAll() error {
+func (m *QuotaConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MonthlyConfig) validate(all bool) error {
+func (m *QuotaConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Sms
+	// no validation rules for Monthly
 
-	// no validation rules for Email
+	// no validation rules for Daily
 
 	if len(errors) > 0 {
-		return MonthlyConfigMultiError(errors)
+		return QuotaConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// MonthlyConfigMultiError is an error wrapping multiple validation errors
-// returned by MonthlyConfig.ValidateAll() if the designated constraints
-// aren't met.
-type MonthlyConfigMultiError []error
+// QuotaConfigMultiError is an error wrapping multiple validation errors
+// returned by QuotaConfig.ValidateAll() if the designated constraints aren't met.
+type QuotaConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MonthlyConfigMultiError) Error() string {
+func (m QuotaConfigMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -590,11 +593,11 @@ func (m MonthlyConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MonthlyConfigMultiError) AllErrors() []error { return m }
+func (m QuotaConfigMultiError) AllErrors() []error { return m }
 
-// MonthlyConfigValidationError is the validation error returned by
-// MonthlyConfig.Validate if the designated constraints aren't met.
-type MonthlyConfigValidationError struct {
+// QuotaConfigValidationError is the validation error returned by
+// QuotaConfig.Validate if the designated constraints aren't met.
+type QuotaConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -602,22 +605,22 @@ type MonthlyConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e MonthlyConfigValidationError) Field() string { return e.field }
+func (e QuotaConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MonthlyConfigValidationError) Reason() string { return e.reason }
+func (e QuotaConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MonthlyConfigValidationError) Cause() error { return e.cause }
+func (e QuotaConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MonthlyConfigValidationError) Key() bool { return e.key }
+func (e QuotaConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MonthlyConfigValidationError) ErrorName() string { return "MonthlyConfigValidationError" }
+func (e QuotaConfigValidationError) ErrorName() string { return "QuotaConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MonthlyConfigValidationError) Error() string {
+func (e QuotaConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -629,14 +632,14 @@ func (e MonthlyConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMonthlyConfig.%s: %s%s",
+		"invalid %sQuotaConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MonthlyConfigValidationError{}
+var _ error = QuotaConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -644,53 +647,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MonthlyConfigValidationError{}
+} = QuotaConfigValidationError{}
 
-// Validate checks the field values on QuotaConfig with the rules defined in
+// Validate checks the field values on CallbackConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *QuotaConfig) Validate() error {
+func (m *CallbackConfig) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QuotaConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in QuotaConfigMultiError, or
-// nil if none found.
-func (m *QuotaConfig) ValidateAll() error {
+// ValidateAll checks the field values on CallbackConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CallbackConfigMultiError,
+// or nil if none found.
+func (m *CallbackConfig) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QuotaConfig) validate(all bool) error {
+func (m *CallbackConfig) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ServiceName
+
 	if all {
-		switch v := interface{}(m.GetMonthly()).(type) {
+		switch v := interface{}(m.GetRetryPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, QuotaConfigValidationError{
-					field:  "Monthly",
+				errors = append(errors, CallbackConfigValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, QuotaConfigValidationError{
-					field:  "Monthly",
+				errors = append(errors, CallbackConfigValidationError{
+					field:  "RetryPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMonthly()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return QuotaConfigValidationError{
-				field:  "Monthly",
+			return CallbackConfigValidationError{
+				field:  "RetryPolicy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -698,18 +703,19 @@ func (m *QuotaConfig) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return QuotaConfigMultiError(errors)
+		return CallbackConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// QuotaConfigMultiError is an error wrapping multiple validation errors
-// returned by QuotaConfig.ValidateAll() if the designated constraints aren't met.
-type QuotaConfigMultiError []error
+// CallbackConfigMultiError is an error wrapping multiple validation errors
+// returned by CallbackConfig.ValidateAll() if the designated constraints
+// aren't met.
+type CallbackConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QuotaConfigMultiError) Error() string {
+func (m CallbackConfigMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -718,11 +724,11 @@ func (m QuotaConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QuotaConfigMultiError) AllErrors() []error { return m }
+func (m CallbackConfigMultiError) AllErrors() []error { return m }
 
-// QuotaConfigValidationError is the validation error returned by
-// QuotaConfig.Validate if the designated constraints aren't met.
-type QuotaConfigValidationError struct {
+// CallbackConfigValidationError is the validation error returned by
+// CallbackConfig.Validate if the designated constraints aren't met.
+type CallbackConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -730,22 +736,22 @@ type QuotaConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e QuotaConfigValidationError) Field() string { return e.field }
+func (e CallbackConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QuotaConfigValidationError) Reason() string { return e.reason }
+func (e CallbackConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QuotaConfigValidationError) Cause() error { return e.cause }
+func (e CallbackConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QuotaConfigValidationError) Key() bool { return e.key }
+func (e CallbackConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QuotaConfigValidationError) ErrorName() string { return "QuotaConfigValidationError" }
+func (e CallbackConfigValidationError) ErrorName() string { return "CallbackConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QuotaConfigValidationError) Error() string {
+func (e CallbackConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -757,14 +763,14 @@ func (e QuotaConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQuotaConfig.%s: %s%s",
+		"invalid %sCallbackConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QuotaConfigValidationError{}
+var _ error = CallbackConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -772,75 +778,428 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QuotaConfigValidationError{}
+} = CallbackConfigValidationError{}
 
-// Validate checks the field values on CallbackConfig with the rules defined in
+// Validate checks the field values on QuietInterval with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CallbackConfig) Validate() error {
+func (m *QuietInterval) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CallbackConfig with the rules defined
+// ValidateAll checks the field values on QuietInterval with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CallbackConfigMultiError,
-// or nil if none found.
-func (m *CallbackConfig) ValidateAll() error {
+// result is a list of violation errors wrapped in QuietIntervalMultiError, or
+// nil if none found.
+func (m *QuietInterval) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CallbackConfig) validate(all bool) error {
+func (m *QuietInterval) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceName
+	// no validation rules for Start
 
-	if all {
-		switch v := interface{}(m.GetRetryPolicy()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CallbackConfigValidationError{
-					field:  "RetryPolicy",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return QuietIntervalMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietIntervalMultiError is an error wrapping multiple validation errors
+// returned by QuietInterval.ValidateAll() if the designated constraints
+// aren't met.
+type QuietIntervalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietIntervalMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietIntervalMultiError) AllErrors() []error { return m }
+
+// QuietIntervalValidationError is the validation error returned by
+// QuietInterval.Validate if the designated constraints aren't met.
+type QuietIntervalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietIntervalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietIntervalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietIntervalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietIntervalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietIntervalValidationError) ErrorName() string { return "QuietIntervalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietIntervalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietInterval.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietIntervalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietIntervalValidationError{}
+
+// Validate checks the field values on QuietHoursConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QuietHoursConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietHoursConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuietHoursConfigMultiError, or nil if none found.
+func (m *QuietHoursConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuietHoursConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetIntervals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuietHoursConfigValidationError{
+						field:  fmt.Sprintf("Intervals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuietHoursConfigValidationError{
+						field:  fmt.Sprintf("Intervals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CallbackConfigValidationError{
-					field:  "RetryPolicy",
+				return QuietHoursConfigValidationError{
+					field:  fmt.Sprintf("Intervals[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CallbackConfigValidationError{
-				field:  "RetryPolicy",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	if len(errors) > 0 {
+		return QuietHoursConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietHoursConfigMultiError is an error wrapping multiple validation errors
+// returned by QuietHoursConfig.ValidateAll() if the designated constraints
+// aren't met.
+type QuietHoursConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietHoursConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietHoursConfigMultiError) AllErrors() []error { return m }
+
+// QuietHoursConfigValidationError is the validation error returned by
+// QuietHoursConfig.Validate if the designated constraints aren't met.
+type QuietHoursConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietHoursConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietHoursConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietHoursConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietHoursConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietHoursConfigValidationError) ErrorName() string { return "QuietHoursConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietHoursConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietHoursConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietHoursConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietHoursConfigValidationError{}
+
+// Validate checks the field values on FrequencyCapRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FrequencyCapRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequencyCapRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FrequencyCapRuleMultiError, or nil if none found.
+func (m *FrequencyCapRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequencyCapRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for BusinessType
+
+	// no validation rules for Limit
+
+	// no validation rules for WindowSeconds
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return FrequencyCapRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// FrequencyCapRuleMultiError is an error wrapping multiple validation errors
+// returned by FrequencyCapRule.ValidateAll() if the designated constraints
+// aren't met.
+type FrequencyCapRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FrequencyCapRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FrequencyCapRuleMultiError) AllErrors() []error { return m }
+
+// FrequencyCapRuleValidationError is the validation error returned by
+// FrequencyCapRule.Validate if the designated constraints aren't met.
+type FrequencyCapRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FrequencyCapRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FrequencyCapRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FrequencyCapRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FrequencyCapRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FrequencyCapRuleValidationError) ErrorName() string { return "FrequencyCapRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FrequencyCapRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFrequencyCapRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FrequencyCapRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FrequencyCapRuleValidationError{}
+
+// Validate checks the field values on FrequencyCapConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FrequencyCapConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FrequencyCapConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FrequencyCapConfigMultiError, or nil if none found.
+func (m *FrequencyCapConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FrequencyCapConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FrequencyCapConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FrequencyCapConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FrequencyCapConfigValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CallbackConfigMultiError(errors)
+		return FrequencyCapConfigMultiError(errors)
 	}
 
 	return nil
 }
 
-// CallbackConfigMultiError is an error wrapping multiple validation errors
-// returned by CallbackConfig.ValidateAll() if the designated constraints
+// FrequencyCapConfigMultiError is an error wrapping multiple validation errors
+// returned by FrequencyCapConfig.ValidateAll() if the designated constraints
 // aren't met.
-type CallbackConfigMultiError []error
+type FrequencyCapConfigMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CallbackConfigMultiError) Error() string {
+func (m FrequencyCapConfigMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -849,11 +1208,11 @@ func (m CallbackConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CallbackConfigMultiError) AllErrors() []error { return m }
+func (m FrequencyCapConfigMultiError) AllErrors() []error { return m }
 
-// CallbackConfigValidationError is the validation error returned by
-// CallbackConfig.Validate if the designated constraints aren't met.
-type CallbackConfigValidationError struct {
+// FrequencyCapConfigValidationError is the validation error returned by
+// FrequencyCapConfig.Validate if the designated constraints aren't met.
+type FrequencyCapConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -861,22 +1220,24 @@ type CallbackConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e CallbackConfigValidationError) Field() string { return e.field }
+func (e FrequencyCapConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CallbackConfigValidationError) Reason() string { return e.reason }
+func (e FrequencyCapConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CallbackConfigValidationError) Cause() error { return e.cause }
+func (e FrequencyCapConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CallbackConfigValidationError) Key() bool { return e.key }
+func (e FrequencyCapConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CallbackConfigValidationError) ErrorName() string { return "CallbackConfigValidationError" }
+func (e FrequencyCapConfigValidationError) ErrorName() string {
+	return "FrequencyCapConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CallbackConfigValidationError) Error() string {
+func (e FrequencyCapConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -888,14 +1249,14 @@ func (e CallbackConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCallbackConfig.%s: %s%s",
+		"invalid %sFrequencyCapConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CallbackConfigValidationError{}
+var _ error = FrequencyCapConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -903,7 +1264,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CallbackConfigValidationError{}
+} = FrequencyCapConfigValidationError{}
 
 // Validate checks the field values on BusinessConfig with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -1049,6 +1410,64 @@ func (m *BusinessConfig) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrequencyCap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "FrequencyCap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BusinessConfigValidationError{
+					field:  "FrequencyCap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrequencyCap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BusinessConfigValidationError{
+				field:  "FrequencyCap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BusinessConfigMultiError(errors)
 	}
@@ -0,0 +1,658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: config/v1/quota.proto
+
+package configv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Quota represents the remaining quota of a business on a channel
+type Quota struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BizId   int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Channel string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Quota   int32                  `protobuf:"varint,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// 0 means unlimited
+	DailyLimit    int32 `protobuf:"varint,4,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	DailyUsed     int32 `protobuf:"varint,5,opt,name=daily_used,json=dailyUsed,proto3" json:"daily_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_config_v1_quota_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quota) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *Quota) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Quota) GetQuota() int32 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *Quota) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *Quota) GetDailyUsed() int32 {
+	if x != nil {
+		return x.DailyUsed
+	}
+	return 0
+}
+
+// QuotaLedger represents an append-only record of a quota change
+type QuotaLedger struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId   int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Channel string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// RESET or ADJUST
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// positive for top-ups, negative for deductions
+	Delta int32 `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	// quota after the change
+	Balance       int32  `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator      string `protobuf:"bytes,8,opt,name=operator,proto3" json:"operator,omitempty"`
+	Ctime         int64  `protobuf:"varint,9,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaLedger) Reset() {
+	*x = QuotaLedger{}
+	mi := &file_config_v1_quota_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaLedger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaLedger) ProtoMessage() {}
+
+func (x *QuotaLedger) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaLedger.ProtoReflect.Descriptor instead.
+func (*QuotaLedger) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuotaLedger) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuotaLedger) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *QuotaLedger) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *QuotaLedger) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *QuotaLedger) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *QuotaLedger) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *QuotaLedger) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QuotaLedger) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *QuotaLedger) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// GetQuotaRequest represents the request for GetQuota method
+type GetQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaRequest) Reset() {
+	*x = GetQuotaRequest{}
+	mi := &file_config_v1_quota_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaRequest) ProtoMessage() {}
+
+func (x *GetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetQuotaRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// GetQuotaResponse represents the response for GetQuota method
+type GetQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *Quota                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaResponse) Reset() {
+	*x = GetQuotaResponse{}
+	mi := &file_config_v1_quota_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaResponse) ProtoMessage() {}
+
+func (x *GetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetQuotaResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// AdjustQuotaRequest represents the request for AdjustQuota method
+type AdjustQuotaRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BizId   int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Channel string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// positive for top-ups, negative for deductions
+	Delta         int32  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator      string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaRequest) Reset() {
+	*x = AdjustQuotaRequest{}
+	mi := &file_config_v1_quota_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaRequest) ProtoMessage() {}
+
+func (x *AdjustQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaRequest.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdjustQuotaRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustQuotaRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdjustQuotaRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// AdjustQuotaResponse represents the response for AdjustQuota method
+type AdjustQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *Quota                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustQuotaResponse) Reset() {
+	*x = AdjustQuotaResponse{}
+	mi := &file_config_v1_quota_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustQuotaResponse) ProtoMessage() {}
+
+func (x *AdjustQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustQuotaResponse.ProtoReflect.Descriptor instead.
+func (*AdjustQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AdjustQuotaResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// ListQuotaLedgerRequest represents the request for ListQuotaLedger method
+type ListQuotaLedgerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for all channels
+	Channel       string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Offset        int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaLedgerRequest) Reset() {
+	*x = ListQuotaLedgerRequest{}
+	mi := &file_config_v1_quota_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaLedgerRequest) ProtoMessage() {}
+
+func (x *ListQuotaLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ListQuotaLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListQuotaLedgerRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ListQuotaLedgerRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListQuotaLedgerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListQuotaLedgerResponse represents the response for ListQuotaLedger method
+type ListQuotaLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ledgers       []*QuotaLedger         `protobuf:"bytes,1,rep,name=ledgers,proto3" json:"ledgers,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotaLedgerResponse) Reset() {
+	*x = ListQuotaLedgerResponse{}
+	mi := &file_config_v1_quota_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotaLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotaLedgerResponse) ProtoMessage() {}
+
+func (x *ListQuotaLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_quota_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotaLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ListQuotaLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_quota_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListQuotaLedgerResponse) GetLedgers() []*QuotaLedger {
+	if x != nil {
+		return x.Ledgers
+	}
+	return nil
+}
+
+func (x *ListQuotaLedgerResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_config_v1_quota_proto protoreflect.FileDescriptor
+
+const file_config_v1_quota_proto_rawDesc = "" +
+	"\n" +
+	"\x15config/v1/quota.proto\x12\tconfig.v1\"\x8e\x01\n" +
+	"\x05Quota\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x14\n" +
+	"\x05quota\x18\x03 \x01(\x05R\x05quota\x12\x1f\n" +
+	"\vdaily_limit\x18\x04 \x01(\x05R\n" +
+	"dailyLimit\x12\x1d\n" +
+	"\n" +
+	"daily_used\x18\x05 \x01(\x05R\tdailyUsed\"\xdc\x01\n" +
+	"\vQuotaLedger\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06biz_id\x18\x02 \x01(\x03R\x05bizId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x05R\x05delta\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\x05R\abalance\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1a\n" +
+	"\boperator\x18\b \x01(\tR\boperator\x12\x14\n" +
+	"\x05ctime\x18\t \x01(\x03R\x05ctime\"+\n" +
+	"\x0fGetQuotaRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\":\n" +
+	"\x10GetQuotaResponse\x12&\n" +
+	"\x05quota\x18\x01 \x01(\v2\x10.config.v1.QuotaR\x05quota\"\x8f\x01\n" +
+	"\x12AdjustQuotaRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\"=\n" +
+	"\x13AdjustQuotaResponse\x12&\n" +
+	"\x05quota\x18\x01 \x01(\v2\x10.config.v1.QuotaR\x05quota\"`\n" +
+	"\x16ListQuotaLedgerRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
+	"\x17ListQuotaLedgerResponse\x120\n" +
+	"\aledgers\x18\x01 \x03(\v2\x16.config.v1.QuotaLedgerR\aledgers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\x81\x02\n" +
+	"\fQuotaService\x12E\n" +
+	"\bGetQuota\x12\x1a.config.v1.GetQuotaRequest\x1a\x1b.config.v1.GetQuotaResponse\"\x00\x12N\n" +
+	"\vAdjustQuota\x12\x1d.config.v1.AdjustQuotaRequest\x1a\x1e.config.v1.AdjustQuotaResponse\"\x00\x12Z\n" +
+	"\x0fListQuotaLedger\x12!.config.v1.ListQuotaLedgerRequest\x1a\".config.v1.ListQuotaLedgerResponse\"\x00B\xaa\x01\n" +
+	"\rcom.config.v1B\n" +
+	"QuotaProtoP\x01ZHgitee.com/flycash/notification-platform/api/proto/gen/config/v1;configv1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
+	"Config::V1b\x06proto3"
+
+var (
+	file_config_v1_quota_proto_rawDescOnce sync.Once
+	file_config_v1_quota_proto_rawDescData []byte
+)
+
+func file_config_v1_quota_proto_rawDescGZIP() []byte {
+	file_config_v1_quota_proto_rawDescOnce.Do(func() {
+		file_config_v1_quota_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_quota_proto_rawDesc), len(file_config_v1_quota_proto_rawDesc)))
+	})
+	return file_config_v1_quota_proto_rawDescData
+}
+
+var (
+	file_config_v1_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+	file_config_v1_quota_proto_goTypes  = []any{
+		(*Quota)(nil),                   // 0: config.v1.Quota
+		(*QuotaLedger)(nil),             // 1: config.v1.QuotaLedger
+		(*GetQuotaRequest)(nil),         // 2: config.v1.GetQuotaRequest
+		(*GetQuotaResponse)(nil),        // 3: config.v1.GetQuotaResponse
+		(*AdjustQuotaRequest)(nil),      // 4: config.v1.AdjustQuotaRequest
+		(*AdjustQuotaResponse)(nil),     // 5: config.v1.AdjustQuotaResponse
+		(*ListQuotaLedgerRequest)(nil),  // 6: config.v1.ListQuotaLedgerRequest
+		(*ListQuotaLedgerResponse)(nil), // 7: config.v1.ListQuotaLedgerResponse
+	}
+)
+
+var file_config_v1_quota_proto_depIdxs = []int32{
+	0, // 0: config.v1.GetQuotaResponse.quota:type_name -> config.v1.Quota
+	0, // 1: config.v1.AdjustQuotaResponse.quota:type_name -> config.v1.Quota
+	1, // 2: config.v1.ListQuotaLedgerResponse.ledgers:type_name -> config.v1.QuotaLedger
+	2, // 3: config.v1.QuotaService.GetQuota:input_type -> config.v1.GetQuotaRequest
+	4, // 4: config.v1.QuotaService.AdjustQuota:input_type -> config.v1.AdjustQuotaRequest
+	6, // 5: config.v1.QuotaService.ListQuotaLedger:input_type -> config.v1.ListQuotaLedgerRequest
+	3, // 6: config.v1.QuotaService.GetQuota:output_type -> config.v1.GetQuotaResponse
+	5, // 7: config.v1.QuotaService.AdjustQuota:output_type -> config.v1.AdjustQuotaResponse
+	7, // 8: config.v1.QuotaService.ListQuotaLedger:output_type -> config.v1.ListQuotaLedgerResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_quota_proto_init() }
+func file_config_v1_quota_proto_init() {
+	if File_config_v1_quota_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_quota_proto_rawDesc), len(file_config_v1_quota_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_config_v1_quota_proto_goTypes,
+		DependencyIndexes: file_config_v1_quota_proto_depIdxs,
+		MessageInfos:      file_config_v1_quota_proto_msgTypes,
+	}.Build()
+	File_config_v1_quota_proto = out.File
+	file_config_v1_quota_proto_goTypes = nil
+	file_config_v1_quota_proto_depIdxs = nil
+}
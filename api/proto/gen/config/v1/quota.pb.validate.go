@@ -0,0 +1,981 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: config/v1/quota.proto
+
+package configv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Quota with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Quota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Quota with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QuotaMultiError, or nil if none found.
+func (m *Quota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Quota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Channel
+
+	// no validation rules for Quota
+
+	// no validation rules for DailyLimit
+
+	// no validation rules for DailyUsed
+
+	if len(errors) > 0 {
+		return QuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaMultiError is an error wrapping multiple validation errors returned by
+// Quota.ValidateAll() if the designated constraints aren't met.
+type QuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaMultiError) AllErrors() []error { return m }
+
+// QuotaValidationError is the validation error returned by Quota.Validate if
+// the designated constraints aren't met.
+type QuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaValidationError) ErrorName() string { return "QuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaValidationError{}
+
+// Validate checks the field values on QuotaLedger with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaLedger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaLedger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaLedgerMultiError, or
+// nil if none found.
+func (m *QuotaLedger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaLedger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BizId
+
+	// no validation rules for Channel
+
+	// no validation rules for Type
+
+	// no validation rules for Delta
+
+	// no validation rules for Balance
+
+	// no validation rules for Reason
+
+	// no validation rules for Operator
+
+	// no validation rules for Ctime
+
+	if len(errors) > 0 {
+		return QuotaLedgerMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaLedgerMultiError is an error wrapping multiple validation errors
+// returned by QuotaLedger.ValidateAll() if the designated constraints aren't met.
+type QuotaLedgerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaLedgerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaLedgerMultiError) AllErrors() []error { return m }
+
+// QuotaLedgerValidationError is the validation error returned by
+// QuotaLedger.Validate if the designated constraints aren't met.
+type QuotaLedgerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaLedgerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaLedgerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaLedgerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaLedgerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaLedgerValidationError) ErrorName() string { return "QuotaLedgerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaLedgerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaLedger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaLedgerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaLedgerValidationError{}
+
+// Validate checks the field values on GetQuotaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaRequestMultiError, or nil if none found.
+func (m *GetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	if len(errors) > 0 {
+		return GetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// GetQuotaRequestValidationError is the validation error returned by
+// GetQuotaRequest.Validate if the designated constraints aren't met.
+type GetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaRequestValidationError) ErrorName() string { return "GetQuotaRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaRequestValidationError{}
+
+// Validate checks the field values on GetQuotaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuotaResponseMultiError, or nil if none found.
+func (m *GetQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuotaResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQuotaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuotaResponseMultiError) AllErrors() []error { return m }
+
+// GetQuotaResponseValidationError is the validation error returned by
+// GetQuotaResponse.Validate if the designated constraints aren't met.
+type GetQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuotaResponseValidationError) ErrorName() string { return "GetQuotaResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuotaResponseValidationError{}
+
+// Validate checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaRequestMultiError, or nil if none found.
+func (m *AdjustQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for Channel
+
+	// no validation rules for Delta
+
+	// no validation rules for Reason
+
+	// no validation rules for Operator
+
+	if len(errors) > 0 {
+		return AdjustQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustQuotaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaRequestMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaRequestValidationError is the validation error returned by
+// AdjustQuotaRequest.Validate if the designated constraints aren't met.
+type AdjustQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaRequestValidationError) ErrorName() string {
+	return "AdjustQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaRequestValidationError{}
+
+// Validate checks the field values on AdjustQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustQuotaResponseMultiError, or nil if none found.
+func (m *AdjustQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdjustQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdjustQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdjustQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdjustQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by AdjustQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustQuotaResponseMultiError) AllErrors() []error { return m }
+
+// AdjustQuotaResponseValidationError is the validation error returned by
+// AdjustQuotaResponse.Validate if the designated constraints aren't met.
+type AdjustQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustQuotaResponseValidationError) ErrorName() string {
+	return "AdjustQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustQuotaResponseValidationError{}
+
+// Validate checks the field values on ListQuotaLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaLedgerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaLedgerRequestMultiError, or nil if none found.
+func (m *ListQuotaLedgerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaLedgerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListQuotaLedgerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaLedgerRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaLedgerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaLedgerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaLedgerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaLedgerRequestMultiError) AllErrors() []error { return m }
+
+// ListQuotaLedgerRequestValidationError is the validation error returned by
+// ListQuotaLedgerRequest.Validate if the designated constraints aren't met.
+type ListQuotaLedgerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaLedgerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaLedgerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaLedgerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaLedgerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaLedgerRequestValidationError) ErrorName() string {
+	return "ListQuotaLedgerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaLedgerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaLedgerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaLedgerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaLedgerRequestValidationError{}
+
+// Validate checks the field values on ListQuotaLedgerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQuotaLedgerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQuotaLedgerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQuotaLedgerResponseMultiError, or nil if none found.
+func (m *ListQuotaLedgerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQuotaLedgerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLedgers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQuotaLedgerResponseValidationError{
+						field:  fmt.Sprintf("Ledgers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQuotaLedgerResponseValidationError{
+						field:  fmt.Sprintf("Ledgers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQuotaLedgerResponseValidationError{
+					field:  fmt.Sprintf("Ledgers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListQuotaLedgerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQuotaLedgerResponseMultiError is an error wrapping multiple validation
+// errors returned by ListQuotaLedgerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListQuotaLedgerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQuotaLedgerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQuotaLedgerResponseMultiError) AllErrors() []error { return m }
+
+// ListQuotaLedgerResponseValidationError is the validation error returned by
+// ListQuotaLedgerResponse.Validate if the designated constraints aren't met.
+type ListQuotaLedgerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQuotaLedgerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQuotaLedgerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQuotaLedgerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQuotaLedgerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQuotaLedgerResponseValidationError) ErrorName() string {
+	return "ListQuotaLedgerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQuotaLedgerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQuotaLedgerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListQuotaLedgerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQuotaLedgerResponseValidationError{}
@@ -0,0 +1,541 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: config/v1/suppression.proto
+
+package configv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Suppression represents a receiver who has unsubscribed from notifications
+type Suppression struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Receiver string                 `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// empty for all channels
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 0 for all business types, 1 promotion, 2 notification, 3 verification code
+	BusinessType  int64  `protobuf:"varint,4,opt,name=business_type,json=businessType,proto3" json:"business_type,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Ctime         int64  `protobuf:"varint,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Utime         int64  `protobuf:"varint,7,opt,name=utime,proto3" json:"utime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suppression) Reset() {
+	*x = Suppression{}
+	mi := &file_config_v1_suppression_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suppression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suppression) ProtoMessage() {}
+
+func (x *Suppression) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suppression.ProtoReflect.Descriptor instead.
+func (*Suppression) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Suppression) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Suppression) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Suppression) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Suppression) GetBusinessType() int64 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+func (x *Suppression) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Suppression) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Suppression) GetUtime() int64 {
+	if x != nil {
+		return x.Utime
+	}
+	return 0
+}
+
+// AddSuppressionRequest represents the request for AddSuppression method
+type AddSuppressionRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Receiver string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// empty for all channels
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// 0 for all business types
+	BusinessType  int64  `protobuf:"varint,3,opt,name=business_type,json=businessType,proto3" json:"business_type,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSuppressionRequest) Reset() {
+	*x = AddSuppressionRequest{}
+	mi := &file_config_v1_suppression_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSuppressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSuppressionRequest) ProtoMessage() {}
+
+func (x *AddSuppressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSuppressionRequest.ProtoReflect.Descriptor instead.
+func (*AddSuppressionRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddSuppressionRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *AddSuppressionRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AddSuppressionRequest) GetBusinessType() int64 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+func (x *AddSuppressionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// AddSuppressionResponse represents the response for AddSuppression method
+type AddSuppressionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddSuppressionResponse) Reset() {
+	*x = AddSuppressionResponse{}
+	mi := &file_config_v1_suppression_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddSuppressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSuppressionResponse) ProtoMessage() {}
+
+func (x *AddSuppressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSuppressionResponse.ProtoReflect.Descriptor instead.
+func (*AddSuppressionResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{2}
+}
+
+// RemoveSuppressionRequest represents the request for RemoveSuppression method
+type RemoveSuppressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receiver      string                 `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	BusinessType  int64                  `protobuf:"varint,3,opt,name=business_type,json=businessType,proto3" json:"business_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSuppressionRequest) Reset() {
+	*x = RemoveSuppressionRequest{}
+	mi := &file_config_v1_suppression_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSuppressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSuppressionRequest) ProtoMessage() {}
+
+func (x *RemoveSuppressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSuppressionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSuppressionRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveSuppressionRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *RemoveSuppressionRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RemoveSuppressionRequest) GetBusinessType() int64 {
+	if x != nil {
+		return x.BusinessType
+	}
+	return 0
+}
+
+// RemoveSuppressionResponse represents the response for RemoveSuppression method
+type RemoveSuppressionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSuppressionResponse) Reset() {
+	*x = RemoveSuppressionResponse{}
+	mi := &file_config_v1_suppression_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSuppressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSuppressionResponse) ProtoMessage() {}
+
+func (x *RemoveSuppressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSuppressionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSuppressionResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{4}
+}
+
+// ListSuppressionsRequest represents the request for ListSuppressions method
+type ListSuppressionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for all receivers
+	Receiver      string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Offset        int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppressionsRequest) Reset() {
+	*x = ListSuppressionsRequest{}
+	mi := &file_config_v1_suppression_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppressionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppressionsRequest) ProtoMessage() {}
+
+func (x *ListSuppressionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppressionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppressionsRequest) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSuppressionsRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListSuppressionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListSuppressionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListSuppressionsResponse represents the response for ListSuppressions method
+type ListSuppressionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppressions  []*Suppression         `protobuf:"bytes,1,rep,name=suppressions,proto3" json:"suppressions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppressionsResponse) Reset() {
+	*x = ListSuppressionsResponse{}
+	mi := &file_config_v1_suppression_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppressionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppressionsResponse) ProtoMessage() {}
+
+func (x *ListSuppressionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_suppression_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppressionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppressionsResponse) Descriptor() ([]byte, []int) {
+	return file_config_v1_suppression_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSuppressionsResponse) GetSuppressions() []*Suppression {
+	if x != nil {
+		return x.Suppressions
+	}
+	return nil
+}
+
+func (x *ListSuppressionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_config_v1_suppression_proto protoreflect.FileDescriptor
+
+const file_config_v1_suppression_proto_rawDesc = "" +
+	"\n" +
+	"\x1bconfig/v1/suppression.proto\x12\tconfig.v1\"\xbc\x01\n" +
+	"\vSuppression\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\breceiver\x18\x02 \x01(\tR\breceiver\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12#\n" +
+	"\rbusiness_type\x18\x04 \x01(\x03R\fbusinessType\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05ctime\x18\x06 \x01(\x03R\x05ctime\x12\x14\n" +
+	"\x05utime\x18\a \x01(\x03R\x05utime\"\x8a\x01\n" +
+	"\x15AddSuppressionRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12#\n" +
+	"\rbusiness_type\x18\x03 \x01(\x03R\fbusinessType\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x18\n" +
+	"\x16AddSuppressionResponse\"u\n" +
+	"\x18RemoveSuppressionRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12#\n" +
+	"\rbusiness_type\x18\x03 \x01(\x03R\fbusinessType\"\x1b\n" +
+	"\x19RemoveSuppressionResponse\"c\n" +
+	"\x17ListSuppressionsRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"l\n" +
+	"\x18ListSuppressionsResponse\x12:\n" +
+	"\fsuppressions\x18\x01 \x03(\v2\x16.config.v1.SuppressionR\fsuppressions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xae\x02\n" +
+	"\x12SuppressionService\x12W\n" +
+	"\x0eAddSuppression\x12 .config.v1.AddSuppressionRequest\x1a!.config.v1.AddSuppressionResponse\"\x00\x12`\n" +
+	"\x11RemoveSuppression\x12#.config.v1.RemoveSuppressionRequest\x1a$.config.v1.RemoveSuppressionResponse\"\x00\x12]\n" +
+	"\x10ListSuppressions\x12\".config.v1.ListSuppressionsRequest\x1a#.config.v1.ListSuppressionsResponse\"\x00B\xb0\x01\n" +
+	"\rcom.config.v1B\x10SuppressionProtoP\x01ZHgitee.com/flycash/notification-platform/api/proto/gen/config/v1;configv1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
+	"Config::V1b\x06proto3"
+
+var (
+	file_config_v1_suppression_proto_rawDescOnce sync.Once
+	file_config_v1_suppression_proto_rawDescData []byte
+)
+
+func file_config_v1_suppression_proto_rawDescGZIP() []byte {
+	file_config_v1_suppression_proto_rawDescOnce.Do(func() {
+		file_config_v1_suppression_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_config_v1_suppression_proto_rawDesc), len(file_config_v1_suppression_proto_rawDesc)))
+	})
+	return file_config_v1_suppression_proto_rawDescData
+}
+
+var (
+	file_config_v1_suppression_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+	file_config_v1_suppression_proto_goTypes  = []any{
+		(*Suppression)(nil),               // 0: config.v1.Suppression
+		(*AddSuppressionRequest)(nil),     // 1: config.v1.AddSuppressionRequest
+		(*AddSuppressionResponse)(nil),    // 2: config.v1.AddSuppressionResponse
+		(*RemoveSuppressionRequest)(nil),  // 3: config.v1.RemoveSuppressionRequest
+		(*RemoveSuppressionResponse)(nil), // 4: config.v1.RemoveSuppressionResponse
+		(*ListSuppressionsRequest)(nil),   // 5: config.v1.ListSuppressionsRequest
+		(*ListSuppressionsResponse)(nil),  // 6: config.v1.ListSuppressionsResponse
+	}
+)
+
+var file_config_v1_suppression_proto_depIdxs = []int32{
+	0, // 0: config.v1.ListSuppressionsResponse.suppressions:type_name -> config.v1.Suppression
+	1, // 1: config.v1.SuppressionService.AddSuppression:input_type -> config.v1.AddSuppressionRequest
+	3, // 2: config.v1.SuppressionService.RemoveSuppression:input_type -> config.v1.RemoveSuppressionRequest
+	5, // 3: config.v1.SuppressionService.ListSuppressions:input_type -> config.v1.ListSuppressionsRequest
+	2, // 4: config.v1.SuppressionService.AddSuppression:output_type -> config.v1.AddSuppressionResponse
+	4, // 5: config.v1.SuppressionService.RemoveSuppression:output_type -> config.v1.RemoveSuppressionResponse
+	6, // 6: config.v1.SuppressionService.ListSuppressions:output_type -> config.v1.ListSuppressionsResponse
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_v1_suppression_proto_init() }
+func file_config_v1_suppression_proto_init() {
+	if File_config_v1_suppression_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_suppression_proto_rawDesc), len(file_config_v1_suppression_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_config_v1_suppression_proto_goTypes,
+		DependencyIndexes: file_config_v1_suppression_proto_depIdxs,
+		MessageInfos:      file_config_v1_suppression_proto_msgTypes,
+	}.Build()
+	File_config_v1_suppression_proto = out.File
+	file_config_v1_suppression_proto_goTypes = nil
+	file_config_v1_suppression_proto_depIdxs = nil
+}
@@ -0,0 +1,817 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: config/v1/suppression.proto
+
+package configv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Suppression with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Suppression) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Suppression with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SuppressionMultiError, or
+// nil if none found.
+func (m *Suppression) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Suppression) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Receiver
+
+	// no validation rules for Channel
+
+	// no validation rules for BusinessType
+
+	// no validation rules for Reason
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	if len(errors) > 0 {
+		return SuppressionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuppressionMultiError is an error wrapping multiple validation errors
+// returned by Suppression.ValidateAll() if the designated constraints aren't met.
+type SuppressionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuppressionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuppressionMultiError) AllErrors() []error { return m }
+
+// SuppressionValidationError is the validation error returned by
+// Suppression.Validate if the designated constraints aren't met.
+type SuppressionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuppressionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuppressionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuppressionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuppressionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuppressionValidationError) ErrorName() string { return "SuppressionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SuppressionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuppression.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuppressionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuppressionValidationError{}
+
+// Validate checks the field values on AddSuppressionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSuppressionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSuppressionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddSuppressionRequestMultiError, or nil if none found.
+func (m *AddSuppressionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSuppressionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Channel
+
+	// no validation rules for BusinessType
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return AddSuppressionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSuppressionRequestMultiError is an error wrapping multiple validation
+// errors returned by AddSuppressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddSuppressionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSuppressionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSuppressionRequestMultiError) AllErrors() []error { return m }
+
+// AddSuppressionRequestValidationError is the validation error returned by
+// AddSuppressionRequest.Validate if the designated constraints aren't met.
+type AddSuppressionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSuppressionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSuppressionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSuppressionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSuppressionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSuppressionRequestValidationError) ErrorName() string {
+	return "AddSuppressionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSuppressionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSuppressionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSuppressionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSuppressionRequestValidationError{}
+
+// Validate checks the field values on AddSuppressionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddSuppressionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddSuppressionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddSuppressionResponseMultiError, or nil if none found.
+func (m *AddSuppressionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddSuppressionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AddSuppressionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddSuppressionResponseMultiError is an error wrapping multiple validation
+// errors returned by AddSuppressionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddSuppressionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddSuppressionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddSuppressionResponseMultiError) AllErrors() []error { return m }
+
+// AddSuppressionResponseValidationError is the validation error returned by
+// AddSuppressionResponse.Validate if the designated constraints aren't met.
+type AddSuppressionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddSuppressionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddSuppressionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddSuppressionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddSuppressionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddSuppressionResponseValidationError) ErrorName() string {
+	return "AddSuppressionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddSuppressionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddSuppressionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddSuppressionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddSuppressionResponseValidationError{}
+
+// Validate checks the field values on RemoveSuppressionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveSuppressionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveSuppressionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveSuppressionRequestMultiError, or nil if none found.
+func (m *RemoveSuppressionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveSuppressionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Channel
+
+	// no validation rules for BusinessType
+
+	if len(errors) > 0 {
+		return RemoveSuppressionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveSuppressionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveSuppressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveSuppressionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveSuppressionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveSuppressionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveSuppressionRequestValidationError is the validation error returned by
+// RemoveSuppressionRequest.Validate if the designated constraints aren't met.
+type RemoveSuppressionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveSuppressionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveSuppressionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveSuppressionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveSuppressionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveSuppressionRequestValidationError) ErrorName() string {
+	return "RemoveSuppressionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveSuppressionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveSuppressionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveSuppressionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveSuppressionRequestValidationError{}
+
+// Validate checks the field values on RemoveSuppressionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveSuppressionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveSuppressionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveSuppressionResponseMultiError, or nil if none found.
+func (m *RemoveSuppressionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveSuppressionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveSuppressionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveSuppressionResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveSuppressionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RemoveSuppressionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveSuppressionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveSuppressionResponseMultiError) AllErrors() []error { return m }
+
+// RemoveSuppressionResponseValidationError is the validation error returned by
+// RemoveSuppressionResponse.Validate if the designated constraints aren't met.
+type RemoveSuppressionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveSuppressionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveSuppressionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveSuppressionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveSuppressionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveSuppressionResponseValidationError) ErrorName() string {
+	return "RemoveSuppressionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveSuppressionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveSuppressionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveSuppressionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveSuppressionResponseValidationError{}
+
+// Validate checks the field values on ListSuppressionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuppressionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuppressionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuppressionsRequestMultiError, or nil if none found.
+func (m *ListSuppressionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuppressionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListSuppressionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuppressionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSuppressionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuppressionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuppressionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuppressionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSuppressionsRequestValidationError is the validation error returned by
+// ListSuppressionsRequest.Validate if the designated constraints aren't met.
+type ListSuppressionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuppressionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuppressionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuppressionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuppressionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuppressionsRequestValidationError) ErrorName() string {
+	return "ListSuppressionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuppressionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuppressionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuppressionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuppressionsRequestValidationError{}
+
+// Validate checks the field values on ListSuppressionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSuppressionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSuppressionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSuppressionsResponseMultiError, or nil if none found.
+func (m *ListSuppressionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSuppressionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSuppressions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSuppressionsResponseValidationError{
+						field:  fmt.Sprintf("Suppressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSuppressionsResponseValidationError{
+						field:  fmt.Sprintf("Suppressions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSuppressionsResponseValidationError{
+					field:  fmt.Sprintf("Suppressions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListSuppressionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSuppressionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSuppressionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSuppressionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSuppressionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSuppressionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSuppressionsResponseValidationError is the validation error returned by
+// ListSuppressionsResponse.Validate if the designated constraints aren't met.
+type ListSuppressionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSuppressionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSuppressionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSuppressionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSuppressionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSuppressionsResponseValidationError) ErrorName() string {
+	return "ListSuppressionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSuppressionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSuppressionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSuppressionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSuppressionsResponseValidationError{}
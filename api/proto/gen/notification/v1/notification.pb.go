@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -96,17 +97,32 @@ const (
 	SendStatus_SUCCEEDED SendStatus = 4
 	// 发送失败
 	SendStatus_FAILED SendStatus = 5
+	// 部分接收者发送成功
+	SendStatus_PARTIAL_SUCCESS SendStatus = 6
+	// 供应商回执确认所有接收者均已送达
+	SendStatus_DELIVERED SendStatus = 7
+	// 供应商回执确认所有接收者均未送达
+	SendStatus_UNDELIVERED SendStatus = 8
+	// 发送失败，等待按渠道重试策略重试
+	SendStatus_RETRYING SendStatus = 9
+	// 接收者在退订名单中，没有发送
+	SendStatus_SUPPRESSED SendStatus = 10
 )
 
 // Enum value maps for SendStatus.
 var (
 	SendStatus_name = map[int32]string{
-		0: "SEND_STATUS_UNSPECIFIED",
-		1: "PREPARE",
-		2: "CANCELED",
-		3: "PENDING",
-		4: "SUCCEEDED",
-		5: "FAILED",
+		0:  "SEND_STATUS_UNSPECIFIED",
+		1:  "PREPARE",
+		2:  "CANCELED",
+		3:  "PENDING",
+		4:  "SUCCEEDED",
+		5:  "FAILED",
+		6:  "PARTIAL_SUCCESS",
+		7:  "DELIVERED",
+		8:  "UNDELIVERED",
+		9:  "RETRYING",
+		10: "SUPPRESSED",
 	}
 	SendStatus_value = map[string]int32{
 		"SEND_STATUS_UNSPECIFIED": 0,
@@ -115,6 +131,11 @@ var (
 		"PENDING":                 3,
 		"SUCCEEDED":               4,
 		"FAILED":                  5,
+		"PARTIAL_SUCCESS":         6,
+		"DELIVERED":               7,
+		"UNDELIVERED":             8,
+		"RETRYING":                9,
+		"SUPPRESSED":              10,
 	}
 )
 
@@ -183,6 +204,24 @@ const (
 	ErrorCode_PROVIDER_NOT_FOUND ErrorCode = 15
 	// 未知渠道类型
 	ErrorCode_UNKNOWN_CHANNEL ErrorCode = 16
+	// 通知已经开始发送或者已经结束，不能取消
+	ErrorCode_NOTIFICATION_NOT_CANCELABLE ErrorCode = 17
+	// 通知已经开始发送或者已经结束，不能修改
+	ErrorCode_NOTIFICATION_NOT_EDITABLE ErrorCode = 18
+	// 模板没有通过内部审核或者供应商审核
+	ErrorCode_TEMPLATE_NOT_APPROVED ErrorCode = 19
+	// 供应商限流，稍后重试可以成功
+	ErrorCode_PROVIDER_THROTTLED ErrorCode = 20
+	// 供应商故障，例如余额不足、签名不可用、接口异常
+	ErrorCode_PROVIDER_FAULT ErrorCode = 21
+	// 接收者无效，例如空号
+	ErrorCode_RECEIVER_INVALID ErrorCode = 22
+	// 接收者都已经退订
+	ErrorCode_RECEIVER_SUPPRESSED ErrorCode = 23
+	// 已经超过计划发送结束时间，不再发送
+	ErrorCode_DEADLINE_PASSED ErrorCode = 24
+	// 接收者超过了发送频率上限
+	ErrorCode_FREQUENCY_CAPPED ErrorCode = 25
 )
 
 // Enum value maps for ErrorCode.
@@ -205,25 +244,43 @@ var (
 		14: "QUOTA_NOT_FOUND",
 		15: "PROVIDER_NOT_FOUND",
 		16: "UNKNOWN_CHANNEL",
+		17: "NOTIFICATION_NOT_CANCELABLE",
+		18: "NOTIFICATION_NOT_EDITABLE",
+		19: "TEMPLATE_NOT_APPROVED",
+		20: "PROVIDER_THROTTLED",
+		21: "PROVIDER_FAULT",
+		22: "RECEIVER_INVALID",
+		23: "RECEIVER_SUPPRESSED",
+		24: "DEADLINE_PASSED",
+		25: "FREQUENCY_CAPPED",
 	}
 	ErrorCode_value = map[string]int32{
-		"ERROR_CODE_UNSPECIFIED":     0,
-		"INVALID_PARAMETER":          1,
-		"RATE_LIMITED":               2,
-		"TEMPLATE_NOT_FOUND":         3,
-		"CHANNEL_DISABLED":           4,
-		"CREATE_NOTIFICATION_FAILED": 5,
-		"BIZ_ID_NOT_FOUND":           6,
-		"NOTIFICATION_NOT_FOUND":     7,
-		"NO_AVAILABLE_PROVIDER":      8,
-		"NO_AVAILABLE_CHANNEL":       9,
-		"SEND_NOTIFICATION_FAILED":   10,
-		"CONFIG_NOT_FOUND":           11,
-		"NO_QUOTA_CONFIG":            12,
-		"NO_QUOTA":                   13,
-		"QUOTA_NOT_FOUND":            14,
-		"PROVIDER_NOT_FOUND":         15,
-		"UNKNOWN_CHANNEL":            16,
+		"ERROR_CODE_UNSPECIFIED":      0,
+		"INVALID_PARAMETER":           1,
+		"RATE_LIMITED":                2,
+		"TEMPLATE_NOT_FOUND":          3,
+		"CHANNEL_DISABLED":            4,
+		"CREATE_NOTIFICATION_FAILED":  5,
+		"BIZ_ID_NOT_FOUND":            6,
+		"NOTIFICATION_NOT_FOUND":      7,
+		"NO_AVAILABLE_PROVIDER":       8,
+		"NO_AVAILABLE_CHANNEL":        9,
+		"SEND_NOTIFICATION_FAILED":    10,
+		"CONFIG_NOT_FOUND":            11,
+		"NO_QUOTA_CONFIG":             12,
+		"NO_QUOTA":                    13,
+		"QUOTA_NOT_FOUND":             14,
+		"PROVIDER_NOT_FOUND":          15,
+		"UNKNOWN_CHANNEL":             16,
+		"NOTIFICATION_NOT_CANCELABLE": 17,
+		"NOTIFICATION_NOT_EDITABLE":   18,
+		"TEMPLATE_NOT_APPROVED":       19,
+		"PROVIDER_THROTTLED":          20,
+		"PROVIDER_FAULT":              21,
+		"RECEIVER_INVALID":            22,
+		"RECEIVER_SUPPRESSED":         23,
+		"DEADLINE_PASSED":             24,
+		"FREQUENCY_CAPPED":            25,
 	}
 )
 
@@ -264,6 +321,7 @@ type SendStrategy struct {
 	//	*SendStrategy_Scheduled
 	//	*SendStrategy_TimeWindow
 	//	*SendStrategy_Deadline
+	//	*SendStrategy_Recurring
 	StrategyType  isSendStrategy_StrategyType `protobuf_oneof:"strategy_type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -351,6 +409,15 @@ func (x *SendStrategy) GetDeadline() *SendStrategy_DeadlineStrategy {
 	return nil
 }
 
+func (x *SendStrategy) GetRecurring() *SendStrategy_RecurringStrategy {
+	if x != nil {
+		if x, ok := x.StrategyType.(*SendStrategy_Recurring); ok {
+			return x.Recurring
+		}
+	}
+	return nil
+}
+
 type isSendStrategy_StrategyType interface {
 	isSendStrategy_StrategyType()
 }
@@ -380,6 +447,11 @@ type SendStrategy_Deadline struct {
 	Deadline *SendStrategy_DeadlineStrategy `protobuf:"bytes,5,opt,name=deadline,proto3,oneof"`
 }
 
+type SendStrategy_Recurring struct {
+	// 按 cron 表达式周期发送
+	Recurring *SendStrategy_RecurringStrategy `protobuf:"bytes,6,opt,name=recurring,proto3,oneof"`
+}
+
 func (*SendStrategy_Immediate) isSendStrategy_StrategyType() {}
 
 func (*SendStrategy_Delayed) isSendStrategy_StrategyType() {}
@@ -390,6 +462,8 @@ func (*SendStrategy_TimeWindow) isSendStrategy_StrategyType() {}
 
 func (*SendStrategy_Deadline) isSendStrategy_StrategyType() {}
 
+func (*SendStrategy_Recurring) isSendStrategy_StrategyType() {}
+
 // 通知
 type Notification struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -412,9 +486,17 @@ type Notification struct {
 	// string field2 = 8;
 	// 重要，并且几乎大家都要传
 	// string importantField = 2;
-	Receiver      string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 发送使用的语言，BCP-47 语言标签，如 zh-HK、en-US。
+	// 模版没有该语言的本地化内容时逐级降级，如 zh-HK -> zh-CN -> 默认内容
+	Locale string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
+	// 单独指定某些接收者使用的语言，键为接收者，没有指定的接收者使用 locale
+	ReceiverLocales map[string]string `protobuf:"bytes,9,rep,name=receiver_locales,json=receiverLocales,proto3" json:"receiver_locales,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 跨渠道降级时目标渠道使用的接收者。不同渠道的接收者类型不同（手机号、邮箱、用户ID），
+	// 没有指定接收者的渠道不会降级
+	FailoverReceivers []*FailoverReceivers `protobuf:"bytes,10,rep,name=failover_receivers,json=failoverReceivers,proto3" json:"failover_receivers,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Notification) Reset() {
@@ -496,6 +578,80 @@ func (x *Notification) GetReceiver() string {
 	return ""
 }
 
+func (x *Notification) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Notification) GetReceiverLocales() map[string]string {
+	if x != nil {
+		return x.ReceiverLocales
+	}
+	return nil
+}
+
+func (x *Notification) GetFailoverReceivers() []*FailoverReceivers {
+	if x != nil {
+		return x.FailoverReceivers
+	}
+	return nil
+}
+
+// 降级到某个渠道时使用的接收者
+type FailoverReceivers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       Channel                `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	Receivers     []string               `protobuf:"bytes,2,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailoverReceivers) Reset() {
+	*x = FailoverReceivers{}
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailoverReceivers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailoverReceivers) ProtoMessage() {}
+
+func (x *FailoverReceivers) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailoverReceivers.ProtoReflect.Descriptor instead.
+func (*FailoverReceivers) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FailoverReceivers) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *FailoverReceivers) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
 // 同步单条发送通知请求
 type SendNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -506,7 +662,7 @@ type SendNotificationRequest struct {
 
 func (x *SendNotificationRequest) Reset() {
 	*x = SendNotificationRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -518,7 +674,7 @@ func (x *SendNotificationRequest) String() string {
 func (*SendNotificationRequest) ProtoMessage() {}
 
 func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[2]
+	mi := &file_notification_v1_notification_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,7 +687,7 @@ func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{2}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SendNotificationRequest) GetNotification() *Notification {
@@ -544,21 +700,31 @@ func (x *SendNotificationRequest) GetNotification() *Notification {
 // 同步单条发送通知响应
 type SendNotificationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 通知平台生成的通知ID
+	// 通知平台生成的通知ID，周期发送时为周期通知的ID
 	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
 	// 发送状态
 	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
 	// 失败时的错误代码
 	ErrorCode ErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
 	// 错误详情
-	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 每个接收者的发送结果
+	ReceiverResults []*ReceiverResult `protobuf:"bytes,5,rep,name=receiver_results,json=receiverResults,proto3" json:"receiver_results,omitempty"`
+	// 实际发送成功的渠道，跨渠道降级时与请求中的渠道不同
+	SentChannel Channel `protobuf:"varint,6,opt,name=sent_channel,json=sentChannel,proto3,enum=notification.v1.Channel" json:"sent_channel,omitempty"`
+	// 已经重试的次数
+	RetryCount int32 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// 下一次重试的时间，毫秒，状态为 RETRYING 时有效
+	NextRetryTime int64 `protobuf:"varint,8,opt,name=next_retry_time,json=nextRetryTime,proto3" json:"next_retry_time,omitempty"`
+	// 每一次失败的发送尝试
+	Attempts      []*SendAttempt `protobuf:"bytes,9,rep,name=attempts,proto3" json:"attempts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendNotificationResponse) Reset() {
 	*x = SendNotificationResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -570,7 +736,7 @@ func (x *SendNotificationResponse) String() string {
 func (*SendNotificationResponse) ProtoMessage() {}
 
 func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[3]
+	mi := &file_notification_v1_notification_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +749,7 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{3}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SendNotificationResponse) GetNotificationId() uint64 {
@@ -614,6 +780,205 @@ func (x *SendNotificationResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SendNotificationResponse) GetReceiverResults() []*ReceiverResult {
+	if x != nil {
+		return x.ReceiverResults
+	}
+	return nil
+}
+
+func (x *SendNotificationResponse) GetSentChannel() Channel {
+	if x != nil {
+		return x.SentChannel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *SendNotificationResponse) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *SendNotificationResponse) GetNextRetryTime() int64 {
+	if x != nil {
+		return x.NextRetryTime
+	}
+	return 0
+}
+
+func (x *SendNotificationResponse) GetAttempts() []*SendAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// 一次失败的发送尝试
+type SendAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 第几次发送，从 1 开始
+	Attempt int32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// 发送渠道
+	Channel Channel `protobuf:"varint,2,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// RETRYING 表示之后还会重试，FAILED 表示重试次数已经用完
+	Status SendStatus `protobuf:"varint,3,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 失败原因
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 发送时间，毫秒
+	Ctime         int64 `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAttempt) Reset() {
+	*x = SendAttempt{}
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAttempt) ProtoMessage() {}
+
+func (x *SendAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAttempt.ProtoReflect.Descriptor instead.
+func (*SendAttempt) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendAttempt) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *SendAttempt) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *SendAttempt) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *SendAttempt) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendAttempt) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 单个接收者的发送结果
+type ReceiverResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者(手机/邮箱/用户ID)
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 该接收者的发送状态，SUCCEEDED、FAILED 或 SUPPRESSED
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 供应商返回的状态码
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 供应商返回的描述信息
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 实际发送的供应商
+	Provider      string `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiverResult) Reset() {
+	*x = ReceiverResult{}
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiverResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiverResult) ProtoMessage() {}
+
+func (x *ReceiverResult) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiverResult.ProtoReflect.Descriptor instead.
+func (*ReceiverResult) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReceiverResult) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *ReceiverResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReceiverResult) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 // 异步单条发送通知请求
 type SendNotificationAsyncRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -624,7 +989,7 @@ type SendNotificationAsyncRequest struct {
 
 func (x *SendNotificationAsyncRequest) Reset() {
 	*x = SendNotificationAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +1001,7 @@ func (x *SendNotificationAsyncRequest) String() string {
 func (*SendNotificationAsyncRequest) ProtoMessage() {}
 
 func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[4]
+	mi := &file_notification_v1_notification_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +1014,7 @@ func (x *SendNotificationAsyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncRequest.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{4}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
@@ -662,19 +1027,21 @@ func (x *SendNotificationAsyncRequest) GetNotification() *Notification {
 // 异步单条发送通知响应
 type SendNotificationAsyncResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 通知平台生成的通知ID
+	// 通知平台生成的通知ID，周期发送时为周期通知的ID，所有接收者都已退订时通知保存为 SUPPRESSED，不会发送
 	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
 	// 失败时的错误代码
 	ErrorCode ErrorCode `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
 	// 错误详情
-	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 已退订的接收者的结果，状态为 SUPPRESSED
+	ReceiverResults []*ReceiverResult `protobuf:"bytes,6,rep,name=receiver_results,json=receiverResults,proto3" json:"receiver_results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *SendNotificationAsyncResponse) Reset() {
 	*x = SendNotificationAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +1053,7 @@ func (x *SendNotificationAsyncResponse) String() string {
 func (*SendNotificationAsyncResponse) ProtoMessage() {}
 
 func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[5]
+	mi := &file_notification_v1_notification_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +1066,7 @@ func (x *SendNotificationAsyncResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendNotificationAsyncResponse.ProtoReflect.Descriptor instead.
 func (*SendNotificationAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{5}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SendNotificationAsyncResponse) GetNotificationId() uint64 {
@@ -723,6 +1090,13 @@ func (x *SendNotificationAsyncResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *SendNotificationAsyncResponse) GetReceiverResults() []*ReceiverResult {
+	if x != nil {
+		return x.ReceiverResults
+	}
+	return nil
+}
+
 // 同步批量发送通知请求
 type BatchSendNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -733,7 +1107,7 @@ type BatchSendNotificationsRequest struct {
 
 func (x *BatchSendNotificationsRequest) Reset() {
 	*x = BatchSendNotificationsRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -745,7 +1119,7 @@ func (x *BatchSendNotificationsRequest) String() string {
 func (*BatchSendNotificationsRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[6]
+	mi := &file_notification_v1_notification_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +1132,7 @@ func (x *BatchSendNotificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{6}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BatchSendNotificationsRequest) GetNotifications() []*Notification {
@@ -783,7 +1157,7 @@ type BatchSendNotificationsResponse struct {
 
 func (x *BatchSendNotificationsResponse) Reset() {
 	*x = BatchSendNotificationsResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1169,7 @@ func (x *BatchSendNotificationsResponse) String() string {
 func (*BatchSendNotificationsResponse) ProtoMessage() {}
 
 func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[7]
+	mi := &file_notification_v1_notification_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1182,7 @@ func (x *BatchSendNotificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchSendNotificationsResponse.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{7}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BatchSendNotificationsResponse) GetResults() []*SendNotificationResponse {
@@ -842,7 +1216,7 @@ type BatchSendNotificationsAsyncRequest struct {
 
 func (x *BatchSendNotificationsAsyncRequest) Reset() {
 	*x = BatchSendNotificationsAsyncRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1228,7 @@ func (x *BatchSendNotificationsAsyncRequest) String() string {
 func (*BatchSendNotificationsAsyncRequest) ProtoMessage() {}
 
 func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[8]
+	mi := &file_notification_v1_notification_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,40 +1241,1107 @@ func (x *BatchSendNotificationsAsyncRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BatchSendNotificationsAsyncRequest.ProtoReflect.Descriptor instead.
 func (*BatchSendNotificationsAsyncRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{8}
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchSendNotificationsAsyncRequest) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// 异步批量发送通知响应
+type BatchSendNotificationsAsyncResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID，与请求中的通知一一对应，所有接收者都已退订的通知保存为 SUPPRESSED，不会发送
+	NotificationIds []uint64 `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BatchSendNotificationsAsyncResponse) Reset() {
+	*x = BatchSendNotificationsAsyncResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSendNotificationsAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendNotificationsAsyncResponse) ProtoMessage() {}
+
+func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendNotificationsAsyncResponse.ProtoReflect.Descriptor instead.
+func (*BatchSendNotificationsAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchSendNotificationsAsyncResponse) GetNotificationIds() []uint64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+// 准备事务请求
+type TxPrepareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxPrepareRequest) Reset() {
+	*x = TxPrepareRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxPrepareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPrepareRequest) ProtoMessage() {}
+
+func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPrepareRequest.ProtoReflect.Descriptor instead.
+func (*TxPrepareRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TxPrepareRequest) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// 准备事务响应
+type TxPrepareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxPrepareResponse) Reset() {
+	*x = TxPrepareResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxPrepareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPrepareResponse) ProtoMessage() {}
+
+func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPrepareResponse.ProtoReflect.Descriptor instead.
+func (*TxPrepareResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+}
+
+// 提交事务请求
+type TxCommitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // 事务唯一标识
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxCommitRequest) Reset() {
+	*x = TxCommitRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxCommitRequest) ProtoMessage() {}
+
+func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxCommitRequest.ProtoReflect.Descriptor instead.
+func (*TxCommitRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TxCommitRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 提交事务响应
+type TxCommitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxCommitResponse) Reset() {
+	*x = TxCommitResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxCommitResponse) ProtoMessage() {}
+
+func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxCommitResponse.ProtoReflect.Descriptor instead.
+func (*TxCommitResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{16}
+}
+
+// 回滚事务请求
+type TxCancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // 事务唯一标识
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxCancelRequest) Reset() {
+	*x = TxCancelRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxCancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxCancelRequest) ProtoMessage() {}
+
+func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxCancelRequest.ProtoReflect.Descriptor instead.
+func (*TxCancelRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TxCancelRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 回滚事务响应
+type TxCancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TxCancelResponse) Reset() {
+	*x = TxCancelResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TxCancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxCancelResponse) ProtoMessage() {}
+
+func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxCancelResponse.ProtoReflect.Descriptor instead.
+func (*TxCancelResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+// 取消通知请求
+type CancelNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务内唯一标识
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNotificationRequest) Reset() {
+	*x = CancelNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNotificationRequest) ProtoMessage() {}
+
+func (x *CancelNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CancelNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// 取消通知响应
+type CancelNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务内唯一标识
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 取消成功时为 CANCELED，取消失败时是通知当前的状态
+	Status SendStatus `protobuf:"varint,3,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 取消失败时的错误代码
+	ErrorCode ErrorCode `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 错误详情
+	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelNotificationResponse) Reset() {
+	*x = CancelNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelNotificationResponse) ProtoMessage() {}
+
+func (x *CancelNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelNotificationResponse.ProtoReflect.Descriptor instead.
+func (*CancelNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelNotificationResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CancelNotificationResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *CancelNotificationResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *CancelNotificationResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *CancelNotificationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 批量取消通知请求
+type BatchCancelNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务内唯一标识列表
+	Keys          []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCancelNotificationsRequest) Reset() {
+	*x = BatchCancelNotificationsRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCancelNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCancelNotificationsRequest) ProtoMessage() {}
+
+func (x *BatchCancelNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCancelNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCancelNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchCancelNotificationsRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// 批量取消通知响应
+type BatchCancelNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按请求中 keys 的顺序返回每个通知的取消结果
+	Results       []*CancelNotificationResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCancelNotificationsResponse) Reset() {
+	*x = BatchCancelNotificationsResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCancelNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCancelNotificationsResponse) ProtoMessage() {}
+
+func (x *BatchCancelNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCancelNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCancelNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchCancelNotificationsResponse) GetResults() []*CancelNotificationResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 修改通知请求，只能修改 PENDING 状态的通知
+type UpdateNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 业务内唯一标识
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 新的发送策略，不传时不修改
+	Strategy *SendStrategy `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// 新的模版参数，没有设置 update_mask 时为空表示不修改
+	TemplateParams map[string]string `protobuf:"bytes,3,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 新的接收者，没有设置 update_mask 时为空表示不修改
+	Receivers []string `protobuf:"bytes,4,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// 要修改的字段，可选 strategy、template_params、receivers。
+	// 设置后只修改列出的字段，列出的字段为空时也会修改，比如把模版参数清空
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationRequest) Reset() {
+	*x = UpdateNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateNotificationRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateNotificationRequest) GetStrategy() *SendStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+func (x *UpdateNotificationRequest) GetTemplateParams() map[string]string {
+	if x != nil {
+		return x.TemplateParams
+	}
+	return nil
+}
+
+func (x *UpdateNotificationRequest) GetReceivers() []string {
+	if x != nil {
+		return x.Receivers
+	}
+	return nil
+}
+
+func (x *UpdateNotificationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// 修改通知响应
+type UpdateNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 通知当前的状态
+	Status SendStatus `protobuf:"varint,2,opt,name=status,proto3,enum=notification.v1.SendStatus" json:"status,omitempty"`
+	// 计划发送开始时间，毫秒
+	ScheduledStime int64 `protobuf:"varint,3,opt,name=scheduled_stime,json=scheduledStime,proto3" json:"scheduled_stime,omitempty"`
+	// 计划发送结束时间，毫秒
+	ScheduledEtime int64 `protobuf:"varint,4,opt,name=scheduled_etime,json=scheduledEtime,proto3" json:"scheduled_etime,omitempty"`
+	// 修改失败时的错误代码
+	ErrorCode ErrorCode `protobuf:"varint,5,opt,name=error_code,json=errorCode,proto3,enum=notification.v1.ErrorCode" json:"error_code,omitempty"`
+	// 错误详情
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationResponse) Reset() {
+	*x = UpdateNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateNotificationResponse) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *UpdateNotificationResponse) GetStatus() SendStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SendStatus_SEND_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateNotificationResponse) GetScheduledStime() int64 {
+	if x != nil {
+		return x.ScheduledStime
+	}
+	return 0
+}
+
+func (x *UpdateNotificationResponse) GetScheduledEtime() int64 {
+	if x != nil {
+		return x.ScheduledEtime
+	}
+	return 0
+}
+
+func (x *UpdateNotificationResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *UpdateNotificationResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// 站内信
+type InboxMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 站内信ID
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 通知平台生成的通知ID
+	NotificationId uint64 `protobuf:"varint,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	// 接收者标识
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 内容
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// 是否已读
+	Read bool `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	// 已读时间，毫秒时间戳
+	ReadTime int64 `protobuf:"varint,7,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	// 创建时间，毫秒时间戳
+	Ctime         int64 `protobuf:"varint,8,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxMessage) Reset() {
+	*x = InboxMessage{}
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxMessage) ProtoMessage() {}
+
+func (x *InboxMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxMessage.ProtoReflect.Descriptor instead.
+func (*InboxMessage) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InboxMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetNotificationId() uint64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *InboxMessage) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *InboxMessage) GetReadTime() int64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+// 分页查询站内信请求
+type ListInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者标识
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 偏移量
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 每页数量，最大100
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// 是否只查询未读
+	UnreadOnly    bool `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesRequest) Reset() {
+	*x = ListInboxMessagesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesRequest) ProtoMessage() {}
+
+func (x *ListInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListInboxMessagesRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ListInboxMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInboxMessagesRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// 分页查询站内信响应
+type ListInboxMessagesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Messages []*InboxMessage        `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	// 符合条件的总数
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxMessagesResponse) Reset() {
+	*x = ListInboxMessagesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxMessagesResponse) ProtoMessage() {}
+
+func (x *ListInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListInboxMessagesResponse) GetMessages() []*InboxMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ListInboxMessagesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 标记站内信已读请求
+type MarkInboxMessagesReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者标识
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 站内信ID，为空时表示全部标记为已读
+	MessageIds    []uint64 `protobuf:"varint,2,rep,packed,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxMessagesReadRequest) Reset() {
+	*x = MarkInboxMessagesReadRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxMessagesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxMessagesReadRequest) ProtoMessage() {}
+
+func (x *MarkInboxMessagesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxMessagesReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesReadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MarkInboxMessagesReadRequest) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MarkInboxMessagesReadRequest) GetMessageIds() []uint64 {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+// 标记站内信已读响应
+type MarkInboxMessagesReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxMessagesReadResponse) Reset() {
+	*x = MarkInboxMessagesReadResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxMessagesReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxMessagesReadResponse) ProtoMessage() {}
+
+func (x *MarkInboxMessagesReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxMessagesReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesReadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{29}
+}
+
+// 标记站内信未读请求
+type MarkInboxMessagesUnreadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者标识
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 站内信ID
+	MessageIds    []uint64 `protobuf:"varint,2,rep,packed,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxMessagesUnreadRequest) Reset() {
+	*x = MarkInboxMessagesUnreadRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxMessagesUnreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxMessagesUnreadRequest) ProtoMessage() {}
+
+func (x *MarkInboxMessagesUnreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *BatchSendNotificationsAsyncRequest) GetNotifications() []*Notification {
+// Deprecated: Use MarkInboxMessagesUnreadRequest.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesUnreadRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MarkInboxMessagesUnreadRequest) GetReceiver() string {
 	if x != nil {
-		return x.Notifications
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *MarkInboxMessagesUnreadRequest) GetMessageIds() []uint64 {
+	if x != nil {
+		return x.MessageIds
 	}
 	return nil
 }
 
-// 异步批量发送通知响应
-type BatchSendNotificationsAsyncResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 通知平台生成的通知ID
-	NotificationIds []uint64 `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 标记站内信未读响应
+type MarkInboxMessagesUnreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchSendNotificationsAsyncResponse) Reset() {
-	*x = BatchSendNotificationsAsyncResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+func (x *MarkInboxMessagesUnreadResponse) Reset() {
+	*x = MarkInboxMessagesUnreadResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchSendNotificationsAsyncResponse) String() string {
+func (x *MarkInboxMessagesUnreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchSendNotificationsAsyncResponse) ProtoMessage() {}
+func (*MarkInboxMessagesUnreadResponse) ProtoMessage() {}
 
-func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[9]
+func (x *MarkInboxMessagesUnreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,41 +2352,37 @@ func (x *BatchSendNotificationsAsyncResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchSendNotificationsAsyncResponse.ProtoReflect.Descriptor instead.
-func (*BatchSendNotificationsAsyncResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *BatchSendNotificationsAsyncResponse) GetNotificationIds() []uint64 {
-	if x != nil {
-		return x.NotificationIds
-	}
-	return nil
+// Deprecated: Use MarkInboxMessagesUnreadResponse.ProtoReflect.Descriptor instead.
+func (*MarkInboxMessagesUnreadResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{31}
 }
 
-// 准备事务请求
-type TxPrepareRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+// 删除站内信请求
+type DeleteInboxMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者标识
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// 站内信ID
+	MessageIds    []uint64 `protobuf:"varint,2,rep,packed,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TxPrepareRequest) Reset() {
-	*x = TxPrepareRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+func (x *DeleteInboxMessagesRequest) Reset() {
+	*x = DeleteInboxMessagesRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxPrepareRequest) String() string {
+func (x *DeleteInboxMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxPrepareRequest) ProtoMessage() {}
+func (*DeleteInboxMessagesRequest) ProtoMessage() {}
 
-func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[10]
+func (x *DeleteInboxMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,40 +2393,47 @@ func (x *TxPrepareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxPrepareRequest.ProtoReflect.Descriptor instead.
-func (*TxPrepareRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteInboxMessagesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TxPrepareRequest) GetNotification() *Notification {
+func (x *DeleteInboxMessagesRequest) GetReceiver() string {
 	if x != nil {
-		return x.Notification
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *DeleteInboxMessagesRequest) GetMessageIds() []uint64 {
+	if x != nil {
+		return x.MessageIds
 	}
 	return nil
 }
 
-// 准备事务响应
-type TxPrepareResponse struct {
+// 删除站内信响应
+type DeleteInboxMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TxPrepareResponse) Reset() {
-	*x = TxPrepareResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+func (x *DeleteInboxMessagesResponse) Reset() {
+	*x = DeleteInboxMessagesResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxPrepareResponse) String() string {
+func (x *DeleteInboxMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxPrepareResponse) ProtoMessage() {}
+func (*DeleteInboxMessagesResponse) ProtoMessage() {}
 
-func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[11]
+func (x *DeleteInboxMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,34 +2444,35 @@ func (x *TxPrepareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxPrepareResponse.ProtoReflect.Descriptor instead.
-func (*TxPrepareResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteInboxMessagesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{33}
 }
 
-// 提交事务请求
-type TxCommitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // 事务唯一标识
+// 获取未读站内信数量请求
+type GetInboxUnreadCountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 接收者标识
+	Receiver      string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TxCommitRequest) Reset() {
-	*x = TxCommitRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+func (x *GetInboxUnreadCountRequest) Reset() {
+	*x = GetInboxUnreadCountRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxCommitRequest) String() string {
+func (x *GetInboxUnreadCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxCommitRequest) ProtoMessage() {}
+func (*GetInboxUnreadCountRequest) ProtoMessage() {}
 
-func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[12]
+func (x *GetInboxUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1038,40 +2483,41 @@ func (x *TxCommitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxCommitRequest.ProtoReflect.Descriptor instead.
-func (*TxCommitRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetInboxUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetInboxUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *TxCommitRequest) GetKey() string {
+func (x *GetInboxUnreadCountRequest) GetReceiver() string {
 	if x != nil {
-		return x.Key
+		return x.Receiver
 	}
 	return ""
 }
 
-// 提交事务响应
-type TxCommitResponse struct {
+// 获取未读站内信数量响应
+type GetInboxUnreadCountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TxCommitResponse) Reset() {
-	*x = TxCommitResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+func (x *GetInboxUnreadCountResponse) Reset() {
+	*x = GetInboxUnreadCountResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxCommitResponse) String() string {
+func (x *GetInboxUnreadCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxCommitResponse) ProtoMessage() {}
+func (*GetInboxUnreadCountResponse) ProtoMessage() {}
 
-func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[13]
+func (x *GetInboxUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,34 +2528,46 @@ func (x *TxCommitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxCommitResponse.ProtoReflect.Descriptor instead.
-func (*TxCommitResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetInboxUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetInboxUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{35}
 }
 
-// 回滚事务请求
-type TxCancelRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // 事务唯一标识
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetInboxUnreadCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *TxCancelRequest) Reset() {
-	*x = TxCancelRequest{}
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+// 预览通知请求
+type PreviewNotificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 模板ID
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// 模板版本ID，不传时使用已发布的活跃版本
+	VersionId int64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// 模板参数
+	TemplateParams map[string]string `protobuf:"bytes,3,rep,name=template_params,json=templateParams,proto3" json:"template_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewNotificationRequest) Reset() {
+	*x = PreviewNotificationRequest{}
+	mi := &file_notification_v1_notification_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxCancelRequest) String() string {
+func (x *PreviewNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxCancelRequest) ProtoMessage() {}
+func (*PreviewNotificationRequest) ProtoMessage() {}
 
-func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[14]
+func (x *PreviewNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,40 +2578,68 @@ func (x *TxCancelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxCancelRequest.ProtoReflect.Descriptor instead.
-func (*TxCancelRequest) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PreviewNotificationRequest.ProtoReflect.Descriptor instead.
+func (*PreviewNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *TxCancelRequest) GetKey() string {
+func (x *PreviewNotificationRequest) GetTemplateId() string {
 	if x != nil {
-		return x.Key
+		return x.TemplateId
 	}
 	return ""
 }
 
-// 回滚事务响应
-type TxCancelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *PreviewNotificationRequest) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *PreviewNotificationRequest) GetTemplateParams() map[string]string {
+	if x != nil {
+		return x.TemplateParams
+	}
+	return nil
+}
+
+// 预览通知响应
+type PreviewNotificationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 渠道
+	Channel Channel `protobuf:"varint,1,opt,name=channel,proto3,enum=notification.v1.Channel" json:"channel,omitempty"`
+	// 渲染使用的模版版本ID
+	VersionId int64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// 签名，短信签名或邮件发件人名称
+	Signature string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// 标题，邮件主题、站内信标题，短信没有标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// 渲染后的内容
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// 模版声明的占位符
+	Placeholders []string `protobuf:"bytes,6,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	// 按当前的供应商选择器会被选中的供应商，无可用供应商时为空
+	ProviderName  string `protobuf:"bytes,7,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TxCancelResponse) Reset() {
-	*x = TxCancelResponse{}
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+func (x *PreviewNotificationResponse) Reset() {
+	*x = PreviewNotificationResponse{}
+	mi := &file_notification_v1_notification_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TxCancelResponse) String() string {
+func (x *PreviewNotificationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxCancelResponse) ProtoMessage() {}
+func (*PreviewNotificationResponse) ProtoMessage() {}
 
-func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[15]
+func (x *PreviewNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,9 +2650,58 @@ func (x *TxCancelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxCancelResponse.ProtoReflect.Descriptor instead.
-func (*TxCancelResponse) Descriptor() ([]byte, []int) {
-	return file_notification_v1_notification_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PreviewNotificationResponse.ProtoReflect.Descriptor instead.
+func (*PreviewNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PreviewNotificationResponse) GetChannel() Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return Channel_CHANNEL_UNSPECIFIED
+}
+
+func (x *PreviewNotificationResponse) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *PreviewNotificationResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *PreviewNotificationResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PreviewNotificationResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PreviewNotificationResponse) GetPlaceholders() []string {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+func (x *PreviewNotificationResponse) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
 }
 
 // 空结构表示立即发送
@@ -1178,7 +2713,7 @@ type SendStrategy_ImmediateStrategy struct {
 
 func (x *SendStrategy_ImmediateStrategy) Reset() {
 	*x = SendStrategy_ImmediateStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +2725,7 @@ func (x *SendStrategy_ImmediateStrategy) String() string {
 func (*SendStrategy_ImmediateStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ImmediateStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[16]
+	mi := &file_notification_v1_notification_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +2751,7 @@ type SendStrategy_DelayedStrategy struct {
 
 func (x *SendStrategy_DelayedStrategy) Reset() {
 	*x = SendStrategy_DelayedStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1228,7 +2763,7 @@ func (x *SendStrategy_DelayedStrategy) String() string {
 func (*SendStrategy_DelayedStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DelayedStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[17]
+	mi := &file_notification_v1_notification_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,7 +2796,7 @@ type SendStrategy_ScheduledStrategy struct {
 
 func (x *SendStrategy_ScheduledStrategy) Reset() {
 	*x = SendStrategy_ScheduledStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1273,7 +2808,7 @@ func (x *SendStrategy_ScheduledStrategy) String() string {
 func (*SendStrategy_ScheduledStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_ScheduledStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[18]
+	mi := &file_notification_v1_notification_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +2843,7 @@ type SendStrategy_TimeWindowStrategy struct {
 
 func (x *SendStrategy_TimeWindowStrategy) Reset() {
 	*x = SendStrategy_TimeWindowStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1320,7 +2855,7 @@ func (x *SendStrategy_TimeWindowStrategy) String() string {
 func (*SendStrategy_TimeWindowStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_TimeWindowStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[19]
+	mi := &file_notification_v1_notification_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +2895,7 @@ type SendStrategy_DeadlineStrategy struct {
 
 func (x *SendStrategy_DeadlineStrategy) Reset() {
 	*x = SendStrategy_DeadlineStrategy{}
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +2907,7 @@ func (x *SendStrategy_DeadlineStrategy) String() string {
 func (*SendStrategy_DeadlineStrategy) ProtoMessage() {}
 
 func (x *SendStrategy_DeadlineStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_v1_notification_proto_msgTypes[20]
+	mi := &file_notification_v1_notification_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,18 +2930,95 @@ func (x *SendStrategy_DeadlineStrategy) GetDeadline() *timestamppb.Timestamp {
 	return nil
 }
 
+// 每一次发送都会生成独立的通知，key 为 {key}:{发送时间 yyyyMMddHHmmss}。
+// 发送响应中的 notification_id 是周期通知的ID，不是某一次发送的通知ID，
+// 使用请求中的 key 调用 GetNotificationsByKeys、CancelNotification 查询不到，
+// 需要使用 {key}:{发送时间} 查询或者取消某一次发送
+type SendStrategy_RecurringStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 标准的五段 cron 表达式，例如 "0 9 * * 1" 表示每周一九点
+	Cron string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`
+	// cron 表达式所在的时区，例如 "Asia/Shanghai"，为空时使用服务端本地时区
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// 可选，不晚于这个时间发送
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 可选，最多发送的次数，0 表示不限制
+	MaxOccurrences int32 `protobuf:"varint,4,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendStrategy_RecurringStrategy) Reset() {
+	*x = SendStrategy_RecurringStrategy{}
+	mi := &file_notification_v1_notification_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStrategy_RecurringStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStrategy_RecurringStrategy) ProtoMessage() {}
+
+func (x *SendStrategy_RecurringStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_v1_notification_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStrategy_RecurringStrategy.ProtoReflect.Descriptor instead.
+func (*SendStrategy_RecurringStrategy) Descriptor() ([]byte, []int) {
+	return file_notification_v1_notification_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *SendStrategy_RecurringStrategy) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *SendStrategy_RecurringStrategy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SendStrategy_RecurringStrategy) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SendStrategy_RecurringStrategy) GetMaxOccurrences() int32 {
+	if x != nil {
+		return x.MaxOccurrences
+	}
+	return 0
+}
+
 var File_notification_v1_notification_proto protoreflect.FileDescriptor
 
 const file_notification_v1_notification_proto_rawDesc = "" +
 	"\n" +
-	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\x06\n" +
+	"\"notification/v1/notification.proto\x12\x0fnotification.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\b\n" +
 	"\fSendStrategy\x12O\n" +
 	"\timmediate\x18\x01 \x01(\v2/.notification.v1.SendStrategy.ImmediateStrategyH\x00R\timmediate\x12I\n" +
 	"\adelayed\x18\x02 \x01(\v2-.notification.v1.SendStrategy.DelayedStrategyH\x00R\adelayed\x12O\n" +
 	"\tscheduled\x18\x03 \x01(\v2/.notification.v1.SendStrategy.ScheduledStrategyH\x00R\tscheduled\x12S\n" +
 	"\vtime_window\x18\x04 \x01(\v20.notification.v1.SendStrategy.TimeWindowStrategyH\x00R\n" +
 	"timeWindow\x12L\n" +
-	"\bdeadline\x18\x05 \x01(\v2..notification.v1.SendStrategy.DeadlineStrategyH\x00R\bdeadline\x1a\x13\n" +
+	"\bdeadline\x18\x05 \x01(\v2..notification.v1.SendStrategy.DeadlineStrategyH\x00R\bdeadline\x12O\n" +
+	"\trecurring\x18\x06 \x01(\v2/.notification.v1.SendStrategy.RecurringStrategyH\x00R\trecurring\x1a\x13\n" +
 	"\x11ImmediateStrategy\x1a6\n" +
 	"\x0fDelayedStrategy\x12#\n" +
 	"\rdelay_seconds\x18\x01 \x01(\x03R\fdelaySeconds\x1aL\n" +
@@ -1416,8 +3028,13 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x17start_time_milliseconds\x18\x01 \x01(\x03R\x15startTimeMilliseconds\x122\n" +
 	"\x15end_time_milliseconds\x18\x02 \x01(\x03R\x13endTimeMilliseconds\x1aJ\n" +
 	"\x10DeadlineStrategy\x126\n" +
-	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadlineB\x0f\n" +
-	"\rstrategy_type\"\x89\x03\n" +
+	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x1a\xa3\x01\n" +
+	"\x11RecurringStrategy\x12\x12\n" +
+	"\x04cron\x18\x01 \x01(\tR\x04cron\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12'\n" +
+	"\x0fmax_occurrences\x18\x04 \x01(\x05R\x0emaxOccurrencesB\x0f\n" +
+	"\rstrategy_type\"\x97\x05\n" +
 	"\fNotification\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
 	"\treceivers\x18\x02 \x03(\tR\treceivers\x122\n" +
@@ -1426,25 +3043,54 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"templateId\x12Z\n" +
 	"\x0ftemplate_params\x18\x05 \x03(\v21.notification.v1.Notification.TemplateParamsEntryR\x0etemplateParams\x129\n" +
 	"\bstrategy\x18\x06 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x12\x1a\n" +
-	"\breceiver\x18\a \x01(\tR\breceiver\x1aA\n" +
+	"\breceiver\x18\a \x01(\tR\breceiver\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12]\n" +
+	"\x10receiver_locales\x18\t \x03(\v22.notification.v1.Notification.ReceiverLocalesEntryR\x0freceiverLocales\x12Q\n" +
+	"\x12failover_receivers\x18\n" +
+	" \x03(\v2\".notification.v1.FailoverReceiversR\x11failoverReceivers\x1aA\n" +
 	"\x13TemplateParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14ReceiverLocalesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"e\n" +
+	"\x11FailoverReceivers\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1c\n" +
+	"\treceivers\x18\x02 \x03(\tR\treceivers\"\\\n" +
 	"\x17SendNotificationRequest\x12A\n" +
-	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xd8\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xe4\x03\n" +
 	"\x18SendNotificationResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
 	"\n" +
 	"error_code\x18\x03 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"a\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\x12J\n" +
+	"\x10receiver_results\x18\x05 \x03(\v2\x1f.notification.v1.ReceiverResultR\x0freceiverResults\x12;\n" +
+	"\fsent_channel\x18\x06 \x01(\x0e2\x18.notification.v1.ChannelR\vsentChannel\x12\x1f\n" +
+	"\vretry_count\x18\a \x01(\x05R\n" +
+	"retryCount\x12&\n" +
+	"\x0fnext_retry_time\x18\b \x01(\x03R\rnextRetryTime\x128\n" +
+	"\battempts\x18\t \x03(\v2\x1c.notification.v1.SendAttemptR\battempts\"\xc0\x01\n" +
+	"\vSendAttempt\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x122\n" +
+	"\achannel\x18\x02 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x14\n" +
+	"\x05ctime\x18\x05 \x01(\x03R\x05ctime\"\xab\x01\n" +
+	"\x0eReceiverResult\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\"a\n" +
 	"\x1cSendNotificationAsyncRequest\x12A\n" +
-	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xa8\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.v1.NotificationR\fnotification\"\xf4\x01\n" +
 	"\x1dSendNotificationAsyncResponse\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x129\n" +
 	"\n" +
 	"error_code\x18\x04 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"d\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12J\n" +
+	"\x10receiver_results\x18\x06 \x03(\v2\x1f.notification.v1.ReceiverResultR\x0freceiverResults\"d\n" +
 	"\x1dBatchSendNotificationsRequest\x12C\n" +
 	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.v1.NotificationR\rnotifications\"\xab\x01\n" +
 	"\x1eBatchSendNotificationsResponse\x12C\n" +
@@ -1464,13 +3110,99 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x10TxCommitResponse\"#\n" +
 	"\x0fTxCancelRequest\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\"\x12\n" +
-	"\x10TxCancelResponse*B\n" +
+	"\x10TxCancelResponse\"-\n" +
+	"\x19CancelNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"\xec\x01\n" +
+	"\x1aCancelNotificationResponse\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x129\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"5\n" +
+	"\x1fBatchCancelNotificationsRequest\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"i\n" +
+	" BatchCancelNotificationsResponse\x12E\n" +
+	"\aresults\x18\x01 \x03(\v2+.notification.v1.CancelNotificationResponseR\aresults\"\xef\x02\n" +
+	"\x19UpdateNotificationRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\bstrategy\x18\x02 \x01(\v2\x1d.notification.v1.SendStrategyR\bstrategy\x12g\n" +
+	"\x0ftemplate_params\x18\x03 \x03(\v2>.notification.v1.UpdateNotificationRequest.TemplateParamsEntryR\x0etemplateParams\x12\x1c\n" +
+	"\treceivers\x18\x04 \x03(\tR\treceivers\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x1aA\n" +
+	"\x13TemplateParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xac\x02\n" +
+	"\x1aUpdateNotificationResponse\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x04R\x0enotificationId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.notification.v1.SendStatusR\x06status\x12'\n" +
+	"\x0fscheduled_stime\x18\x03 \x01(\x03R\x0escheduledStime\x12'\n" +
+	"\x0fscheduled_etime\x18\x04 \x01(\x03R\x0escheduledEtime\x129\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\x0e2\x1a.notification.v1.ErrorCodeR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"\xda\x01\n" +
+	"\fInboxMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0fnotification_id\x18\x02 \x01(\x04R\x0enotificationId\x12\x1a\n" +
+	"\breceiver\x18\x03 \x01(\tR\breceiver\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x12\n" +
+	"\x04read\x18\x06 \x01(\bR\x04read\x12\x1b\n" +
+	"\tread_time\x18\a \x01(\x03R\breadTime\x12\x14\n" +
+	"\x05ctime\x18\b \x01(\x03R\x05ctime\"\x85\x01\n" +
+	"\x18ListInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\"l\n" +
+	"\x19ListInboxMessagesResponse\x129\n" +
+	"\bmessages\x18\x01 \x03(\v2\x1d.notification.v1.InboxMessageR\bmessages\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"[\n" +
+	"\x1cMarkInboxMessagesReadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\x04R\n" +
+	"messageIds\"\x1f\n" +
+	"\x1dMarkInboxMessagesReadResponse\"]\n" +
+	"\x1eMarkInboxMessagesUnreadRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\x04R\n" +
+	"messageIds\"!\n" +
+	"\x1fMarkInboxMessagesUnreadResponse\"Y\n" +
+	"\x1aDeleteInboxMessagesRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\x04R\n" +
+	"messageIds\"\x1d\n" +
+	"\x1bDeleteInboxMessagesResponse\"8\n" +
+	"\x1aGetInboxUnreadCountRequest\x12\x1a\n" +
+	"\breceiver\x18\x01 \x01(\tR\breceiver\"3\n" +
+	"\x1bGetInboxUnreadCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\x89\x02\n" +
+	"\x1aPreviewNotificationRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03R\tversionId\x12h\n" +
+	"\x0ftemplate_params\x18\x03 \x03(\v2?.notification.v1.PreviewNotificationRequest.TemplateParamsEntryR\x0etemplateParams\x1aA\n" +
+	"\x13TemplateParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x02\n" +
+	"\x1bPreviewNotificationResponse\x122\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x18.notification.v1.ChannelR\achannel\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\x03R\tversionId\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\"\n" +
+	"\fplaceholders\x18\x06 \x03(\tR\fplaceholders\x12#\n" +
+	"\rprovider_name\x18\a \x01(\tR\fproviderName*B\n" +
 	"\aChannel\x12\x17\n" +
 	"\x13CHANNEL_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03SMS\x10\x01\x12\t\n" +
 	"\x05EMAIL\x10\x02\x12\n" +
 	"\n" +
-	"\x06IN_APP\x10\x03*l\n" +
+	"\x06IN_APP\x10\x03*\xbf\x01\n" +
 	"\n" +
 	"SendStatus\x12\x1b\n" +
 	"\x17SEND_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
@@ -1479,7 +3211,14 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\aPENDING\x10\x03\x12\r\n" +
 	"\tSUCCEEDED\x10\x04\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x05*\x9e\x03\n" +
+	"\x06FAILED\x10\x05\x12\x13\n" +
+	"\x0fPARTIAL_SUCCESS\x10\x06\x12\r\n" +
+	"\tDELIVERED\x10\a\x12\x0f\n" +
+	"\vUNDELIVERED\x10\b\x12\f\n" +
+	"\bRETRYING\x10\t\x12\x0e\n" +
+	"\n" +
+	"SUPPRESSED\x10\n" +
+	"*\xff\x04\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11INVALID_PARAMETER\x10\x01\x12\x10\n" +
@@ -1498,7 +3237,16 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\bNO_QUOTA\x10\r\x12\x13\n" +
 	"\x0fQUOTA_NOT_FOUND\x10\x0e\x12\x16\n" +
 	"\x12PROVIDER_NOT_FOUND\x10\x0f\x12\x13\n" +
-	"\x0fUNKNOWN_CHANNEL\x10\x102\xf2\x05\n" +
+	"\x0fUNKNOWN_CHANNEL\x10\x10\x12\x1f\n" +
+	"\x1bNOTIFICATION_NOT_CANCELABLE\x10\x11\x12\x1d\n" +
+	"\x19NOTIFICATION_NOT_EDITABLE\x10\x12\x12\x19\n" +
+	"\x15TEMPLATE_NOT_APPROVED\x10\x13\x12\x16\n" +
+	"\x12PROVIDER_THROTTLED\x10\x14\x12\x12\n" +
+	"\x0ePROVIDER_FAULT\x10\x15\x12\x14\n" +
+	"\x10RECEIVER_INVALID\x10\x16\x12\x17\n" +
+	"\x13RECEIVER_SUPPRESSED\x10\x17\x12\x13\n" +
+	"\x0fDEADLINE_PASSED\x10\x18\x12\x14\n" +
+	"\x10FREQUENCY_CAPPED\x10\x192\x89\x0e\n" +
 	"\x13NotificationService\x12g\n" +
 	"\x10SendNotification\x12(.notification.v1.SendNotificationRequest\x1a).notification.v1.SendNotificationResponse\x12v\n" +
 	"\x15SendNotificationAsync\x12-.notification.v1.SendNotificationAsyncRequest\x1a..notification.v1.SendNotificationAsyncResponse\x12y\n" +
@@ -1506,7 +3254,16 @@ const file_notification_v1_notification_proto_rawDesc = "" +
 	"\x1bBatchSendNotificationsAsync\x123.notification.v1.BatchSendNotificationsAsyncRequest\x1a4.notification.v1.BatchSendNotificationsAsyncResponse\x12R\n" +
 	"\tTxPrepare\x12!.notification.v1.TxPrepareRequest\x1a\".notification.v1.TxPrepareResponse\x12O\n" +
 	"\bTxCommit\x12 .notification.v1.TxCommitRequest\x1a!.notification.v1.TxCommitResponse\x12O\n" +
-	"\bTxCancel\x12 .notification.v1.TxCancelRequest\x1a!.notification.v1.TxCancelResponseB\xdb\x01\n" +
+	"\bTxCancel\x12 .notification.v1.TxCancelRequest\x1a!.notification.v1.TxCancelResponse\x12m\n" +
+	"\x12CancelNotification\x12*.notification.v1.CancelNotificationRequest\x1a+.notification.v1.CancelNotificationResponse\x12\x7f\n" +
+	"\x18BatchCancelNotifications\x120.notification.v1.BatchCancelNotificationsRequest\x1a1.notification.v1.BatchCancelNotificationsResponse\x12m\n" +
+	"\x12UpdateNotification\x12*.notification.v1.UpdateNotificationRequest\x1a+.notification.v1.UpdateNotificationResponse\x12j\n" +
+	"\x11ListInboxMessages\x12).notification.v1.ListInboxMessagesRequest\x1a*.notification.v1.ListInboxMessagesResponse\x12v\n" +
+	"\x15MarkInboxMessagesRead\x12-.notification.v1.MarkInboxMessagesReadRequest\x1a..notification.v1.MarkInboxMessagesReadResponse\x12|\n" +
+	"\x17MarkInboxMessagesUnread\x12/.notification.v1.MarkInboxMessagesUnreadRequest\x1a0.notification.v1.MarkInboxMessagesUnreadResponse\x12p\n" +
+	"\x13DeleteInboxMessages\x12+.notification.v1.DeleteInboxMessagesRequest\x1a,.notification.v1.DeleteInboxMessagesResponse\x12p\n" +
+	"\x13GetInboxUnreadCount\x12+.notification.v1.GetInboxUnreadCountRequest\x1a,.notification.v1.GetInboxUnreadCountResponse\x12p\n" +
+	"\x13PreviewNotification\x12+.notification.v1.PreviewNotificationRequest\x1a,.notification.v1.PreviewNotificationResponseB\xdb\x01\n" +
 	"\x13com.notification.v1B\x11NotificationProtoP\x01ZTgitee.com/flycash/notification-platform/api/proto/gen/notification/v1;notificationv1\xa2\x02\x03NXX\xaa\x02\x0fNotification.V1\xca\x02\x0fNotification\\V1\xe2\x02\x1bNotification\\V1\\GPBMetadata\xea\x02\x10Notification::V1b\x06proto3"
 
 var (
@@ -1523,76 +3280,144 @@ func file_notification_v1_notification_proto_rawDescGZIP() []byte {
 
 var (
 	file_notification_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-	file_notification_v1_notification_proto_msgTypes  = make([]protoimpl.MessageInfo, 22)
+	file_notification_v1_notification_proto_msgTypes  = make([]protoimpl.MessageInfo, 48)
 	file_notification_v1_notification_proto_goTypes   = []any{
 		(Channel)(0),                                // 0: notification.v1.Channel
 		(SendStatus)(0),                             // 1: notification.v1.SendStatus
 		(ErrorCode)(0),                              // 2: notification.v1.ErrorCode
 		(*SendStrategy)(nil),                        // 3: notification.v1.SendStrategy
 		(*Notification)(nil),                        // 4: notification.v1.Notification
-		(*SendNotificationRequest)(nil),             // 5: notification.v1.SendNotificationRequest
-		(*SendNotificationResponse)(nil),            // 6: notification.v1.SendNotificationResponse
-		(*SendNotificationAsyncRequest)(nil),        // 7: notification.v1.SendNotificationAsyncRequest
-		(*SendNotificationAsyncResponse)(nil),       // 8: notification.v1.SendNotificationAsyncResponse
-		(*BatchSendNotificationsRequest)(nil),       // 9: notification.v1.BatchSendNotificationsRequest
-		(*BatchSendNotificationsResponse)(nil),      // 10: notification.v1.BatchSendNotificationsResponse
-		(*BatchSendNotificationsAsyncRequest)(nil),  // 11: notification.v1.BatchSendNotificationsAsyncRequest
-		(*BatchSendNotificationsAsyncResponse)(nil), // 12: notification.v1.BatchSendNotificationsAsyncResponse
-		(*TxPrepareRequest)(nil),                    // 13: notification.v1.TxPrepareRequest
-		(*TxPrepareResponse)(nil),                   // 14: notification.v1.TxPrepareResponse
-		(*TxCommitRequest)(nil),                     // 15: notification.v1.TxCommitRequest
-		(*TxCommitResponse)(nil),                    // 16: notification.v1.TxCommitResponse
-		(*TxCancelRequest)(nil),                     // 17: notification.v1.TxCancelRequest
-		(*TxCancelResponse)(nil),                    // 18: notification.v1.TxCancelResponse
-		(*SendStrategy_ImmediateStrategy)(nil),      // 19: notification.v1.SendStrategy.ImmediateStrategy
-		(*SendStrategy_DelayedStrategy)(nil),        // 20: notification.v1.SendStrategy.DelayedStrategy
-		(*SendStrategy_ScheduledStrategy)(nil),      // 21: notification.v1.SendStrategy.ScheduledStrategy
-		(*SendStrategy_TimeWindowStrategy)(nil),     // 22: notification.v1.SendStrategy.TimeWindowStrategy
-		(*SendStrategy_DeadlineStrategy)(nil),       // 23: notification.v1.SendStrategy.DeadlineStrategy
-		nil,                                         // 24: notification.v1.Notification.TemplateParamsEntry
-		(*timestamppb.Timestamp)(nil),               // 25: google.protobuf.Timestamp
+		(*FailoverReceivers)(nil),                   // 5: notification.v1.FailoverReceivers
+		(*SendNotificationRequest)(nil),             // 6: notification.v1.SendNotificationRequest
+		(*SendNotificationResponse)(nil),            // 7: notification.v1.SendNotificationResponse
+		(*SendAttempt)(nil),                         // 8: notification.v1.SendAttempt
+		(*ReceiverResult)(nil),                      // 9: notification.v1.ReceiverResult
+		(*SendNotificationAsyncRequest)(nil),        // 10: notification.v1.SendNotificationAsyncRequest
+		(*SendNotificationAsyncResponse)(nil),       // 11: notification.v1.SendNotificationAsyncResponse
+		(*BatchSendNotificationsRequest)(nil),       // 12: notification.v1.BatchSendNotificationsRequest
+		(*BatchSendNotificationsResponse)(nil),      // 13: notification.v1.BatchSendNotificationsResponse
+		(*BatchSendNotificationsAsyncRequest)(nil),  // 14: notification.v1.BatchSendNotificationsAsyncRequest
+		(*BatchSendNotificationsAsyncResponse)(nil), // 15: notification.v1.BatchSendNotificationsAsyncResponse
+		(*TxPrepareRequest)(nil),                    // 16: notification.v1.TxPrepareRequest
+		(*TxPrepareResponse)(nil),                   // 17: notification.v1.TxPrepareResponse
+		(*TxCommitRequest)(nil),                     // 18: notification.v1.TxCommitRequest
+		(*TxCommitResponse)(nil),                    // 19: notification.v1.TxCommitResponse
+		(*TxCancelRequest)(nil),                     // 20: notification.v1.TxCancelRequest
+		(*TxCancelResponse)(nil),                    // 21: notification.v1.TxCancelResponse
+		(*CancelNotificationRequest)(nil),           // 22: notification.v1.CancelNotificationRequest
+		(*CancelNotificationResponse)(nil),          // 23: notification.v1.CancelNotificationResponse
+		(*BatchCancelNotificationsRequest)(nil),     // 24: notification.v1.BatchCancelNotificationsRequest
+		(*BatchCancelNotificationsResponse)(nil),    // 25: notification.v1.BatchCancelNotificationsResponse
+		(*UpdateNotificationRequest)(nil),           // 26: notification.v1.UpdateNotificationRequest
+		(*UpdateNotificationResponse)(nil),          // 27: notification.v1.UpdateNotificationResponse
+		(*InboxMessage)(nil),                        // 28: notification.v1.InboxMessage
+		(*ListInboxMessagesRequest)(nil),            // 29: notification.v1.ListInboxMessagesRequest
+		(*ListInboxMessagesResponse)(nil),           // 30: notification.v1.ListInboxMessagesResponse
+		(*MarkInboxMessagesReadRequest)(nil),        // 31: notification.v1.MarkInboxMessagesReadRequest
+		(*MarkInboxMessagesReadResponse)(nil),       // 32: notification.v1.MarkInboxMessagesReadResponse
+		(*MarkInboxMessagesUnreadRequest)(nil),      // 33: notification.v1.MarkInboxMessagesUnreadRequest
+		(*MarkInboxMessagesUnreadResponse)(nil),     // 34: notification.v1.MarkInboxMessagesUnreadResponse
+		(*DeleteInboxMessagesRequest)(nil),          // 35: notification.v1.DeleteInboxMessagesRequest
+		(*DeleteInboxMessagesResponse)(nil),         // 36: notification.v1.DeleteInboxMessagesResponse
+		(*GetInboxUnreadCountRequest)(nil),          // 37: notification.v1.GetInboxUnreadCountRequest
+		(*GetInboxUnreadCountResponse)(nil),         // 38: notification.v1.GetInboxUnreadCountResponse
+		(*PreviewNotificationRequest)(nil),          // 39: notification.v1.PreviewNotificationRequest
+		(*PreviewNotificationResponse)(nil),         // 40: notification.v1.PreviewNotificationResponse
+		(*SendStrategy_ImmediateStrategy)(nil),      // 41: notification.v1.SendStrategy.ImmediateStrategy
+		(*SendStrategy_DelayedStrategy)(nil),        // 42: notification.v1.SendStrategy.DelayedStrategy
+		(*SendStrategy_ScheduledStrategy)(nil),      // 43: notification.v1.SendStrategy.ScheduledStrategy
+		(*SendStrategy_TimeWindowStrategy)(nil),     // 44: notification.v1.SendStrategy.TimeWindowStrategy
+		(*SendStrategy_DeadlineStrategy)(nil),       // 45: notification.v1.SendStrategy.DeadlineStrategy
+		(*SendStrategy_RecurringStrategy)(nil),      // 46: notification.v1.SendStrategy.RecurringStrategy
+		nil,                                         // 47: notification.v1.Notification.TemplateParamsEntry
+		nil,                                         // 48: notification.v1.Notification.ReceiverLocalesEntry
+		nil,                                         // 49: notification.v1.UpdateNotificationRequest.TemplateParamsEntry
+		nil,                                         // 50: notification.v1.PreviewNotificationRequest.TemplateParamsEntry
+		(*fieldmaskpb.FieldMask)(nil),               // 51: google.protobuf.FieldMask
+		(*timestamppb.Timestamp)(nil),               // 52: google.protobuf.Timestamp
 	}
 )
 
 var file_notification_v1_notification_proto_depIdxs = []int32{
-	19, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
-	20, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
-	21, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
-	22, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
-	23, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
-	0,  // 5: notification.v1.Notification.channel:type_name -> notification.v1.Channel
-	24, // 6: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
-	3,  // 7: notification.v1.Notification.strategy:type_name -> notification.v1.SendStrategy
-	4,  // 8: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
-	1,  // 9: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
-	2,  // 10: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 11: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
-	2,  // 12: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
-	4,  // 13: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
-	6,  // 14: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
-	4,  // 15: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
-	4,  // 16: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
-	25, // 17: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
-	25, // 18: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
-	5,  // 19: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
-	7,  // 20: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
-	9,  // 21: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
-	11, // 22: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
-	13, // 23: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
-	15, // 24: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
-	17, // 25: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
-	6,  // 26: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
-	8,  // 27: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
-	10, // 28: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
-	12, // 29: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
-	14, // 30: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
-	16, // 31: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
-	18, // 32: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	41, // 0: notification.v1.SendStrategy.immediate:type_name -> notification.v1.SendStrategy.ImmediateStrategy
+	42, // 1: notification.v1.SendStrategy.delayed:type_name -> notification.v1.SendStrategy.DelayedStrategy
+	43, // 2: notification.v1.SendStrategy.scheduled:type_name -> notification.v1.SendStrategy.ScheduledStrategy
+	44, // 3: notification.v1.SendStrategy.time_window:type_name -> notification.v1.SendStrategy.TimeWindowStrategy
+	45, // 4: notification.v1.SendStrategy.deadline:type_name -> notification.v1.SendStrategy.DeadlineStrategy
+	46, // 5: notification.v1.SendStrategy.recurring:type_name -> notification.v1.SendStrategy.RecurringStrategy
+	0,  // 6: notification.v1.Notification.channel:type_name -> notification.v1.Channel
+	47, // 7: notification.v1.Notification.template_params:type_name -> notification.v1.Notification.TemplateParamsEntry
+	3,  // 8: notification.v1.Notification.strategy:type_name -> notification.v1.SendStrategy
+	48, // 9: notification.v1.Notification.receiver_locales:type_name -> notification.v1.Notification.ReceiverLocalesEntry
+	5,  // 10: notification.v1.Notification.failover_receivers:type_name -> notification.v1.FailoverReceivers
+	0,  // 11: notification.v1.FailoverReceivers.channel:type_name -> notification.v1.Channel
+	4,  // 12: notification.v1.SendNotificationRequest.notification:type_name -> notification.v1.Notification
+	1,  // 13: notification.v1.SendNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 14: notification.v1.SendNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	9,  // 15: notification.v1.SendNotificationResponse.receiver_results:type_name -> notification.v1.ReceiverResult
+	0,  // 16: notification.v1.SendNotificationResponse.sent_channel:type_name -> notification.v1.Channel
+	8,  // 17: notification.v1.SendNotificationResponse.attempts:type_name -> notification.v1.SendAttempt
+	0,  // 18: notification.v1.SendAttempt.channel:type_name -> notification.v1.Channel
+	1,  // 19: notification.v1.SendAttempt.status:type_name -> notification.v1.SendStatus
+	1,  // 20: notification.v1.ReceiverResult.status:type_name -> notification.v1.SendStatus
+	4,  // 21: notification.v1.SendNotificationAsyncRequest.notification:type_name -> notification.v1.Notification
+	2,  // 22: notification.v1.SendNotificationAsyncResponse.error_code:type_name -> notification.v1.ErrorCode
+	9,  // 23: notification.v1.SendNotificationAsyncResponse.receiver_results:type_name -> notification.v1.ReceiverResult
+	4,  // 24: notification.v1.BatchSendNotificationsRequest.notifications:type_name -> notification.v1.Notification
+	7,  // 25: notification.v1.BatchSendNotificationsResponse.results:type_name -> notification.v1.SendNotificationResponse
+	4,  // 26: notification.v1.BatchSendNotificationsAsyncRequest.notifications:type_name -> notification.v1.Notification
+	4,  // 27: notification.v1.TxPrepareRequest.notification:type_name -> notification.v1.Notification
+	1,  // 28: notification.v1.CancelNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 29: notification.v1.CancelNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	23, // 30: notification.v1.BatchCancelNotificationsResponse.results:type_name -> notification.v1.CancelNotificationResponse
+	3,  // 31: notification.v1.UpdateNotificationRequest.strategy:type_name -> notification.v1.SendStrategy
+	49, // 32: notification.v1.UpdateNotificationRequest.template_params:type_name -> notification.v1.UpdateNotificationRequest.TemplateParamsEntry
+	51, // 33: notification.v1.UpdateNotificationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 34: notification.v1.UpdateNotificationResponse.status:type_name -> notification.v1.SendStatus
+	2,  // 35: notification.v1.UpdateNotificationResponse.error_code:type_name -> notification.v1.ErrorCode
+	28, // 36: notification.v1.ListInboxMessagesResponse.messages:type_name -> notification.v1.InboxMessage
+	50, // 37: notification.v1.PreviewNotificationRequest.template_params:type_name -> notification.v1.PreviewNotificationRequest.TemplateParamsEntry
+	0,  // 38: notification.v1.PreviewNotificationResponse.channel:type_name -> notification.v1.Channel
+	52, // 39: notification.v1.SendStrategy.ScheduledStrategy.send_time:type_name -> google.protobuf.Timestamp
+	52, // 40: notification.v1.SendStrategy.DeadlineStrategy.deadline:type_name -> google.protobuf.Timestamp
+	52, // 41: notification.v1.SendStrategy.RecurringStrategy.end_time:type_name -> google.protobuf.Timestamp
+	6,  // 42: notification.v1.NotificationService.SendNotification:input_type -> notification.v1.SendNotificationRequest
+	10, // 43: notification.v1.NotificationService.SendNotificationAsync:input_type -> notification.v1.SendNotificationAsyncRequest
+	12, // 44: notification.v1.NotificationService.BatchSendNotifications:input_type -> notification.v1.BatchSendNotificationsRequest
+	14, // 45: notification.v1.NotificationService.BatchSendNotificationsAsync:input_type -> notification.v1.BatchSendNotificationsAsyncRequest
+	16, // 46: notification.v1.NotificationService.TxPrepare:input_type -> notification.v1.TxPrepareRequest
+	18, // 47: notification.v1.NotificationService.TxCommit:input_type -> notification.v1.TxCommitRequest
+	20, // 48: notification.v1.NotificationService.TxCancel:input_type -> notification.v1.TxCancelRequest
+	22, // 49: notification.v1.NotificationService.CancelNotification:input_type -> notification.v1.CancelNotificationRequest
+	24, // 50: notification.v1.NotificationService.BatchCancelNotifications:input_type -> notification.v1.BatchCancelNotificationsRequest
+	26, // 51: notification.v1.NotificationService.UpdateNotification:input_type -> notification.v1.UpdateNotificationRequest
+	29, // 52: notification.v1.NotificationService.ListInboxMessages:input_type -> notification.v1.ListInboxMessagesRequest
+	31, // 53: notification.v1.NotificationService.MarkInboxMessagesRead:input_type -> notification.v1.MarkInboxMessagesReadRequest
+	33, // 54: notification.v1.NotificationService.MarkInboxMessagesUnread:input_type -> notification.v1.MarkInboxMessagesUnreadRequest
+	35, // 55: notification.v1.NotificationService.DeleteInboxMessages:input_type -> notification.v1.DeleteInboxMessagesRequest
+	37, // 56: notification.v1.NotificationService.GetInboxUnreadCount:input_type -> notification.v1.GetInboxUnreadCountRequest
+	39, // 57: notification.v1.NotificationService.PreviewNotification:input_type -> notification.v1.PreviewNotificationRequest
+	7,  // 58: notification.v1.NotificationService.SendNotification:output_type -> notification.v1.SendNotificationResponse
+	11, // 59: notification.v1.NotificationService.SendNotificationAsync:output_type -> notification.v1.SendNotificationAsyncResponse
+	13, // 60: notification.v1.NotificationService.BatchSendNotifications:output_type -> notification.v1.BatchSendNotificationsResponse
+	15, // 61: notification.v1.NotificationService.BatchSendNotificationsAsync:output_type -> notification.v1.BatchSendNotificationsAsyncResponse
+	17, // 62: notification.v1.NotificationService.TxPrepare:output_type -> notification.v1.TxPrepareResponse
+	19, // 63: notification.v1.NotificationService.TxCommit:output_type -> notification.v1.TxCommitResponse
+	21, // 64: notification.v1.NotificationService.TxCancel:output_type -> notification.v1.TxCancelResponse
+	23, // 65: notification.v1.NotificationService.CancelNotification:output_type -> notification.v1.CancelNotificationResponse
+	25, // 66: notification.v1.NotificationService.BatchCancelNotifications:output_type -> notification.v1.BatchCancelNotificationsResponse
+	27, // 67: notification.v1.NotificationService.UpdateNotification:output_type -> notification.v1.UpdateNotificationResponse
+	30, // 68: notification.v1.NotificationService.ListInboxMessages:output_type -> notification.v1.ListInboxMessagesResponse
+	32, // 69: notification.v1.NotificationService.MarkInboxMessagesRead:output_type -> notification.v1.MarkInboxMessagesReadResponse
+	34, // 70: notification.v1.NotificationService.MarkInboxMessagesUnread:output_type -> notification.v1.MarkInboxMessagesUnreadResponse
+	36, // 71: notification.v1.NotificationService.DeleteInboxMessages:output_type -> notification.v1.DeleteInboxMessagesResponse
+	38, // 72: notification.v1.NotificationService.GetInboxUnreadCount:output_type -> notification.v1.GetInboxUnreadCountResponse
+	40, // 73: notification.v1.NotificationService.PreviewNotification:output_type -> notification.v1.PreviewNotificationResponse
+	58, // [58:74] is the sub-list for method output_type
+	42, // [42:58] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_notification_v1_notification_proto_init() }
@@ -1606,6 +3431,7 @@ func file_notification_v1_notification_proto_init() {
 		(*SendStrategy_Scheduled)(nil),
 		(*SendStrategy_TimeWindow)(nil),
 		(*SendStrategy_Deadline)(nil),
+		(*SendStrategy_Recurring)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1613,7 +3439,7 @@ func file_notification_v1_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_v1_notification_proto_rawDesc), len(file_notification_v1_notification_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   22,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
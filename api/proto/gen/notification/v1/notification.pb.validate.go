@@ -263,6 +263,47 @@ func (m *SendStrategy) validate(all bool) error {
 			}
 		}
 
+	case *SendStrategy_Recurring:
+		if v == nil {
+			err := SendStrategyValidationError{
+				field:  "StrategyType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRecurring()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Recurring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendStrategyValidationError{
+						field:  "Recurring",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRecurring()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendStrategyValidationError{
+					field:  "Recurring",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -405,6 +446,44 @@ func (m *Notification) validate(all bool) error {
 
 	// no validation rules for Receiver
 
+	// no validation rules for Locale
+
+	// no validation rules for ReceiverLocales
+
+	for idx, item := range m.GetFailoverReceivers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("FailoverReceivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NotificationValidationError{
+						field:  fmt.Sprintf("FailoverReceivers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationValidationError{
+					field:  fmt.Sprintf("FailoverReceivers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return NotificationMultiError(errors)
 	}
@@ -482,6 +561,110 @@ var _ interface {
 	ErrorName() string
 } = NotificationValidationError{}
 
+// Validate checks the field values on FailoverReceivers with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FailoverReceivers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailoverReceivers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FailoverReceiversMultiError, or nil if none found.
+func (m *FailoverReceivers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailoverReceivers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	if len(errors) > 0 {
+		return FailoverReceiversMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailoverReceiversMultiError is an error wrapping multiple validation errors
+// returned by FailoverReceivers.ValidateAll() if the designated constraints
+// aren't met.
+type FailoverReceiversMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailoverReceiversMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailoverReceiversMultiError) AllErrors() []error { return m }
+
+// FailoverReceiversValidationError is the validation error returned by
+// FailoverReceivers.Validate if the designated constraints aren't met.
+type FailoverReceiversValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailoverReceiversValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailoverReceiversValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailoverReceiversValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailoverReceiversValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailoverReceiversValidationError) ErrorName() string {
+	return "FailoverReceiversValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FailoverReceiversValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailoverReceivers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailoverReceiversValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailoverReceiversValidationError{}
+
 // Validate checks the field values on SendNotificationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -643,6 +826,80 @@ func (m *SendNotificationResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	for idx, item := range m.GetReceiverResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendNotificationResponseValidationError{
+					field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SentChannel
+
+	// no validation rules for RetryCount
+
+	// no validation rules for NextRetryTime
+
+	for idx, item := range m.GetAttempts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendNotificationResponseValidationError{
+						field:  fmt.Sprintf("Attempts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendNotificationResponseValidationError{
+					field:  fmt.Sprintf("Attempts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SendNotificationResponseMultiError(errors)
 	}
@@ -723,71 +980,51 @@ var _ interface {
 	ErrorName() string
 } = SendNotificationResponseValidationError{}
 
-// Validate checks the field values on SendNotificationAsyncRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SendNotificationAsyncRequest) Validate() error {
+// Validate checks the field values on SendAttempt with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendAttempt) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SendNotificationAsyncRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SendNotificationAsyncRequestMultiError, or nil if none found.
-func (m *SendNotificationAsyncRequest) ValidateAll() error {
+// ValidateAll checks the field values on SendAttempt with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendAttemptMultiError, or
+// nil if none found.
+func (m *SendAttempt) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SendNotificationAsyncRequest) validate(all bool) error {
+func (m *SendAttempt) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNotification()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SendNotificationAsyncRequestValidationError{
-					field:  "Notification",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SendNotificationAsyncRequestValidationError{
-					field:  "Notification",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SendNotificationAsyncRequestValidationError{
-				field:  "Notification",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Attempt
+
+	// no validation rules for Channel
+
+	// no validation rules for Status
+
+	// no validation rules for Message
+
+	// no validation rules for Ctime
 
 	if len(errors) > 0 {
-		return SendNotificationAsyncRequestMultiError(errors)
+		return SendAttemptMultiError(errors)
 	}
 
 	return nil
 }
 
-// SendNotificationAsyncRequestMultiError is an error wrapping multiple
-// validation errors returned by SendNotificationAsyncRequest.ValidateAll() if
-// the designated constraints aren't met.
-type SendNotificationAsyncRequestMultiError []error
+// SendAttemptMultiError is an error wrapping multiple validation errors
+// returned by SendAttempt.ValidateAll() if the designated constraints aren't met.
+type SendAttemptMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SendNotificationAsyncRequestMultiError) Error() string {
+func (m SendAttemptMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -796,10 +1033,249 @@ func (m SendNotificationAsyncRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SendNotificationAsyncRequestMultiError) AllErrors() []error { return m }
+func (m SendAttemptMultiError) AllErrors() []error { return m }
 
-// SendNotificationAsyncRequestValidationError is the validation error returned
-// by SendNotificationAsyncRequest.Validate if the designated constraints
+// SendAttemptValidationError is the validation error returned by
+// SendAttempt.Validate if the designated constraints aren't met.
+type SendAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendAttemptValidationError) ErrorName() string { return "SendAttemptValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendAttemptValidationError{}
+
+// Validate checks the field values on ReceiverResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiverResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiverResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiverResultMultiError,
+// or nil if none found.
+func (m *ReceiverResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiverResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Status
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for Provider
+
+	if len(errors) > 0 {
+		return ReceiverResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiverResultMultiError is an error wrapping multiple validation errors
+// returned by ReceiverResult.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiverResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiverResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiverResultMultiError) AllErrors() []error { return m }
+
+// ReceiverResultValidationError is the validation error returned by
+// ReceiverResult.Validate if the designated constraints aren't met.
+type ReceiverResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiverResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiverResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiverResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiverResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiverResultValidationError) ErrorName() string { return "ReceiverResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiverResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiverResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiverResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiverResultValidationError{}
+
+// Validate checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendNotificationAsyncRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendNotificationAsyncRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendNotificationAsyncRequestMultiError, or nil if none found.
+func (m *SendNotificationAsyncRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendNotificationAsyncRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNotification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendNotificationAsyncRequestValidationError{
+					field:  "Notification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendNotificationAsyncRequestValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SendNotificationAsyncRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendNotificationAsyncRequestMultiError is an error wrapping multiple
+// validation errors returned by SendNotificationAsyncRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SendNotificationAsyncRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendNotificationAsyncRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendNotificationAsyncRequestMultiError) AllErrors() []error { return m }
+
+// SendNotificationAsyncRequestValidationError is the validation error returned
+// by SendNotificationAsyncRequest.Validate if the designated constraints
 // aren't met.
 type SendNotificationAsyncRequestValidationError struct {
 	field  string
@@ -883,6 +1359,40 @@ func (m *SendNotificationAsyncResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	for idx, item := range m.GetReceiverResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendNotificationAsyncResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendNotificationAsyncResponseValidationError{
+						field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendNotificationAsyncResponseValidationError{
+					field:  fmt.Sprintf("ReceiverResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SendNotificationAsyncResponseMultiError(errors)
 	}
@@ -1688,7 +2198,2133 @@ func (e TxPrepareResponseValidationError) ErrorName() string {
 }
 
 // Error satisfies the builtin error interface
-func (e TxPrepareResponseValidationError) Error() string {
+func (e TxPrepareResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxPrepareResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxPrepareResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxPrepareResponseValidationError{}
+
+// Validate checks the field values on TxCommitRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxCommitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxCommitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxCommitRequestMultiError, or nil if none found.
+func (m *TxCommitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxCommitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return TxCommitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxCommitRequestMultiError is an error wrapping multiple validation errors
+// returned by TxCommitRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TxCommitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxCommitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxCommitRequestMultiError) AllErrors() []error { return m }
+
+// TxCommitRequestValidationError is the validation error returned by
+// TxCommitRequest.Validate if the designated constraints aren't met.
+type TxCommitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxCommitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxCommitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxCommitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxCommitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxCommitRequestValidationError) ErrorName() string { return "TxCommitRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxCommitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxCommitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxCommitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxCommitRequestValidationError{}
+
+// Validate checks the field values on TxCommitResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxCommitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxCommitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxCommitResponseMultiError, or nil if none found.
+func (m *TxCommitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxCommitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TxCommitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxCommitResponseMultiError is an error wrapping multiple validation errors
+// returned by TxCommitResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TxCommitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxCommitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxCommitResponseMultiError) AllErrors() []error { return m }
+
+// TxCommitResponseValidationError is the validation error returned by
+// TxCommitResponse.Validate if the designated constraints aren't met.
+type TxCommitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxCommitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxCommitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxCommitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxCommitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxCommitResponseValidationError) ErrorName() string { return "TxCommitResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxCommitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxCommitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxCommitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxCommitResponseValidationError{}
+
+// Validate checks the field values on TxCancelRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxCancelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxCancelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxCancelRequestMultiError, or nil if none found.
+func (m *TxCancelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxCancelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return TxCancelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxCancelRequestMultiError is an error wrapping multiple validation errors
+// returned by TxCancelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TxCancelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxCancelRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxCancelRequestMultiError) AllErrors() []error { return m }
+
+// TxCancelRequestValidationError is the validation error returned by
+// TxCancelRequest.Validate if the designated constraints aren't met.
+type TxCancelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxCancelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxCancelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxCancelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxCancelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxCancelRequestValidationError) ErrorName() string { return "TxCancelRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxCancelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxCancelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxCancelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxCancelRequestValidationError{}
+
+// Validate checks the field values on TxCancelResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxCancelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxCancelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxCancelResponseMultiError, or nil if none found.
+func (m *TxCancelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxCancelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TxCancelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxCancelResponseMultiError is an error wrapping multiple validation errors
+// returned by TxCancelResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TxCancelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxCancelResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxCancelResponseMultiError) AllErrors() []error { return m }
+
+// TxCancelResponseValidationError is the validation error returned by
+// TxCancelResponse.Validate if the designated constraints aren't met.
+type TxCancelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxCancelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxCancelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxCancelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxCancelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxCancelResponseValidationError) ErrorName() string { return "TxCancelResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxCancelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxCancelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxCancelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxCancelResponseValidationError{}
+
+// Validate checks the field values on CancelNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationRequestMultiError, or nil if none found.
+func (m *CancelNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CancelNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationRequestMultiError) AllErrors() []error { return m }
+
+// CancelNotificationRequestValidationError is the validation error returned by
+// CancelNotificationRequest.Validate if the designated constraints aren't met.
+type CancelNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationRequestValidationError) ErrorName() string {
+	return "CancelNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationRequestValidationError{}
+
+// Validate checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelNotificationResponseMultiError, or nil if none found.
+func (m *CancelNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return CancelNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelNotificationResponseMultiError) AllErrors() []error { return m }
+
+// CancelNotificationResponseValidationError is the validation error returned
+// by CancelNotificationResponse.Validate if the designated constraints aren't met.
+type CancelNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelNotificationResponseValidationError) ErrorName() string {
+	return "CancelNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelNotificationResponseValidationError{}
+
+// Validate checks the field values on BatchCancelNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCancelNotificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCancelNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchCancelNotificationsRequestMultiError, or nil if none found.
+func (m *BatchCancelNotificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCancelNotificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return BatchCancelNotificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCancelNotificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchCancelNotificationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchCancelNotificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCancelNotificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCancelNotificationsRequestMultiError) AllErrors() []error { return m }
+
+// BatchCancelNotificationsRequestValidationError is the validation error
+// returned by BatchCancelNotificationsRequest.Validate if the designated
+// constraints aren't met.
+type BatchCancelNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCancelNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCancelNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCancelNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCancelNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCancelNotificationsRequestValidationError) ErrorName() string {
+	return "BatchCancelNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCancelNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCancelNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCancelNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCancelNotificationsRequestValidationError{}
+
+// Validate checks the field values on BatchCancelNotificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchCancelNotificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCancelNotificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchCancelNotificationsResponseMultiError, or nil if none found.
+func (m *BatchCancelNotificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCancelNotificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCancelNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCancelNotificationsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCancelNotificationsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCancelNotificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCancelNotificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchCancelNotificationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCancelNotificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCancelNotificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCancelNotificationsResponseMultiError) AllErrors() []error { return m }
+
+// BatchCancelNotificationsResponseValidationError is the validation error
+// returned by BatchCancelNotificationsResponse.Validate if the designated
+// constraints aren't met.
+type BatchCancelNotificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCancelNotificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCancelNotificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCancelNotificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCancelNotificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCancelNotificationsResponseValidationError) ErrorName() string {
+	return "BatchCancelNotificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCancelNotificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCancelNotificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCancelNotificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCancelNotificationsResponseValidationError{}
+
+// Validate checks the field values on UpdateNotificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNotificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNotificationRequestMultiError, or nil if none found.
+func (m *UpdateNotificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetStrategy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNotificationRequestValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNotificationRequestValidationError{
+					field:  "Strategy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrategy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNotificationRequestValidationError{
+				field:  "Strategy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TemplateParams
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNotificationRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNotificationRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNotificationRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNotificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateNotificationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateNotificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationRequestValidationError is the validation error returned by
+// UpdateNotificationRequest.Validate if the designated constraints aren't met.
+type UpdateNotificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationRequestValidationError) ErrorName() string {
+	return "UpdateNotificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationRequestValidationError{}
+
+// Validate checks the field values on UpdateNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNotificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNotificationResponseMultiError, or nil if none found.
+func (m *UpdateNotificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Status
+
+	// no validation rules for ScheduledStime
+
+	// no validation rules for ScheduledEtime
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return UpdateNotificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateNotificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationResponseValidationError is the validation error returned
+// by UpdateNotificationResponse.Validate if the designated constraints aren't met.
+type UpdateNotificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationResponseValidationError) ErrorName() string {
+	return "UpdateNotificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationResponseValidationError{}
+
+// Validate checks the field values on InboxMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InboxMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboxMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InboxMessageMultiError, or
+// nil if none found.
+func (m *InboxMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboxMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NotificationId
+
+	// no validation rules for Receiver
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for Read
+
+	// no validation rules for ReadTime
+
+	// no validation rules for Ctime
+
+	if len(errors) > 0 {
+		return InboxMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboxMessageMultiError is an error wrapping multiple validation errors
+// returned by InboxMessage.ValidateAll() if the designated constraints aren't met.
+type InboxMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboxMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboxMessageMultiError) AllErrors() []error { return m }
+
+// InboxMessageValidationError is the validation error returned by
+// InboxMessage.Validate if the designated constraints aren't met.
+type InboxMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboxMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboxMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboxMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboxMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboxMessageValidationError) ErrorName() string { return "InboxMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InboxMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboxMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboxMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboxMessageValidationError{}
+
+// Validate checks the field values on ListInboxMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInboxMessagesRequestMultiError, or nil if none found.
+func (m *ListInboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	// no validation rules for UnreadOnly
+
+	if len(errors) > 0 {
+		return ListInboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInboxMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListInboxMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListInboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInboxMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListInboxMessagesRequestValidationError is the validation error returned by
+// ListInboxMessagesRequest.Validate if the designated constraints aren't met.
+type ListInboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInboxMessagesRequestValidationError) ErrorName() string {
+	return "ListInboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInboxMessagesRequestValidationError{}
+
+// Validate checks the field values on ListInboxMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInboxMessagesResponseMultiError, or nil if none found.
+func (m *ListInboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInboxMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListInboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInboxMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInboxMessagesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListInboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInboxMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListInboxMessagesResponseValidationError is the validation error returned by
+// ListInboxMessagesResponse.Validate if the designated constraints aren't met.
+type ListInboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInboxMessagesResponseValidationError) ErrorName() string {
+	return "ListInboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInboxMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInboxMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInboxMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInboxMessagesResponseValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesReadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesReadRequestMultiError, or nil if none found.
+func (m *MarkInboxMessagesReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesReadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesReadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type MarkInboxMessagesReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesReadRequestValidationError is the validation error returned
+// by MarkInboxMessagesReadRequest.Validate if the designated constraints
+// aren't met.
+type MarkInboxMessagesReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesReadRequestValidationError) ErrorName() string {
+	return "MarkInboxMessagesReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesReadRequestValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesReadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesReadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesReadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesReadResponseMultiError, or nil if none found.
+func (m *MarkInboxMessagesReadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesReadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesReadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesReadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MarkInboxMessagesReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesReadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesReadResponseMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesReadResponseValidationError is the validation error
+// returned by MarkInboxMessagesReadResponse.Validate if the designated
+// constraints aren't met.
+type MarkInboxMessagesReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesReadResponseValidationError) ErrorName() string {
+	return "MarkInboxMessagesReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesReadResponseValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesUnreadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesUnreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesUnreadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesUnreadRequestMultiError, or nil if none found.
+func (m *MarkInboxMessagesUnreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesUnreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesUnreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesUnreadRequestMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesUnreadRequest.ValidateAll()
+// if the designated constraints aren't met.
+type MarkInboxMessagesUnreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesUnreadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesUnreadRequestMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesUnreadRequestValidationError is the validation error
+// returned by MarkInboxMessagesUnreadRequest.Validate if the designated
+// constraints aren't met.
+type MarkInboxMessagesUnreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesUnreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesUnreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesUnreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesUnreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesUnreadRequestValidationError) ErrorName() string {
+	return "MarkInboxMessagesUnreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesUnreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesUnreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesUnreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesUnreadRequestValidationError{}
+
+// Validate checks the field values on MarkInboxMessagesUnreadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MarkInboxMessagesUnreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkInboxMessagesUnreadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MarkInboxMessagesUnreadResponseMultiError, or nil if none found.
+func (m *MarkInboxMessagesUnreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkInboxMessagesUnreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return MarkInboxMessagesUnreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkInboxMessagesUnreadResponseMultiError is an error wrapping multiple
+// validation errors returned by MarkInboxMessagesUnreadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type MarkInboxMessagesUnreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkInboxMessagesUnreadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkInboxMessagesUnreadResponseMultiError) AllErrors() []error { return m }
+
+// MarkInboxMessagesUnreadResponseValidationError is the validation error
+// returned by MarkInboxMessagesUnreadResponse.Validate if the designated
+// constraints aren't met.
+type MarkInboxMessagesUnreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkInboxMessagesUnreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkInboxMessagesUnreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkInboxMessagesUnreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkInboxMessagesUnreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkInboxMessagesUnreadResponseValidationError) ErrorName() string {
+	return "MarkInboxMessagesUnreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkInboxMessagesUnreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkInboxMessagesUnreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkInboxMessagesUnreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkInboxMessagesUnreadResponseValidationError{}
+
+// Validate checks the field values on DeleteInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteInboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteInboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteInboxMessagesRequestMultiError, or nil if none found.
+func (m *DeleteInboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteInboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Receiver
+
+	if len(errors) > 0 {
+		return DeleteInboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteInboxMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteInboxMessagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteInboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteInboxMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteInboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// DeleteInboxMessagesRequestValidationError is the validation error returned
+// by DeleteInboxMessagesRequest.Validate if the designated constraints aren't met.
+type DeleteInboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInboxMessagesRequestValidationError) ErrorName() string {
+	return "DeleteInboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteInboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteInboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteInboxMessagesRequestValidationError{}
+
+// Validate checks the field values on DeleteInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteInboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteInboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteInboxMessagesResponseMultiError, or nil if none found.
+func (m *DeleteInboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteInboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteInboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteInboxMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteInboxMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteInboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteInboxMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteInboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// DeleteInboxMessagesResponseValidationError is the validation error returned
+// by DeleteInboxMessagesResponse.Validate if the designated constraints
+// aren't met.
+type DeleteInboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInboxMessagesResponseValidationError) ErrorName() string {
+	return "DeleteInboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInboxMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1700,14 +4336,14 @@ func (e TxPrepareResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxPrepareResponse.%s: %s%s",
+		"invalid %sDeleteInboxMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxPrepareResponseValidationError{}
+var _ error = DeleteInboxMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1715,46 +4351,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxPrepareResponseValidationError{}
+} = DeleteInboxMessagesResponseValidationError{}
 
-// Validate checks the field values on TxCommitRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TxCommitRequest) Validate() error {
+// Validate checks the field values on GetInboxUnreadCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInboxUnreadCountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxCommitRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetInboxUnreadCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxCommitRequestMultiError, or nil if none found.
-func (m *TxCommitRequest) ValidateAll() error {
+// GetInboxUnreadCountRequestMultiError, or nil if none found.
+func (m *GetInboxUnreadCountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxCommitRequest) validate(all bool) error {
+func (m *GetInboxUnreadCountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
+	// no validation rules for Receiver
 
 	if len(errors) > 0 {
-		return TxCommitRequestMultiError(errors)
+		return GetInboxUnreadCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxCommitRequestMultiError is an error wrapping multiple validation errors
-// returned by TxCommitRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TxCommitRequestMultiError []error
+// GetInboxUnreadCountRequestMultiError is an error wrapping multiple
+// validation errors returned by GetInboxUnreadCountRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetInboxUnreadCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxCommitRequestMultiError) Error() string {
+func (m GetInboxUnreadCountRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1763,11 +4399,11 @@ func (m TxCommitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxCommitRequestMultiError) AllErrors() []error { return m }
+func (m GetInboxUnreadCountRequestMultiError) AllErrors() []error { return m }
 
-// TxCommitRequestValidationError is the validation error returned by
-// TxCommitRequest.Validate if the designated constraints aren't met.
-type TxCommitRequestValidationError struct {
+// GetInboxUnreadCountRequestValidationError is the validation error returned
+// by GetInboxUnreadCountRequest.Validate if the designated constraints aren't met.
+type GetInboxUnreadCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1775,22 +4411,24 @@ type TxCommitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxCommitRequestValidationError) Field() string { return e.field }
+func (e GetInboxUnreadCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxCommitRequestValidationError) Reason() string { return e.reason }
+func (e GetInboxUnreadCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxCommitRequestValidationError) Cause() error { return e.cause }
+func (e GetInboxUnreadCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxCommitRequestValidationError) Key() bool { return e.key }
+func (e GetInboxUnreadCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxCommitRequestValidationError) ErrorName() string { return "TxCommitRequestValidationError" }
+func (e GetInboxUnreadCountRequestValidationError) ErrorName() string {
+	return "GetInboxUnreadCountRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TxCommitRequestValidationError) Error() string {
+func (e GetInboxUnreadCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1802,14 +4440,14 @@ func (e TxCommitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxCommitRequest.%s: %s%s",
+		"invalid %sGetInboxUnreadCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxCommitRequestValidationError{}
+var _ error = GetInboxUnreadCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1817,44 +4455,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxCommitRequestValidationError{}
+} = GetInboxUnreadCountRequestValidationError{}
 
-// Validate checks the field values on TxCommitResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TxCommitResponse) Validate() error {
+// Validate checks the field values on GetInboxUnreadCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInboxUnreadCountResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxCommitResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetInboxUnreadCountResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxCommitResponseMultiError, or nil if none found.
-func (m *TxCommitResponse) ValidateAll() error {
+// GetInboxUnreadCountResponseMultiError, or nil if none found.
+func (m *GetInboxUnreadCountResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxCommitResponse) validate(all bool) error {
+func (m *GetInboxUnreadCountResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Count
+
 	if len(errors) > 0 {
-		return TxCommitResponseMultiError(errors)
+		return GetInboxUnreadCountResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxCommitResponseMultiError is an error wrapping multiple validation errors
-// returned by TxCommitResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TxCommitResponseMultiError []error
+// GetInboxUnreadCountResponseMultiError is an error wrapping multiple
+// validation errors returned by GetInboxUnreadCountResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetInboxUnreadCountResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxCommitResponseMultiError) Error() string {
+func (m GetInboxUnreadCountResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1863,11 +4503,12 @@ func (m TxCommitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxCommitResponseMultiError) AllErrors() []error { return m }
+func (m GetInboxUnreadCountResponseMultiError) AllErrors() []error { return m }
 
-// TxCommitResponseValidationError is the validation error returned by
-// TxCommitResponse.Validate if the designated constraints aren't met.
-type TxCommitResponseValidationError struct {
+// GetInboxUnreadCountResponseValidationError is the validation error returned
+// by GetInboxUnreadCountResponse.Validate if the designated constraints
+// aren't met.
+type GetInboxUnreadCountResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1875,22 +4516,24 @@ type TxCommitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxCommitResponseValidationError) Field() string { return e.field }
+func (e GetInboxUnreadCountResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxCommitResponseValidationError) Reason() string { return e.reason }
+func (e GetInboxUnreadCountResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxCommitResponseValidationError) Cause() error { return e.cause }
+func (e GetInboxUnreadCountResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxCommitResponseValidationError) Key() bool { return e.key }
+func (e GetInboxUnreadCountResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxCommitResponseValidationError) ErrorName() string { return "TxCommitResponseValidationError" }
+func (e GetInboxUnreadCountResponseValidationError) ErrorName() string {
+	return "GetInboxUnreadCountResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TxCommitResponseValidationError) Error() string {
+func (e GetInboxUnreadCountResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1902,14 +4545,14 @@ func (e TxCommitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxCommitResponse.%s: %s%s",
+		"invalid %sGetInboxUnreadCountResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxCommitResponseValidationError{}
+var _ error = GetInboxUnreadCountResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1917,46 +4560,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxCommitResponseValidationError{}
+} = GetInboxUnreadCountResponseValidationError{}
 
-// Validate checks the field values on TxCancelRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TxCancelRequest) Validate() error {
+// Validate checks the field values on PreviewNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewNotificationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxCancelRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PreviewNotificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxCancelRequestMultiError, or nil if none found.
-func (m *TxCancelRequest) ValidateAll() error {
+// PreviewNotificationRequestMultiError, or nil if none found.
+func (m *PreviewNotificationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxCancelRequest) validate(all bool) error {
+func (m *PreviewNotificationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
+	// no validation rules for TemplateId
+
+	// no validation rules for VersionId
+
+	// no validation rules for TemplateParams
 
 	if len(errors) > 0 {
-		return TxCancelRequestMultiError(errors)
+		return PreviewNotificationRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxCancelRequestMultiError is an error wrapping multiple validation errors
-// returned by TxCancelRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TxCancelRequestMultiError []error
+// PreviewNotificationRequestMultiError is an error wrapping multiple
+// validation errors returned by PreviewNotificationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewNotificationRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxCancelRequestMultiError) Error() string {
+func (m PreviewNotificationRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1965,11 +4612,11 @@ func (m TxCancelRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxCancelRequestMultiError) AllErrors() []error { return m }
+func (m PreviewNotificationRequestMultiError) AllErrors() []error { return m }
 
-// TxCancelRequestValidationError is the validation error returned by
-// TxCancelRequest.Validate if the designated constraints aren't met.
-type TxCancelRequestValidationError struct {
+// PreviewNotificationRequestValidationError is the validation error returned
+// by PreviewNotificationRequest.Validate if the designated constraints aren't met.
+type PreviewNotificationRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1977,22 +4624,24 @@ type TxCancelRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxCancelRequestValidationError) Field() string { return e.field }
+func (e PreviewNotificationRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxCancelRequestValidationError) Reason() string { return e.reason }
+func (e PreviewNotificationRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxCancelRequestValidationError) Cause() error { return e.cause }
+func (e PreviewNotificationRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxCancelRequestValidationError) Key() bool { return e.key }
+func (e PreviewNotificationRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxCancelRequestValidationError) ErrorName() string { return "TxCancelRequestValidationError" }
+func (e PreviewNotificationRequestValidationError) ErrorName() string {
+	return "PreviewNotificationRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TxCancelRequestValidationError) Error() string {
+func (e PreviewNotificationRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2004,14 +4653,14 @@ func (e TxCancelRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxCancelRequest.%s: %s%s",
+		"invalid %sPreviewNotificationRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxCancelRequestValidationError{}
+var _ error = PreviewNotificationRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2019,44 +4668,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxCancelRequestValidationError{}
+} = PreviewNotificationRequestValidationError{}
 
-// Validate checks the field values on TxCancelResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TxCancelResponse) Validate() error {
+// Validate checks the field values on PreviewNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewNotificationResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TxCancelResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PreviewNotificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TxCancelResponseMultiError, or nil if none found.
-func (m *TxCancelResponse) ValidateAll() error {
+// PreviewNotificationResponseMultiError, or nil if none found.
+func (m *PreviewNotificationResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TxCancelResponse) validate(all bool) error {
+func (m *PreviewNotificationResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Channel
+
+	// no validation rules for VersionId
+
+	// no validation rules for Signature
+
+	// no validation rules for Title
+
+	// no validation rules for Content
+
+	// no validation rules for ProviderName
+
 	if len(errors) > 0 {
-		return TxCancelResponseMultiError(errors)
+		return PreviewNotificationResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TxCancelResponseMultiError is an error wrapping multiple validation errors
-// returned by TxCancelResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TxCancelResponseMultiError []error
+// PreviewNotificationResponseMultiError is an error wrapping multiple
+// validation errors returned by PreviewNotificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewNotificationResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TxCancelResponseMultiError) Error() string {
+func (m PreviewNotificationResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2065,11 +4726,12 @@ func (m TxCancelResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TxCancelResponseMultiError) AllErrors() []error { return m }
+func (m PreviewNotificationResponseMultiError) AllErrors() []error { return m }
 
-// TxCancelResponseValidationError is the validation error returned by
-// TxCancelResponse.Validate if the designated constraints aren't met.
-type TxCancelResponseValidationError struct {
+// PreviewNotificationResponseValidationError is the validation error returned
+// by PreviewNotificationResponse.Validate if the designated constraints
+// aren't met.
+type PreviewNotificationResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2077,22 +4739,24 @@ type TxCancelResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxCancelResponseValidationError) Field() string { return e.field }
+func (e PreviewNotificationResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxCancelResponseValidationError) Reason() string { return e.reason }
+func (e PreviewNotificationResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxCancelResponseValidationError) Cause() error { return e.cause }
+func (e PreviewNotificationResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxCancelResponseValidationError) Key() bool { return e.key }
+func (e PreviewNotificationResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxCancelResponseValidationError) ErrorName() string { return "TxCancelResponseValidationError" }
+func (e PreviewNotificationResponseValidationError) ErrorName() string {
+	return "PreviewNotificationResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TxCancelResponseValidationError) Error() string {
+func (e PreviewNotificationResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2104,14 +4768,14 @@ func (e TxCancelResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxCancelResponse.%s: %s%s",
+		"invalid %sPreviewNotificationResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxCancelResponseValidationError{}
+var _ error = PreviewNotificationResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2119,7 +4783,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxCancelResponseValidationError{}
+} = PreviewNotificationResponseValidationError{}
 
 // Validate checks the field values on SendStrategy_ImmediateStrategy with the
 // rules defined in the proto definition for this message. If any rules are
@@ -2699,3 +5363,141 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SendStrategy_DeadlineStrategyValidationError{}
+
+// Validate checks the field values on SendStrategy_RecurringStrategy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendStrategy_RecurringStrategy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendStrategy_RecurringStrategy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SendStrategy_RecurringStrategyMultiError, or nil if none found.
+func (m *SendStrategy_RecurringStrategy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendStrategy_RecurringStrategy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cron
+
+	// no validation rules for Timezone
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendStrategy_RecurringStrategyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendStrategy_RecurringStrategyValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendStrategy_RecurringStrategyValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxOccurrences
+
+	if len(errors) > 0 {
+		return SendStrategy_RecurringStrategyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendStrategy_RecurringStrategyMultiError is an error wrapping multiple
+// validation errors returned by SendStrategy_RecurringStrategy.ValidateAll()
+// if the designated constraints aren't met.
+type SendStrategy_RecurringStrategyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendStrategy_RecurringStrategyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendStrategy_RecurringStrategyMultiError) AllErrors() []error { return m }
+
+// SendStrategy_RecurringStrategyValidationError is the validation error
+// returned by SendStrategy_RecurringStrategy.Validate if the designated
+// constraints aren't met.
+type SendStrategy_RecurringStrategyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendStrategy_RecurringStrategyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendStrategy_RecurringStrategyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendStrategy_RecurringStrategyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendStrategy_RecurringStrategyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendStrategy_RecurringStrategyValidationError) ErrorName() string {
+	return "SendStrategy_RecurringStrategyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendStrategy_RecurringStrategyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendStrategy_RecurringStrategy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendStrategy_RecurringStrategyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendStrategy_RecurringStrategyValidationError{}
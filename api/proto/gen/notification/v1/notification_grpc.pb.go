@@ -27,6 +27,15 @@ const (
 	NotificationService_TxPrepare_FullMethodName                   = "/notification.v1.NotificationService/TxPrepare"
 	NotificationService_TxCommit_FullMethodName                    = "/notification.v1.NotificationService/TxCommit"
 	NotificationService_TxCancel_FullMethodName                    = "/notification.v1.NotificationService/TxCancel"
+	NotificationService_CancelNotification_FullMethodName          = "/notification.v1.NotificationService/CancelNotification"
+	NotificationService_BatchCancelNotifications_FullMethodName    = "/notification.v1.NotificationService/BatchCancelNotifications"
+	NotificationService_UpdateNotification_FullMethodName          = "/notification.v1.NotificationService/UpdateNotification"
+	NotificationService_ListInboxMessages_FullMethodName           = "/notification.v1.NotificationService/ListInboxMessages"
+	NotificationService_MarkInboxMessagesRead_FullMethodName       = "/notification.v1.NotificationService/MarkInboxMessagesRead"
+	NotificationService_MarkInboxMessagesUnread_FullMethodName     = "/notification.v1.NotificationService/MarkInboxMessagesUnread"
+	NotificationService_DeleteInboxMessages_FullMethodName         = "/notification.v1.NotificationService/DeleteInboxMessages"
+	NotificationService_GetInboxUnreadCount_FullMethodName         = "/notification.v1.NotificationService/GetInboxUnreadCount"
+	NotificationService_PreviewNotification_FullMethodName         = "/notification.v1.NotificationService/PreviewNotification"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -47,6 +56,24 @@ type NotificationServiceClient interface {
 	TxCommit(ctx context.Context, in *TxCommitRequest, opts ...grpc.CallOption) (*TxCommitResponse, error)
 	// 取消事务
 	TxCancel(ctx context.Context, in *TxCancelRequest, opts ...grpc.CallOption) (*TxCancelResponse, error)
+	// 取消还没有开始发送的通知，包括延迟、定时和时间窗口内发送的通知
+	CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error)
+	// 批量取消通知，某个通知取消失败不影响其他通知
+	BatchCancelNotifications(ctx context.Context, in *BatchCancelNotificationsRequest, opts ...grpc.CallOption) (*BatchCancelNotificationsResponse, error)
+	// 修改还没有开始发送的通知的发送策略、模版参数和接收者
+	UpdateNotification(ctx context.Context, in *UpdateNotificationRequest, opts ...grpc.CallOption) (*UpdateNotificationResponse, error)
+	// 分页查询接收者的站内信，按创建时间倒序
+	ListInboxMessages(ctx context.Context, in *ListInboxMessagesRequest, opts ...grpc.CallOption) (*ListInboxMessagesResponse, error)
+	// 标记站内信为已读
+	MarkInboxMessagesRead(ctx context.Context, in *MarkInboxMessagesReadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesReadResponse, error)
+	// 标记站内信为未读
+	MarkInboxMessagesUnread(ctx context.Context, in *MarkInboxMessagesUnreadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesUnreadResponse, error)
+	// 删除站内信
+	DeleteInboxMessages(ctx context.Context, in *DeleteInboxMessagesRequest, opts ...grpc.CallOption) (*DeleteInboxMessagesResponse, error)
+	// 获取接收者的未读站内信数量
+	GetInboxUnreadCount(ctx context.Context, in *GetInboxUnreadCountRequest, opts ...grpc.CallOption) (*GetInboxUnreadCountResponse, error)
+	// 预览通知，只渲染模版并给出会使用的供应商，不落库、不扣额度、不回调
+	PreviewNotification(ctx context.Context, in *PreviewNotificationRequest, opts ...grpc.CallOption) (*PreviewNotificationResponse, error)
 }
 
 type notificationServiceClient struct {
@@ -127,6 +154,96 @@ func (c *notificationServiceClient) TxCancel(ctx context.Context, in *TxCancelRe
 	return out, nil
 }
 
+func (c *notificationServiceClient) CancelNotification(ctx context.Context, in *CancelNotificationRequest, opts ...grpc.CallOption) (*CancelNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_CancelNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) BatchCancelNotifications(ctx context.Context, in *BatchCancelNotificationsRequest, opts ...grpc.CallOption) (*BatchCancelNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCancelNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_BatchCancelNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateNotification(ctx context.Context, in *UpdateNotificationRequest, opts ...grpc.CallOption) (*UpdateNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListInboxMessages(ctx context.Context, in *ListInboxMessagesRequest, opts ...grpc.CallOption) (*ListInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ListInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkInboxMessagesRead(ctx context.Context, in *MarkInboxMessagesReadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkInboxMessagesReadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkInboxMessagesRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkInboxMessagesUnread(ctx context.Context, in *MarkInboxMessagesUnreadRequest, opts ...grpc.CallOption) (*MarkInboxMessagesUnreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkInboxMessagesUnreadResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkInboxMessagesUnread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteInboxMessages(ctx context.Context, in *DeleteInboxMessagesRequest, opts ...grpc.CallOption) (*DeleteInboxMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInboxMessagesResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteInboxMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetInboxUnreadCount(ctx context.Context, in *GetInboxUnreadCountRequest, opts ...grpc.CallOption) (*GetInboxUnreadCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInboxUnreadCountResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetInboxUnreadCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PreviewNotification(ctx context.Context, in *PreviewNotificationRequest, opts ...grpc.CallOption) (*PreviewNotificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PreviewNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations should embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -145,6 +262,24 @@ type NotificationServiceServer interface {
 	TxCommit(context.Context, *TxCommitRequest) (*TxCommitResponse, error)
 	// 取消事务
 	TxCancel(context.Context, *TxCancelRequest) (*TxCancelResponse, error)
+	// 取消还没有开始发送的通知，包括延迟、定时和时间窗口内发送的通知
+	CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error)
+	// 批量取消通知，某个通知取消失败不影响其他通知
+	BatchCancelNotifications(context.Context, *BatchCancelNotificationsRequest) (*BatchCancelNotificationsResponse, error)
+	// 修改还没有开始发送的通知的发送策略、模版参数和接收者
+	UpdateNotification(context.Context, *UpdateNotificationRequest) (*UpdateNotificationResponse, error)
+	// 分页查询接收者的站内信，按创建时间倒序
+	ListInboxMessages(context.Context, *ListInboxMessagesRequest) (*ListInboxMessagesResponse, error)
+	// 标记站内信为已读
+	MarkInboxMessagesRead(context.Context, *MarkInboxMessagesReadRequest) (*MarkInboxMessagesReadResponse, error)
+	// 标记站内信为未读
+	MarkInboxMessagesUnread(context.Context, *MarkInboxMessagesUnreadRequest) (*MarkInboxMessagesUnreadResponse, error)
+	// 删除站内信
+	DeleteInboxMessages(context.Context, *DeleteInboxMessagesRequest) (*DeleteInboxMessagesResponse, error)
+	// 获取接收者的未读站内信数量
+	GetInboxUnreadCount(context.Context, *GetInboxUnreadCountRequest) (*GetInboxUnreadCountResponse, error)
+	// 预览通知，只渲染模版并给出会使用的供应商，不落库、不扣额度、不回调
+	PreviewNotification(context.Context, *PreviewNotificationRequest) (*PreviewNotificationResponse, error)
 }
 
 // UnimplementedNotificationServiceServer should be embedded to have
@@ -181,6 +316,42 @@ func (UnimplementedNotificationServiceServer) TxCommit(context.Context, *TxCommi
 func (UnimplementedNotificationServiceServer) TxCancel(context.Context, *TxCancelRequest) (*TxCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxCancel not implemented")
 }
+
+func (UnimplementedNotificationServiceServer) CancelNotification(context.Context, *CancelNotificationRequest) (*CancelNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotification not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) BatchCancelNotifications(context.Context, *BatchCancelNotificationsRequest) (*BatchCancelNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelNotifications not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) UpdateNotification(context.Context, *UpdateNotificationRequest) (*UpdateNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotification not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) ListInboxMessages(context.Context, *ListInboxMessagesRequest) (*ListInboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInboxMessages not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) MarkInboxMessagesRead(context.Context, *MarkInboxMessagesReadRequest) (*MarkInboxMessagesReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInboxMessagesRead not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) MarkInboxMessagesUnread(context.Context, *MarkInboxMessagesUnreadRequest) (*MarkInboxMessagesUnreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkInboxMessagesUnread not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) DeleteInboxMessages(context.Context, *DeleteInboxMessagesRequest) (*DeleteInboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInboxMessages not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) GetInboxUnreadCount(context.Context, *GetInboxUnreadCountRequest) (*GetInboxUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInboxUnreadCount not implemented")
+}
+
+func (UnimplementedNotificationServiceServer) PreviewNotification(context.Context, *PreviewNotificationRequest) (*PreviewNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewNotification not implemented")
+}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -327,6 +498,168 @@ func _NotificationService_TxCancel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_CancelNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CancelNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelNotification(ctx, req.(*CancelNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_BatchCancelNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCancelNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).BatchCancelNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_BatchCancelNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).BatchCancelNotifications(ctx, req.(*BatchCancelNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateNotification(ctx, req.(*UpdateNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ListInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListInboxMessages(ctx, req.(*ListInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkInboxMessagesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInboxMessagesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkInboxMessagesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkInboxMessagesRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkInboxMessagesRead(ctx, req.(*MarkInboxMessagesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkInboxMessagesUnread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkInboxMessagesUnreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkInboxMessagesUnread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkInboxMessagesUnread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkInboxMessagesUnread(ctx, req.(*MarkInboxMessagesUnreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteInboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteInboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteInboxMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteInboxMessages(ctx, req.(*DeleteInboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetInboxUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboxUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetInboxUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetInboxUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetInboxUnreadCount(ctx, req.(*GetInboxUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PreviewNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PreviewNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PreviewNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PreviewNotification(ctx, req.(*PreviewNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +695,42 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxCancel",
 			Handler:    _NotificationService_TxCancel_Handler,
 		},
+		{
+			MethodName: "CancelNotification",
+			Handler:    _NotificationService_CancelNotification_Handler,
+		},
+		{
+			MethodName: "BatchCancelNotifications",
+			Handler:    _NotificationService_BatchCancelNotifications_Handler,
+		},
+		{
+			MethodName: "UpdateNotification",
+			Handler:    _NotificationService_UpdateNotification_Handler,
+		},
+		{
+			MethodName: "ListInboxMessages",
+			Handler:    _NotificationService_ListInboxMessages_Handler,
+		},
+		{
+			MethodName: "MarkInboxMessagesRead",
+			Handler:    _NotificationService_MarkInboxMessagesRead_Handler,
+		},
+		{
+			MethodName: "MarkInboxMessagesUnread",
+			Handler:    _NotificationService_MarkInboxMessagesUnread_Handler,
+		},
+		{
+			MethodName: "DeleteInboxMessages",
+			Handler:    _NotificationService_DeleteInboxMessages_Handler,
+		},
+		{
+			MethodName: "GetInboxUnreadCount",
+			Handler:    _NotificationService_GetInboxUnreadCount_Handler,
+		},
+		{
+			MethodName: "PreviewNotification",
+			Handler:    _NotificationService_PreviewNotification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/v1/notification.proto",
@@ -4,15 +4,21 @@ package ioc
 
 import (
 	"context"
+	"net"
+	"strconv"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/service/provider/metrics"
 	"gitee.com/flycash/notification-platform/internal/service/provider/tracing"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/econf"
+	"github.com/gotomicro/ego/core/elog"
 
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 
 	grpcapi "gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
@@ -24,17 +30,28 @@ import (
 	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email"
+	emailclient "gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/provider/inapp"
+	"gitee.com/flycash/notification-platform/internal/service/provider/limit"
+	"gitee.com/flycash/notification-platform/internal/service/provider/loadbalancer"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	receiptweb "gitee.com/flycash/notification-platform/internal/web/receipt"
+	templateweb "gitee.com/flycash/notification-platform/internal/web/template"
 	"github.com/google/wire"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 var (
@@ -59,6 +76,7 @@ var (
 		dao.NewNotificationDAO,
 		redis.NewQuotaCache,
 		notificationsvc.NewSendingTimeoutTask,
+		ioc.InitRetryTask,
 	)
 	txNotificationSvcSet = wire.NewSet(
 		notificationsvc.NewTxNotificationService,
@@ -68,15 +86,23 @@ var (
 	)
 	senderSvcSet = wire.NewSet(
 		newSMSClients,
+		newEmailClients,
 		newChannel,
 		newTaskPool,
 		newSender,
+		frequencycap.NewService,
+		redis.NewFrequencyCapCache,
 	)
 	sendNotificationSvcSet = wire.NewSet(
 		notificationsvc.NewSendService,
+		notificationsvc.NewPreviewService,
 		sendstrategy.NewDispatcher,
 		sendstrategy.NewImmediateStrategy,
 		sendstrategy.NewDefaultStrategy,
+		sendstrategy.NewRecurringStrategy,
+		quiethours.NewService,
+		repository.NewRecurringNotificationRepository,
+		dao.NewRecurringNotificationDAO,
 	)
 	callbackSvcSet = wire.NewSet(
 		callback.NewService,
@@ -93,40 +119,159 @@ var (
 	)
 	templateSvcSet = wire.NewSet(
 		templatesvc.NewChannelTemplateService,
+		compliance.NewChecker,
+		newComplianceConfig,
+		newLocaleFallbacks,
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
+		templateweb.NewHandler,
 	)
-	schedulerSet = wire.NewSet(scheduler.NewScheduler)
-	quotaSvcSet  = wire.NewSet(
+	auditSvcSet = wire.NewSet(
+		auditsvc.NewService,
+		newAuditConfig,
+		repository.NewAuditRepository,
+		dao.NewAuditDAO,
+		ioc.InitKafkaProducer,
+		ioc.InitAuditResultProducer,
+		ioc.InitAuditResultConsumer,
+		grpcapi.NewAuditServer,
+	)
+	inboxSvcSet = wire.NewSet(
+		inboxsvc.NewService,
+		repository.NewInboxRepository,
+		dao.NewInboxDAO,
+	)
+	receiptSvcSet = wire.NewSet(
+		receipt.NewService,
+		receipt.NewSyncTask,
+		repository.NewDeliveryReceiptRepository,
+		dao.NewDeliveryReceiptDAO,
+		receiptweb.NewHandler,
+		newReceiptConfig,
+	)
+	schedulerSet = wire.NewSet(
+		scheduler.NewScheduler,
+		scheduler.NewRecurringScheduler,
+	)
+	quotaSvcSet = wire.NewSet(
 		quota.NewService,
 		quota.NewQuotaMonthlyResetCron,
 		repository.NewQuotaRepository,
-		dao.NewQuotaDAO)
+		dao.NewQuotaDAO,
+		grpcapi.NewQuotaServer)
+	suppressionSvcSet = wire.NewSet(
+		suppression.NewService,
+		newAutoSuppressConfig,
+		repository.NewSuppressionRepository,
+		dao.NewSuppressionDAO,
+		redis.NewSuppressionCache,
+		grpcapi.NewSuppressionServer)
 )
 
 func newChannel(
 	clients map[string]client.Client,
+	emailClients map[string]emailclient.Client,
+	providerSvc providersvc.Service,
 	templateSvc templatesvc.ChannelTemplateService,
+	inboxSvc inboxsvc.Service,
+	cmd goredis.Cmdable,
 ) channel.Channel {
 	return channel.NewDispatcher(map[domain.Channel]channel.Channel{
-		domain.ChannelEmail: channel.NewSMSChannel(newMockSMSSelectorBuilder()),
+		domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(clients, templateSvc,
+			newProviderLimiter(providerSvc, cmd, domain.ChannelSMS))),
+		domain.ChannelEmail: channel.NewEmailChannel(newEmailSelectorBuilder(emailClients, templateSvc,
+			newProviderLimiter(providerSvc, cmd, domain.ChannelEmail))),
+		domain.ChannelInApp: channel.NewInAppChannel(newInAppSelectorBuilder(providerSvc, templateSvc, inboxSvc)),
 	})
 }
 
+// newProviderLimiter 按 providers 表中配置的 QPS 和每日上限对同一个渠道的供应商限流
+func newProviderLimiter(providerSvc providersvc.Service, cmd goredis.Cmdable, ch domain.Channel) provider.Limiter {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, ch)
+	if err != nil {
+		panic(err)
+	}
+	return limit.NewRedisLimiter(cmd, entities)
+}
+
 func newSMSSelectorBuilder(
 	clients map[string]client.Client,
 	templateSvc templatesvc.ChannelTemplateService,
-) *sequential.SelectorBuilder {
+	limiter provider.Limiter,
+) provider.SelectorBuilder {
 	// 构建SMS供应商
 	providers := make([]provider.Provider, 0, len(clients))
 	for name := range clients {
-		providers = append(providers, sms.NewSMSProvider(
+		providers = append(providers, limit.NewProvider(metrics.NewProvider(name, tracing.NewProvider(sms.NewSMSProvider(
+			name,
+			templateSvc,
+			clients[name],
+		), name)), limiter))
+	}
+	return newSelectorBuilder("channel.sms", providers, limiter)
+}
+
+func newEmailSelectorBuilder(
+	clients map[string]emailclient.Client,
+	templateSvc templatesvc.ChannelTemplateService,
+	limiter provider.Limiter,
+) provider.SelectorBuilder {
+	// 构建邮件供应商
+	providers := make([]provider.Provider, 0, len(clients))
+	for name := range clients {
+		providers = append(providers, limit.NewProvider(metrics.NewProvider(name, tracing.NewProvider(email.NewEmailProvider(
 			name,
 			templateSvc,
 			clients[name],
-		))
+		), name)), limiter))
+	}
+	return newSelectorBuilder("channel.email", providers, limiter)
+}
+
+// newInAppSelectorBuilder 站内信不依赖外部供应商，配置的供应商记录只用于关联模版
+func newInAppSelectorBuilder(
+	providerSvc providersvc.Service,
+	templateSvc templatesvc.ChannelTemplateService,
+	inboxSvc inboxsvc.Service,
+) provider.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelInApp)
+	if err != nil {
+		panic(err)
+	}
+	providers := make([]provider.Provider, 0, len(entities))
+	for i := range entities {
+		name := entities[i].Name
+		providers = append(providers, metrics.NewProvider(name, tracing.NewProvider(inapp.NewInAppProvider(
+			name,
+			templateSvc,
+			inboxSvc,
+		), name)))
+	}
+	// 站内信直接写入收件箱，不需要限流
+	return sequential.NewSelectorBuilder(providers, nil)
+}
+
+// newSelectorBuilder 根据配置选择供应商的选择策略，默认按顺序选择
+func newSelectorBuilder(key string, providers []provider.Provider, limiter provider.Limiter) provider.SelectorBuilder {
+	type Config struct {
+		// sequential 或 loadbalancer
+		Selector  string `yaml:"selector"`
+		BufferLen int    `yaml:"bufferLen"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey(key, &cfg); err != nil {
+		panic(err)
 	}
-	return sequential.NewSelectorBuilder(providers)
+	if cfg.Selector == "loadbalancer" {
+		return loadbalancer.NewSelectorBuilder(providers, cfg.BufferLen, limiter)
+	}
+	return sequential.NewSelectorBuilder(providers, limiter)
 }
 
 func newSMSClients(providerSvc providersvc.Service) map[string]client.Client {
@@ -154,15 +299,46 @@ func newSMSClients(providerSvc providersvc.Service) map[string]client.Client {
 			}
 			cli = c
 			clients[entities[i].Name] = cli
+		} else {
+			elog.DefaultLogger.Warn("不支持的短信供应商，忽略", elog.String("provider", entities[i].Name))
 		}
 	}
 	return clients
 }
 
-func newMockSMSSelectorBuilder() *sequential.SelectorBuilder {
-	return sequential.NewSelectorBuilder([]provider.Provider{
-		metrics.NewProvider("ali", tracing.NewProvider(provider.NewMockProvider(), "ali")),
-	})
+// newEmailClients 目前邮件供应商都通过 SMTP 协议接入
+// Endpoint 为 host:port，APIKey 为认证用户名兼发件地址，APISecret 为认证密码
+func newEmailClients(providerSvc providersvc.Service) map[string]emailclient.Client {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelEmail)
+	if err != nil {
+		panic(err)
+	}
+	clients := make(map[string]emailclient.Client)
+	for i := range entities {
+		host, portStr, err1 := net.SplitHostPort(entities[i].Endpoint)
+		if err1 != nil {
+			panic(err1)
+		}
+		port, err1 := strconv.Atoi(portStr)
+		if err1 != nil {
+			panic(err1)
+		}
+		c, err1 := emailclient.NewSMTPClient(emailclient.SMTPConfig{
+			Host:       host,
+			Port:       port,
+			Username:   entities[i].APIKey,
+			Password:   entities[i].APISecret,
+			RequireTLS: true,
+		})
+		if err1 != nil {
+			panic(err1)
+		}
+		clients[entities[i].Name] = c
+	}
+	return clients
 }
 
 func newTaskPool() pool.TaskPool {
@@ -198,11 +374,73 @@ func newSender(repo repository.NotificationRepository,
 	callbackSvc callback.Service,
 	channel channel.Channel,
 	taskPool pool.TaskPool,
+	frequencyCap frequencycap.Service,
+	suppressionSvc suppression.Service,
 ) sender.NotificationSender {
-	s := sender.NewSender(repo, configSvc, callbackSvc, channel, taskPool)
+	s := sender.NewSender(repo, configSvc, callbackSvc, channel, taskPool, frequencyCap, suppressionSvc)
 	return sender.NewTracingSender(sender.NewMetricsSender(s))
 }
 
+// newAutoSuppressConfig 没有配置时使用默认值
+func newAutoSuppressConfig() suppression.AutoSuppressConfig {
+	cfg := suppression.DefaultAutoSuppressConfig()
+	if err := econf.UnmarshalKey("suppression.autoSuppress", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newAuditConfig 没有配置审核人时不分配审核人
+func newAuditConfig() auditsvc.Config {
+	var cfg auditsvc.Config
+	if err := econf.UnmarshalKey("audit", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newReceiptConfig 回执接口直接暴露给供应商，必须配置口令
+func newReceiptConfig() receiptweb.Config {
+	var cfg receiptweb.Config
+	if err := econf.UnmarshalKey("receipt", &cfg); err != nil {
+		panic(err)
+	}
+	if cfg.Token == "" {
+		panic("receipt.token 不能为空")
+	}
+	return cfg
+}
+
+// newComplianceConfig 没有配置时不做合规检查
+func newComplianceConfig() compliance.Config {
+	var cfg compliance.Config
+	if err := econf.UnmarshalKey("template.compliance", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newLocaleFallbacks 语言的降级规则，没有配置规则的语言去掉最后一段再匹配，最后使用默认内容
+func newLocaleFallbacks() domain.LocaleFallbacks {
+	var cfg map[string]string
+	if err := econf.UnmarshalKey("template.localeFallbacks", &cfg); err != nil {
+		panic(err)
+	}
+	fallbacks := make(domain.LocaleFallbacks, len(cfg))
+	for from, to := range cfg {
+		normalizedFrom, err := domain.NormalizeLocale(from)
+		if err != nil {
+			panic(err)
+		}
+		normalizedTo, err := domain.NormalizeLocale(to)
+		if err != nil {
+			panic(err)
+		}
+		fallbacks[normalizedFrom] = normalizedTo
+	}
+	return fallbacks
+}
+
 func InitGrpcServer() *ioc.App {
 	wire.Build(
 		// 基础设施
@@ -228,20 +466,30 @@ func InitGrpcServer() *ioc.App {
 		templateSvcSet,
 
 		// 审计服务
-		auditsvc.NewService,
+		auditSvcSet,
 
 		// 事务通知服务
 		txNotificationSvcSet,
 
+		// 站内信服务
+		inboxSvcSet,
+
 		// 调度器
 		schedulerSet,
 
 		// 额度控制服务
 		quotaSvcSet,
 
+		// 退订名单服务
+		suppressionSvcSet,
+
+		// 供应商回执服务
+		receiptSvcSet,
+
 		// GRPC服务器
 		grpcapi.NewServer,
 		ioc.InitGrpc,
+		ioc.InitGinServer,
 		ioc.InitTasks,
 		ioc.Crons,
 		wire.Struct(new(ioc.App), "*"),
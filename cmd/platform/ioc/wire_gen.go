@@ -18,23 +18,40 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email"
+	client2 "gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/provider/inapp"
+	"gitee.com/flycash/notification-platform/internal/service/provider/limit"
+	"gitee.com/flycash/notification-platform/internal/service/provider/loadbalancer"
 	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/metrics"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	"gitee.com/flycash/notification-platform/internal/service/provider/tracing"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	receipt2 "gitee.com/flycash/notification-platform/internal/web/receipt"
+	"gitee.com/flycash/notification-platform/internal/web/template"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	"github.com/gotomicro/ego/core/elog"
+	redis2 "github.com/redis/go-redis/v9"
+	"net"
+	"strconv"
 	"time"
 )
 
@@ -46,16 +63,29 @@ func InitGrpcServer() *ioc.App {
 	cmdable := ioc.InitRedisCmd()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
 	channelTemplateDAO := dao.NewChannelTemplateDAO(v)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
 	string2 := ioc.InitProviderEncryptKey()
 	providerDAO := dao.NewProviderDAO(v, string2)
 	providerRepository := repository.NewProviderRepository(providerDAO)
-	manageService := manage.NewProviderService(providerRepository)
-	auditService := audit.NewService()
-	v2 := newSMSClients(manageService)
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, v2)
+	service := manage.NewProviderService(providerRepository)
+	auditDAO := dao.NewAuditDAO(v)
+	auditRepository := repository.NewAuditRepository(auditDAO)
+	producer := ioc.InitKafkaProducer()
+	resultCallbackEventProducer := ioc.InitAuditResultProducer(producer)
+	auditConfig := newAuditConfig()
+	auditService := audit.NewService(auditRepository, resultCallbackEventProducer, auditConfig)
+	complianceConfig := newComplianceConfig()
+	checker := compliance.NewChecker(complianceConfig)
+	v2 := newSMSClients(service)
+	localeFallbacks := newLocaleFallbacks()
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, service, auditService, checker, v2, localeFallbacks)
+	suppressionDAO := dao.NewSuppressionDAO(v)
+	suppressionCache := redis.NewSuppressionCache(cmdable)
+	suppressionRepository := repository.NewSuppressionRepository(suppressionDAO, suppressionCache)
+	autoSuppressConfig := newAutoSuppressConfig()
+	suppressionService := suppression.NewService(suppressionRepository, channelTemplateService, autoSuppressConfig)
+	notificationService := notification.NewNotificationService(notificationRepository, channelTemplateService, suppressionService, localeFallbacks)
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
 	client := ioc.InitRedisClient()
 	cache := ioc.InitGoCache()
@@ -66,34 +96,60 @@ func InitGrpcServer() *ioc.App {
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
 	callbackService := callback.NewService(businessConfigService, callbackLogRepository)
-	channel := newChannel(v2, channelTemplateService)
+	v3 := newEmailClients(service)
+	inboxDAO := dao.NewInboxDAO(v)
+	inboxRepository := repository.NewInboxRepository(inboxDAO)
+	inboxService := inbox.NewService(inboxRepository)
+	channel := newChannel(v2, v3, service, channelTemplateService, inboxService, cmdable)
 	taskPool := newTaskPool()
-	notificationSender := newSender(notificationRepository, businessConfigService, callbackService, channel, taskPool)
+	frequencyCapCache := redis.NewFrequencyCapCache(cmdable)
+	frequencycapService := frequencycap.NewService(businessConfigService, channelTemplateService, frequencyCapCache)
+	notificationSender := newSender(notificationRepository, businessConfigService, callbackService, channel, taskPool, frequencycapService, suppressionService)
 	immediateSendStrategy := sendstrategy.NewImmediateStrategy(notificationRepository, notificationSender)
-	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService)
-	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy)
-	sendService := notification.NewSendService(channelTemplateService, service, sendStrategy)
+	quiethoursService := quiethours.NewService(businessConfigService, channelTemplateService)
+	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService, quiethoursService)
+	recurringNotificationDAO := dao.NewRecurringNotificationDAO(v)
+	recurringNotificationRepository := repository.NewRecurringNotificationRepository(recurringNotificationDAO)
+	recurringSendStrategy := sendstrategy.NewRecurringStrategy(recurringNotificationRepository)
+	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy, recurringSendStrategy)
+	sendService := notification.NewSendService(channelTemplateService, notificationService, sendStrategy, suppressionService)
 	txNotificationDAO := dao.NewTxNotificationDAO(v)
 	txNotificationRepository := repository.NewTxNotificationRepository(txNotificationDAO)
 	dlockClient := ioc.InitDistributedLock(client)
-	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender)
-	notificationServer := grpc.NewServer(service, sendService, txNotificationService, channelTemplateService)
+	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender, suppressionService)
+	previewService := notification.NewPreviewService(channelTemplateService, channel)
+	notificationServer := grpc.NewServer(notificationService, sendService, txNotificationService, channelTemplateService, inboxService, previewService, localeFallbacks)
+	quotaDAO := dao.NewQuotaDAO(v)
+	quotaRepository := repository.NewQuotaRepository(quotaDAO, quotaCache)
+	quotaService := quota.NewService(quotaRepository)
+	quotaServer := grpc.NewQuotaServer(quotaService)
+	suppressionServer := grpc.NewSuppressionServer(suppressionService)
+	auditServer := grpc.NewAuditServer(auditService)
 	component := ioc.InitEtcdClient()
-	egrpcComponent := ioc.InitGrpc(notificationServer, component)
+	egrpcComponent := ioc.InitGrpc(notificationServer, quotaServer, suppressionServer, auditServer, component)
+	deliveryReceiptDAO := dao.NewDeliveryReceiptDAO(v)
+	deliveryReceiptRepository := repository.NewDeliveryReceiptRepository(deliveryReceiptDAO)
+	receiptService := receipt.NewService(deliveryReceiptRepository, notificationRepository, callbackService, v2)
+	receiptConfig := newReceiptConfig()
+	handler := receipt2.NewHandler(receiptService, receiptConfig)
+	templateHandler := template.NewHandler(channelTemplateService, previewService, auditService, notificationService)
+	eginComponent := ioc.InitGinServer(handler, templateHandler)
 	asyncRequestResultCallbackTask := callback.NewAsyncRequestResultCallbackTask(dlockClient, callbackService)
-	notificationScheduler := scheduler.NewScheduler(service, notificationSender, dlockClient)
+	notificationScheduler := scheduler.NewScheduler(notificationService, notificationSender, quiethoursService, dlockClient)
 	sendingTimeoutTask := notification.NewSendingTimeoutTask(dlockClient, notificationRepository)
 	txCheckTask := notification.NewTxCheckTask(txNotificationRepository, businessConfigService, dlockClient)
-	v3 := ioc.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask)
-	quotaDAO := dao.NewQuotaDAO(v)
-	quotaRepository := repository.NewQuotaRepository(quotaDAO)
-	quotaService := quota.NewService(quotaRepository)
+	syncTask := receipt.NewSyncTask(dlockClient, receiptService)
+	retryTask := ioc.InitRetryTask(notificationRepository, notificationSender, quiethoursService, dlockClient)
+	recurringScheduler := scheduler.NewRecurringScheduler(recurringNotificationRepository, sendStrategy, dlockClient)
+	auditResultConsumer := ioc.InitAuditResultConsumer(channelTemplateService)
+	v4 := ioc.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask, syncTask, retryTask, recurringScheduler, auditResultConsumer)
 	monthlyResetCron := quota.NewQuotaMonthlyResetCron(businessConfigRepository, quotaService)
-	v4 := ioc.Crons(monthlyResetCron, businessConfigRepository)
+	v5 := ioc.Crons(monthlyResetCron, businessConfigRepository)
 	app := &ioc.App{
 		GrpcServer: egrpcComponent,
-		Tasks:      v3,
-		Crons:      v4,
+		HTTPServer: eginComponent,
+		Tasks:      v4,
+		Crons:      v5,
 	}
 	return app
 }
@@ -103,43 +159,130 @@ func InitGrpcServer() *ioc.App {
 var (
 	BaseSet              = wire.NewSet(ioc.InitDB, ioc.InitDistributedLock, ioc.InitEtcdClient, ioc.InitIDGenerator, ioc.InitRedisClient, ioc.InitGoCache, ioc.InitRedisCmd, local.NewLocalCache, redis.NewCache)
 	configSvcSet         = wire.NewSet(config.NewBusinessConfigService, repository.NewBusinessConfigRepository, dao.NewBusinessConfigDAO)
-	notificationSvcSet   = wire.NewSet(notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, redis.NewQuotaCache, notification.NewSendingTimeoutTask)
+	notificationSvcSet   = wire.NewSet(notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, redis.NewQuotaCache, notification.NewSendingTimeoutTask, ioc.InitRetryTask)
 	txNotificationSvcSet = wire.NewSet(notification.NewTxNotificationService, repository.NewTxNotificationRepository, dao.NewTxNotificationDAO, notification.NewTxCheckTask)
 	senderSvcSet         = wire.NewSet(
 		newSMSClients,
+		newEmailClients,
 		newChannel,
 		newTaskPool,
-		newSender,
+		newSender, frequencycap.NewService, redis.NewFrequencyCapCache,
 	)
-	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy)
+	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, notification.NewPreviewService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy, sendstrategy.NewRecurringStrategy, quiethours.NewService, repository.NewRecurringNotificationRepository, dao.NewRecurringNotificationDAO)
 	callbackSvcSet         = wire.NewSet(callback.NewService, repository.NewCallbackLogRepository, dao.NewCallbackLogDAO, callback.NewAsyncRequestResultCallbackTask)
 	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc.InitProviderEncryptKey)
-	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO)
-	schedulerSet           = wire.NewSet(scheduler.NewScheduler)
-	quotaSvcSet            = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO)
+	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, compliance.NewChecker, newComplianceConfig,
+		newLocaleFallbacks, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO, template.NewHandler,
+	)
+	auditSvcSet       = wire.NewSet(audit.NewService, newAuditConfig, repository.NewAuditRepository, dao.NewAuditDAO, ioc.InitKafkaProducer, ioc.InitAuditResultProducer, ioc.InitAuditResultConsumer, grpc.NewAuditServer)
+	inboxSvcSet       = wire.NewSet(inbox.NewService, repository.NewInboxRepository, dao.NewInboxDAO)
+	receiptSvcSet     = wire.NewSet(receipt.NewService, receipt.NewSyncTask, repository.NewDeliveryReceiptRepository, dao.NewDeliveryReceiptDAO, receipt2.NewHandler, newReceiptConfig)
+	schedulerSet      = wire.NewSet(scheduler.NewScheduler, scheduler.NewRecurringScheduler)
+	quotaSvcSet       = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO, grpc.NewQuotaServer)
+	suppressionSvcSet = wire.NewSet(suppression.NewService, newAutoSuppressConfig, repository.NewSuppressionRepository, dao.NewSuppressionDAO, redis.NewSuppressionCache, grpc.NewSuppressionServer)
 )
 
 func newChannel(
 	clients map[string]client.Client,
+	emailClients map[string]client2.Client,
+	providerSvc manage.Service,
 	templateSvc manage2.ChannelTemplateService,
+	inboxSvc inbox.Service,
+	cmd redis2.Cmdable,
 ) channel.Channel {
-	return channel.NewDispatcher(map[domain.Channel]channel.Channel{domain.ChannelEmail: channel.NewSMSChannel(newMockSMSSelectorBuilder())})
+	return channel.NewDispatcher(map[domain.Channel]channel.Channel{domain.ChannelSMS: channel.NewSMSChannel(newSMSSelectorBuilder(clients, templateSvc,
+		newProviderLimiter(providerSvc, cmd, domain.ChannelSMS))), domain.ChannelEmail: channel.NewEmailChannel(newEmailSelectorBuilder(emailClients, templateSvc,
+		newProviderLimiter(providerSvc, cmd, domain.ChannelEmail))), domain.ChannelInApp: channel.NewInAppChannel(newInAppSelectorBuilder(providerSvc, templateSvc, inboxSvc)),
+	})
+}
+
+// newProviderLimiter 按 providers 表中配置的 QPS 和每日上限对同一个渠道的供应商限流
+func newProviderLimiter(providerSvc manage.Service, cmd redis2.Cmdable, ch domain.Channel) provider.Limiter {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, ch)
+	if err != nil {
+		panic(err)
+	}
+	return limit.NewRedisLimiter(cmd, entities)
 }
 
 func newSMSSelectorBuilder(
 	clients map[string]client.Client,
 	templateSvc manage2.ChannelTemplateService,
-) *sequential.SelectorBuilder {
+	limiter provider.Limiter,
+) provider.SelectorBuilder {
 
 	providers := make([]provider.Provider, 0, len(clients))
 	for name := range clients {
-		providers = append(providers, sms.NewSMSProvider(
+		providers = append(providers, limit.NewProvider(metrics.NewProvider(name, tracing.NewProvider(sms.NewSMSProvider(
 			name,
 			templateSvc,
 			clients[name],
-		))
+		), name)), limiter))
 	}
-	return sequential.NewSelectorBuilder(providers)
+	return newSelectorBuilder("channel.sms", providers, limiter)
+}
+
+func newEmailSelectorBuilder(
+	clients map[string]client2.Client,
+	templateSvc manage2.ChannelTemplateService,
+	limiter provider.Limiter,
+) provider.SelectorBuilder {
+
+	providers := make([]provider.Provider, 0, len(clients))
+	for name := range clients {
+		providers = append(providers, limit.NewProvider(metrics.NewProvider(name, tracing.NewProvider(email.NewEmailProvider(
+			name,
+			templateSvc,
+			clients[name],
+		), name)), limiter))
+	}
+	return newSelectorBuilder("channel.email", providers, limiter)
+}
+
+// newInAppSelectorBuilder 站内信不依赖外部供应商，配置的供应商记录只用于关联模版
+func newInAppSelectorBuilder(
+	providerSvc manage.Service,
+	templateSvc manage2.ChannelTemplateService,
+	inboxSvc inbox.Service,
+) provider.SelectorBuilder {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelInApp)
+	if err != nil {
+		panic(err)
+	}
+	providers := make([]provider.Provider, 0, len(entities))
+	for i := range entities {
+		name := entities[i].Name
+		providers = append(providers, metrics.NewProvider(name, tracing.NewProvider(inapp.NewInAppProvider(
+			name,
+			templateSvc,
+			inboxSvc,
+		), name)))
+	}
+
+	return sequential.NewSelectorBuilder(providers, nil)
+}
+
+// newSelectorBuilder 根据配置选择供应商的选择策略，默认按顺序选择
+func newSelectorBuilder(key string, providers []provider.Provider, limiter provider.Limiter) provider.SelectorBuilder {
+	type Config struct {
+		// sequential 或 loadbalancer
+		Selector  string `yaml:"selector"`
+		BufferLen int    `yaml:"bufferLen"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey(key, &cfg); err != nil {
+		panic(err)
+	}
+	if cfg.Selector == "loadbalancer" {
+		return loadbalancer.NewSelectorBuilder(providers, cfg.BufferLen, limiter)
+	}
+	return sequential.NewSelectorBuilder(providers, limiter)
 }
 
 func newSMSClients(providerSvc manage.Service) map[string]client.Client {
@@ -167,13 +310,47 @@ func newSMSClients(providerSvc manage.Service) map[string]client.Client {
 			}
 			cli = c
 			clients[entities[i].Name] = cli
+		} else {
+			elog.DefaultLogger.
+				Warn("不支持的短信供应商，忽略", elog.String("provider", entities[i].Name))
 		}
 	}
 	return clients
 }
 
-func newMockSMSSelectorBuilder() *sequential.SelectorBuilder {
-	return sequential.NewSelectorBuilder([]provider.Provider{metrics.NewProvider("ali", tracing.NewProvider(provider.NewMockProvider(), "ali"))})
+// newEmailClients 目前邮件供应商都通过 SMTP 协议接入
+// Endpoint 为 host:port，APIKey 为认证用户名兼发件地址，APISecret 为认证密码
+func newEmailClients(providerSvc manage.Service) map[string]client2.Client {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+
+	entities, err := providerSvc.GetByChannel(ctx, domain.ChannelEmail)
+	if err != nil {
+		panic(err)
+	}
+	clients := make(map[string]client2.Client)
+	for i := range entities {
+		host, portStr, err1 := net.SplitHostPort(entities[i].Endpoint)
+		if err1 != nil {
+			panic(err1)
+		}
+		port, err1 := strconv.Atoi(portStr)
+		if err1 != nil {
+			panic(err1)
+		}
+		c, err1 := client2.NewSMTPClient(client2.SMTPConfig{
+			Host:       host,
+			Port:       port,
+			Username:   entities[i].APIKey,
+			Password:   entities[i].APISecret,
+			RequireTLS: true,
+		})
+		if err1 != nil {
+			panic(err1)
+		}
+		clients[entities[i].Name] = c
+	}
+	return clients
 }
 
 func newTaskPool() pool.TaskPool {
@@ -205,7 +382,69 @@ func newSender(repo repository.NotificationRepository,
 	callbackSvc callback.Service, channel2 channel.Channel,
 
 	taskPool pool.TaskPool,
+	frequencyCap frequencycap.Service,
+	suppressionSvc suppression.Service,
 ) sender.NotificationSender {
-	s := sender.NewSender(repo, configSvc, callbackSvc, channel2, taskPool)
+	s := sender.NewSender(repo, configSvc, callbackSvc, channel2, taskPool, frequencyCap, suppressionSvc)
 	return sender.NewTracingSender(sender.NewMetricsSender(s))
 }
+
+// newAutoSuppressConfig 没有配置时使用默认值
+func newAutoSuppressConfig() suppression.AutoSuppressConfig {
+	cfg := suppression.DefaultAutoSuppressConfig()
+	if err := econf.UnmarshalKey("suppression.autoSuppress", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newAuditConfig 没有配置审核人时不分配审核人
+func newAuditConfig() audit.Config {
+	var cfg audit.Config
+	if err := econf.UnmarshalKey("audit", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newReceiptConfig 回执接口直接暴露给供应商，必须配置口令
+func newReceiptConfig() receipt2.Config {
+	var cfg receipt2.Config
+	if err := econf.UnmarshalKey("receipt", &cfg); err != nil {
+		panic(err)
+	}
+	if cfg.Token == "" {
+		panic("receipt.token 不能为空")
+	}
+	return cfg
+}
+
+// newComplianceConfig 没有配置时不做合规检查
+func newComplianceConfig() compliance.Config {
+	var cfg compliance.Config
+	if err := econf.UnmarshalKey("template.compliance", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// newLocaleFallbacks 语言的降级规则，没有配置规则的语言去掉最后一段再匹配，最后使用默认内容
+func newLocaleFallbacks() domain.LocaleFallbacks {
+	var cfg map[string]string
+	if err := econf.UnmarshalKey("template.localeFallbacks", &cfg); err != nil {
+		panic(err)
+	}
+	fallbacks := make(domain.LocaleFallbacks, len(cfg))
+	for from, to := range cfg {
+		normalizedFrom, err := domain.NormalizeLocale(from)
+		if err != nil {
+			panic(err)
+		}
+		normalizedTo, err := domain.NormalizeLocale(to)
+		if err != nil {
+			panic(err)
+		}
+		fallbacks[normalizedFrom] = normalizedTo
+	}
+	return fallbacks
+}
@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	auditv1 "gitee.com/flycash/notification-platform/api/proto/gen/audit/v1"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/audit"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuditServer 内置的审核流程，审核人通过它查看、分配和审核
+type AuditServer struct {
+	auditv1.UnimplementedAuditServiceServer
+	svc audit.Service
+}
+
+func NewAuditServer(svc audit.Service) *AuditServer {
+	return &AuditServer{svc: svc}
+}
+
+// GetAudit 查询审核记录以及所有审核意见
+func (s *AuditServer) GetAudit(ctx context.Context, req *auditv1.GetAuditRequest) (*auditv1.GetAuditResponse, error) {
+	a, err := s.svc.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.GetAuditResponse{Audit: s.toAuditPB(a)}, nil
+}
+
+// ListAudits 按时间倒序分页查询分配给审核人的记录
+func (s *AuditServer) ListAudits(ctx context.Context, req *auditv1.ListAuditsRequest) (*auditv1.ListAuditsResponse, error) {
+	found, total, err := s.svc.ListByReviewer(ctx, req.GetReviewerId(), domain.AuditStatus(req.GetStatus()),
+		int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.ListAuditsResponse{
+		Audits: slice.Map(found, func(_ int, src domain.Audit) *auditv1.Audit {
+			return s.toAuditPB(src)
+		}),
+		Total: total,
+	}, nil
+}
+
+// AssignAudit 重新分配审核人
+func (s *AuditServer) AssignAudit(ctx context.Context, req *auditv1.AssignAuditRequest) (*auditv1.AssignAuditResponse, error) {
+	if err := s.svc.Assign(ctx, req.GetId(), req.GetReviewerId()); err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.AssignAuditResponse{}, nil
+}
+
+// ApproveAudit 审核通过
+func (s *AuditServer) ApproveAudit(ctx context.Context, req *auditv1.ApproveAuditRequest) (*auditv1.ApproveAuditResponse, error) {
+	if err := s.svc.Approve(ctx, req.GetId(), req.GetReviewerId(), req.GetComment()); err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.ApproveAuditResponse{}, nil
+}
+
+// RejectAudit 审核拒绝
+func (s *AuditServer) RejectAudit(ctx context.Context, req *auditv1.RejectAuditRequest) (*auditv1.RejectAuditResponse, error) {
+	if err := s.svc.Reject(ctx, req.GetId(), req.GetReviewerId(), req.GetReason()); err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.RejectAuditResponse{}, nil
+}
+
+// CommentAudit 添加审核意见
+func (s *AuditServer) CommentAudit(ctx context.Context, req *auditv1.CommentAuditRequest) (*auditv1.CommentAuditResponse, error) {
+	c, err := s.svc.Comment(ctx, req.GetId(), req.GetReviewerId(), req.GetContent())
+	if err != nil {
+		return nil, s.auditError(err)
+	}
+	return &auditv1.CommentAuditResponse{Comment: s.toCommentPB(c)}, nil
+}
+
+func (s *AuditServer) toAuditPB(a domain.Audit) *auditv1.Audit {
+	return &auditv1.Audit{
+		Id:           a.ID,
+		ResourceId:   a.ResourceID,
+		ResourceType: string(a.ResourceType),
+		Content:      a.Content,
+		ReviewerId:   a.ReviewerID,
+		Status:       a.Status.String(),
+		RejectReason: a.RejectReason,
+		AuditTime:    a.AuditTime,
+		Ctime:        a.Ctime,
+		Utime:        a.Utime,
+		Comments: slice.Map(a.Comments, func(_ int, src domain.AuditComment) *auditv1.AuditComment {
+			return s.toCommentPB(src)
+		}),
+	}
+}
+
+func (s *AuditServer) toCommentPB(c domain.AuditComment) *auditv1.AuditComment {
+	return &auditv1.AuditComment{
+		Id:         c.ID,
+		ReviewerId: c.ReviewerID,
+		Content:    c.Content,
+		Ctime:      c.Ctime,
+	}
+}
+
+func (s *AuditServer) auditError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrAuditNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, errs.ErrAuditAlreadyDecided):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, errs.ErrAuditReviewerMismatch):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
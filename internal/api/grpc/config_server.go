@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
@@ -81,14 +82,16 @@ func protoToDomainBusinessConfig(protoConfig *configv1.BusinessConfig) domain.Bu
 	if protoConfig.ChannelConfig != nil {
 		channelConfig := &domain.ChannelConfig{
 			Channels: make([]domain.ChannelItem, 0, len(protoConfig.ChannelConfig.Channels)),
+			Failover: protoConfig.ChannelConfig.Failover,
 		}
 
 		// Convert each channel item
 		for _, channel := range protoConfig.ChannelConfig.Channels {
 			channelConfig.Channels = append(channelConfig.Channels, domain.ChannelItem{
-				Channel:  channel.Channel,
-				Priority: int(channel.Priority),
-				Enabled:  channel.Enabled,
+				Channel:   channel.Channel,
+				Priority:  int(channel.Priority),
+				Enabled:   channel.Enabled,
+				Templates: channel.Templates,
 			})
 		}
 
@@ -119,10 +122,8 @@ func protoToDomainBusinessConfig(protoConfig *configv1.BusinessConfig) domain.Bu
 	// Convert QuotaConfig if exists
 	if protoConfig.Quota != nil && protoConfig.Quota.Monthly != nil {
 		domainConfig.Quota = &domain.QuotaConfig{
-			Monthly: domain.MonthlyConfig{
-				SMS:   int(protoConfig.Quota.Monthly.Sms),
-				EMAIL: int(protoConfig.Quota.Monthly.Email),
-			},
+			Monthly: convertProtoChannelQuotas(protoConfig.Quota.Monthly),
+			Daily:   convertProtoChannelQuotas(protoConfig.Quota.Daily),
 		}
 	}
 
@@ -140,9 +141,65 @@ func protoToDomainBusinessConfig(protoConfig *configv1.BusinessConfig) domain.Bu
 		domainConfig.CallbackConfig = callbackConfig
 	}
 
+	// Convert QuietHoursConfig if exists
+	if protoConfig.QuietHours != nil {
+		domainConfig.QuietHours = convertProtoQuietHours(protoConfig.QuietHours)
+	}
+
+	// Convert FrequencyCapConfig if exists
+	if protoConfig.FrequencyCap != nil {
+		domainConfig.FrequencyCap = convertProtoFrequencyCap(protoConfig.FrequencyCap)
+	}
+
 	return domainConfig
 }
 
+func convertProtoQuietHours(protoQuietHours *configv1.QuietHoursConfig) *domain.QuietHours {
+	quietHours := &domain.QuietHours{
+		Timezone:      protoQuietHours.Timezone,
+		Intervals:     make([]domain.QuietInterval, 0, len(protoQuietHours.Intervals)),
+		BusinessTypes: make([]domain.BusinessType, 0, len(protoQuietHours.BusinessTypes)),
+	}
+	for _, interval := range protoQuietHours.Intervals {
+		quietHours.Intervals = append(quietHours.Intervals, domain.QuietInterval{
+			Start: interval.Start,
+			End:   interval.End,
+		})
+	}
+	for _, businessType := range protoQuietHours.BusinessTypes {
+		quietHours.BusinessTypes = append(quietHours.BusinessTypes, domain.BusinessType(businessType))
+	}
+	return quietHours
+}
+
+func convertProtoFrequencyCap(protoFrequencyCap *configv1.FrequencyCapConfig) *domain.FrequencyCapConfig {
+	frequencyCap := &domain.FrequencyCapConfig{
+		Rules: make([]domain.FrequencyCapRule, 0, len(protoFrequencyCap.Rules)),
+	}
+	for _, rule := range protoFrequencyCap.Rules {
+		frequencyCap.Rules = append(frequencyCap.Rules, domain.FrequencyCapRule{
+			Channel:       domain.Channel(strings.ToUpper(rule.Channel)),
+			BusinessType:  domain.BusinessType(rule.BusinessType),
+			Limit:         rule.Limit,
+			WindowSeconds: rule.WindowSeconds,
+			Action:        domain.FrequencyCapAction(strings.ToUpper(rule.Action)),
+		})
+	}
+	return frequencyCap
+}
+
+// convertProtoChannelQuotas 渠道名统一转成大写，和 domain.Channel 保持一致
+func convertProtoChannelQuotas(quotas map[string]int32) map[domain.Channel]int32 {
+	if quotas == nil {
+		return nil
+	}
+	res := make(map[domain.Channel]int32, len(quotas))
+	for channel, quota := range quotas {
+		res[domain.Channel(strings.ToUpper(channel))] = quota
+	}
+	return res
+}
+
 func convertProtoRetryConfig(protoRetry *configv1.RetryConfig) *retry.Config {
 	return &retry.Config{
 		Type: "exponential",
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListInboxMessages 分页查询接收者的站内信
+func (s *NotificationServer) ListInboxMessages(ctx context.Context, req *notificationv1.ListInboxMessagesRequest) (*notificationv1.ListInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msgs, total, err := s.inboxSvc.List(ctx, bizID, req.GetReceiver(), req.GetUnreadOnly(), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, s.inboxError(err)
+	}
+	return &notificationv1.ListInboxMessagesResponse{
+		Messages: slice.Map(msgs, func(_ int, src domain.InboxMessage) *notificationv1.InboxMessage {
+			return &notificationv1.InboxMessage{
+				Id:             src.ID,
+				NotificationId: src.NotificationID,
+				Receiver:       src.Receiver,
+				Title:          src.Title,
+				Content:        src.Content,
+				Read:           src.Status.IsRead(),
+				ReadTime:       src.ReadTime,
+				Ctime:          src.Ctime,
+			}
+		}),
+		Total: total,
+	}, nil
+}
+
+// MarkInboxMessagesRead 标记站内信为已读
+func (s *NotificationServer) MarkInboxMessagesRead(ctx context.Context, req *notificationv1.MarkInboxMessagesReadRequest) (*notificationv1.MarkInboxMessagesReadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err = s.inboxSvc.MarkRead(ctx, bizID, req.GetReceiver(), req.GetMessageIds()); err != nil {
+		return nil, s.inboxError(err)
+	}
+	return &notificationv1.MarkInboxMessagesReadResponse{}, nil
+}
+
+// MarkInboxMessagesUnread 标记站内信为未读
+func (s *NotificationServer) MarkInboxMessagesUnread(ctx context.Context, req *notificationv1.MarkInboxMessagesUnreadRequest) (*notificationv1.MarkInboxMessagesUnreadResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err = s.inboxSvc.MarkUnread(ctx, bizID, req.GetReceiver(), req.GetMessageIds()); err != nil {
+		return nil, s.inboxError(err)
+	}
+	return &notificationv1.MarkInboxMessagesUnreadResponse{}, nil
+}
+
+// DeleteInboxMessages 删除站内信
+func (s *NotificationServer) DeleteInboxMessages(ctx context.Context, req *notificationv1.DeleteInboxMessagesRequest) (*notificationv1.DeleteInboxMessagesResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err = s.inboxSvc.Delete(ctx, bizID, req.GetReceiver(), req.GetMessageIds()); err != nil {
+		return nil, s.inboxError(err)
+	}
+	return &notificationv1.DeleteInboxMessagesResponse{}, nil
+}
+
+// GetInboxUnreadCount 获取接收者的未读站内信数量
+func (s *NotificationServer) GetInboxUnreadCount(ctx context.Context, req *notificationv1.GetInboxUnreadCountRequest) (*notificationv1.GetInboxUnreadCountResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	cnt, err := s.inboxSvc.UnreadCount(ctx, bizID, req.GetReceiver())
+	if err != nil {
+		return nil, s.inboxError(err)
+	}
+	return &notificationv1.GetInboxUnreadCountResponse{Count: cnt}, nil
+}
+
+func (s *NotificationServer) inboxError(err error) error {
+	if errors.Is(err, errs.ErrInvalidParameter) {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
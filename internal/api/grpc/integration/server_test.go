@@ -62,6 +62,7 @@ func (s *GRPCServerTestSuite) SetupSuite() {
 	// 创建成功响应的模拟客户端
 	ctrl := gomock.NewController(s.T())
 	mockClient := smsmocks.NewMockClient(ctrl)
+	mockClient.EXPECT().ErrorCodes().Return(nil).AnyTimes()
 
 	// 模拟Send方法
 	mockClient.EXPECT().Send(gomock.Any()).Return(client.SendResp{
@@ -337,8 +338,8 @@ func (s *BaseGRPCServerTestSuite) prepareTemplateData() int64 {
 		},
 		RateLimit: 100,
 		Quota: &domain.QuotaConfig{
-			Monthly: domain.MonthlyConfig{
-				SMS: 1000,
+			Monthly: map[domain.Channel]int32{
+				domain.ChannelSMS: 1000,
 			},
 		},
 	}
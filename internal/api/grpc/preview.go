@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PreviewNotification 预览通知，只渲染模版并给出会使用的供应商
+func (s *NotificationServer) PreviewNotification(ctx context.Context, req *notificationv1.PreviewNotificationRequest) (*notificationv1.PreviewNotificationResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	templateID, err := strconv.ParseInt(req.GetTemplateId(), 10, 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: 模板ID: %s", errs.ErrInvalidParameter, req.GetTemplateId())
+	}
+
+	preview, err := s.previewSvc.Preview(ctx, bizID, templateID, req.GetVersionId(), req.GetTemplateParams())
+	if err != nil {
+		switch {
+		case errors.Is(err, errs.ErrInvalidParameter):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, errs.ErrTemplateNotFound), errors.Is(err, errs.ErrTemplateVersionNotFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, errs.ErrNoAvailableChannel):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+
+	return &notificationv1.PreviewNotificationResponse{
+		Channel:      s.convertToGRPCChannel(preview.Channel),
+		VersionId:    preview.VersionID,
+		Signature:    preview.Signature,
+		Title:        preview.Title,
+		Content:      preview.Content,
+		Placeholders: preview.Placeholders,
+		ProviderName: preview.ProviderName,
+	}, nil
+}
+
+// convertToGRPCChannel 将领域渠道转换为gRPC渠道
+func (s *NotificationServer) convertToGRPCChannel(channel domain.Channel) notificationv1.Channel {
+	switch channel {
+	case domain.ChannelSMS:
+		return notificationv1.Channel_SMS
+	case domain.ChannelEmail:
+		return notificationv1.Channel_EMAIL
+	case domain.ChannelInApp:
+		return notificationv1.Channel_IN_APP
+	default:
+		return notificationv1.Channel_CHANNEL_UNSPECIFIED
+	}
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	configv1 "gitee.com/flycash/notification-platform/api/proto/gen/config/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuotaServer 额度查询、调整以及流水查询
+type QuotaServer struct {
+	configv1.UnimplementedQuotaServiceServer
+	svc quota.Service
+}
+
+func NewQuotaServer(svc quota.Service) *QuotaServer {
+	return &QuotaServer{svc: svc}
+}
+
+// GetQuota 查询当前业务方在某个渠道上的剩余额度
+func (q *QuotaServer) GetQuota(ctx context.Context, req *configv1.GetQuotaRequest) (*configv1.GetQuotaResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	found, err := q.svc.GetQuota(ctx, bizID, domain.Channel(req.GetChannel()))
+	if err != nil {
+		return nil, q.quotaError(err)
+	}
+	return &configv1.GetQuotaResponse{Quota: q.toQuota(found)}, nil
+}
+
+// AdjustQuota 管理员为指定业务方充值或扣减额度
+func (q *QuotaServer) AdjustQuota(ctx context.Context, req *configv1.AdjustQuotaRequest) (*configv1.AdjustQuotaResponse, error) {
+	if req.GetBizId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: BizID", errs.ErrInvalidParameter)
+	}
+	adjusted, err := q.svc.AdjustQuota(ctx, req.GetBizId(), domain.Channel(req.GetChannel()),
+		req.GetDelta(), req.GetReason(), req.GetOperator())
+	if err != nil {
+		return nil, q.quotaError(err)
+	}
+	return &configv1.AdjustQuotaResponse{Quota: q.toQuota(adjusted)}, nil
+}
+
+// ListQuotaLedger 按时间倒序分页查询当前业务方的额度流水
+func (q *QuotaServer) ListQuotaLedger(ctx context.Context, req *configv1.ListQuotaLedgerRequest) (*configv1.ListQuotaLedgerResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	ledgers, total, err := q.svc.ListLedgers(ctx, bizID, domain.Channel(req.GetChannel()), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, q.quotaError(err)
+	}
+	return &configv1.ListQuotaLedgerResponse{
+		Ledgers: slice.Map(ledgers, func(_ int, src domain.QuotaLedger) *configv1.QuotaLedger {
+			return &configv1.QuotaLedger{
+				Id:       src.ID,
+				BizId:    src.BizID,
+				Channel:  src.Channel.String(),
+				Type:     src.Type.String(),
+				Delta:    src.Delta,
+				Balance:  src.Balance,
+				Reason:   src.Reason,
+				Operator: src.Operator,
+				Ctime:    src.Ctime,
+			}
+		}),
+		Total: total,
+	}, nil
+}
+
+func (q *QuotaServer) toQuota(src domain.Quota) *configv1.Quota {
+	return &configv1.Quota{
+		BizId:      src.BizID,
+		Channel:    src.Channel.String(),
+		Quota:      src.Quota,
+		DailyLimit: src.DailyLimit,
+		DailyUsed:  src.DailyUsed,
+	}
+}
+
+func (q *QuotaServer) quotaError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrNoQuota):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, errs.ErrQuotaNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
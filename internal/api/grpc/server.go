@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 
 	"gitee.com/flycash/notification-platform/internal/errs"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
+	"github.com/ecodeclub/ekit/slice"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -31,6 +34,10 @@ type NotificationServer struct {
 	sendSvc         notificationsvc.SendService
 	txnSvc          notificationsvc.TxNotificationService
 	templateSvc     templatesvc.ChannelTemplateService
+	inboxSvc        inboxsvc.Service
+	previewSvc      notificationsvc.PreviewService
+	// localeFallbacks 语言的降级规则，校验参数时要覆盖降级链上所有可能用到的本地化内容
+	localeFallbacks domain.LocaleFallbacks
 }
 
 // NewServer 创建通知平台gRPC服务器
@@ -38,12 +45,18 @@ func NewServer(notificationSvc notificationsvc.Service,
 	sendSvc notificationsvc.SendService,
 	txnSvc notificationsvc.TxNotificationService,
 	templateSvc templatesvc.ChannelTemplateService,
+	inboxSvc inboxsvc.Service,
+	previewSvc notificationsvc.PreviewService,
+	localeFallbacks domain.LocaleFallbacks,
 ) *NotificationServer {
 	return &NotificationServer{
 		notificationSvc: notificationSvc,
 		sendSvc:         sendSvc,
 		txnSvc:          txnSvc,
 		templateSvc:     templateSvc,
+		inboxSvc:        inboxSvc,
+		previewSvc:      previewSvc,
+		localeFallbacks: localeFallbacks,
 	}
 }
 
@@ -81,6 +94,9 @@ func (s *NotificationServer) SendNotification(ctx context.Context, req *notifica
 
 	response.NotificationId = result.NotificationID
 	response.Status = s.convertToGRPCSendStatus(result.Status)
+	response.ReceiverResults = s.convertToGRPCReceiverResults(result.ReceiverResults)
+	response.SentChannel = s.convertToGRPCChannel(result.SentChannel)
+	s.setGRPCSendError(response, domain.NewSendError(result.Error))
 	return response, nil
 }
 
@@ -103,12 +119,19 @@ func (s *NotificationServer) buildNotification(ctx context.Context, n *notificat
 		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s", errs.ErrInvalidParameter, n.TemplateId)
 	}
 
-	if !tmpl.HasPublished() {
+	// A/B 测试时按通知的 key 选择版本，业务方重试同一条通知时总是选到同一个版本
+	version := tmpl.PickVersion(bizID, notification.Key)
+	if version == nil {
 		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s 未发布", errs.ErrInvalidParameter, n.TemplateId)
 	}
 
+	// 按模版声明的占位符校验参数，避免参数名拼写错误等问题到了供应商侧才暴露
+	if err = version.ValidateParams(s.localeFallbacks, notification); err != nil {
+		return domain.Notification{}, fmt.Errorf("%w: 模板ID: %s %w", errs.ErrInvalidParameter, n.TemplateId, err)
+	}
+
 	notification.BizID = bizID
-	notification.Template.VersionID = tmpl.ActiveVersionID
+	notification.Template.VersionID = version.ID
 	return notification, nil
 }
 
@@ -125,14 +148,67 @@ func (s *NotificationServer) convertToGRPCSendStatus(status domain.SendStatus) n
 		return notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		return notificationv1.SendStatus_FAILED
+	case domain.SendStatusPartialSuccess:
+		return notificationv1.SendStatus_PARTIAL_SUCCESS
+	case domain.SendStatusDelivered:
+		return notificationv1.SendStatus_DELIVERED
+	case domain.SendStatusUndelivered:
+		return notificationv1.SendStatus_UNDELIVERED
+	case domain.SendStatusRetrying:
+		return notificationv1.SendStatus_RETRYING
+	case domain.SendStatusSuppressed:
+		return notificationv1.SendStatus_SUPPRESSED
 	default:
 		return notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	}
 }
 
+// convertToGRPCReceiverResults 将每个接收者的发送结果转换为gRPC结构
+func (s *NotificationServer) convertToGRPCReceiverResults(results []domain.ReceiverResult) []*notificationv1.ReceiverResult {
+	return slice.Map(results, func(_ int, src domain.ReceiverResult) *notificationv1.ReceiverResult {
+		return &notificationv1.ReceiverResult{
+			Receiver: src.Receiver,
+			Status:   s.convertToGRPCSendStatus(src.Status),
+			Code:     src.Code,
+			Message:  src.Message,
+			Provider: src.Provider,
+		}
+	})
+}
+
+// convertToGRPCSendAttempts 将每一次失败的发送尝试转换为gRPC结构
+func (s *NotificationServer) convertToGRPCSendAttempts(attempts []domain.SendAttempt) []*notificationv1.SendAttempt {
+	return slice.Map(attempts, func(_ int, src domain.SendAttempt) *notificationv1.SendAttempt {
+		return &notificationv1.SendAttempt{
+			Attempt: src.Attempt,
+			Channel: s.convertToGRPCChannel(src.Channel),
+			Status:  s.convertToGRPCSendStatus(src.Status),
+			Message: src.Message,
+			Ctime:   src.Ctime,
+		}
+	})
+}
+
+// setGRPCSendError 将通知最近一次发送失败的原因放到响应中，发送成功时没有错误
+func (s *NotificationServer) setGRPCSendError(response *notificationv1.SendNotificationResponse, sendErr *domain.SendError) {
+	if sendErr == nil {
+		return
+	}
+	response.ErrorCode = s.convertToGRPCErrorCode(sendErr)
+	if response.ErrorCode == notificationv1.ErrorCode_ERROR_CODE_UNSPECIFIED {
+		// 从数据库中读出来的错误没有原始错误，无法进一步细分
+		response.ErrorCode = notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED
+	}
+	response.ErrorMessage = sendErr.Message
+}
+
 // convertToGRPCErrorCode 将错误映射为gRPC错误代码
 func (s *NotificationServer) convertToGRPCErrorCode(err error) notificationv1.ErrorCode {
 	// 注意：这个函数只处理业务错误，系统错误由isSystemError判断后直接通过gRPC status返回
+	// 先按发送错误的分类映射，这样供应商的问题不会被归为笼统的发送失败
+	if code := domain.NewSendError(err).Code; code != domain.SendErrorCodeUnknown {
+		return code.ToAPI()
+	}
 	switch {
 	case errors.Is(err, errs.ErrInvalidParameter):
 		return notificationv1.ErrorCode_INVALID_PARAMETER
@@ -182,6 +258,12 @@ func (s *NotificationServer) convertToGRPCErrorCode(err error) notificationv1.Er
 	case errors.Is(err, errs.ErrUnknownChannel):
 		return notificationv1.ErrorCode_UNKNOWN_CHANNEL
 
+	case errors.Is(err, errs.ErrNotificationNotCancelable):
+		return notificationv1.ErrorCode_NOTIFICATION_NOT_CANCELABLE
+
+	case errors.Is(err, errs.ErrNotificationNotEditable):
+		return notificationv1.ErrorCode_NOTIFICATION_NOT_EDITABLE
+
 	default:
 		return notificationv1.ErrorCode_ERROR_CODE_UNSPECIFIED
 	}
@@ -222,6 +304,7 @@ func (s *NotificationServer) SendNotificationAsync(ctx context.Context, req *not
 
 	// 将结果转换为响应
 	response.NotificationId = result.NotificationID
+	response.ReceiverResults = s.convertToGRPCReceiverResults(result.ReceiverResults)
 	return response, nil
 }
 
@@ -298,7 +381,8 @@ func (s *NotificationServer) BatchSendNotifications(ctx context.Context, req *no
 	for i := range responses.Results {
 		results[i] = s.buildGRPCSendResponse(responses.Results[i], nil)
 		if notifications[first].SendStrategyConfig.Type == domain.SendStrategyImmediate &&
-			domain.SendStatusSucceeded == responses.Results[i].Status {
+			(domain.SendStatusSucceeded == responses.Results[i].Status ||
+				domain.SendStatusPartialSuccess == responses.Results[i].Status) {
 			successCount++
 		}
 		if notifications[first].SendStrategyConfig.Type != domain.SendStrategyImmediate &&
@@ -316,9 +400,12 @@ func (s *NotificationServer) BatchSendNotifications(ctx context.Context, req *no
 // buildGRPCSendResponse 将领域响应转换为gRPC响应
 func (s *NotificationServer) buildGRPCSendResponse(result domain.SendResponse, err error) *notificationv1.SendNotificationResponse {
 	response := &notificationv1.SendNotificationResponse{
-		NotificationId: result.NotificationID,
-		Status:         s.convertToGRPCSendStatus(result.Status),
+		NotificationId:  result.NotificationID,
+		Status:          s.convertToGRPCSendStatus(result.Status),
+		ReceiverResults: s.convertToGRPCReceiverResults(result.ReceiverResults),
+		SentChannel:     s.convertToGRPCChannel(result.SentChannel),
 	}
+	s.setGRPCSendError(response, domain.NewSendError(result.Error))
 	// 如果有错误，提取错误代码和消息
 	if err != nil {
 		response.ErrorMessage = err.Error()
@@ -438,6 +525,151 @@ func (s *NotificationServer) TxCancel(ctx context.Context, request *notification
 	return &notificationv1.TxCancelResponse{}, err
 }
 
+// CancelNotification 处理取消通知请求
+func (s *NotificationServer) CancelNotification(ctx context.Context, req *notificationv1.CancelNotificationRequest) (*notificationv1.CancelNotificationResponse, error) {
+	if req == nil || req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "请求参数无效: key不能为空")
+	}
+
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	n, err := s.notificationSvc.Cancel(ctx, bizID, req.Key)
+	return s.buildGRPCCancelResponse(notificationsvc.CancelResult{Key: req.Key, Notification: n, Err: err})
+}
+
+// BatchCancelNotifications 处理批量取消通知请求
+func (s *NotificationServer) BatchCancelNotifications(ctx context.Context, req *notificationv1.BatchCancelNotificationsRequest) (*notificationv1.BatchCancelNotificationsResponse, error) {
+	if req == nil || len(req.Keys) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "请求参数无效: keys不能为空")
+	}
+
+	if len(req.Keys) > batchSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "%v: %d > %d", errs.ErrBatchSizeOverLimit, len(req.Keys), batchSizeLimit)
+	}
+
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	results, err := s.notificationSvc.BatchCancel(ctx, bizID, req.Keys...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "批量取消通知失败: %v", err)
+	}
+
+	response := &notificationv1.BatchCancelNotificationsResponse{
+		Results: make([]*notificationv1.CancelNotificationResponse, 0, len(results)),
+	}
+	for i := range results {
+		result, err := s.buildGRPCCancelResponse(results[i])
+		if err != nil {
+			return nil, err
+		}
+		response.Results = append(response.Results, result)
+	}
+	return response, nil
+}
+
+// UpdateNotification 处理修改通知请求
+func (s *NotificationServer) UpdateNotification(ctx context.Context, req *notificationv1.UpdateNotificationRequest) (*notificationv1.UpdateNotificationResponse, error) {
+	if req == nil || req.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "请求参数无效: key不能为空")
+	}
+
+	// 从metadata中解析Authorization JWT Token
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	update, err := s.buildNotificationUpdate(req, bizID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	n, err := s.notificationSvc.Update(ctx, update)
+	if err != nil {
+		if s.isSystemError(err) {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		return &notificationv1.UpdateNotificationResponse{
+			NotificationId: n.ID,
+			Status:         s.convertToGRPCSendStatus(n.Status),
+			ErrorCode:      s.convertToGRPCErrorCode(err),
+			ErrorMessage:   err.Error(),
+		}, nil
+	}
+	return &notificationv1.UpdateNotificationResponse{
+		NotificationId: n.ID,
+		Status:         s.convertToGRPCSendStatus(n.Status),
+		ScheduledStime: n.ScheduledSTime.UnixMilli(),
+		ScheduledEtime: n.ScheduledETime.UnixMilli(),
+	}, nil
+}
+
+// buildNotificationUpdate 设置了 update_mask 时只修改列出的字段，列出的字段为空也会修改；
+// 没有设置时兼容原来的行为，只修改不为空的字段
+func (s *NotificationServer) buildNotificationUpdate(req *notificationv1.UpdateNotificationRequest, bizID int64) (domain.NotificationUpdate, error) {
+	update := domain.NotificationUpdate{
+		BizID: bizID,
+		Key:   req.Key,
+	}
+	if req.UpdateMask == nil {
+		if req.Strategy != nil {
+			cfg := domain.NewSendStrategyConfigFromAPI(req.Strategy)
+			update.SendStrategyConfig = &cfg
+		}
+		if len(req.TemplateParams) > 0 {
+			update.TemplateParams = req.TemplateParams
+		}
+		if len(req.Receivers) > 0 {
+			update.Receivers = req.Receivers
+		}
+		return update, nil
+	}
+	for _, path := range req.UpdateMask.GetPaths() {
+		switch path {
+		case "strategy":
+			if req.Strategy == nil {
+				return domain.NotificationUpdate{}, fmt.Errorf("%w: update_mask 包含 strategy 但是没有传发送策略", errs.ErrInvalidParameter)
+			}
+			cfg := domain.NewSendStrategyConfigFromAPI(req.Strategy)
+			update.SendStrategyConfig = &cfg
+		case "template_params":
+			// 不为 nil 表示修改，空 map 表示清空模版参数
+			update.TemplateParams = make(map[string]string, len(req.TemplateParams))
+			maps.Copy(update.TemplateParams, req.TemplateParams)
+		case "receivers":
+			update.Receivers = append([]string{}, req.Receivers...)
+		default:
+			return domain.NotificationUpdate{}, fmt.Errorf("%w: update_mask 不支持字段 %q", errs.ErrInvalidParameter, path)
+		}
+	}
+	return update, nil
+}
+
+// buildGRPCCancelResponse 构造单个通知的取消结果，系统错误直接通过gRPC status返回
+func (s *NotificationServer) buildGRPCCancelResponse(result notificationsvc.CancelResult) (*notificationv1.CancelNotificationResponse, error) {
+	if result.Err != nil && s.isSystemError(result.Err) {
+		return nil, status.Errorf(codes.Internal, "%v", result.Err)
+	}
+	response := &notificationv1.CancelNotificationResponse{
+		Key:            result.Key,
+		NotificationId: result.Notification.ID,
+		Status:         s.convertToGRPCSendStatus(result.Notification.Status),
+	}
+	if result.Err != nil {
+		response.ErrorCode = s.convertToGRPCErrorCode(result.Err)
+		response.ErrorMessage = result.Err.Error()
+	}
+	return response, nil
+}
+
 // QueryNotification 处理单条查询通知请求
 func (s *NotificationServer) QueryNotification(ctx context.Context, req *notificationv1.QueryNotificationRequest) (*notificationv1.QueryNotificationResponse, error) {
 	// 检查请求参数
@@ -466,11 +698,18 @@ func (s *NotificationServer) QueryNotification(ctx context.Context, req *notific
 
 	// 将结果转换为响应
 	const zero = 0
+	result := &notificationv1.SendNotificationResponse{
+		NotificationId:  notifications[zero].ID,
+		Status:          s.convertToGRPCSendStatus(notifications[zero].Status),
+		ReceiverResults: s.convertToGRPCReceiverResults(notifications[zero].ReceiverResults),
+		SentChannel:     s.convertToGRPCChannel(notifications[zero].SentChannel),
+		RetryCount:      notifications[zero].RetryCount,
+		NextRetryTime:   notifications[zero].NextRetryTime,
+		Attempts:        s.convertToGRPCSendAttempts(notifications[zero].Attempts),
+	}
+	s.setGRPCSendError(result, notifications[zero].Error)
 	return &notificationv1.QueryNotificationResponse{
-		Result: &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[zero].ID,
-			Status:         s.convertToGRPCSendStatus(notifications[zero].Status),
-		},
+		Result: result,
 	}, nil
 }
 
@@ -508,10 +747,17 @@ func (s *NotificationServer) BatchQueryNotifications(ctx context.Context, req *n
 	}
 
 	for i := range notifications {
-		response.Results = append(response.Results, &notificationv1.SendNotificationResponse{
-			NotificationId: notifications[i].ID,
-			Status:         s.convertToGRPCSendStatus(notifications[i].Status),
-		})
+		result := &notificationv1.SendNotificationResponse{
+			NotificationId:  notifications[i].ID,
+			Status:          s.convertToGRPCSendStatus(notifications[i].Status),
+			ReceiverResults: s.convertToGRPCReceiverResults(notifications[i].ReceiverResults),
+			SentChannel:     s.convertToGRPCChannel(notifications[i].SentChannel),
+			RetryCount:      notifications[i].RetryCount,
+			NextRetryTime:   notifications[i].NextRetryTime,
+			Attempts:        s.convertToGRPCSendAttempts(notifications[i].Attempts),
+		}
+		s.setGRPCSendError(result, notifications[i].Error)
+		response.Results = append(response.Results, result)
 	}
 
 	return response, nil
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	configv1 "gitee.com/flycash/notification-platform/api/proto/gen/config/v1"
+	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SuppressionServer 当前业务方的退订名单管理
+type SuppressionServer struct {
+	configv1.UnimplementedSuppressionServiceServer
+	svc suppression.Service
+}
+
+func NewSuppressionServer(svc suppression.Service) *SuppressionServer {
+	return &SuppressionServer{svc: svc}
+}
+
+// AddSuppression 接收者退订之后不再给他发送匹配渠道和业务类型的通知
+func (s *SuppressionServer) AddSuppression(ctx context.Context, req *configv1.AddSuppressionRequest) (*configv1.AddSuppressionResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	err = s.svc.Add(ctx, domain.Suppression{
+		BizID:        bizID,
+		Channel:      domain.Channel(req.GetChannel()),
+		BusinessType: domain.BusinessType(req.GetBusinessType()),
+		Receiver:     req.GetReceiver(),
+		Reason:       req.GetReason(),
+	})
+	if err != nil {
+		return nil, s.suppressionError(err)
+	}
+	return &configv1.AddSuppressionResponse{}, nil
+}
+
+// RemoveSuppression 接收者重新订阅
+func (s *SuppressionServer) RemoveSuppression(ctx context.Context, req *configv1.RemoveSuppressionRequest) (*configv1.RemoveSuppressionResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	err = s.svc.Remove(ctx, domain.Suppression{
+		BizID:        bizID,
+		Channel:      domain.Channel(req.GetChannel()),
+		BusinessType: domain.BusinessType(req.GetBusinessType()),
+		Receiver:     req.GetReceiver(),
+	})
+	if err != nil {
+		return nil, s.suppressionError(err)
+	}
+	return &configv1.RemoveSuppressionResponse{}, nil
+}
+
+// ListSuppressions 按时间倒序分页查询当前业务方的退订名单
+func (s *SuppressionServer) ListSuppressions(ctx context.Context, req *configv1.ListSuppressionsRequest) (*configv1.ListSuppressionsResponse, error) {
+	bizID, err := jwt.GetBizIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	found, total, err := s.svc.List(ctx, bizID, req.GetReceiver(), int(req.GetOffset()), int(req.GetLimit()))
+	if err != nil {
+		return nil, s.suppressionError(err)
+	}
+	return &configv1.ListSuppressionsResponse{
+		Suppressions: slice.Map(found, func(_ int, src domain.Suppression) *configv1.Suppression {
+			return &configv1.Suppression{
+				Id:           src.ID,
+				Receiver:     src.Receiver,
+				Channel:      src.Channel.String(),
+				BusinessType: src.BusinessType.ToInt64(),
+				Reason:       src.Reason,
+				Ctime:        src.Ctime,
+				Utime:        src.Utime,
+			}
+		}),
+		Total: total,
+	}, nil
+}
+
+func (s *SuppressionServer) suppressionError(err error) error {
+	switch {
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, errs.ErrSuppressionNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
 type ResourceType string
 
 const (
@@ -11,9 +17,38 @@ func (r ResourceType) IsTemplate() bool {
 }
 
 type Audit struct {
+	ID           int64
 	ResourceID   int64        // 模版版本ID
 	ResourceType ResourceType // TEMPLATE
 	Content      string       // 完整JSON串，模版信息-基本+版本+渠道名（多个）
+	ReviewerID   int64        // 分配的审核人ID，0表示没有分配，任何审核人都可以审核
+	Status       AuditStatus  // 审核中、已通过、已拒绝
+	RejectReason string       // 拒绝原因
+	AuditTime    int64        // 审核时间
+	Ctime        int64
+	Utime        int64
+
+	Comments []AuditComment // 审核意见，按时间正序
+}
+
+// CanBeDecidedBy 审核中并且分配给了该审核人，或者没有分配审核人
+func (a Audit) CanBeDecidedBy(reviewerID int64) error {
+	if !a.Status.IsInReview() {
+		return fmt.Errorf("%w: 审核记录ID %d 状态 %s", errs.ErrAuditAlreadyDecided, a.ID, a.Status)
+	}
+	if a.ReviewerID != 0 && a.ReviewerID != reviewerID {
+		return fmt.Errorf("%w: 审核记录ID %d 分配给了 %d", errs.ErrAuditReviewerMismatch, a.ID, a.ReviewerID)
+	}
+	return nil
+}
+
+// AuditComment 审核意见，审核人可以在做出审核结果之前多次添加
+type AuditComment struct {
+	ID         int64
+	AuditID    int64
+	ReviewerID int64
+	Content    string
+	Ctime      int64
 }
 
 type AuditContent struct {
@@ -28,4 +63,15 @@ type AuditContent struct {
 	Content       string   `json:"content"`       // 模板内容
 	Remark        string   `json:"remark"`        // 申请说明
 	ProviderNames []string `json:"providerNames"` // 供应商名称
+	// ComplianceWarnings 合规检查发现的、不阻止提交的问题，提醒审核人重点关注
+	ComplianceWarnings []ComplianceViolation `json:"complianceWarnings,omitempty"`
+	// Locales 本地化内容，和默认内容一起审核
+	Locales []AuditLocaleContent `json:"locales,omitempty"`
+}
+
+// AuditLocaleContent 审核内容中某种语言的本地化内容
+type AuditLocaleContent struct {
+	Locale    string `json:"locale"`    // 语言
+	Signature string `json:"signature"` // 签名，为空时使用默认内容的签名
+	Content   string `json:"content"`   // 模版内容
 }
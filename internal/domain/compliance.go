@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// ComplianceRule 模版内容合规检查的规则
+type ComplianceRule string
+
+const (
+	ComplianceRuleSensitiveWord ComplianceRule = "SENSITIVE_WORD" // 敏感词
+	ComplianceRuleShortURL      ComplianceRule = "SHORT_URL"      // 短链接
+	ComplianceRuleSignature     ComplianceRule = "SIGNATURE"      // 缺少签名
+	ComplianceRuleSMSLength     ComplianceRule = "SMS_LENGTH"     // 短信长度和计费条数
+	ComplianceRuleOptOut        ComplianceRule = "OPT_OUT"        // 营销模版缺少退订方式
+)
+
+func (r ComplianceRule) String() string {
+	return string(r)
+}
+
+// ComplianceViolation 一条不合规的地方，Blocking 为 true 时不允许提交审核，
+// 否则只在审核内容中标注出来，交给审核人判断
+type ComplianceViolation struct {
+	Rule     ComplianceRule `json:"rule"`
+	Blocking bool           `json:"blocking"`
+	Message  string         `json:"message"`
+}
+
+// ComplianceViolations 一次合规检查的结果
+type ComplianceViolations []ComplianceViolation
+
+// Err 有阻止提交的问题时返回 errs.ErrTemplateNonCompliant，错误信息中包含所有阻止提交的问题
+func (vs ComplianceViolations) Err() error {
+	var msgs []string
+	for i := range vs {
+		if vs[i].Blocking {
+			msgs = append(msgs, vs[i].Message)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", errs.ErrTemplateNonCompliant, strings.Join(msgs, "；"))
+}
+
+// Warnings 不阻止提交、需要标注给审核人的问题
+func (vs ComplianceViolations) Warnings() []ComplianceViolation {
+	var res []ComplianceViolation
+	for i := range vs {
+		if !vs[i].Blocking {
+			res = append(res, vs[i])
+		}
+	}
+	return res
+}
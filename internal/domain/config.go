@@ -1,37 +1,97 @@
 package domain
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+
 	"gitee.com/flycash/notification-platform/internal/pkg/retry"
 )
 
 // BusinessConfig 业务配置领域对象
 type BusinessConfig struct {
-	ID             int64           // 业务标识
-	OwnerID        int64           // 业务方ID
-	OwnerType      string          // 业务方类型：person-个人,organization-组织
-	ChannelConfig  *ChannelConfig  // 渠道配置，JSON格式
-	TxnConfig      *TxnConfig      // 事务配置，JSON格式
-	RateLimit      int             // 每秒最大请求数
-	Quota          *QuotaConfig    // 配额设置，JSON格式
-	CallbackConfig *CallbackConfig // 回调配置
-	Ctime          int64           // 创建时间
-	Utime          int64           // 更新时间
+	ID             int64               // 业务标识
+	OwnerID        int64               // 业务方ID
+	OwnerType      string              // 业务方类型：person-个人,organization-组织
+	ChannelConfig  *ChannelConfig      // 渠道配置，JSON格式
+	TxnConfig      *TxnConfig          // 事务配置，JSON格式
+	RateLimit      int                 // 每秒最大请求数
+	Quota          *QuotaConfig        // 配额设置，JSON格式
+	CallbackConfig *CallbackConfig     // 回调配置
+	QuietHours     *QuietHours         // 免打扰配置
+	FrequencyCap   *FrequencyCapConfig // 接收者发送频率上限
+	Ctime          int64               // 创建时间
+	Utime          int64               // 更新时间
 }
+
+// QuotaConfig 按渠道配置的额度，没有配置每月额度的渠道无法发送
 type QuotaConfig struct {
-	Monthly MonthlyConfig `json:"monthly"`
+	// Monthly 每月额度，每月重置
+	Monthly map[Channel]int32 `json:"monthly"`
+	// Daily 每日发送上限，只对配置了每月额度的渠道生效，没有配置表示不限制
+	Daily map[Channel]int32 `json:"daily"`
 }
-type MonthlyConfig struct {
-	SMS   int `json:"sms"`
-	EMAIL int `json:"email"`
+
+// UnmarshalJSON 兼容旧版本以小写渠道名作为键的配置，如 {"monthly":{"sms":100}}
+func (q *QuotaConfig) UnmarshalJSON(data []byte) error {
+	type quotaConfig QuotaConfig
+	var cfg quotaConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+	q.Monthly, q.Daily = normalizeQuotaChannels(cfg.Monthly), normalizeQuotaChannels(cfg.Daily)
+	return nil
 }
+
+func normalizeQuotaChannels(m map[Channel]int32) map[Channel]int32 {
+	if m == nil {
+		return nil
+	}
+	res := make(map[Channel]int32, len(m))
+	for ch, val := range m {
+		res[Channel(strings.ToUpper(ch.String()))] = val
+	}
+	return res
+}
+
 type ChannelConfig struct {
 	Channels    []ChannelItem `json:"channels"`
 	RetryPolicy *retry.Config `json:"retryPolicy"`
+	// Failover 开启后，通知在原渠道的所有供应商都发送失败时，按优先级依次尝试其他启用的渠道，
+	// 只会降级到通知中指定了接收者的渠道
+	Failover bool `json:"failover"`
 }
+
+// FailoverChannels 返回 from 渠道发送失败后可以降级的渠道，按优先级排序，Priority 越小越优先。
+// 没有开启降级时返回空
+func (c *ChannelConfig) FailoverChannels(from Channel) []ChannelItem {
+	if !c.Failover {
+		return nil
+	}
+	res := make([]ChannelItem, 0, len(c.Channels))
+	for i := range c.Channels {
+		if c.Channels[i].Enabled && Channel(c.Channels[i].Channel) != from {
+			res = append(res, c.Channels[i])
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Priority < res[j].Priority
+	})
+	return res
+}
+
 type ChannelItem struct {
 	Channel  string `json:"channel"`
 	Priority int    `json:"priority"`
 	Enabled  bool   `json:"enabled"`
+	// Templates 原渠道模版ID到本渠道模版ID的映射，降级到本渠道时使用映射后的模版，
+	// 没有映射的模版不会降级到本渠道
+	Templates map[int64]int64 `json:"templates"`
 }
 
 type TxnConfig struct {
@@ -47,3 +107,110 @@ type CallbackConfig struct {
 	ServiceName string        `json:"serviceName"`
 	RetryPolicy *retry.Config `json:"retryPolicy"`
 }
+
+// QuietHours 免打扰配置，落在免打扰时段内的通知会推迟到时段结束之后再发送
+type QuietHours struct {
+	// Timezone 免打扰时段所在的时区，例如 Asia/Shanghai，为空时使用本地时区
+	Timezone  string          `json:"timezone"`
+	Intervals []QuietInterval `json:"intervals"`
+	// BusinessTypes 生效的业务类型，为空时对验证码以外的所有业务类型生效
+	BusinessTypes []BusinessType `json:"businessTypes"`
+}
+
+// QuietInterval 每天的免打扰时段，格式为 HH:MM，左闭右开。
+// End 早于 Start 表示跨过零点，例如 22:00 到 08:00
+type QuietInterval struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+const (
+	quietIntervalLayout = "15:04"
+	minutesPerHour      = 60
+)
+
+func (q *QuietHours) Validate() error {
+	if _, err := q.location(); err != nil {
+		return err
+	}
+	for _, interval := range q.Intervals {
+		if _, _, err := interval.minutes(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppliesTo 免打扰配置是否对该业务类型生效，没有配置业务类型时验证码不受限制
+func (q *QuietHours) AppliesTo(businessType BusinessType) bool {
+	if len(q.BusinessTypes) == 0 {
+		return businessType != BusinessTypeVerificationCode
+	}
+	return slices.Contains(q.BusinessTypes, businessType)
+}
+
+// NextAllowedTime t 落在免打扰时段内时返回时段结束的时间和 true，否则返回 t 和 false。
+// 时段结束的时候可能正好落在另一个时段内，所以会一直推迟到不在任何时段内为止
+func (q *QuietHours) NextAllowedTime(t time.Time) (time.Time, bool) {
+	loc, err := q.location()
+	if err != nil {
+		return t, false
+	}
+	deferred := false
+	// 每一轮至少会跳出一个时段，所以最多循环时段数量次
+	for range len(q.Intervals) {
+		end, ok := q.quietUntil(t.In(loc))
+		if !ok {
+			break
+		}
+		t, deferred = end, true
+	}
+	return t, deferred
+}
+
+// quietUntil t 落在某个免打扰时段内时返回这个时段结束的时间
+func (q *QuietHours) quietUntil(t time.Time) (time.Time, bool) {
+	current := t.Hour()*minutesPerHour + t.Minute()
+	endAt := func(days, minutes int) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day()+days, minutes/minutesPerHour, minutes%minutesPerHour, 0, 0, t.Location())
+	}
+	for _, interval := range q.Intervals {
+		start, end, err := interval.minutes()
+		if err != nil || start == end {
+			continue
+		}
+		switch {
+		case start < end && current >= start && current < end,
+			start > end && current < end:
+			return endAt(0, end), true
+		case start > end && current >= start:
+			// 跨过零点的时段，到第二天才结束
+			return endAt(1, end), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (q *QuietHours) location() (*time.Location, error) {
+	if q.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 免打扰时区 %q 不正确", errs.ErrInvalidParameter, q.Timezone)
+	}
+	return loc, nil
+}
+
+// minutes 返回时段开始和结束是一天中的第几分钟
+func (i QuietInterval) minutes() (start, end int, err error) {
+	s, err := time.Parse(quietIntervalLayout, i.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: 免打扰开始时间 %q 不正确", errs.ErrInvalidParameter, i.Start)
+	}
+	e, err := time.Parse(quietIntervalLayout, i.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: 免打扰结束时间 %q 不正确", errs.ErrInvalidParameter, i.End)
+	}
+	return s.Hour()*minutesPerHour + s.Minute(), e.Hour()*minutesPerHour + e.Minute(), nil
+}
@@ -0,0 +1,21 @@
+package domain
+
+// DeliveryReceipt 供应商回执，表示消息是否真正送达接收者
+type DeliveryReceipt struct {
+	Provider  string // 供应商名称
+	MessageID string // 供应商返回的消息ID，阿里云为 BizId，腾讯云为 SerialNo
+	Receiver  string // 接收者
+	Delivered bool   // 是否送达
+	Code      string // 供应商返回的状态码
+	Message   string // 供应商返回的描述信息
+}
+
+// AwaitingReceipt 已被供应商受理、还在等待回执的接收者
+type AwaitingReceipt struct {
+	ID             uint64 // 接收者发送结果ID
+	NotificationID uint64
+	Receiver       string
+	Provider       string
+	MessageID      string
+	SendTime       int64 // 发送时间，毫秒
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// FrequencyCapAction 接收者超过发送频率上限时的处理方式
+type FrequencyCapAction string
+
+const (
+	// FrequencyCapActionDrop 不再发送给超过上限的接收者，其他接收者正常发送
+	FrequencyCapActionDrop FrequencyCapAction = "DROP"
+	// FrequencyCapActionDefer 有接收者超过上限时整条通知推迟到所有接收者都可以发送的时间
+	FrequencyCapActionDefer FrequencyCapAction = "DEFER"
+)
+
+// ReceiverResultCodeFrequencyCapped 接收者因为超过发送频率上限没有发送
+const ReceiverResultCodeFrequencyCapped = "FREQUENCY_CAPPED"
+
+// FrequencyCapConfig 接收者发送频率上限配置，统计业务内所有通知，避免同一个接收者短时间内收到过多通知
+type FrequencyCapConfig struct {
+	// Rules 按顺序匹配，只使用第一条匹配的规则
+	Rules []FrequencyCapRule `json:"rules"`
+}
+
+// FrequencyCapRule 例如 {"channel":"SMS","businessType":1,"limit":3,"windowSeconds":86400}
+// 表示每个接收者 24 小时内最多收到 3 条营销短信
+type FrequencyCapRule struct {
+	// Channel 为空时匹配所有渠道，多个渠道共用一个计数
+	Channel Channel `json:"channel"`
+	// BusinessType 为 0 时匹配所有业务类型，多个业务类型共用一个计数
+	BusinessType  BusinessType       `json:"businessType"`
+	Limit         int32              `json:"limit"`
+	WindowSeconds int64              `json:"windowSeconds"`
+	Action        FrequencyCapAction `json:"action"` // 为空时为 DROP
+}
+
+func (c *FrequencyCapConfig) Validate() error {
+	for i := range c.Rules {
+		if err := c.Rules[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match 返回第一条匹配渠道和业务类型的规则
+func (c *FrequencyCapConfig) Match(channel Channel, businessType BusinessType) (FrequencyCapRule, bool) {
+	for i := range c.Rules {
+		if c.Rules[i].Matches(channel, businessType) {
+			return c.Rules[i], true
+		}
+	}
+	return FrequencyCapRule{}, false
+}
+
+func (r FrequencyCapRule) Validate() error {
+	if r.Limit <= 0 || r.WindowSeconds <= 0 {
+		return fmt.Errorf("%w: 频率上限和统计窗口必须大于0", errs.ErrInvalidParameter)
+	}
+	if r.Action != "" && r.Action != FrequencyCapActionDrop && r.Action != FrequencyCapActionDefer {
+		return fmt.Errorf("%w: 不支持的频率上限处理方式 %s", errs.ErrInvalidParameter, r.Action)
+	}
+	return nil
+}
+
+func (r FrequencyCapRule) Matches(channel Channel, businessType BusinessType) bool {
+	return (r.Channel == "" || r.Channel == channel) &&
+		(r.BusinessType == 0 || r.BusinessType == businessType)
+}
+
+func (r FrequencyCapRule) Window() time.Duration {
+	return time.Duration(r.WindowSeconds) * time.Second
+}
+
+func (r FrequencyCapRule) IsDefer() bool {
+	return r.Action == FrequencyCapActionDefer
+}
+
+// Scope 计数的范围，同一个业务内匹配同一个渠道和业务类型的通知共用一个计数
+func (r FrequencyCapRule) Scope() string {
+	channel := r.Channel.String()
+	if channel == "" {
+		channel = "*"
+	}
+	return fmt.Sprintf("%s:%d", channel, r.BusinessType)
+}
@@ -0,0 +1,31 @@
+package domain
+
+// InboxMessageStatus 站内信状态
+type InboxMessageStatus string
+
+const (
+	InboxMessageStatusUnread InboxMessageStatus = "UNREAD" // 未读
+	InboxMessageStatusRead   InboxMessageStatus = "READ"   // 已读
+)
+
+func (s InboxMessageStatus) String() string {
+	return string(s)
+}
+
+func (s InboxMessageStatus) IsRead() bool {
+	return s == InboxMessageStatusRead
+}
+
+// InboxMessage 站内信，每个接收者一条
+type InboxMessage struct {
+	ID             uint64             // 站内信ID
+	BizID          int64              // 业务ID
+	NotificationID uint64             // 通知ID
+	Receiver       string             // 接收者，一般是用户ID
+	Title          string             // 标题
+	Content        string             // 渲染后的内容
+	Status         InboxMessageStatus // 状态
+	ReadTime       int64              // 已读时间
+	Ctime          int64              // 创建时间
+	Utime          int64              // 更新时间
+}
@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+)
+
+// maxLocaleLength BCP-47 语言标签的长度上限
+const maxLocaleLength = 35
+
+// NormalizeLocale 把 BCP-47 语言标签转换为规范的大小写，如 zh_hk 转换为 zh-HK、zh-hant-tw 转换为 zh-Hant-TW。
+// 空字符串表示没有指定语言，原样返回
+func NormalizeLocale(locale string) (string, error) {
+	if locale == "" {
+		return "", nil
+	}
+	if len(locale) > maxLocaleLength {
+		return "", fmt.Errorf("%w: 语言 %q 太长", errs.ErrInvalidParameter, locale)
+	}
+	subtags := strings.Split(strings.ReplaceAll(locale, "_", "-"), "-")
+	for i, subtag := range subtags {
+		if !isValidSubtag(subtag) {
+			return "", fmt.Errorf("%w: 语言 %q 不是合法的 BCP-47 语言标签", errs.ErrInvalidParameter, locale)
+		}
+		switch {
+		case i == 0:
+			if len(subtag) < 2 || len(subtag) > 8 || !isAlpha(subtag) {
+				return "", fmt.Errorf("%w: 语言 %q 不是合法的 BCP-47 语言标签", errs.ErrInvalidParameter, locale)
+			}
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4 && isAlpha(subtag):
+			// 文字，如 Hant
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 && isAlpha(subtag), len(subtag) == 3 && !isAlpha(subtag):
+			// 地区，如 HK、419
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-"), nil
+}
+
+func isValidSubtag(subtag string) bool {
+	if subtag == "" || len(subtag) > 8 {
+		return false
+	}
+	for _, c := range subtag {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// LocaleFallbacks 语言的降级规则，键和值都是规范的语言标签，如 zh-HK: zh-CN。
+// 没有配置规则的语言去掉最后一段再匹配，如 en-GB 降级到 en
+type LocaleFallbacks map[string]string
+
+// Chain 按顺序返回可以使用的语言，最后一个总是空字符串，表示默认内容。
+// 比如配置了 zh-HK: zh-CN 时，zh-HK 的结果是 zh-HK、zh-CN、zh、默认内容
+func (f LocaleFallbacks) Chain(locale string) []string {
+	res := make([]string, 0, 4)
+	seen := make(map[string]struct{}, 4)
+	for locale != "" {
+		if _, ok := seen[locale]; ok {
+			break
+		}
+		seen[locale] = struct{}{}
+		res = append(res, locale)
+		if next, ok := f[locale]; ok {
+			locale = next
+			continue
+		}
+		idx := strings.LastIndex(locale, "-")
+		if idx < 0 {
+			break
+		}
+		locale = locale[:idx]
+	}
+	return append(res, "")
+}
+
+// ChannelTemplateLocale 模版版本某种语言的本地化内容，和默认内容一起提交内部审核，
+// 之后作为单独的模版分别提交给每个供应商审核
+type ChannelTemplateLocale struct {
+	ID                int64  // 本地化内容ID
+	TemplateID        int64  // 模板ID
+	TemplateVersionID int64  // 模版版本ID
+	Locale            string // BCP-47 语言标签，如 zh-HK、en-US
+	Signature         string // 签名，为空时使用版本的签名
+	Content           string // 模版内容，占位符必须和版本的默认内容一致
+	Ctime             int64  // 创建时间
+	Utime             int64  // 更新时间
+
+	Providers []ChannelTemplateProvider // 本地化内容在各个供应商的审核信息
+}
+
+// GetLocale 获取指定语言的本地化内容，没有时返回 nil
+func (v *ChannelTemplateVersion) GetLocale(locale string) *ChannelTemplateLocale {
+	for i := range v.Locales {
+		if v.Locales[i].Locale == locale {
+			return &v.Locales[i]
+		}
+	}
+	return nil
+}
+
+// ReachableLocales 发送给使用这些语言的接收者时可能用到的内容，按降级链的顺序去重，默认内容的 Locale 为空。
+// 发送时使用降级链上第一个在供应商审核通过的语言，所以链上每一种存在的本地化内容都可能用到，最后总是默认内容
+func (v *ChannelTemplateVersion) ReachableLocales(fallbacks LocaleFallbacks, locales ...string) []ChannelTemplateLocale {
+	if len(locales) == 0 {
+		locales = []string{""}
+	}
+	res := make([]ChannelTemplateLocale, 0, len(v.Locales)+1)
+	seen := make(map[string]struct{}, len(v.Locales)+1)
+	for _, locale := range locales {
+		for _, candidate := range fallbacks.Chain(locale) {
+			if _, ok := seen[candidate]; ok {
+				continue
+			}
+			if candidate == "" {
+				seen[candidate] = struct{}{}
+				res = append(res, ChannelTemplateLocale{Signature: v.Signature, Content: v.Content})
+				continue
+			}
+			if l := v.GetLocale(candidate); l != nil {
+				seen[candidate] = struct{}{}
+				res = append(res, *l)
+			}
+		}
+	}
+	return res
+}
+
+// ValidateParams 按模版声明的占位符校验通知的参数，降级链上通知和接收者可能用到的每一种本地化内容都要校验。
+// 腾讯云 {1} 格式的模版由供应商替换参数，不校验
+func (v *ChannelTemplateVersion) ValidateParams(fallbacks LocaleFallbacks, n Notification) error {
+	groups := n.ReceiversByLocale()
+	locales := make([]string, 0, len(groups))
+	for i := range groups {
+		locales = append(locales, groups[i].Locale)
+	}
+	for _, l := range v.ReachableLocales(fallbacks, locales...) {
+		if !render.UsesPlaceholders(l.Content) {
+			continue
+		}
+		parsed, err := render.Parse(l.Content)
+		if err == nil {
+			err = parsed.Validate(n.Template.Params)
+		}
+		if err != nil {
+			return fmt.Errorf("语言: %q: %w", l.Locale, err)
+		}
+	}
+	return nil
+}
+
+// Localize 返回使用指定语言内容的版本，Signature、Content 和 Providers 都替换为该语言的，
+// locale 为空或者没有该语言时返回默认内容
+func (v ChannelTemplateVersion) Localize(locale string) ChannelTemplateVersion {
+	localized := v
+	localized.Locales = nil
+	l := v.GetLocale(locale)
+	if locale == "" || l == nil {
+		return localized
+	}
+	if l.Signature != "" {
+		localized.Signature = l.Signature
+	}
+	localized.Content = l.Content
+	localized.Providers = l.Providers
+	return localized
+}
+
+// LocaleReceivers 使用同一种语言的接收者
+type LocaleReceivers struct {
+	Locale    string
+	Receivers []string
+}
+
+// ReceiverLocale 接收者使用的语言，没有单独指定时使用通知的语言
+func (n *Notification) ReceiverLocale(receiver string) string {
+	if locale, ok := n.ReceiverLocales[receiver]; ok && locale != "" {
+		return locale
+	}
+	return n.Locale
+}
+
+// ReceiversByLocale 按语言给接收者分组，组和组内的接收者都保持接收者原来的顺序
+func (n *Notification) ReceiversByLocale() []LocaleReceivers {
+	if len(n.ReceiverLocales) == 0 {
+		return []LocaleReceivers{{Locale: n.Locale, Receivers: n.Receivers}}
+	}
+	var res []LocaleReceivers
+	index := make(map[string]int)
+	for _, receiver := range n.Receivers {
+		locale := n.ReceiverLocale(receiver)
+		i, ok := index[locale]
+		if !ok {
+			i = len(res)
+			index[locale] = i
+			res = append(res, LocaleReceivers{Locale: locale})
+		}
+		res[i].Receivers = append(res[i].Receivers, receiver)
+	}
+	return res
+}
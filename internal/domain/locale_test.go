@@ -0,0 +1,172 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		locale  string
+		want    string
+		wantErr error
+	}{
+		{name: "没有指定语言", locale: "", want: ""},
+		{name: "只有语言", locale: "EN", want: "en"},
+		{name: "语言和地区", locale: "zh_hk", want: "zh-HK"},
+		{name: "语言、文字和地区", locale: "zh-hant-tw", want: "zh-Hant-TW"},
+		{name: "数字地区", locale: "es-419", want: "es-419"},
+		{name: "语言太短", locale: "z-CN", wantErr: errs.ErrInvalidParameter},
+		{name: "非法字符", locale: "zh-C N", wantErr: errs.ErrInvalidParameter},
+		{name: "空的子标签", locale: "zh--CN", wantErr: errs.ErrInvalidParameter},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NormalizeLocale(tc.locale)
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLocaleFallbacks_Chain(t *testing.T) {
+	t.Parallel()
+
+	fallbacks := LocaleFallbacks{"zh-HK": "zh-CN", "a-B": "a-C", "a-C": "a-B"}
+	testCases := []struct {
+		name   string
+		locale string
+		want   []string
+	}{
+		{name: "没有指定语言", locale: "", want: []string{""}},
+		{name: "按配置的规则降级", locale: "zh-HK", want: []string{"zh-HK", "zh-CN", "zh", ""}},
+		{name: "没有配置规则时去掉最后一段", locale: "en-GB", want: []string{"en-GB", "en", ""}},
+		{name: "规则有环", locale: "a-B", want: []string{"a-B", "a-C", ""}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, fallbacks.Chain(tc.locale))
+		})
+	}
+}
+
+func TestChannelTemplateVersion_Localize(t *testing.T) {
+	t.Parallel()
+
+	version := ChannelTemplateVersion{
+		ID:        1,
+		Signature: "默认签名",
+		Content:   "验证码：${code}",
+		Providers: []ChannelTemplateProvider{{ProviderName: "aliyun", ProviderTemplateID: "SMS_ZH"}},
+		Locales: []ChannelTemplateLocale{
+			{
+				Locale:    "en-US",
+				Content:   "Your code is ${code}",
+				Providers: []ChannelTemplateProvider{{ProviderName: "aliyun", Locale: "en-US", ProviderTemplateID: "SMS_EN"}},
+			},
+		},
+	}
+
+	localized := version.Localize("en-US")
+	assert.Equal(t, "默认签名", localized.Signature)
+	assert.Equal(t, "Your code is ${code}", localized.Content)
+	assert.Equal(t, "SMS_EN", localized.Providers[0].ProviderTemplateID)
+	assert.Nil(t, localized.Locales)
+
+	// 没有该语言时使用默认内容
+	localized = version.Localize("ja-JP")
+	assert.Equal(t, version.Content, localized.Content)
+	assert.Equal(t, "SMS_ZH", localized.Providers[0].ProviderTemplateID)
+}
+
+func TestChannelTemplateVersion_ReachableLocales(t *testing.T) {
+	t.Parallel()
+
+	version := ChannelTemplateVersion{
+		Content: "验证码：${code}",
+		Locales: []ChannelTemplateLocale{
+			{Locale: "zh-HK", Content: "驗證碼：${code}"},
+			{Locale: "zh-CN", Content: "验证码：${code}，${minutes}分钟内有效"},
+			{Locale: "en-US", Content: "Your code is ${code}"},
+		},
+	}
+	fallbacks := LocaleFallbacks{"zh-HK": "zh-CN"}
+	contents := func(locales []ChannelTemplateLocale) []string {
+		res := make([]string, 0, len(locales))
+		for i := range locales {
+			res = append(res, locales[i].Locale+"|"+locales[i].Content)
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name    string
+		locales []string
+		want    []string
+	}{
+		{name: "没有指定语言只有默认内容", want: []string{"|验证码：${code}"}},
+		{
+			name:    "降级链上的内容都可能用到",
+			locales: []string{"zh-HK"},
+			want:    []string{"zh-HK|驗證碼：${code}", "zh-CN|验证码：${code}，${minutes}分钟内有效", "|验证码：${code}"},
+		},
+		{
+			name:    "接收者的语言去重",
+			locales: []string{"en-US", "en-GB", "en-US"},
+			want:    []string{"en-US|Your code is ${code}", "|验证码：${code}"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, contents(version.ReachableLocales(fallbacks, tc.locales...)))
+		})
+	}
+}
+
+func TestNotification_ReceiversByLocale(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		notification Notification
+		want         []LocaleReceivers
+	}{
+		{
+			name:         "所有接收者使用通知的语言",
+			notification: Notification{Receivers: []string{"a", "b"}, Locale: "zh-CN"},
+			want:         []LocaleReceivers{{Locale: "zh-CN", Receivers: []string{"a", "b"}}},
+		},
+		{
+			name: "单独指定了语言的接收者分到不同的组",
+			notification: Notification{
+				Receivers:       []string{"a", "b", "c", "d"},
+				Locale:          "zh-CN",
+				ReceiverLocales: map[string]string{"b": "en-US", "d": "en-US"},
+			},
+			want: []LocaleReceivers{
+				{Locale: "zh-CN", Receivers: []string{"a", "c"}},
+				{Locale: "en-US", Receivers: []string{"b", "d"}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.notification.ReceiversByLocale())
+		})
+	}
+}
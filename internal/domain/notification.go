@@ -9,6 +9,7 @@ import (
 	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
 
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/retry"
 )
 
 // SendStatus 通知状态
@@ -21,6 +22,12 @@ const (
 	SendStatusSending   SendStatus = "SENDING"   // 待发送
 	SendStatusSucceeded SendStatus = "SUCCEEDED" // 发送成功
 	SendStatusFailed    SendStatus = "FAILED"    // 发送失败
+	SendStatusRetrying  SendStatus = "RETRYING"  // 发送失败，等待按渠道重试策略重试
+
+	SendStatusPartialSuccess SendStatus = "PARTIAL_SUCCESS" // 部分接收者发送成功
+	SendStatusDelivered      SendStatus = "DELIVERED"       // 供应商回执确认已送达
+	SendStatusUndelivered    SendStatus = "UNDELIVERED"     // 供应商回执确认未送达
+	SendStatusSuppressed     SendStatus = "SUPPRESSED"      // 接收者在退订名单中，没有发送
 )
 
 func (s SendStatus) String() string {
@@ -49,6 +56,61 @@ type Notification struct {
 	ScheduledETime     time.Time          `json:"scheduledETime"` // 计划发送结束时间
 	Version            int                `json:"version"`        // 版本号
 	SendStrategyConfig SendStrategyConfig `json:"sendStrategyConfig"`
+	ReceiverResults    []ReceiverResult   `json:"receiverResults"` // 每个接收者的发送结果
+	SentChannel        Channel            `json:"sentChannel"`     // 实际发送成功的渠道，跨渠道降级时与 Channel 不同
+	RetryCount         int32              `json:"retryCount"`      // 已经重试的次数
+	NextRetryTime      int64              `json:"nextRetryTime"`   // 下一次重试的时间，毫秒
+	Attempts           []SendAttempt      `json:"attempts"`        // 每一次失败的发送尝试
+	Error              *SendError         `json:"error,omitempty"` // 最近一次发送失败的原因，发送成功时为 nil
+	// Locale 发送使用的语言，按模版的本地化内容逐级降级，为空时使用默认内容
+	Locale string `json:"locale,omitempty"`
+	// ReceiverLocales 单独指定某些接收者使用的语言，没有指定的接收者使用 Locale
+	ReceiverLocales map[string]string `json:"receiverLocales,omitempty"`
+	// FailoverReceivers 跨渠道降级时目标渠道使用的接收者，键为渠道。
+	// 不同渠道的接收者类型不同，没有指定接收者的渠道不会降级
+	FailoverReceivers map[Channel][]string `json:"failoverReceivers,omitempty"`
+}
+
+// SendAttempt 一次失败的发送尝试
+type SendAttempt struct {
+	Attempt int32      `json:"attempt"` // 第几次发送，从 1 开始
+	Channel Channel    `json:"channel"`
+	Status  SendStatus `json:"status"` // RETRYING 表示之后还会重试，FAILED 表示重试次数已经用完
+	Message string     `json:"message"`
+	Ctime   int64      `json:"ctime"`
+}
+
+// SetNextRetryTimeAndStatus 发送失败后按渠道配置中的重试策略计算下一次重试时间，
+// 可以重试时状态变为 RETRYING，没有配置重试策略或者重试次数已经用完时状态变为 FAILED
+func (n *Notification) SetNextRetryTimeAndStatus(cfg *ChannelConfig, message string) {
+	attempt := n.RetryCount + 1
+	interval, ok := n.nextRetryInterval(cfg)
+	if ok {
+		n.Status = SendStatusRetrying
+		n.RetryCount++
+		n.NextRetryTime = time.Now().Add(interval).UnixMilli()
+	} else {
+		n.Status = SendStatusFailed
+		n.NextRetryTime = 0
+	}
+	n.Attempts = append(n.Attempts, SendAttempt{
+		Attempt: attempt,
+		Channel: n.Channel,
+		Status:  n.Status,
+		Message: message,
+		Ctime:   time.Now().UnixMilli(),
+	})
+}
+
+func (n *Notification) nextRetryInterval(cfg *ChannelConfig) (time.Duration, bool) {
+	if cfg == nil || cfg.RetryPolicy == nil {
+		return 0, false
+	}
+	s, err := retry.NewRetry(*cfg.RetryPolicy)
+	if err != nil {
+		return 0, false
+	}
+	return s.NextWithRetries(n.RetryCount + 1)
 }
 
 func (n *Notification) SetSendTime() {
@@ -57,10 +119,72 @@ func (n *Notification) SetSendTime() {
 	n.ScheduledETime = etime
 }
 
+// DeferSendTime 把计划发送时间推迟到 t，保持原来发送时间窗口的长度
+func (n *Notification) DeferSendTime(t time.Time) {
+	window := n.ScheduledETime.Sub(n.ScheduledSTime)
+	n.ScheduledSTime = t
+	n.ScheduledETime = t.Add(window)
+}
+
+// NotificationUpdate 修改还没有开始发送的通知，字段为 nil 时不修改
+type NotificationUpdate struct {
+	BizID              int64
+	Key                string
+	SendStrategyConfig *SendStrategyConfig
+	TemplateParams     map[string]string
+	Receivers          []string
+}
+
+// IsEditable 只有等待调度的通知才能修改
+func (n *Notification) IsEditable() bool {
+	return n.Status == SendStatusPending
+}
+
+// ApplyUpdate 修改通知的发送策略、模版参数和接收者，修改发送策略时重新计算计划发送时间
+func (n *Notification) ApplyUpdate(update NotificationUpdate) error {
+	if update.Receivers != nil {
+		if len(update.Receivers) == 0 {
+			return fmt.Errorf("%w: Receivers= %v", errs.ErrInvalidParameter, update.Receivers)
+		}
+		n.Receivers = update.Receivers
+	}
+	if update.TemplateParams != nil {
+		n.Template.Params = update.TemplateParams
+	}
+	if update.SendStrategyConfig != nil {
+		// 周期通知由调度器生成，已经创建的通知不能再改成周期发送
+		if update.SendStrategyConfig.Type == SendStrategyRecurring {
+			return fmt.Errorf("%w: 不能修改为周期发送策略", errs.ErrInvalidParameter)
+		}
+		if err := update.SendStrategyConfig.Validate(); err != nil {
+			return err
+		}
+		n.SendStrategyConfig = *update.SendStrategyConfig
+		n.SetSendTime()
+	}
+	return nil
+}
+
+// IsCancelable 还没有开始发送的通知才能取消，包括等待调度的和等待重试的
+func (n *Notification) IsCancelable() bool {
+	return n.Status == SendStatusPending || n.Status == SendStatusRetrying
+}
+
 func (n *Notification) IsImmediate() bool {
 	return n.SendStrategyConfig.Type == SendStrategyImmediate
 }
 
+// deadlineTolerance 调度和发送本身需要时间，允许一些误差
+const deadlineTolerance = 3 * time.Second
+
+// IsDeadlinePassed 第一次发送时已经超过计划发送结束时间，不应该再发送。
+// 重试的时间由重试策略控制，不受计划发送结束时间的限制
+func (n *Notification) IsDeadlinePassed(now time.Time) bool {
+	// 没有设置计划发送结束时间时，数据库中读出来的是 0
+	return n.RetryCount == 0 && n.ScheduledETime.UnixMilli() > 0 &&
+		now.After(n.ScheduledETime.Add(deadlineTolerance))
+}
+
 // ReplaceAsyncImmediate 如果是是立刻发送，就修改为默认的策略
 func (n *Notification) ReplaceAsyncImmediate() {
 	if n.IsImmediate() {
@@ -94,10 +218,6 @@ func (n *Notification) Validate() error {
 		return fmt.Errorf("%w: Template.VersionID = %d", errs.ErrInvalidParameter, n.Template.VersionID)
 	}
 
-	if len(n.Template.Params) == 0 {
-		return fmt.Errorf("%w: Template.Params = %q", errs.ErrInvalidParameter, n.Template.Params)
-	}
-
 	if err := n.SendStrategyConfig.Validate(); err != nil {
 		return err
 	}
@@ -116,6 +236,22 @@ func (n *Notification) MarshalReceivers() (string, error) {
 	return n.marshal(n.Receivers)
 }
 
+// MarshalReceiverLocales 没有单独指定接收者的语言时返回空字符串
+func (n *Notification) MarshalReceiverLocales() (string, error) {
+	if len(n.ReceiverLocales) == 0 {
+		return "", nil
+	}
+	return n.marshal(n.ReceiverLocales)
+}
+
+// MarshalFailoverReceivers 没有指定降级渠道的接收者时返回空字符串
+func (n *Notification) MarshalFailoverReceivers() (string, error) {
+	if len(n.FailoverReceivers) == 0 {
+		return "", nil
+	}
+	return n.marshal(n.FailoverReceivers)
+}
+
 func (n *Notification) MarshalTemplateParams() (string, error) {
 	return n.marshal(n.Template.Params)
 }
@@ -143,6 +279,16 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 		return Notification{}, err
 	}
 
+	locale, receiverLocales, err := getDomainLocales(n)
+	if err != nil {
+		return Notification{}, err
+	}
+
+	failoverReceivers, err := getDomainFailoverReceivers(n)
+	if err != nil {
+		return Notification{}, err
+	}
+
 	return Notification{
 		Key:       n.Key,
 		Receivers: n.FindReceivers(),
@@ -152,11 +298,55 @@ func NewNotificationFromAPI(n *notificationv1.Notification) (Notification, error
 			Params: n.TemplateParams,
 		},
 		SendStrategyConfig: getDomainSendStrategyConfig(n),
+		Locale:             locale,
+		ReceiverLocales:    receiverLocales,
+		FailoverReceivers:  failoverReceivers,
 	}, nil
 }
 
+func getDomainFailoverReceivers(n *notificationv1.Notification) (map[Channel][]string, error) {
+	if len(n.FailoverReceivers) == 0 {
+		return nil, nil
+	}
+	res := make(map[Channel][]string, len(n.FailoverReceivers))
+	for _, item := range n.FailoverReceivers {
+		channel, err := channelFromAPI(item.GetChannel())
+		if err != nil {
+			return nil, err
+		}
+		if len(item.GetReceivers()) == 0 {
+			return nil, fmt.Errorf("%w: 降级渠道 %s 的接收者不能为空", errs.ErrInvalidParameter, channel)
+		}
+		res[channel] = item.GetReceivers()
+	}
+	return res, nil
+}
+
+func getDomainLocales(n *notificationv1.Notification) (string, map[string]string, error) {
+	locale, err := NormalizeLocale(n.Locale)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(n.ReceiverLocales) == 0 {
+		return locale, nil, nil
+	}
+	receiverLocales := make(map[string]string, len(n.ReceiverLocales))
+	for receiver, l := range n.ReceiverLocales {
+		normalized, err := NormalizeLocale(l)
+		if err != nil {
+			return "", nil, fmt.Errorf("接收者 %s: %w", receiver, err)
+		}
+		receiverLocales[receiver] = normalized
+	}
+	return locale, receiverLocales, nil
+}
+
 func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
-	switch n.Channel {
+	return channelFromAPI(n.Channel)
+}
+
+func channelFromAPI(channel notificationv1.Channel) (Channel, error) {
+	switch channel {
 	case notificationv1.Channel_SMS:
 		return ChannelSMS, nil
 	case notificationv1.Channel_EMAIL:
@@ -169,6 +359,11 @@ func getDomainChannel(n *notificationv1.Notification) (Channel, error) {
 }
 
 func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyConfig {
+	return NewSendStrategyConfigFromAPI(n.Strategy)
+}
+
+// NewSendStrategyConfigFromAPI 将 API 中的发送策略转换为领域对象，没有指定时为立即发送
+func NewSendStrategyConfigFromAPI(strategy *notificationv1.SendStrategy) SendStrategyConfig {
 	// 构建发送策略
 	sendStrategyType := SendStrategyImmediate // 默认为立即发送
 	var delaySeconds int64
@@ -176,10 +371,13 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 	var startTimeMilliseconds int64
 	var endTimeMilliseconds int64
 	var deadlineTime time.Time
+	var cronExpr, timezone string
+	var recurringEndTime time.Time
+	var maxOccurrences int32
 
 	// 处理发送策略
-	if n.Strategy != nil {
-		switch s := n.Strategy.StrategyType.(type) {
+	if strategy != nil {
+		switch s := strategy.StrategyType.(type) {
 		case *notificationv1.SendStrategy_Immediate:
 			sendStrategyType = SendStrategyImmediate
 		case *notificationv1.SendStrategy_Delayed:
@@ -203,6 +401,16 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 				sendStrategyType = SendStrategyDeadline
 				deadlineTime = s.Deadline.Deadline.AsTime()
 			}
+		case *notificationv1.SendStrategy_Recurring:
+			if s.Recurring != nil {
+				sendStrategyType = SendStrategyRecurring
+				cronExpr = s.Recurring.Cron
+				timezone = s.Recurring.Timezone
+				if s.Recurring.EndTime != nil {
+					recurringEndTime = s.Recurring.EndTime.AsTime()
+				}
+				maxOccurrences = s.Recurring.MaxOccurrences
+			}
 		}
 	}
 	return SendStrategyConfig{
@@ -212,5 +420,10 @@ func getDomainSendStrategyConfig(n *notificationv1.Notification) SendStrategyCon
 		StartTime:     time.Unix(startTimeMilliseconds, 0),
 		EndTime:       time.Unix(endTimeMilliseconds, 0),
 		DeadlineTime:  deadlineTime,
+
+		Cron:             cronExpr,
+		Timezone:         timezone,
+		RecurringEndTime: recurringEndTime,
+		MaxOccurrences:   maxOccurrences,
 	}
 }
@@ -0,0 +1,13 @@
+package domain
+
+// Preview 通知预览结果，只渲染模版、选出供应商，不会落库也不会真正发送
+type Preview struct {
+	TemplateID   int64    // 模板ID
+	VersionID    int64    // 渲染使用的模版版本ID
+	Channel      Channel  // 渠道类型
+	Signature    string   // 签名，短信签名或邮件发件人名称
+	Title        string   // 标题，邮件主题、站内信标题，短信没有标题
+	Content      string   // 渲染后的内容
+	Placeholders []string // 模版声明的占位符
+	ProviderName string   // 按当前的供应商选择器会被选中的供应商，无可用供应商时为空
+}
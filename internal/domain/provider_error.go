@@ -0,0 +1,34 @@
+package domain
+
+// ProviderErrorKind 供应商错误码的分类，决定发送失败之后怎么处理
+type ProviderErrorKind string
+
+const (
+	// ProviderErrorKindRetryable 临时性错误，稍后重试可能成功。没有登记的错误码都按这一类处理
+	ProviderErrorKindRetryable ProviderErrorKind = "RETRYABLE"
+	// ProviderErrorKindThrottled 供应商限流，也是可以重试的错误
+	ProviderErrorKindThrottled ProviderErrorKind = "THROTTLED"
+	// ProviderErrorKindReceiver 接收者本身的问题，例如空号、号码格式错误，换供应商或者重试都不会成功
+	ProviderErrorKindReceiver ProviderErrorKind = "RECEIVER"
+	// ProviderErrorKindProvider 供应商的问题，例如余额不足、签名或模版不可用，应该换一个供应商发送
+	ProviderErrorKindProvider ProviderErrorKind = "PROVIDER"
+)
+
+func (k ProviderErrorKind) String() string {
+	return string(k)
+}
+
+// IsReceiverFault 是否是接收者的问题
+func (k ProviderErrorKind) IsReceiverFault() bool {
+	return k == ProviderErrorKindReceiver
+}
+
+// IsProviderFault 是否是供应商的问题
+func (k ProviderErrorKind) IsProviderFault() bool {
+	return k == ProviderErrorKindProvider
+}
+
+// IsThrottled 是否是供应商限流
+func (k ProviderErrorKind) IsThrottled() bool {
+	return k == ProviderErrorKindThrottled
+}
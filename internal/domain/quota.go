@@ -2,6 +2,34 @@ package domain
 
 type Quota struct {
 	BizID   int64
-	Quota   int32
+	Quota   int32 // 剩余额度
 	Channel Channel
+	// DailyLimit 每日发送上限，0 表示不限制
+	DailyLimit int32
+	// DailyUsed 当日已发送数量，只在查询时返回
+	DailyUsed int32
+}
+
+type QuotaLedgerType string
+
+const (
+	QuotaLedgerTypeReset  QuotaLedgerType = "RESET"  // 每月重置
+	QuotaLedgerTypeAdjust QuotaLedgerType = "ADJUST" // 管理员充值或扣减
+)
+
+func (t QuotaLedgerType) String() string {
+	return string(t)
+}
+
+// QuotaLedger 额度流水，只追加不修改，记录业务方额度的每一次变化
+type QuotaLedger struct {
+	ID       int64
+	BizID    int64
+	Channel  Channel
+	Type     QuotaLedgerType
+	Delta    int32  // 变化量，正数为增加，负数为减少
+	Balance  int32  // 变化后的额度
+	Reason   string // 变化原因
+	Operator string // 操作人
+	Ctime    int64
 }
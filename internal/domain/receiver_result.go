@@ -0,0 +1,83 @@
+package domain
+
+// ReceiverResult 单个接收者的发送结果
+type ReceiverResult struct {
+	Receiver string `json:"receiver"` // 接收者(手机/邮箱/用户ID)
+	// Status 该接收者的发送状态，发送时为 SUCCEEDED 或 FAILED，
+	// 收到供应商回执后 SUCCEEDED 会变为 DELIVERED 或 UNDELIVERED
+	Status    SendStatus `json:"status"`
+	Code      string     `json:"code"`      // 供应商返回的状态码
+	Message   string     `json:"message"`   // 供应商返回的描述信息
+	Provider  string     `json:"provider"`  // 实际发送的供应商
+	MessageID string     `json:"messageId"` // 供应商返回的消息ID，用于匹配回执
+	// ErrorKind 发送失败时供应商错误码的分类
+	ErrorKind ProviderErrorKind `json:"errorKind,omitempty"`
+}
+
+// NewReceiverResults 为所有接收者生成相同的发送结果，
+// 用于不区分接收者返回结果的供应商，以及整体发送失败的场景
+func NewReceiverResults(receivers []string, status SendStatus, provider, code, message string) []ReceiverResult {
+	results := make([]ReceiverResult, 0, len(receivers))
+	for i := range receivers {
+		results = append(results, ReceiverResult{
+			Receiver: receivers[i],
+			Status:   status,
+			Code:     code,
+			Message:  message,
+			Provider: provider,
+		})
+	}
+	return results
+}
+
+// AggregateSendStatus 根据每个接收者的发送结果汇总通知的发送状态，
+// 全部成功为 SUCCEEDED，全部失败为 FAILED，否则为 PARTIAL_SUCCESS，退订的接收者不参与汇总
+func AggregateSendStatus(results []ReceiverResult) SendStatus {
+	var succeeded, failed int
+	for i := range results {
+		switch results[i].Status {
+		case SendStatusSuppressed:
+			// 没有发送，也不会有回执
+		case SendStatusSucceeded:
+			succeeded++
+		default:
+			failed++
+		}
+	}
+	switch {
+	case failed == 0:
+		return SendStatusSucceeded
+	case succeeded == 0:
+		return SendStatusFailed
+	default:
+		return SendStatusPartialSuccess
+	}
+}
+
+// AggregateDeliveryStatus 根据每个接收者的回执汇总通知的最终状态，
+// 还有接收者在等待回执时 final 为 false。
+// 全部送达为 DELIVERED，全部未送达或发送失败为 UNDELIVERED，否则为 PARTIAL_SUCCESS，退订的接收者不参与汇总
+func AggregateDeliveryStatus(results []ReceiverResult) (status SendStatus, final bool) {
+	var delivered, undelivered int
+	for i := range results {
+		switch results[i].Status {
+		case SendStatusSuppressed:
+			// 没有发送，也不会有回执
+		case SendStatusSucceeded:
+			// 供应商已受理，等待回执
+			return "", false
+		case SendStatusDelivered:
+			delivered++
+		default:
+			undelivered++
+		}
+	}
+	switch {
+	case undelivered == 0:
+		return SendStatusDelivered, true
+	case delivered == 0:
+		return SendStatusUndelivered, true
+	default:
+		return SendStatusPartialSuccess, true
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// RecurringStatus 周期通知状态
+type RecurringStatus string
+
+const (
+	RecurringStatusActive   RecurringStatus = "ACTIVE"   // 还需要继续发送
+	RecurringStatusFinished RecurringStatus = "FINISHED" // 到达结束时间或者最大发送次数
+)
+
+func (s RecurringStatus) String() string {
+	return string(s)
+}
+
+// RecurringNotification 周期通知，调度器按 cron 表达式把每一次发送生成为独立的通知
+type RecurringNotification struct {
+	ID uint64
+	// Notification 每一次发送使用的通知，发送策略为 RECURRING
+	Notification Notification
+	Occurrences  int32     // 已经生成的通知数量
+	NextTime     time.Time // 下一次发送的时间
+	Status       RecurringStatus
+	Version      int
+	Ctime        time.Time
+	Utime        time.Time
+}
+
+// NewRecurringNotification 根据周期发送策略创建周期通知，并计算第一次发送的时间
+func NewRecurringNotification(n Notification) (RecurringNotification, error) {
+	next, ok, err := n.SendStrategyConfig.NextOccurrence(time.Now())
+	if err != nil {
+		return RecurringNotification{}, err
+	}
+	r := RecurringNotification{
+		ID:           n.ID,
+		Notification: n,
+		NextTime:     next,
+		Status:       RecurringStatusActive,
+	}
+	if !ok {
+		r.Status = RecurringStatusFinished
+	}
+	return r, nil
+}
+
+// Occurrence 生成本次发送的通知，key 由周期通知的 key 和发送时间组成，
+// 同一个发送时间重复生成时会因为唯一索引冲突而失败，不会重复发送
+func (r *RecurringNotification) Occurrence() Notification {
+	n := r.Notification
+	n.ID = 0
+	n.Key = fmt.Sprintf("%s:%s", r.Notification.Key, r.NextTime.Format("20060102150405"))
+	n.SendStrategyConfig = SendStrategyConfig{
+		Type:      SendStrategyTimeWindow,
+		StartTime: r.NextTime,
+		EndTime:   r.NextTime.Add(recurringSendWindow),
+	}
+	return n
+}
+
+// Advance 生成本次发送的通知之后，计算下一次发送的时间，
+// 达到最大发送次数或者超过结束时间时周期通知结束
+func (r *RecurringNotification) Advance() error {
+	r.Occurrences++
+	cfg := r.Notification.SendStrategyConfig
+	if cfg.MaxOccurrences > 0 && r.Occurrences >= cfg.MaxOccurrences {
+		r.Status = RecurringStatusFinished
+		return nil
+	}
+	next, ok, err := cfg.NextOccurrence(r.NextTime)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		r.Status = RecurringStatusFinished
+		return nil
+	}
+	r.NextTime = next
+	return nil
+}
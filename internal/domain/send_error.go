@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+
+	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// SendErrorCode 发送失败原因的分类，用于区分是供应商的问题还是请求本身的问题
+type SendErrorCode string
+
+const (
+	// SendErrorCodeUnknown 没有办法归类的错误
+	SendErrorCodeUnknown SendErrorCode = "UNKNOWN"
+	// SendErrorCodeInvalidParameter 请求参数错误
+	SendErrorCodeInvalidParameter SendErrorCode = "INVALID_PARAMETER"
+	// SendErrorCodeQuotaExhausted 额度已经用完
+	SendErrorCodeQuotaExhausted SendErrorCode = "QUOTA_EXHAUSTED"
+	// SendErrorCodeTemplateNotApproved 模版没有通过内部审核或者供应商审核
+	SendErrorCodeTemplateNotApproved SendErrorCode = "TEMPLATE_NOT_APPROVED"
+	// SendErrorCodeProviderThrottled 供应商限流，稍后重试可以成功
+	SendErrorCodeProviderThrottled SendErrorCode = "PROVIDER_THROTTLED"
+	// SendErrorCodeProviderFault 供应商故障，例如余额不足、签名不可用、接口异常
+	SendErrorCodeProviderFault SendErrorCode = "PROVIDER_FAULT"
+	// SendErrorCodeNoAvailableProvider 没有可用的供应商，通常是所有供应商都不健康
+	SendErrorCodeNoAvailableProvider SendErrorCode = "NO_AVAILABLE_PROVIDER"
+	// SendErrorCodeReceiverInvalid 接收者无效，例如空号
+	SendErrorCodeReceiverInvalid SendErrorCode = "RECEIVER_INVALID"
+	// SendErrorCodeFrequencyCapped 接收者超过了发送频率上限
+	SendErrorCodeFrequencyCapped SendErrorCode = "FREQUENCY_CAPPED"
+	// SendErrorCodeSuppressed 接收者都已经退订
+	SendErrorCodeSuppressed SendErrorCode = "SUPPRESSED"
+	// SendErrorCodeDeadlinePassed 已经超过截止时间
+	SendErrorCodeDeadlinePassed SendErrorCode = "DEADLINE_PASSED"
+	// SendErrorCodeSendingTimeout 发送中的通知长时间没有结果，被超时任务标记为失败
+	SendErrorCodeSendingTimeout SendErrorCode = "SENDING_TIMEOUT"
+)
+
+func (c SendErrorCode) String() string {
+	return string(c)
+}
+
+// ToAPI 转换为接口和回调中的错误码，同步返回和回调给业务方的错误码必须一致
+func (c SendErrorCode) ToAPI() notificationv1.ErrorCode {
+	switch c {
+	case SendErrorCodeInvalidParameter:
+		return notificationv1.ErrorCode_INVALID_PARAMETER
+	case SendErrorCodeQuotaExhausted:
+		return notificationv1.ErrorCode_NO_QUOTA
+	case SendErrorCodeTemplateNotApproved:
+		return notificationv1.ErrorCode_TEMPLATE_NOT_APPROVED
+	case SendErrorCodeProviderThrottled:
+		return notificationv1.ErrorCode_PROVIDER_THROTTLED
+	case SendErrorCodeProviderFault:
+		return notificationv1.ErrorCode_PROVIDER_FAULT
+	case SendErrorCodeNoAvailableProvider:
+		return notificationv1.ErrorCode_NO_AVAILABLE_PROVIDER
+	case SendErrorCodeReceiverInvalid:
+		return notificationv1.ErrorCode_RECEIVER_INVALID
+	case SendErrorCodeFrequencyCapped:
+		return notificationv1.ErrorCode_FREQUENCY_CAPPED
+	case SendErrorCodeSuppressed:
+		return notificationv1.ErrorCode_RECEIVER_SUPPRESSED
+	case SendErrorCodeDeadlinePassed:
+		return notificationv1.ErrorCode_DEADLINE_PASSED
+	default:
+		return notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED
+	}
+}
+
+// SendError 结构化的发送错误，随通知一起保存，并且通过接口和回调返回给业务方
+type SendError struct {
+	Code    SendErrorCode `json:"code"`
+	Message string        `json:"message"`
+	// err 原始错误，从数据库中读出来的错误没有原始错误
+	err error
+}
+
+// NewSendError 按错误链中的哨兵错误确定错误码，err 为 nil 时返回 nil
+func NewSendError(err error) *SendError {
+	if err == nil {
+		return nil
+	}
+	var se *SendError
+	if errors.As(err, &se) {
+		return se
+	}
+	return &SendError{Code: sendErrorCode(err), Message: err.Error(), err: err}
+}
+
+func (e *SendError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *SendError) Unwrap() error {
+	return e.err
+}
+
+// sendErrorCode 越具体的错误越靠前，例如 ErrInvalidReceiver 同时也是 ErrSendNotificationFailed
+func sendErrorCode(err error) SendErrorCode {
+	switch {
+	case errors.Is(err, errs.ErrInvalidReceiver):
+		return SendErrorCodeReceiverInvalid
+	case errors.Is(err, errs.ErrProviderThrottled):
+		return SendErrorCodeProviderThrottled
+	case errors.Is(err, errs.ErrProviderFault):
+		return SendErrorCodeProviderFault
+	case errors.Is(err, errs.ErrNoAvailableProvider):
+		return SendErrorCodeNoAvailableProvider
+	case errors.Is(err, errs.ErrReceiverFrequencyCapped):
+		return SendErrorCodeFrequencyCapped
+	case errors.Is(err, errs.ErrDeadlinePassed):
+		return SendErrorCodeDeadlinePassed
+	case errors.Is(err, errs.ErrNoQuota):
+		return SendErrorCodeQuotaExhausted
+	case errors.Is(err, errs.ErrTemplateVersionNotApprovedByPlatform),
+		errors.Is(err, errs.ErrTemplateVersionNotApprovedByProvider):
+		return SendErrorCodeTemplateNotApproved
+	case errors.Is(err, errs.ErrInvalidParameter):
+		return SendErrorCodeInvalidParameter
+	default:
+		return SendErrorCodeUnknown
+	}
+}
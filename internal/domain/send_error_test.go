@@ -0,0 +1,40 @@
+//go:build unit
+
+package domain
+
+import (
+	"testing"
+
+	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendErrorCode_ToAPI(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		code SendErrorCode
+		want notificationv1.ErrorCode
+	}{
+		{code: SendErrorCodeInvalidParameter, want: notificationv1.ErrorCode_INVALID_PARAMETER},
+		{code: SendErrorCodeQuotaExhausted, want: notificationv1.ErrorCode_NO_QUOTA},
+		{code: SendErrorCodeTemplateNotApproved, want: notificationv1.ErrorCode_TEMPLATE_NOT_APPROVED},
+		{code: SendErrorCodeProviderThrottled, want: notificationv1.ErrorCode_PROVIDER_THROTTLED},
+		{code: SendErrorCodeProviderFault, want: notificationv1.ErrorCode_PROVIDER_FAULT},
+		{code: SendErrorCodeNoAvailableProvider, want: notificationv1.ErrorCode_NO_AVAILABLE_PROVIDER},
+		{code: SendErrorCodeReceiverInvalid, want: notificationv1.ErrorCode_RECEIVER_INVALID},
+		{code: SendErrorCodeFrequencyCapped, want: notificationv1.ErrorCode_FREQUENCY_CAPPED},
+		{code: SendErrorCodeSuppressed, want: notificationv1.ErrorCode_RECEIVER_SUPPRESSED},
+		{code: SendErrorCodeDeadlinePassed, want: notificationv1.ErrorCode_DEADLINE_PASSED},
+		{code: SendErrorCodeSendingTimeout, want: notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED},
+		// 没有办法归类的错误统一返回发送失败
+		{code: SendErrorCodeUnknown, want: notificationv1.ErrorCode_SEND_NOTIFICATION_FAILED},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code.String(), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.code.ToAPI())
+		})
+	}
+}
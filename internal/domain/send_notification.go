@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/robfig/cron/v3"
 )
 
 // SendStrategyType 发送策略类型
@@ -16,8 +17,12 @@ const (
 	SendStrategyScheduled  SendStrategyType = "SCHEDULED"   // 定时发送
 	SendStrategyTimeWindow SendStrategyType = "TIME_WINDOW" // 时间窗口发送
 	SendStrategyDeadline   SendStrategyType = "DEADLINE"    // 截止日期发送
+	SendStrategyRecurring  SendStrategyType = "RECURRING"   // 按 cron 表达式周期发送
 )
 
+// recurringSendWindow 周期发送的每一次发送允许的发送时间窗口
+const recurringSendWindow = 10 * time.Minute
+
 // SendStrategyConfig 发送策略配置
 type SendStrategyConfig struct {
 	Type          SendStrategyType `json:"type"`          // 发送策略类型
@@ -26,6 +31,39 @@ type SendStrategyConfig struct {
 	StartTime     time.Time        `json:"startTime"`     // 窗口发送策略使用，开始时间（毫秒）
 	EndTime       time.Time        `json:"endTime"`       // 窗口发送策略使用，结束时间（毫秒）
 	DeadlineTime  time.Time        `json:"deadlineTime"`  // 截止日期策略使用，截止日期
+
+	Cron             string    `json:"cron"`             // 周期发送策略使用，标准的五段 cron 表达式
+	Timezone         string    `json:"timezone"`         // 周期发送策略使用，cron 表达式所在的时区，为空时使用本地时区
+	RecurringEndTime time.Time `json:"recurringEndTime"` // 周期发送策略使用，不晚于这个时间，零值表示不限制
+	MaxOccurrences   int32     `json:"maxOccurrences"`   // 周期发送策略使用，最多发送的次数，0 表示不限制
+}
+
+// NextOccurrence 周期发送策略中 after 之后的下一次发送时间，超过结束时间时返回 false
+func (e SendStrategyConfig) NextOccurrence(after time.Time) (time.Time, bool, error) {
+	loc, err := e.location()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	schedule, err := cron.ParseStandard(e.Cron)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%w: cron 表达式 %q 不正确", errs.ErrInvalidParameter, e.Cron)
+	}
+	next := schedule.Next(after.In(loc))
+	if next.IsZero() || (!e.RecurringEndTime.IsZero() && next.After(e.RecurringEndTime)) {
+		return time.Time{}, false, nil
+	}
+	return next, true, nil
+}
+
+func (e SendStrategyConfig) location() (*time.Location, error) {
+	if e.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: 时区 %q 不正确", errs.ErrInvalidParameter, e.Timezone)
+	}
+	return loc, nil
 }
 
 // SendTimeWindow 计算最早发送时间和最晚发送时间
@@ -47,6 +85,10 @@ func (e SendStrategyConfig) SendTimeWindow() (stime, etime time.Time) {
 		// 无法精确控制，所以允许一些误差
 		const scheduledTimeTolerance = 3 * time.Second
 		return e.ScheduledTime.Add(-scheduledTimeTolerance), e.ScheduledTime
+	case SendStrategyRecurring:
+		// 周期发送的每一次发送都会生成独立的通知，这里给出的是第一次发送的时间窗口
+		next, _, _ := e.NextOccurrence(time.Now())
+		return next, next.Add(recurringSendWindow)
 	default:
 		// 假定一定检测过了，所以这里随便返回一个就可以
 		now := time.Now()
@@ -75,6 +117,17 @@ func (e SendStrategyConfig) Validate() error {
 		if e.DeadlineTime.IsZero() || e.DeadlineTime.Before(time.Now()) {
 			return fmt.Errorf("%w: 截止日期发送策略需要指定未来的发送时间", errs.ErrInvalidParameter)
 		}
+	case SendStrategyRecurring:
+		if e.MaxOccurrences < 0 {
+			return fmt.Errorf("%w: 周期发送策略的最大发送次数不能小于0", errs.ErrInvalidParameter)
+		}
+		_, ok, err := e.NextOccurrence(time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: 周期发送策略在结束时间之前没有需要发送的时间点", errs.ErrInvalidParameter)
+		}
 	}
 	return nil
 }
@@ -86,6 +139,9 @@ type SendResponse struct {
 	IsIdempotent   bool       `json:"isIdempotent"`    // 是否为幂等响应
 	ProcessedAt    time.Time  `json:"processedAt"`     // 处理时间
 	Error          error      `json:"error,omitempty"` // 错误信息
+
+	ReceiverResults []ReceiverResult `json:"receiverResults"` // 每个接收者的发送结果
+	SentChannel     Channel          `json:"sentChannel"`     // 实际发送成功的渠道
 }
 
 // BatchSendResponse 批量发送响应
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+)
+
+// ReceiverResultCodeSuppressed 接收者在退订名单中没有发送
+const ReceiverResultCodeSuppressed = "SUPPRESSED"
+
+// Suppression 退订名单中的一条记录，接收者退订(STOP)之后不再给他发送匹配的通知
+type Suppression struct {
+	ID    int64
+	BizID int64
+	// Channel 为空时退订所有渠道
+	Channel Channel
+	// BusinessType 为 0 时退订所有业务类型
+	BusinessType BusinessType
+	Receiver     string // 接收者(手机/邮箱/用户ID)
+	Reason       string // 退订原因，例如用户回复 STOP
+	Ctime        int64
+	Utime        int64
+}
+
+func (s Suppression) Validate() error {
+	if s.BizID <= 0 {
+		return fmt.Errorf("%w: BizID = %d", errs.ErrInvalidParameter, s.BizID)
+	}
+	if strings.TrimSpace(s.Receiver) == "" {
+		return fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	if s.Channel != "" && !s.Channel.IsValid() {
+		return fmt.Errorf("%w: 渠道 %s", errs.ErrInvalidParameter, s.Channel)
+	}
+	if s.BusinessType != 0 && !s.BusinessType.IsValid() {
+		return fmt.Errorf("%w: 业务类型 %d", errs.ErrInvalidParameter, s.BusinessType)
+	}
+	return nil
+}
+
+// Matches 判断是否退订了渠道和业务类型
+func (s Suppression) Matches(channel Channel, businessType BusinessType) bool {
+	return (s.Channel == "" || s.Channel == channel) &&
+		(s.BusinessType == 0 || s.BusinessType == businessType)
+}
+
+// MarkSuppressed 所有接收者都已退订，通知不再发送也不扣减额度，
+// 接收者改为退订的接收者，保存后业务方可以查询到每个接收者退订的结果
+func (n *Notification) MarkSuppressed() {
+	n.Status = SendStatusSuppressed
+	n.Error = &SendError{Code: SendErrorCodeSuppressed, Message: "所有接收者都已退订"}
+	n.Receivers = make([]string, 0, len(n.ReceiverResults))
+	for i := range n.ReceiverResults {
+		n.Receivers = append(n.Receivers, n.ReceiverResults[i].Receiver)
+	}
+}
@@ -107,6 +107,9 @@ type ChannelTemplate struct {
 	Ctime           int64        // 创建时间
 	Utime           int64        // 更新时间
 
+	// VersionWeights A/B 测试时各个版本的流量权重，为空时全部流量使用活跃版本
+	VersionWeights []TemplateVersionWeight
+
 	Versions []ChannelTemplateVersion // 关联的所有版本
 }
 
@@ -216,7 +219,8 @@ type ChannelTemplateVersion struct {
 	Ctime                    int64       // 创建时间
 	Utime                    int64       // 更新时间
 
-	Providers []ChannelTemplateProvider // 关联的所有供应商
+	Providers []ChannelTemplateProvider // 默认内容关联的所有供应商
+	Locales   []ChannelTemplateLocale   // 本地化内容，Signature 和 Content 是没有匹配的语言时使用的默认内容
 }
 
 // ChannelTemplateProvider 渠道模板供应商关联
@@ -227,6 +231,7 @@ type ChannelTemplateProvider struct {
 	ProviderID               int64       // 供应商ID
 	ProviderName             string      // 供应商名称
 	ProviderChannel          Channel     // 供应商渠道类型
+	Locale                   string      // 本地化内容的语言，为空表示默认内容
 	RequestID                string      // 审核请求ID
 	ProviderTemplateID       string      // 供应商侧模板ID
 	AuditStatus              AuditStatus // 审核状态
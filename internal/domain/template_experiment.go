@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/hash"
+)
+
+// TemplateVersionWeight A/B 测试中一个版本分到的流量权重
+type TemplateVersionWeight struct {
+	VersionID int64 `json:"versionId"`
+	Weight    int64 `json:"weight"`
+}
+
+// ValidateVersionWeights 参与 A/B 测试的版本至少有两个，不能重复，权重必须大于0
+func ValidateVersionWeights(weights []TemplateVersionWeight) error {
+	if len(weights) < 2 {
+		return fmt.Errorf("%w: A/B 测试至少需要两个版本", errs.ErrInvalidParameter)
+	}
+	seen := make(map[int64]struct{}, len(weights))
+	for _, w := range weights {
+		if w.VersionID <= 0 || w.Weight <= 0 {
+			return fmt.Errorf("%w: 版本ID %d 权重 %d", errs.ErrInvalidParameter, w.VersionID, w.Weight)
+		}
+		if _, ok := seen[w.VersionID]; ok {
+			return fmt.Errorf("%w: 版本ID %d 重复", errs.ErrInvalidParameter, w.VersionID)
+		}
+		seen[w.VersionID] = struct{}{}
+	}
+	return nil
+}
+
+// PickVersion 按 bizID+模版ID+key 分桶，同一条通知重试时选到同一个版本
+func (t *ChannelTemplate) PickVersion(bizID int64, key string) *ChannelTemplateVersion {
+	var total int64
+	for _, w := range t.VersionWeights {
+		total += w.Weight
+	}
+	if total <= 0 {
+		return t.ActiveVersion()
+	}
+	bucket := hash.Hash(bizID, strconv.FormatInt(t.ID, 10)+":"+key) % total
+	for _, w := range t.VersionWeights {
+		if bucket < w.Weight {
+			if v := t.GetVersion(w.VersionID); v != nil {
+				return v
+			}
+			break
+		}
+		bucket -= w.Weight
+	}
+	return t.ActiveVersion()
+}
+
+// VersionOrActive versionID 为 0 时返回活跃版本，否则返回指定的版本
+func (t *ChannelTemplate) VersionOrActive(versionID int64) *ChannelTemplateVersion {
+	if versionID == 0 {
+		return t.ActiveVersion()
+	}
+	return t.GetVersion(versionID)
+}
+
+// TemplateVersionStats 一个版本在统计时间内的发送结果
+type TemplateVersionStats struct {
+	VersionID int64
+	// Counts 各个发送状态的通知数
+	Counts map[SendStatus]int64
+}
+
+// Finished 已经有发送结果的通知数，还在发送流程中的不算
+func (s TemplateVersionStats) Finished() int64 {
+	return s.Sent() + s.Counts[SendStatusFailed]
+}
+
+// Sent 供应商受理成功的通知数，包括之后收到回执的
+func (s TemplateVersionStats) Sent() int64 {
+	return s.Counts[SendStatusSucceeded] + s.Counts[SendStatusPartialSuccess] +
+		s.Counts[SendStatusDelivered] + s.Counts[SendStatusUndelivered]
+}
+
+// SuccessRate 发送成功率，供应商受理成功的通知数 / 有发送结果的通知数
+func (s TemplateVersionStats) SuccessRate() float64 {
+	return ratio(s.Sent(), s.Finished())
+}
+
+// DeliveredRate 送达率，回执确认送达的通知数 / 供应商受理成功的通知数
+func (s TemplateVersionStats) DeliveredRate() float64 {
+	return ratio(s.Counts[SendStatusDelivered], s.Sent())
+}
+
+func ratio(a, b int64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
@@ -0,0 +1,87 @@
+//go:build unit
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVersionWeights(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		weights []TemplateVersionWeight
+		wantErr error
+	}{
+		{
+			name:    "两个版本",
+			weights: []TemplateVersionWeight{{VersionID: 1, Weight: 90}, {VersionID: 2, Weight: 10}},
+		},
+		{
+			name:    "只有一个版本",
+			weights: []TemplateVersionWeight{{VersionID: 1, Weight: 100}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "权重为0",
+			weights: []TemplateVersionWeight{{VersionID: 1, Weight: 100}, {VersionID: 2, Weight: 0}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "版本重复",
+			weights: []TemplateVersionWeight{{VersionID: 1, Weight: 50}, {VersionID: 1, Weight: 50}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, ValidateVersionWeights(tc.weights), tc.wantErr)
+		})
+	}
+}
+
+func TestChannelTemplate_PickVersion(t *testing.T) {
+	t.Parallel()
+
+	tmpl := ChannelTemplate{
+		ID:              1,
+		ActiveVersionID: 10,
+		Versions:        []ChannelTemplateVersion{{ID: 10}, {ID: 11}},
+	}
+
+	t.Run("没有 A/B 测试时使用活跃版本", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, int64(10), tmpl.PickVersion(1, "order-1").ID)
+	})
+
+	t.Run("按权重分配并且同一个key总是同一个版本", func(t *testing.T) {
+		t.Parallel()
+		experiment := tmpl
+		experiment.VersionWeights = []TemplateVersionWeight{{VersionID: 10, Weight: 80}, {VersionID: 11, Weight: 20}}
+
+		const total = 10000
+		counts := make(map[int64]int)
+		for i := 0; i < total; i++ {
+			key := fmt.Sprintf("order-%d", i)
+			picked := experiment.PickVersion(1, key)
+			assert.Equal(t, picked.ID, experiment.PickVersion(1, key).ID)
+			counts[picked.ID]++
+		}
+		assert.InDelta(t, 0.8, float64(counts[10])/total, 0.05)
+		assert.InDelta(t, 0.2, float64(counts[11])/total, 0.05)
+	})
+
+	t.Run("版本不存在时回退到活跃版本", func(t *testing.T) {
+		t.Parallel()
+		experiment := tmpl
+		experiment.VersionWeights = []TemplateVersionWeight{{VersionID: 12, Weight: 1}, {VersionID: 13, Weight: 1}}
+		assert.Equal(t, int64(10), experiment.PickVersion(1, "order-1").ID)
+	})
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"sort"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/textdiff"
+)
+
+// TemplatePublishAction 切换模版活跃版本的方式
+type TemplatePublishAction string
+
+const (
+	TemplatePublishActionPublish  TemplatePublishAction = "PUBLISH"  // 发布新版本
+	TemplatePublishActionRollback TemplatePublishAction = "ROLLBACK" // 回滚到之前审核通过的版本
+)
+
+func (a TemplatePublishAction) String() string {
+	return string(a)
+}
+
+// TemplatePublishRecord 模版活跃版本的一次切换记录
+type TemplatePublishRecord struct {
+	ID            int64
+	TemplateID    int64
+	FromVersionID int64 // 切换前的活跃版本ID，0表示之前没有活跃版本
+	ToVersionID   int64
+	Action        TemplatePublishAction
+	OperatorID    int64 // 操作人ID，发布时为0
+	Reason        string
+	Ctime         int64
+}
+
+// TemplateVersionHistory 模版的所有版本以及活跃版本的切换记录，都按时间倒序
+type TemplateVersionHistory struct {
+	TemplateID      int64
+	ActiveVersionID int64
+	Versions        []ChannelTemplateVersion
+	PublishRecords  []TemplatePublishRecord
+}
+
+// TemplateVersionDiff 两个版本之间的差异
+type TemplateVersionDiff struct {
+	FromVersionID int64
+	ToVersionID   int64
+
+	FromSignature    string
+	ToSignature      string
+	SignatureChanged bool
+
+	Content        []textdiff.Line
+	ContentChanged bool
+
+	// 按供应商名称比较
+	ProvidersAdded   []string
+	ProvidersRemoved []string
+}
+
+// Diff 比较 v 和 to 的签名、内容和供应商
+func (v ChannelTemplateVersion) Diff(to ChannelTemplateVersion) TemplateVersionDiff {
+	content := textdiff.Lines(v.Content, to.Content)
+	added, removed := diffProviderNames(v.Providers, to.Providers)
+	return TemplateVersionDiff{
+		FromVersionID:    v.ID,
+		ToVersionID:      to.ID,
+		FromSignature:    v.Signature,
+		ToSignature:      to.Signature,
+		SignatureChanged: v.Signature != to.Signature,
+		Content:          content,
+		ContentChanged:   textdiff.Changed(content),
+		ProvidersAdded:   added,
+		ProvidersRemoved: removed,
+	}
+}
+
+func diffProviderNames(from, to []ChannelTemplateProvider) (added, removed []string) {
+	fromNames := make(map[string]struct{}, len(from))
+	for i := range from {
+		fromNames[from[i].ProviderName] = struct{}{}
+	}
+	toNames := make(map[string]struct{}, len(to))
+	for i := range to {
+		toNames[to[i].ProviderName] = struct{}{}
+		if _, ok := fromNames[to[i].ProviderName]; !ok {
+			added = append(added, to[i].ProviderName)
+		}
+	}
+	for i := range from {
+		if _, ok := toNames[from[i].ProviderName]; !ok {
+			removed = append(removed, from[i].ProviderName)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -29,6 +29,14 @@ var (
 	ErrProviderNotFound                     = errors.New("供应商记录不存在")
 	ErrUnknownChannel                       = errors.New("未知渠道类型")
 	ErrInvalidOperation                     = errors.New("无效的操作")
+	ErrNotificationNotCancelable            = errors.New("通知已经开始发送或者已经结束，不能取消")
+	ErrNotificationNotEditable              = errors.New("通知已经开始发送或者已经结束，不能修改")
+	ErrReceiverFrequencyCapped              = errors.New("所有接收者都超过了发送频率上限")
+	ErrSuppressionNotFound                  = errors.New("退订记录不存在")
+	ErrInvalidReceiver                      = errors.New("接收者无效，换供应商或者重试都不会成功")
+	ErrProviderThrottled                    = errors.New("供应商限流")
+	ErrProviderFault                        = errors.New("供应商故障")
+	ErrDeadlinePassed                       = errors.New("已经超过截止时间，不再发送")
 
 	ErrCreateTemplateFailed                    = errors.New("创建模版失败")
 	ErrUpdateTemplateFailed                    = errors.New("更新模版失败")
@@ -38,6 +46,13 @@ var (
 	ErrUpdateTemplateProviderAuditStatusFailed = errors.New("更新渠道供应商审核状态失败")
 	ErrSubmitVersionForInternalReviewFailed    = errors.New("提交模版版本内部审核失败")
 	ErrSubmitVersionForProviderReviewFailed    = errors.New("提交模版版本供应商审核失败")
+	ErrTemplateNonCompliant                    = errors.New("模版内容不合规")
+	ErrTemplateActiveVersionChanged            = errors.New("模版的活跃版本已经被修改")
+	ErrTemplateLocaleNotFound                  = errors.New("模版版本没有该语言的本地化内容")
+
+	ErrAuditNotFound         = errors.New("审核记录不存在")
+	ErrAuditAlreadyDecided   = errors.New("审核记录已经有审核结果")
+	ErrAuditReviewerMismatch = errors.New("审核记录没有分配给当前审核人")
 
 	ErrNoAvailableFailoverService = errors.New("没有需要接管的故障服务")
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gotomicro/ego/task/ecron"
 
+	"github.com/gotomicro/ego/server/egin"
 	"github.com/gotomicro/ego/server/egrpc"
 )
 
@@ -14,6 +15,7 @@ type Task interface {
 
 type App struct {
 	GrpcServer *egrpc.Component
+	HTTPServer *egin.Component
 	Tasks      []Task
 	Crons      []ecron.Ecron
 }
@@ -0,0 +1,15 @@
+package ioc
+
+import (
+	receiptweb "gitee.com/flycash/notification-platform/internal/web/receipt"
+	templateweb "gitee.com/flycash/notification-platform/internal/web/template"
+	"github.com/gotomicro/ego/server/egin"
+)
+
+// InitGinServer HTTP 服务用于接收供应商推送的回执，以及模版管理和内置的内部审核
+func InitGinServer(receiptHdl *receiptweb.Handler, templateHdl *templateweb.Handler) *egin.Component {
+	server := egin.Load("server.http").Build()
+	receiptHdl.PublicRoutes(server.Engine)
+	templateHdl.PublicRoutes(server.Engine)
+	return server
+}
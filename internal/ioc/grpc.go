@@ -1,6 +1,8 @@
 package ioc
 
 import (
+	auditv1 "gitee.com/flycash/notification-platform/api/proto/gen/audit/v1"
+	configv1 "gitee.com/flycash/notification-platform/api/proto/gen/config/v1"
 	notificationv1 "gitee.com/flycash/notification-platform/api/proto/gen/notification/v1"
 	grpcapi "gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/api/grpc/interceptor/jwt"
@@ -14,7 +16,12 @@ import (
 	"github.com/gotomicro/ego/server/egrpc"
 )
 
-func InitGrpc(noserver *grpcapi.NotificationServer, etcdClient *eetcd.Component) *egrpc.Component {
+func InitGrpc(noserver *grpcapi.NotificationServer,
+	quotaServer *grpcapi.QuotaServer,
+	suppressionServer *grpcapi.SuppressionServer,
+	auditServer *grpcapi.AuditServer,
+	etcdClient *eetcd.Component,
+) *egrpc.Component {
 	// 注册全局的注册中心
 	type Config struct {
 		Key string `yaml:"key"`
@@ -42,6 +49,9 @@ func InitGrpc(noserver *grpcapi.NotificationServer, etcdClient *eetcd.Component)
 
 	notificationv1.RegisterNotificationServiceServer(server.Server, noserver)
 	notificationv1.RegisterNotificationQueryServiceServer(server.Server, noserver)
+	configv1.RegisterQuotaServiceServer(server.Server, quotaServer)
+	configv1.RegisterSuppressionServiceServer(server.Server, suppressionServer)
+	auditv1.RegisterAuditServiceServer(server.Server, auditServer)
 
 	return server
 }
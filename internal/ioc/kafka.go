@@ -0,0 +1,70 @@
+package ioc
+
+import (
+	"time"
+
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	templateevt "gitee.com/flycash/notification-platform/internal/event/template"
+	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/gotomicro/ego/core/econf"
+)
+
+type kafkaConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+func loadKafkaConfig() kafkaConfig {
+	var cfg kafkaConfig
+	if err := econf.UnmarshalKey("kafka", &cfg); err != nil {
+		panic(err)
+	}
+	return cfg
+}
+
+// InitKafkaProducer 目前只用于发送内置审核的审核结果
+func InitKafkaProducer() *kafka.Producer {
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": loadKafkaConfig().Addr,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return producer
+}
+
+// InitAuditResultProducer 内置审核和外部审核系统一样，通过 audit_result_events 发送审核结果
+func InitAuditResultProducer(producer *kafka.Producer) auditevt.ResultCallbackEventProducer {
+	p, err := auditevt.NewResultCallbackEventProducer(producer)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// InitAuditResultConsumer 消费审核结果，更新模版版本的审核状态，审核通过的提交到供应商审核
+func InitAuditResultConsumer(svc templatesvc.ChannelTemplateService) *templateevt.AuditResultConsumer {
+	type Config struct {
+		GroupID      string        `yaml:"groupID"`
+		BatchSize    int           `yaml:"batchSize"`
+		BatchTimeout time.Duration `yaml:"batchTimeout"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey("audit.resultConsumer", &cfg); err != nil {
+		panic(err)
+	}
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  loadKafkaConfig().Addr,
+		"group.id":           cfg.GroupID,
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": "false",
+	})
+	if err != nil {
+		panic(err)
+	}
+	c, err := templateevt.NewAuditResultConsumer(svc, consumer, cfg.BatchSize, cfg.BatchTimeout)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
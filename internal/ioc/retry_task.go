@@ -0,0 +1,42 @@
+package ioc
+
+import (
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/notification"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
+	"gitee.com/flycash/notification-platform/internal/service/sender"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/meoying/dlock-go"
+)
+
+// InitRetryTask 初始化通知重试任务，没有分库分表时配置为一库一表，只会有一个分片
+func InitRetryTask(
+	repo repository.NotificationRepository,
+	notificationSender sender.NotificationSender,
+	quietHours quiethours.Service,
+	dclient dlock.Client,
+) *notification.RetryTask {
+	type Config struct {
+		DBPrefix        string `yaml:"dbPrefix"`
+		TablePrefix     string `yaml:"tablePrefix"`
+		DBSharding      int64  `yaml:"dbSharding"`
+		TableSharding   int64  `yaml:"tableSharding"`
+		MaxLockedTables int    `yaml:"maxLockedTables"`
+		BatchSize       int    `yaml:"batchSize"`
+	}
+	var cfg Config
+	if err := econf.UnmarshalKey("retry_task", &cfg); err != nil {
+		panic(err)
+	}
+	return notification.NewRetryTask(
+		dclient,
+		repo,
+		notificationSender,
+		quietHours,
+		loopjob.NewResourceSemaphore(cfg.MaxLockedTables),
+		sharding.NewShardingStrategy(cfg.DBPrefix, cfg.TablePrefix, cfg.TableSharding, cfg.DBSharding),
+		cfg.BatchSize,
+	)
+}
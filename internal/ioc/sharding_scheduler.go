@@ -10,6 +10,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
 	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
 	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"github.com/ego-component/eetcd"
@@ -21,6 +22,7 @@ import (
 func InitShardingScheduler(
 	repo repository.NotificationRepository,
 	notificationSender sender.NotificationSender,
+	quietHours quiethours.Service,
 	dclient dlock.Client,
 	shardingStrategy sharding.ShardingStrategy,
 	etcdClient *eetcd.Component,
@@ -72,6 +74,7 @@ func InitShardingScheduler(
 	return scheduler.NewShardingScheduler(
 		repo,
 		notificationSender,
+		quietHours,
 		dclient,
 		shardingStrategy,
 		sem,
@@ -1,8 +1,10 @@
 package ioc
 
 import (
+	templateevt "gitee.com/flycash/notification-platform/internal/event/template"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 )
 
@@ -10,11 +12,19 @@ func InitTasks(t1 *callback.AsyncRequestResultCallbackTask,
 	t2 scheduler.NotificationScheduler,
 	t3 *notification.SendingTimeoutTask,
 	t4 *notification.TxCheckTask,
+	t5 *receipt.SyncTask,
+	t6 *notification.RetryTask,
+	t7 *scheduler.RecurringScheduler,
+	t8 *templateevt.AuditResultConsumer,
 ) []Task {
 	return []Task{
 		t1,
 		t2,
 		t3,
 		t4,
+		t5,
+		t6,
+		t7,
+		t8,
 	}
 }
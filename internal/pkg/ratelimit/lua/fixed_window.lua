@@ -0,0 +1,27 @@
+-- 固定窗口限流算法
+
+-- 限流对象在当前窗口的请求计数键
+local countKey = KEYS[1]
+-- 限流对象的限流事件记录键
+local limitedEventKey = KEYS[2]
+-- 窗口大小（毫秒）
+local window = tonumber(ARGV[1])
+-- 阈值（最大请求数）
+local threshold = tonumber(ARGV[2])
+-- 当前时间戳（毫秒）
+local now = tonumber(ARGV[3])
+
+local cnt = tonumber(redis.call('GET', countKey) or 0)
+
+if cnt >= threshold then
+    -- 执行限流并只记录最新的限流时间
+    redis.call('SET', limitedEventKey, now, 'PX', 86400000)  -- 保留一天
+    return "true"
+else
+    redis.call('INCR', countKey)
+    if cnt == 0 then
+        -- 计数键带有窗口编号，窗口结束后就不再使用
+        redis.call('PEXPIRE', countKey, window)
+    end
+    return "false"
+end
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/net/context"
+)
+
+var (
+	//go:embed lua/fixed_window.lua
+	fixedWindowScript string
+
+	_ Limiter = (*RedisFixedWindowLimiter)(nil)
+)
+
+// RedisFixedWindowLimiter 基于Redis的固定窗口限流器
+// 只记录窗口内的请求数，适合窗口很大、阈值很高的场景，比如每日请求数限制
+type RedisFixedWindowLimiter struct {
+	cmd       redis.Cmdable
+	interval  time.Duration
+	rate      int
+	keyPrefix string
+}
+
+// NewRedisFixedWindowLimiter 创建一个基于Redis的固定窗口限流器，窗口按本地时区对齐，
+// 比如 interval 为 24 小时的时候，每天零点开始一个新的窗口
+func NewRedisFixedWindowLimiter(cmd redis.Cmdable, interval time.Duration, rate int) *RedisFixedWindowLimiter {
+	return &RedisFixedWindowLimiter{
+		cmd:       cmd,
+		interval:  interval,
+		rate:      rate,
+		keyPrefix: "ratelimit:fixed:",
+	}
+}
+
+// Limit 判断是否应该限流
+func (r *RedisFixedWindowLimiter) Limit(ctx context.Context, key string) (bool, error) {
+	now := time.Now()
+	return r.cmd.Eval(ctx, fixedWindowScript,
+		[]string{r.getCountKey(key, now), r.getLimitedEventKey(key)},
+		r.interval.Milliseconds(),
+		r.rate,
+		now.UnixMilli(),
+	).Bool()
+}
+
+// Peek 判断当前窗口内的请求数是否已经达到阈值，不记录当前请求
+func (r *RedisFixedWindowLimiter) Peek(ctx context.Context, key string) (bool, error) {
+	cnt, err := r.cmd.Get(ctx, r.getCountKey(key, time.Now())).Int64()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return cnt >= int64(r.rate), nil
+}
+
+// getCountKey 获取当前窗口请求计数的Redis键
+func (r *RedisFixedWindowLimiter) getCountKey(key string, now time.Time) string {
+	_, offset := now.Zone()
+	window := (now.UnixMilli() + int64(offset)*1000) / r.interval.Milliseconds()
+	return fmt.Sprintf("%scount:%s:%d", r.keyPrefix, key, window)
+}
+
+// getLimitedEventKey 获取限流事件记录的Redis键
+func (r *RedisFixedWindowLimiter) getLimitedEventKey(key string) string {
+	return fmt.Sprintf("%slimitedEvent:%s", r.keyPrefix, key)
+}
+
+// LastLimitTime 获取最近一次限流发生的时间，如果没有发生过限流则返回零值
+func (r *RedisFixedWindowLimiter) LastLimitTime(ctx context.Context, key string) (time.Time, error) {
+	result, err := r.cmd.Eval(ctx, lastLimitTimeScript,
+		[]string{r.getLimitedEventKey(key)}).Int64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if result == 0 {
+		return time.Time{}, nil
+	}
+	return time.UnixMilli(result), nil
+}
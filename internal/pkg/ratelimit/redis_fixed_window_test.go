@@ -0,0 +1,44 @@
+//go:build e2e
+
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisFixedWindowLimiter(t *testing.T) {
+	t.Parallel()
+	rdb := testioc.InitRedis()
+	ctx := t.Context()
+	key := fmt.Sprintf("test:fixed_window:%d", time.Now().UnixNano())
+	limiter := NewRedisFixedWindowLimiter(rdb, time.Second, 3)
+
+	// 保证在同一个窗口内完成测试
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	for i := 0; i < 3; i++ {
+		limited, err := limiter.Limit(ctx, key)
+		require.NoError(t, err)
+		assert.False(t, limited, fmt.Sprintf("第%d个请求不应该被限流", i+1))
+	}
+	limited, err := limiter.Limit(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, limited, "第4个请求应该被限流")
+
+	lastLimitTime, err := limiter.LastLimitTime(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, lastLimitTime.IsZero())
+
+	// 下一个窗口重新计数
+	time.Sleep(time.Second)
+	limited, err = limiter.Limit(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, limited, "新窗口的请求不应该被限流")
+
+	rdb.Del(ctx, limiter.getLimitedEventKey(key))
+}
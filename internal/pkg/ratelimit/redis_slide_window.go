@@ -46,6 +46,16 @@ func (r *RedisSlidingWindowLimiter) Limit(ctx context.Context, key string) (bool
 	).Bool()
 }
 
+// Peek 判断当前窗口内的请求数是否已经达到阈值，不记录当前请求
+func (r *RedisSlidingWindowLimiter) Peek(ctx context.Context, key string) (bool, error) {
+	minScore := time.Now().UnixMilli() - r.interval.Milliseconds()
+	cnt, err := r.cmd.ZCount(ctx, r.getCountKey(key), fmt.Sprintf("(%d", minScore), "+inf").Result()
+	if err != nil {
+		return false, err
+	}
+	return cnt >= int64(r.rate), nil
+}
+
 // getCountKey 获取请求计数的Redis键
 func (r *RedisSlidingWindowLimiter) getCountKey(key string) string {
 	return fmt.Sprintf("%scount:%s", r.keyPrefix, key)
@@ -10,6 +10,8 @@ import (
 type Limiter interface {
 	// Limit 判断是否应该限流
 	Limit(ctx context.Context, key string) (bool, error)
+	// Peek 判断当前是否已经达到阈值，不占用请求机会，也不记录限流事件
+	Peek(ctx context.Context, key string) (bool, error)
 	// LastLimitTime 获取最近一次限流发生的时间，如果没有发生过限流则返回零值
 	LastLimitTime(ctx context.Context, key string) (time.Time, error)
 }
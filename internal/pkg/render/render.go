@@ -0,0 +1,159 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrMalformedTemplate = errors.New("模版内容格式错误")
+	ErrMissingParams     = errors.New("缺少模版参数")
+	ErrUnknownParams     = errors.New("未知的模版参数")
+)
+
+const (
+	placeholderPrefix = "${"
+	placeholderSuffix = "}"
+)
+
+// Template 解析后的模版内容，占位符格式为 ${name}，
+// name 只能由字母、数字、下划线、中划线和点组成
+type Template struct {
+	segments     []segment
+	placeholders []string
+}
+
+type segment struct {
+	// text 为普通文本或占位符名称
+	text        string
+	placeholder bool
+}
+
+// Parse 解析模版内容，提取其中声明的占位符
+func Parse(content string) (*Template, error) {
+	t := &Template{}
+	seen := make(map[string]struct{})
+	rest := content
+	for {
+		start := strings.Index(rest, placeholderPrefix)
+		if start < 0 {
+			t.appendText(rest)
+			return t, nil
+		}
+		t.appendText(rest[:start])
+
+		rest = rest[start+len(placeholderPrefix):]
+		end := strings.Index(rest, placeholderSuffix)
+		if end < 0 {
+			return nil, fmt.Errorf("%w: 占位符 %q 缺少结束符", ErrMalformedTemplate, placeholderPrefix+rest)
+		}
+		name := rest[:end]
+		if !isValidName(name) {
+			return nil, fmt.Errorf("%w: 非法的占位符 %q", ErrMalformedTemplate, placeholderPrefix+name+placeholderSuffix)
+		}
+		t.segments = append(t.segments, segment{text: name, placeholder: true})
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			t.placeholders = append(t.placeholders, name)
+		}
+		rest = rest[end+len(placeholderSuffix):]
+	}
+}
+
+func (t *Template) appendText(text string) {
+	if text != "" {
+		t.segments = append(t.segments, segment{text: text})
+	}
+}
+
+func isValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' && r != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// UsesPlaceholders 模版内容是否使用 ${name} 格式的占位符。
+// 腾讯云等供应商的模版使用 {1} 格式的占位符，参数由供应商替换，平台没办法校验
+func UsesPlaceholders(content string) bool {
+	return strings.Contains(content, placeholderPrefix)
+}
+
+// Placeholders 模版声明的占位符，按首次出现的顺序排列且不重复
+func (t *Template) Placeholders() []string {
+	res := make([]string, len(t.placeholders))
+	copy(res, t.placeholders)
+	return res
+}
+
+// Validate 校验参数与模版声明的占位符是否一致，
+// 缺少参数或者存在模版未声明的参数都会返回错误，错误信息中带有对应的参数名
+func (t *Template) Validate(params map[string]string) error {
+	declared := make(map[string]struct{}, len(t.placeholders))
+	var missing []string
+	for _, name := range t.placeholders {
+		declared[name] = struct{}{}
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	var unknown []string
+	for name := range params {
+		if _, ok := declared[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	var errList []error
+	if len(missing) > 0 {
+		errList = append(errList, fmt.Errorf("%w: %s", ErrMissingParams, strings.Join(missing, ", ")))
+	}
+	if len(unknown) > 0 {
+		errList = append(errList, fmt.Errorf("%w: %s", ErrUnknownParams, strings.Join(unknown, ", ")))
+	}
+	return errors.Join(errList...)
+}
+
+// Execute 校验参数后渲染模版
+func (t *Template) Execute(params map[string]string) (string, error) {
+	if err := t.Validate(params); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, seg := range t.segments {
+		if seg.placeholder {
+			sb.WriteString(params[seg.text])
+			continue
+		}
+		sb.WriteString(seg.text)
+	}
+	return sb.String(), nil
+}
+
+// Render 解析并渲染模版内容
+func Render(content string, params map[string]string) (string, error) {
+	t, err := Parse(content)
+	if err != nil {
+		return "", err
+	}
+	return t.Execute(params)
+}
+
+// SplitTitle 拆分渲染后内容中的标题和正文，用于邮件、站内信等带标题的渠道。
+// 内容有多行时首行为标题、其余为正文；只有一行时以 defaultTitle 作为标题。
+func SplitTitle(defaultTitle, content string) (title, body string) {
+	first, rest, found := strings.Cut(content, "\n")
+	if !found {
+		return defaultTitle, content
+	}
+	return strings.TrimSpace(first), strings.TrimLeft(rest, "\r\n")
+}
@@ -0,0 +1,232 @@
+//go:build unit
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		content          string
+		wantPlaceholders []string
+		wantErr          error
+	}{
+		{
+			name:             "无占位符",
+			content:          "您的订单已发货",
+			wantPlaceholders: []string{},
+		},
+		{
+			name:             "按首次出现顺序去重",
+			content:          "${name}您好，验证码${code}，${name}请勿泄露",
+			wantPlaceholders: []string{"name", "code"},
+		},
+		{
+			name:             "允许的字符",
+			content:          "${user.name}-${order_id}-${sku-1}-${名称}",
+			wantPlaceholders: []string{"user.name", "order_id", "sku-1", "名称"},
+		},
+		{
+			name:             "普通的$符号",
+			content:          "价格$100，优惠${discount}",
+			wantPlaceholders: []string{"discount"},
+		},
+		{
+			name:    "缺少结束符",
+			content: "您的验证码是${code",
+			wantErr: ErrMalformedTemplate,
+		},
+		{
+			name:    "空占位符",
+			content: "您的验证码是${}",
+			wantErr: ErrMalformedTemplate,
+		},
+		{
+			name:    "非法字符",
+			content: "您的验证码是${co de}",
+			wantErr: ErrMalformedTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tmpl, err := Parse(tt.content)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantPlaceholders, tmpl.Placeholders())
+		})
+	}
+}
+
+func TestUsesPlaceholders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{name: "${name} 格式的占位符", content: "您的验证码是${code}", want: true},
+		{name: "格式错误的占位符", content: "您的验证码是${code", want: true},
+		{name: "{1} 格式的占位符", content: "您的验证码是{1}，{2}分钟内有效", want: false},
+		{name: "无占位符", content: "您的订单已发货", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, UsesPlaceholders(tt.content))
+		})
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := Parse("${name}您好，您的验证码是${code}，有效期${minutes}分钟")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		params     map[string]string
+		wantErrs   []error
+		wantErrMsg []string
+	}{
+		{
+			name:   "参数完整",
+			params: map[string]string{"name": "Alice", "code": "123456", "minutes": "5"},
+		},
+		{
+			name:       "缺少参数",
+			params:     map[string]string{"code": "123456"},
+			wantErrs:   []error{ErrMissingParams},
+			wantErrMsg: []string{"name, minutes"},
+		},
+		{
+			name:       "未知参数",
+			params:     map[string]string{"name": "Alice", "code": "123456", "minutes": "5", "nmae": "Alice", "cdoe": "1"},
+			wantErrs:   []error{ErrUnknownParams},
+			wantErrMsg: []string{"cdoe, nmae"},
+		},
+		{
+			name:       "同时缺少参数和存在未知参数",
+			params:     map[string]string{"nmae": "Alice", "code": "123456", "minutes": "5"},
+			wantErrs:   []error{ErrMissingParams, ErrUnknownParams},
+			wantErrMsg: []string{"name", "nmae"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tmpl.Validate(tt.params)
+			if len(tt.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, wantErr := range tt.wantErrs {
+				assert.ErrorIs(t, err, wantErr)
+			}
+			for _, msg := range tt.wantErrMsg {
+				assert.Contains(t, err.Error(), msg)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		params  map[string]string
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "无占位符",
+			content: "您的订单已发货",
+			want:    "您的订单已发货",
+		},
+		{
+			name:    "重复占位符",
+			content: "${name}您好，${name}的验证码是${code}",
+			params:  map[string]string{"name": "Alice", "code": "123456"},
+			want:    "Alice您好，Alice的验证码是123456",
+		},
+		{
+			name:    "参数值不会被再次渲染",
+			content: "${a}${b}",
+			params:  map[string]string{"a": "${b}", "b": "x"},
+			want:    "${b}x",
+		},
+		{
+			name:    "缺少参数",
+			content: "您的验证码是${code}",
+			params:  map[string]string{},
+			wantErr: ErrMissingParams,
+		},
+		{
+			name:    "模版格式错误",
+			content: "您的验证码是${code",
+			params:  map[string]string{"code": "123456"},
+			wantErr: ErrMalformedTemplate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Render(tt.content, tt.params)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitTitle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		content   string
+		wantTitle string
+		wantBody  string
+	}{
+		{
+			name:      "单行内容使用默认标题",
+			content:   "您的验证码是123456",
+			wantTitle: "默认标题",
+			wantBody:  "您的验证码是123456",
+		},
+		{
+			name:      "多行内容首行为标题",
+			content:   " 欢迎注册 \r\n\r\n您的验证码是123456\n请勿泄露",
+			wantTitle: "欢迎注册",
+			wantBody:  "您的验证码是123456\n请勿泄露",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			title, body := SplitTitle("默认标题", tt.content)
+			assert.Equal(t, tt.wantTitle, title)
+			assert.Equal(t, tt.wantBody, body)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package textdiff
+
+import "strings"
+
+// Op 一行的变化
+type Op string
+
+const (
+	OpEqual  Op = "="
+	OpInsert Op = "+"
+	OpDelete Op = "-"
+)
+
+// Line 差异中的一行
+type Line struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// Lines 按行比较 a 和 b，基于最长公共子序列，同一处修改先输出删除的行再输出新增的行。
+// 模版内容一般只有几行到几百行，O(n*m) 的开销可以接受
+func Lines(a, b string) []Line {
+	x, y := splitLines(a), splitLines(b)
+	// lcs[i][j] 是 x[i:] 和 y[j:] 的最长公共子序列长度
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	res := make([]Line, 0, max(len(x), len(y)))
+	i, j := 0, 0
+	for i < len(x) && j < len(y) {
+		switch {
+		case x[i] == y[j]:
+			res = append(res, Line{Op: OpEqual, Text: x[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, Line{Op: OpDelete, Text: x[i]})
+			i++
+		default:
+			res = append(res, Line{Op: OpInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < len(x); i++ {
+		res = append(res, Line{Op: OpDelete, Text: x[i]})
+	}
+	for ; j < len(y); j++ {
+		res = append(res, Line{Op: OpInsert, Text: y[j]})
+	}
+	return res
+}
+
+// Changed 是否有变化
+func Changed(lines []Line) bool {
+	for i := range lines {
+		if lines[i].Op != OpEqual {
+			return true
+		}
+	}
+	return false
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,70 @@
+//go:build unit
+
+package textdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		a, b        string
+		want        []Line
+		wantChanged bool
+	}{
+		{
+			name: "相同",
+			a:    "您好\n验证码${code}",
+			b:    "您好\n验证码${code}",
+			want: []Line{{Op: OpEqual, Text: "您好"}, {Op: OpEqual, Text: "验证码${code}"}},
+		},
+		{
+			name: "修改一行",
+			a:    "您好\n验证码${code}\n请勿泄露",
+			b:    "您好\n您的验证码${code}\n请勿泄露",
+			want: []Line{
+				{Op: OpEqual, Text: "您好"},
+				{Op: OpDelete, Text: "验证码${code}"},
+				{Op: OpInsert, Text: "您的验证码${code}"},
+				{Op: OpEqual, Text: "请勿泄露"},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "新增和删除",
+			a:    "第一行\n第二行",
+			b:    "第二行\r\n第三行",
+			want: []Line{
+				{Op: OpDelete, Text: "第一行"},
+				{Op: OpEqual, Text: "第二行"},
+				{Op: OpInsert, Text: "第三行"},
+			},
+			wantChanged: true,
+		},
+		{
+			name:        "从空内容开始",
+			a:           "",
+			b:           "内容",
+			want:        []Line{{Op: OpInsert, Text: "内容"}},
+			wantChanged: true,
+		},
+		{
+			name: "都是空内容",
+			want: []Line{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := Lines(tc.a, tc.b)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantChanged, Changed(got))
+		})
+	}
+}
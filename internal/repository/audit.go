@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// AuditRepository 审核记录仓储接口
+type AuditRepository interface {
+	Create(ctx context.Context, a domain.Audit) (domain.Audit, error)
+	// GetByID 查询审核记录以及所有审核意见，记录不存在时返回 errs.ErrAuditNotFound
+	GetByID(ctx context.Context, id int64) (domain.Audit, error)
+	// ListByReviewer 按时间倒序分页查询分配给审核人的记录，status 为空时查询所有状态，同时返回符合条件的总数
+	ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error)
+	// CountInReviewByReviewers 统计每个审核人手上审核中的记录数
+	CountInReviewByReviewers(ctx context.Context, reviewerIDs []int64) (map[int64]int64, error)
+	// UpdateReviewer 重新分配审核人，只能修改审核中的记录
+	UpdateReviewer(ctx context.Context, id, reviewerID int64) error
+	// Decide 保存审核结果，comment 不为空时一起保存审核意见
+	Decide(ctx context.Context, a domain.Audit, comment string) error
+	AddComment(ctx context.Context, c domain.AuditComment) (domain.AuditComment, error)
+}
+
+type auditRepository struct {
+	dao dao.AuditDAO
+}
+
+func NewAuditRepository(d dao.AuditDAO) AuditRepository {
+	return &auditRepository{dao: d}
+}
+
+func (r *auditRepository) Create(ctx context.Context, a domain.Audit) (domain.Audit, error) {
+	created, err := r.dao.Create(ctx, r.toEntity(a))
+	if err != nil {
+		return domain.Audit{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *auditRepository) GetByID(ctx context.Context, id int64) (domain.Audit, error) {
+	found, err := r.dao.GetByID(ctx, id)
+	if err != nil {
+		return domain.Audit{}, err
+	}
+	comments, err := r.dao.FindComments(ctx, id)
+	if err != nil {
+		return domain.Audit{}, err
+	}
+	a := r.toDomain(found)
+	a.Comments = slice.Map(comments, func(_ int, src dao.AuditComment) domain.AuditComment {
+		return r.toCommentDomain(src)
+	})
+	return a, nil
+}
+
+func (r *auditRepository) ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error) {
+	found, total, err := r.dao.FindByReviewer(ctx, reviewerID, status.String(), offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(found, func(_ int, src dao.Audit) domain.Audit {
+		return r.toDomain(src)
+	}), total, nil
+}
+
+func (r *auditRepository) CountInReviewByReviewers(ctx context.Context, reviewerIDs []int64) (map[int64]int64, error) {
+	return r.dao.CountInReviewByReviewers(ctx, reviewerIDs)
+}
+
+func (r *auditRepository) UpdateReviewer(ctx context.Context, id, reviewerID int64) error {
+	return r.dao.UpdateReviewer(ctx, id, reviewerID)
+}
+
+func (r *auditRepository) Decide(ctx context.Context, a domain.Audit, comment string) error {
+	var c *dao.AuditComment
+	if comment != "" {
+		c = &dao.AuditComment{AuditID: a.ID, ReviewerID: a.ReviewerID, Content: comment}
+	}
+	return r.dao.Decide(ctx, r.toEntity(a), c)
+}
+
+func (r *auditRepository) AddComment(ctx context.Context, c domain.AuditComment) (domain.AuditComment, error) {
+	created, err := r.dao.CreateComment(ctx, dao.AuditComment{
+		AuditID:    c.AuditID,
+		ReviewerID: c.ReviewerID,
+		Content:    c.Content,
+	})
+	if err != nil {
+		return domain.AuditComment{}, err
+	}
+	return r.toCommentDomain(created), nil
+}
+
+func (r *auditRepository) toEntity(a domain.Audit) dao.Audit {
+	return dao.Audit{
+		ID:           a.ID,
+		ResourceID:   a.ResourceID,
+		ResourceType: string(a.ResourceType),
+		Content:      a.Content,
+		ReviewerID:   a.ReviewerID,
+		Status:       a.Status.String(),
+		RejectReason: a.RejectReason,
+		AuditTime:    a.AuditTime,
+	}
+}
+
+func (r *auditRepository) toDomain(a dao.Audit) domain.Audit {
+	return domain.Audit{
+		ID:           a.ID,
+		ResourceID:   a.ResourceID,
+		ResourceType: domain.ResourceType(a.ResourceType),
+		Content:      a.Content,
+		ReviewerID:   a.ReviewerID,
+		Status:       domain.AuditStatus(a.Status),
+		RejectReason: a.RejectReason,
+		AuditTime:    a.AuditTime,
+		Ctime:        a.Ctime,
+		Utime:        a.Utime,
+	}
+}
+
+func (r *auditRepository) toCommentDomain(c dao.AuditComment) domain.AuditComment {
+	return domain.AuditComment{
+		ID:         c.ID,
+		AuditID:    c.AuditID,
+		ReviewerID: c.ReviewerID,
+		Content:    c.Content,
+		Ctime:      c.Ctime,
+	}
+}
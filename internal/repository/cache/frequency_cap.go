@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+type FrequencyCapCache interface {
+	// Record 在规则的滑动窗口内为每个接收者记录一次发送，返回每个接收者可以再次发送的时间，
+	// 零值表示没有超过上限并且已经记录。同一条通知重复记录不会重复计数。
+	// allOrNothing 为 true 时，只要有接收者超过上限就所有接收者都不记录
+	Record(ctx context.Context, bizID int64, rule domain.FrequencyCapRule,
+		notificationID uint64, receivers []string, allOrNothing bool) ([]time.Time, error)
+}
@@ -13,10 +13,15 @@ type IncrItem struct {
 }
 
 type QuotaCache interface {
+	// CreateOrUpdate 重置剩余额度和每日发送上限，每日上限为 0 表示不限制
 	CreateOrUpdate(ctx context.Context, quota ...domain.Quota) error
+	// Find 返回剩余额度、每日发送上限以及当日已发送数量
 	Find(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error)
+	// Incr 和 Decr 只调整剩余额度，不计入当日发送数量，用于人工调整额度
 	Incr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error
 	Decr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error
+	// MutiIncr 和 MutiDecr 用于发送链路，同时调整剩余额度和当日发送数量，
+	// 扣减时剩余额度不足或者超过每日上限都会整体失败
 	MutiIncr(ctx context.Context, items []IncrItem) error
 	MutiDecr(ctx context.Context, items []IncrItem) error
 }
@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+//go:embed lua/frequency_cap.lua
+var frequencyCapScript string
+
+// frequencyCapCache 每个接收者一个有序集合，记录窗口内每条通知的发送时间
+type frequencyCapCache struct {
+	client redis.Cmdable
+}
+
+func NewFrequencyCapCache(client redis.Cmdable) cache.FrequencyCapCache {
+	return &frequencyCapCache{client: client}
+}
+
+func (f *frequencyCapCache) Record(ctx context.Context, bizID int64, rule domain.FrequencyCapRule,
+	notificationID uint64, receivers []string, allOrNothing bool,
+) ([]time.Time, error) {
+	if len(receivers) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(receivers))
+	for i := range receivers {
+		keys = append(keys, f.key(bizID, rule, receivers[i]))
+	}
+	all := "0"
+	if allOrNothing {
+		all = "1"
+	}
+	res, err := f.client.Eval(ctx, frequencyCapScript, keys,
+		rule.Window().Milliseconds(),
+		rule.Limit,
+		time.Now().UnixMilli(),
+		strconv.FormatUint(notificationID, 10),
+		all,
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	times := make([]time.Time, len(res))
+	for i := range res {
+		if res[i] > 0 {
+			times[i] = time.UnixMilli(res[i])
+		}
+	}
+	return times, nil
+}
+
+// key 业务ID和规则作用范围作为 hash tag，同一次 Record 的所有 key 在 Redis Cluster 中落到同一个 slot，
+// 否则脚本会因为 CROSSSLOT 执行失败
+func (f *frequencyCapCache) key(bizID int64, rule domain.FrequencyCapRule, receiver string) string {
+	return fmt.Sprintf("frequency_cap:{%d:%s}:%s", bizID, rule.Scope(), receiver)
+}
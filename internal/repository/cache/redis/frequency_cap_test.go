@@ -0,0 +1,74 @@
+//go:build e2e
+
+package redis
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrequencyCapCache_Record(t *testing.T) {
+	t.Parallel()
+	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	defer rdb.Close()
+	ctx := t.Context()
+	bizID := time.Now().UnixNano()
+	c := NewFrequencyCapCache(rdb)
+	rule := domain.FrequencyCapRule{
+		Channel:       domain.ChannelSMS,
+		BusinessType:  domain.BusinessTypePromotion,
+		Limit:         2,
+		WindowSeconds: 60,
+	}
+
+	// user-1 用完了上限
+	for id := uint64(1); id <= 2; id++ {
+		res, err := c.Record(ctx, bizID, rule, id, []string{"user-1"}, false)
+		require.NoError(t, err)
+		assert.True(t, res[0].IsZero())
+	}
+	// 同一条通知重复记录不会重复计数
+	res, err := c.Record(ctx, bizID, rule, 2, []string{"user-1"}, false)
+	require.NoError(t, err)
+	assert.True(t, res[0].IsZero())
+
+	// 整体推迟时 user-2 也不记录
+	res, err = c.Record(ctx, bizID, rule, 3, []string{"user-1", "user-2"}, true)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), res[0], 5*time.Second)
+	assert.True(t, res[1].IsZero())
+	cnt, err := rdb.ZCard(ctx, c.(*frequencyCapCache).key(bizID, rule, "user-2")).Result()
+	require.NoError(t, err)
+	assert.Zero(t, cnt)
+
+	// 丢弃时只记录没有超过上限的接收者
+	res, err = c.Record(ctx, bizID, rule, 4, []string{"user-1", "user-2"}, false)
+	require.NoError(t, err)
+	assert.False(t, res[0].IsZero())
+	assert.True(t, res[1].IsZero())
+	cnt, err = rdb.ZCard(ctx, c.(*frequencyCapCache).key(bizID, rule, "user-2")).Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cnt)
+}
+
+func TestFrequencyCapCache_KeyHashTag(t *testing.T) {
+	t.Parallel()
+	c := &frequencyCapCache{}
+	rule := domain.FrequencyCapRule{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypePromotion}
+
+	// Redis Cluster 只用 {} 内的部分计算 slot，不同接收者的 key 要有相同的 hash tag
+	tag := func(key string) string {
+		start := strings.Index(key, "{")
+		end := strings.Index(key, "}")
+		require.True(t, start >= 0 && end > start+1, key)
+		return key[start+1 : end]
+	}
+	assert.Equal(t, tag(c.key(1, rule, "user-1")), tag(c.key(1, rule, "user-2")))
+	assert.NotEqual(t, tag(c.key(1, rule, "user-1")), tag(c.key(2, rule, "user-1")))
+}
@@ -1,20 +1,36 @@
--- 遍历所有键值对进行阈值检查
-for i = 1, #KEYS do
-    local key = KEYS[i]
-    local threshold = tonumber(ARGV[i])
-    local current = tonumber(redis.call('GET', key) or 0)
+-- 每个渠道对应三个键：剩余额度、每日上限、当日已发送数量
+-- ARGV[1] 为当日已发送数量的过期时间（秒），ARGV[i + 1] 为第 i 个渠道的扣减量
+local ttl = tonumber(ARGV[1])
+local n = #KEYS / 3
+
+-- 遍历所有渠道进行阈值检查
+for i = 1, n do
+    local base = (i - 1) * 3
+    local delta = tonumber(ARGV[i + 1])
+    local current = tonumber(redis.call('GET', KEYS[base + 1]) or 0)
 
     -- 值不足时立即返回失败
-    if current < threshold then
-        return key
+    if current < delta then
+        return KEYS[base + 1]
+    end
+
+    -- 没有每日上限表示不限制
+    local limit = redis.call('GET', KEYS[base + 2])
+    if limit then
+        local used = tonumber(redis.call('GET', KEYS[base + 3]) or 0)
+        if used + delta > tonumber(limit) then
+            return KEYS[base + 2]
+        end
     end
 end
 
--- 全部校验通过后执行扣减
-for i = 1, #KEYS do
-    local key = KEYS[i]
-    local delta = tonumber(ARGV[i])
-    redis.call('DECRBY', key, delta)
+-- 全部校验通过后执行扣减，并累加当日已发送数量
+for i = 1, n do
+    local base = (i - 1) * 3
+    local delta = tonumber(ARGV[i + 1])
+    redis.call('DECRBY', KEYS[base + 1], delta)
+    redis.call('INCRBY', KEYS[base + 3], delta)
+    redis.call('EXPIRE', KEYS[base + 3], ttl)
 end
 
-return ""
\ No newline at end of file
+return ""
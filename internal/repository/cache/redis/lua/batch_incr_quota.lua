@@ -1,6 +1,10 @@
--- 遍历所有键值对
-for i = 1, #KEYS do
-    local key = KEYS[i]
+-- 每个渠道对应两个键：剩余额度、当日已发送数量
+-- ARGV[i] 为第 i 个渠道归还的额度
+local n = #KEYS / 2
+
+for i = 1, n do
+    local key = KEYS[(i - 1) * 2 + 1]
+    local usedKey = KEYS[(i - 1) * 2 + 2]
     local param = tonumber(ARGV[i])
     local current = tonumber(redis.call('GET', key) or 0)  -- 处理键不存在的情况
 
@@ -11,6 +15,12 @@ for i = 1, #KEYS do
         -- 值≥0时增加参数值（原子操作）
         redis.call('INCRBY', key, param)
     end
+
+    -- 归还当日已发送数量，跨天后键已经过期则无需处理
+    local used = tonumber(redis.call('GET', usedKey) or 0)
+    if used > 0 then
+        redis.call('DECRBY', usedKey, math.min(used, param))
+    end
 end
 
-return 1  -- 返回成功标志
\ No newline at end of file
+return 1  -- 返回成功标志
@@ -0,0 +1,10 @@
+-- 只扣减剩余额度，额度不足时不扣减
+local current = tonumber(redis.call('GET', KEYS[1]) or 0)
+local delta = tonumber(ARGV[1])
+
+if current < delta then
+    return 0
+end
+
+redis.call('DECRBY', KEYS[1], delta)
+return 1
@@ -0,0 +1,43 @@
+-- 按接收者统计滑动窗口内的发送次数
+-- KEYS 使用相同的 hash tag {bizID:scope}，在 Redis Cluster 中位于同一个 slot
+
+-- 窗口大小（毫秒）
+local window = tonumber(ARGV[1])
+-- 窗口内最多发送的次数
+local limit = tonumber(ARGV[2])
+-- 当前时间戳（毫秒）
+local now = tonumber(ARGV[3])
+-- 通知ID，同一条通知重新发送时不重复计数
+local member = ARGV[4]
+-- 是否只要有接收者超过上限就都不记录
+local allOrNothing = ARGV[5] == '1'
+local min = now - window
+
+-- 每个接收者可以再次发送的时间，0 表示没有超过上限
+local res = {}
+local capped = false
+for i, key in ipairs(KEYS) do
+    redis.call('ZREMRANGEBYSCORE', key, '-inf', min)
+    res[i] = 0
+    if not redis.call('ZSCORE', key, member) then
+        local cnt = redis.call('ZCARD', key)
+        if cnt >= limit then
+            -- 窗口内要有 cnt - limit + 1 条记录过期之后才能再次发送
+            local oldest = redis.call('ZRANGE', key, cnt - limit, cnt - limit, 'WITHSCORES')
+            res[i] = tonumber(oldest[2]) + window
+            capped = true
+        end
+    end
+end
+
+if capped and allOrNothing then
+    return res
+end
+
+for i, key in ipairs(KEYS) do
+    if res[i] == 0 then
+        redis.call('ZADD', key, now, member)
+        redis.call('PEXPIRE', key, window)
+    end
+end
+return res
@@ -5,17 +5,28 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"github.com/gotomicro/ego/core/elog"
 	"github.com/redis/go-redis/v9"
 )
 
+// dailyUsedExpiration 当日已发送数量的过期时间，多留一天避免跨天时提前过期
+const dailyUsedExpiration = 48 * time.Hour
+
 var (
-	ErrQuotaLessThenZero = errors.New("额度小于0")
+	// ErrQuotaLessThenZero 额度不足，上层可以通过 errs.ErrNoQuota 判断
+	ErrQuotaLessThenZero = fmt.Errorf("%w: 额度小于0", errs.ErrNoQuota)
+	// ErrDailyQuotaExceeded 超过每日发送上限，上层可以通过 errs.ErrNoQuota 判断
+	ErrDailyQuotaExceeded = fmt.Errorf("%w: 超过每日发送上限", errs.ErrNoQuota)
 	//go:embed lua/quota.lua
 	quotaScript string
+	//go:embed lua/decr_quota.lua
+	decrQuotaScript string
 	//go:embed lua/batch_decr_quota.lua
 	batchDecrQuotaScript string
 	//go:embed lua/batch_incr_quota.lua
@@ -38,31 +49,32 @@ func (q *quotaCache) MutiIncr(ctx context.Context, items []cache.IncrItem) error
 	if len(items) == 0 {
 		return nil
 	}
-	keys, quotas := q.getKeysAndQuotas(items)
-	err := q.client.Eval(ctx, batchIncrQuotaScript, keys, quotas).Err()
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (q *quotaCache) getKeysAndQuotas(items []cache.IncrItem) (keys []string, quotas []any) {
-	keys = make([]string, 0, len(items))
-	quotas = make([]any, 0, len(items))
-	for idx := range items {
-		item := items[idx]
-		keys = append(keys, q.key(domain.Quota{
-			BizID:   item.BizID,
-			Channel: item.Channel,
-		}))
-		quotas = append(quotas, item.Val)
+	now := time.Now()
+	keys := make([]string, 0, 2*len(items))
+	quotas := make([]any, 0, len(items))
+	for i := range items {
+		keys = append(keys, q.key(items[i].BizID, items[i].Channel),
+			q.dailyUsedKey(items[i].BizID, items[i].Channel, now))
+		quotas = append(quotas, items[i].Val)
 	}
-	return keys, quotas
+	return q.client.Eval(ctx, batchIncrQuotaScript, keys, quotas...).Err()
 }
 
 func (q *quotaCache) MutiDecr(ctx context.Context, items []cache.IncrItem) error {
-	keys, quotas := q.getKeysAndQuotas(items)
-	res, err := q.client.Eval(ctx, batchDecrQuotaScript, keys, quotas).Result()
+	if len(items) == 0 {
+		return nil
+	}
+	now := time.Now()
+	keys := make([]string, 0, 3*len(items))
+	args := make([]any, 0, len(items)+1)
+	args = append(args, int64(dailyUsedExpiration/time.Second))
+	for i := range items {
+		keys = append(keys, q.key(items[i].BizID, items[i].Channel),
+			q.dailyLimitKey(items[i].BizID, items[i].Channel),
+			q.dailyUsedKey(items[i].BizID, items[i].Channel, now))
+		args = append(args, items[i].Val)
+	}
+	res, err := q.client.Eval(ctx, batchDecrQuotaScript, keys, args...).Result()
 	if err != nil {
 		return err
 	}
@@ -70,60 +82,83 @@ func (q *quotaCache) MutiDecr(ctx context.Context, items []cache.IncrItem) error
 	if !ok {
 		return errors.New("返回值不正确")
 	}
-	if resStr != "" {
-		return fmt.Errorf("%s不足 %w", resStr, ErrQuotaLessThenZero)
+	if resStr == "" {
+		return nil
 	}
-	return nil
+	if strings.HasPrefix(resStr, "quota:daily_limit:") {
+		return fmt.Errorf("%s %w", resStr, ErrDailyQuotaExceeded)
+	}
+	return fmt.Errorf("%s不足 %w", resStr, ErrQuotaLessThenZero)
 }
 
 func (q *quotaCache) Incr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error {
-	return q.client.Eval(ctx, quotaScript, []string{q.key(domain.Quota{
-		BizID:   bizID,
-		Channel: channel,
-	})}, quota).Err()
+	return q.client.Eval(ctx, quotaScript, []string{q.key(bizID, channel)}, quota).Err()
 }
 
+// Decr 额度不足时不会扣减，检查和扣减在同一个脚本中完成
 func (q *quotaCache) Decr(ctx context.Context, bizID int64, channel domain.Channel, quota int32) error {
-	res, err := q.client.DecrBy(ctx, q.key(domain.Quota{
-		BizID:   bizID,
-		Channel: channel,
-	}), int64(quota)).Result()
+	res, err := q.client.Eval(ctx, decrQuotaScript, []string{q.key(bizID, channel)}, quota).Int()
 	if err != nil {
 		return err
 	}
-	if res < 0 {
-		elog.Error("库存不足", elog.Int("biz_id", int(bizID)), elog.String("channel", channel.String()))
+	if res == 0 {
+		q.logger.Error("额度不足", elog.Int64("biz_id", bizID), elog.String("channel", channel.String()))
 		return ErrQuotaLessThenZero
 	}
 	return nil
 }
 
 func (q *quotaCache) CreateOrUpdate(ctx context.Context, quotas ...domain.Quota) error {
-	const (
-		number = 2
-	)
-	vals := make([]any, 0, number*len(quotas))
-	for _, quota := range quotas {
-		vals = append(vals, q.key(quota), quota.Quota)
-	}
-	return q.client.MSet(ctx, vals...).Err()
+	_, err := q.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, quota := range quotas {
+			pipe.Set(ctx, q.key(quota.BizID, quota.Channel), quota.Quota, 0)
+			if quota.DailyLimit > 0 {
+				pipe.Set(ctx, q.dailyLimitKey(quota.BizID, quota.Channel), quota.DailyLimit, 0)
+			} else {
+				pipe.Del(ctx, q.dailyLimitKey(quota.BizID, quota.Channel))
+			}
+		}
+		return nil
+	})
+	return err
 }
 
 func (q *quotaCache) Find(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error) {
-	quota, err := q.client.Get(ctx, q.key(domain.Quota{
-		BizID:   bizID,
-		Channel: channel,
-	})).Int()
+	quota, err := q.client.Get(ctx, q.key(bizID, channel)).Int()
+	if err != nil {
+		return domain.Quota{}, err
+	}
+	vals, err := q.client.MGet(ctx, q.dailyLimitKey(bizID, channel), q.dailyUsedKey(bizID, channel, time.Now())).Result()
 	if err != nil {
 		return domain.Quota{}, err
 	}
 	return domain.Quota{
-		BizID:   bizID,
-		Channel: channel,
-		Quota:   int32(quota),
+		BizID:      bizID,
+		Channel:    channel,
+		Quota:      int32(quota),
+		DailyLimit: q.toInt32(vals[0]),
+		DailyUsed:  q.toInt32(vals[1]),
 	}, nil
 }
 
-func (q *quotaCache) key(quota domain.Quota) string {
-	return fmt.Sprintf("quota:%d:%s", quota.BizID, quota.Channel)
+func (q *quotaCache) toInt32(val any) int32 {
+	str, ok := val.(string)
+	if !ok {
+		return 0
+	}
+	var res int32
+	_, _ = fmt.Sscan(str, &res)
+	return res
+}
+
+func (q *quotaCache) key(bizID int64, channel domain.Channel) string {
+	return fmt.Sprintf("quota:%d:%s", bizID, channel)
+}
+
+func (q *quotaCache) dailyLimitKey(bizID int64, channel domain.Channel) string {
+	return fmt.Sprintf("quota:daily_limit:%d:%s", bizID, channel)
+}
+
+func (q *quotaCache) dailyUsedKey(bizID int64, channel domain.Channel, now time.Time) string {
+	return fmt.Sprintf("quota:daily_used:%d:%s:%s", bizID, channel, now.Format("20060102"))
 }
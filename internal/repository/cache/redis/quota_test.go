@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/suite"
@@ -126,6 +127,12 @@ func (s *QuotaCacheTestSuite) TestDecr() {
 	err = s.cache.Decr(s.T().Context(), testQuota.BizID, testQuota.Channel, 60)
 	s.Error(err)
 	s.ErrorIs(err, ErrQuotaLessThenZero)
+	s.ErrorIs(err, errs.ErrNoQuota)
+
+	// 额度不足时不会扣减
+	storedQuota, err = s.cache.Find(s.T().Context(), testQuota.BizID, testQuota.Channel)
+	s.NoError(err)
+	s.Equal(int32(50), storedQuota.Quota)
 }
 
 func (s *QuotaCacheTestSuite) TestMutiIncr() {
@@ -220,6 +227,58 @@ func (s *QuotaCacheTestSuite) TestMutiDecr() {
 	s.NoError(err)
 }
 
+func (s *QuotaCacheTestSuite) TestDailyLimit() {
+	ctx := s.T().Context()
+	err := s.cache.CreateOrUpdate(ctx,
+		domain.Quota{BizID: 7001, Channel: domain.ChannelSMS, Quota: 100, DailyLimit: 10},
+		domain.Quota{BizID: 7001, Channel: domain.ChannelInApp, Quota: 100},
+	)
+	s.NoError(err)
+
+	err = s.cache.MutiDecr(ctx, []cache.IncrItem{
+		{BizID: 7001, Channel: domain.ChannelSMS, Val: 8},
+		{BizID: 7001, Channel: domain.ChannelInApp, Val: 8},
+	})
+	s.NoError(err)
+	storedQuota, err := s.cache.Find(ctx, 7001, domain.ChannelSMS)
+	s.NoError(err)
+	s.Equal(domain.Quota{BizID: 7001, Channel: domain.ChannelSMS, Quota: 92, DailyLimit: 10, DailyUsed: 8}, storedQuota)
+
+	// 剩余额度充足，但是超过了每日上限，整体失败
+	err = s.cache.MutiDecr(ctx, []cache.IncrItem{
+		{BizID: 7001, Channel: domain.ChannelSMS, Val: 3},
+		{BizID: 7001, Channel: domain.ChannelInApp, Val: 3},
+	})
+	s.ErrorIs(err, ErrDailyQuotaExceeded)
+	s.ErrorIs(err, errs.ErrNoQuota)
+	storedQuota, err = s.cache.Find(ctx, 7001, domain.ChannelInApp)
+	s.NoError(err)
+	s.Equal(domain.Quota{BizID: 7001, Channel: domain.ChannelInApp, Quota: 92, DailyUsed: 8}, storedQuota)
+
+	// 发送失败归还额度，同时归还当日已发送数量
+	err = s.cache.MutiIncr(ctx, []cache.IncrItem{{BizID: 7001, Channel: domain.ChannelSMS, Val: 1}})
+	s.NoError(err)
+	err = s.cache.MutiDecr(ctx, []cache.IncrItem{{BizID: 7001, Channel: domain.ChannelSMS, Val: 3}})
+	s.NoError(err)
+	storedQuota, err = s.cache.Find(ctx, 7001, domain.ChannelSMS)
+	s.NoError(err)
+	s.Equal(domain.Quota{BizID: 7001, Channel: domain.ChannelSMS, Quota: 90, DailyLimit: 10, DailyUsed: 10}, storedQuota)
+
+	// 人工调整额度不计入当日已发送数量
+	err = s.cache.Decr(ctx, 7001, domain.ChannelSMS, 5)
+	s.NoError(err)
+	storedQuota, err = s.cache.Find(ctx, 7001, domain.ChannelSMS)
+	s.NoError(err)
+	s.Equal(int32(85), storedQuota.Quota)
+	s.Equal(int32(10), storedQuota.DailyUsed)
+
+	// 取消每日上限
+	err = s.cache.CreateOrUpdate(ctx, domain.Quota{BizID: 7001, Channel: domain.ChannelSMS, Quota: 85})
+	s.NoError(err)
+	err = s.cache.MutiDecr(ctx, []cache.IncrItem{{BizID: 7001, Channel: domain.ChannelSMS, Val: 5}})
+	s.NoError(err)
+}
+
 func TestQuotaCache(t *testing.T) {
 	suite.Run(t, new(QuotaCacheTestSuite))
 }
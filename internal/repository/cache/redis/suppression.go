@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/redis/go-redis/v9"
+)
+
+// suppressionCache 每个接收者一个 key，值为该接收者所有退订记录的 JSON 数组
+type suppressionCache struct {
+	client redis.Cmdable
+	logger *elog.Component
+}
+
+func NewSuppressionCache(client redis.Cmdable) cache.SuppressionCache {
+	return &suppressionCache{client: client, logger: elog.DefaultLogger}
+}
+
+func (s *suppressionCache) Get(ctx context.Context, bizID int64, receivers []string) (map[string][]domain.Suppression, error) {
+	result := make(map[string][]domain.Suppression, len(receivers))
+	if len(receivers) == 0 {
+		return result, nil
+	}
+	keys := make([]string, len(receivers))
+	for i := range receivers {
+		keys[i] = cache.SuppressionKey(bizID, receivers[i])
+	}
+	vals, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis执行MGET失败: %w", err)
+	}
+	for i, val := range vals {
+		strVal, ok := val.(string)
+		if !ok {
+			// 这个键不存在，跳过
+			continue
+		}
+		var suppressions []domain.Suppression
+		if err := json.Unmarshal([]byte(strVal), &suppressions); err != nil {
+			// 当成没有命中缓存，重新从数据库加载
+			s.logger.Error("从redis序列化数据失败", elog.FieldErr(err), elog.String("key", keys[i]))
+			continue
+		}
+		result[receivers[i]] = suppressions
+	}
+	return result, nil
+}
+
+func (s *suppressionCache) Set(ctx context.Context, bizID int64, suppressions map[string][]domain.Suppression) error {
+	if len(suppressions) == 0 {
+		return nil
+	}
+	pipe := s.client.Pipeline()
+	for receiver, found := range suppressions {
+		if found == nil {
+			found = []domain.Suppression{}
+		}
+		data, err := json.Marshal(found)
+		if err != nil {
+			return fmt.Errorf("序列化退订记录失败 %w", err)
+		}
+		pipe.Set(ctx, cache.SuppressionKey(bizID, receiver), data, cache.DefaultExpiredTime)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *suppressionCache) Del(ctx context.Context, bizID int64, receiver string) error {
+	return s.client.Del(ctx, cache.SuppressionKey(bizID, receiver)).Err()
+}
+
+func (s *suppressionCache) IncrReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string, window time.Duration) (int64, error) {
+	key := cache.ReceiverFaultsKey(bizID, channel, receiver)
+	cnt, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("redis执行INCR失败: %w", err)
+	}
+	if cnt == 1 {
+		if err = s.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, fmt.Errorf("redis执行EXPIRE失败: %w", err)
+		}
+	}
+	return cnt, nil
+}
+
+func (s *suppressionCache) DelReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string) error {
+	return s.client.Del(ctx, cache.ReceiverFaultsKey(bizID, channel, receiver)).Err()
+}
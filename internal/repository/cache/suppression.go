@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+const SuppressionPrefix = "suppression"
+
+type SuppressionCache interface {
+	// Get 返回命中缓存的接收者的退订记录，没有退订的接收者对应空切片，没有命中缓存的接收者不在结果中
+	Get(ctx context.Context, bizID int64, receivers []string) (map[string][]domain.Suppression, error)
+	// Set 缓存接收者的退订记录，没有退订的接收者也要缓存空切片，避免每次都查询数据库
+	Set(ctx context.Context, bizID int64, suppressions map[string][]domain.Suppression) error
+	Del(ctx context.Context, bizID int64, receiver string) error
+	// IncrReceiverFaults 接收者在渠道上因为自身问题发送失败的次数加一，返回统计窗口内的次数，
+	// 第一次失败时开始计算窗口
+	IncrReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string, window time.Duration) (int64, error)
+	// DelReceiverFaults 清空接收者在渠道上发送失败的次数
+	DelReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string) error
+}
+
+func SuppressionKey(bizID int64, receiver string) string {
+	return fmt.Sprintf("%s:%d:%s", SuppressionPrefix, bizID, receiver)
+}
+
+func ReceiverFaultsKey(bizID int64, channel domain.Channel, receiver string) string {
+	return fmt.Sprintf("%s:receiver_faults:%d:%s:%s", SuppressionPrefix, bizID, channel, receiver)
+}
@@ -336,6 +336,12 @@ func (b *businessConfigRepository) toDomain(config dao.BusinessConfig) domain.Bu
 	if config.CallbackConfig.Valid {
 		domainCfg.CallbackConfig = &config.CallbackConfig.Val
 	}
+	if config.QuietHours.Valid {
+		domainCfg.QuietHours = &config.QuietHours.Val
+	}
+	if config.FrequencyCap.Valid {
+		domainCfg.FrequencyCap = &config.FrequencyCap.Val
+	}
 	return domainCfg
 }
 
@@ -377,5 +383,19 @@ func (b *businessConfigRepository) toEntity(config domain.BusinessConfig) dao.Bu
 		}
 	}
 
+	if config.QuietHours != nil {
+		businessConfig.QuietHours = sqlx.JSONColumn[domain.QuietHours]{
+			Val:   *config.QuietHours,
+			Valid: true,
+		}
+	}
+
+	if config.FrequencyCap != nil {
+		businessConfig.FrequencyCap = sqlx.JSONColumn[domain.FrequencyCapConfig]{
+			Val:   *config.FrequencyCap,
+			Valid: true,
+		}
+	}
+
 	return businessConfig
 }
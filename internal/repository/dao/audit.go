@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+)
+
+// Audit 审核记录表
+type Audit struct {
+	ID           int64  `gorm:"primaryKey;autoIncrement;comment:'审核记录ID'"`
+	ResourceID   int64  `gorm:"type:BIGINT;NOT NULL;index:idx_resource,priority:1;comment:'资源ID，模版审核时为模版版本ID'"`
+	ResourceType string `gorm:"type:VARCHAR(32);NOT NULL;index:idx_resource,priority:2;comment:'资源类型'"`
+	Content      string `gorm:"type:TEXT;NOT NULL;comment:'审核内容，JSON串'"`
+	ReviewerID   int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;index:idx_reviewer_status,priority:1;comment:'分配的审核人ID，0表示没有分配'"`
+	Status       string `gorm:"type:ENUM('IN_REVIEW','REJECTED','APPROVED');NOT NULL;DEFAULT:'IN_REVIEW';index:idx_reviewer_status,priority:2;comment:'审核状态'"`
+	RejectReason string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'拒绝原因'"`
+	AuditTime    int64  `gorm:"NOT NULL;DEFAULT:0;comment:'审核时间'"`
+	Ctime        int64
+	Utime        int64
+}
+
+// AuditComment 审核意见表
+type AuditComment struct {
+	ID         int64  `gorm:"primaryKey;autoIncrement"`
+	AuditID    int64  `gorm:"type:BIGINT;NOT NULL;index:idx_audit_id;comment:'审核记录ID'"`
+	ReviewerID int64  `gorm:"type:BIGINT;NOT NULL;comment:'审核人ID'"`
+	Content    string `gorm:"type:VARCHAR(1024);NOT NULL;comment:'审核意见'"`
+	Ctime      int64
+}
+
+type AuditDAO interface {
+	Create(ctx context.Context, data Audit) (Audit, error)
+	// GetByID 记录不存在时返回 errs.ErrAuditNotFound
+	GetByID(ctx context.Context, id int64) (Audit, error)
+	// FindByReviewer 按时间倒序分页查询分配给审核人的记录，status 为空时查询所有状态，同时返回符合条件的总数
+	FindByReviewer(ctx context.Context, reviewerID int64, status string, offset, limit int) ([]Audit, int64, error)
+	// CountInReviewByReviewers 统计每个审核人手上审核中的记录数，没有记录的审核人不在结果中
+	CountInReviewByReviewers(ctx context.Context, reviewerIDs []int64) (map[int64]int64, error)
+	// UpdateReviewer 重新分配审核人，只能修改审核中的记录
+	UpdateReviewer(ctx context.Context, id, reviewerID int64) error
+	// Decide 保存审核结果，comment 不为空时一起保存审核意见。
+	// 记录必须是审核中，并且分配给了该审核人或者没有分配，否则返回 errs.ErrAuditAlreadyDecided
+	Decide(ctx context.Context, data Audit, comment *AuditComment) error
+	CreateComment(ctx context.Context, data AuditComment) (AuditComment, error)
+	// FindComments 按时间正序查询审核意见
+	FindComments(ctx context.Context, auditID int64) ([]AuditComment, error)
+}
+
+type auditDAO struct {
+	db *egorm.Component
+}
+
+func NewAuditDAO(db *egorm.Component) AuditDAO {
+	return &auditDAO{db: db}
+}
+
+func (d *auditDAO) Create(ctx context.Context, data Audit) (Audit, error) {
+	now := time.Now().Unix()
+	data.Ctime, data.Utime = now, now
+	err := d.db.WithContext(ctx).Create(&data).Error
+	return data, err
+}
+
+func (d *auditDAO) GetByID(ctx context.Context, id int64) (Audit, error) {
+	var res Audit
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Audit{}, fmt.Errorf("%w: 审核记录ID %d", errs.ErrAuditNotFound, id)
+	}
+	return res, err
+}
+
+func (d *auditDAO) FindByReviewer(ctx context.Context, reviewerID int64, status string, offset, limit int) ([]Audit, int64, error) {
+	query := d.db.WithContext(ctx).Model(&Audit{}).Where("reviewer_id = ?", reviewerID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var res []Audit
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&res).Error
+	return res, total, err
+}
+
+func (d *auditDAO) CountInReviewByReviewers(ctx context.Context, reviewerIDs []int64) (map[int64]int64, error) {
+	type reviewerCount struct {
+		ReviewerID int64
+		Cnt        int64
+	}
+	var counts []reviewerCount
+	err := d.db.WithContext(ctx).Model(&Audit{}).
+		Select("reviewer_id, COUNT(*) AS cnt").
+		Where("reviewer_id IN ? AND status = ?", reviewerIDs, domain.AuditStatusInReview.String()).
+		Group("reviewer_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]int64, len(counts))
+	for _, c := range counts {
+		res[c.ReviewerID] = c.Cnt
+	}
+	return res, nil
+}
+
+func (d *auditDAO) UpdateReviewer(ctx context.Context, id, reviewerID int64) error {
+	res := d.db.WithContext(ctx).Model(&Audit{}).
+		Where("id = ? AND status = ?", id, domain.AuditStatusInReview.String()).
+		Updates(map[string]any{
+			"reviewer_id": reviewerID,
+			"utime":       time.Now().Unix(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return fmt.Errorf("%w: 审核记录ID %d", errs.ErrAuditAlreadyDecided, id)
+	}
+	return nil
+}
+
+func (d *auditDAO) Decide(ctx context.Context, data Audit, comment *AuditComment) error {
+	now := time.Now().Unix()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 用状态做 CAS，避免两个审核人同时审核
+		res := tx.Model(&Audit{}).
+			Where("id = ? AND status = ? AND reviewer_id IN ?",
+				data.ID, domain.AuditStatusInReview.String(), []int64{0, data.ReviewerID}).
+			Updates(map[string]any{
+				"reviewer_id":   data.ReviewerID,
+				"status":        data.Status,
+				"reject_reason": data.RejectReason,
+				"audit_time":    data.AuditTime,
+				"utime":         now,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < 1 {
+			return fmt.Errorf("%w: 审核记录ID %d", errs.ErrAuditAlreadyDecided, data.ID)
+		}
+		if comment == nil {
+			return nil
+		}
+		comment.Ctime = now
+		return tx.Create(comment).Error
+	})
+}
+
+func (d *auditDAO) CreateComment(ctx context.Context, data AuditComment) (AuditComment, error) {
+	data.Ctime = time.Now().Unix()
+	err := d.db.WithContext(ctx).Create(&data).Error
+	return data, err
+}
+
+func (d *auditDAO) FindComments(ctx context.Context, auditID int64) ([]AuditComment, error) {
+	var res []AuditComment
+	err := d.db.WithContext(ctx).
+		Where("audit_id = ?", auditID).
+		Order("id ASC").
+		Find(&res).Error
+	return res, err
+}
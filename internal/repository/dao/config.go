@@ -12,14 +12,16 @@ import (
 
 // BusinessConfig 业务配置表
 type BusinessConfig struct {
-	ID             int64                                  `gorm:"primaryKey;type:BIGINT;comment:'业务标识'"`
-	OwnerID        int64                                  `gorm:"type:BIGINT;comment:'业务方'"`
-	OwnerType      string                                 `gorm:"type:ENUM('person', 'organization');comment:'业务方类型：person-个人,organization-组织'"`
-	ChannelConfig  sqlx.JSONColumn[domain.ChannelConfig]  `gorm:"type:JSON;comment:'{\"channels\":[{\"channel\":\"SMS\", \"priority\":\"1\",\"enabled\":\"true\"},{\"channel\":\"EMAIL\", \"priority\":\"2\",\"enabled\":\"true\"}]}'"`
-	TxnConfig      sqlx.JSONColumn[domain.TxnConfig]      `gorm:"type:JSON;comment:'事务配置'"`
-	RateLimit      int                                    `gorm:"type:INT;DEFAULT:1000;comment:'每秒最大请求数'"`
-	Quota          sqlx.JSONColumn[domain.QuotaConfig]    `gorm:"type:JSON;comment:'{\"monthly\":{\"SMS\":100000,\"EMAIL\":500000}}'"`
-	CallbackConfig sqlx.JSONColumn[domain.CallbackConfig] `gorm:"type:JSON;comment:'回调配置，通知平台回调业务方通知异步请求结果'"`
+	ID             int64                                      `gorm:"primaryKey;type:BIGINT;comment:'业务标识'"`
+	OwnerID        int64                                      `gorm:"type:BIGINT;comment:'业务方'"`
+	OwnerType      string                                     `gorm:"type:ENUM('person', 'organization');comment:'业务方类型：person-个人,organization-组织'"`
+	ChannelConfig  sqlx.JSONColumn[domain.ChannelConfig]      `gorm:"type:JSON;comment:'{\"channels\":[{\"channel\":\"SMS\", \"priority\":\"1\",\"enabled\":\"true\"},{\"channel\":\"EMAIL\", \"priority\":\"2\",\"enabled\":\"true\"}]}'"`
+	TxnConfig      sqlx.JSONColumn[domain.TxnConfig]          `gorm:"type:JSON;comment:'事务配置'"`
+	RateLimit      int                                        `gorm:"type:INT;DEFAULT:1000;comment:'每秒最大请求数'"`
+	Quota          sqlx.JSONColumn[domain.QuotaConfig]        `gorm:"type:JSON;comment:'{\"monthly\":{\"SMS\":100000,\"EMAIL\":500000}}'"`
+	CallbackConfig sqlx.JSONColumn[domain.CallbackConfig]     `gorm:"type:JSON;comment:'回调配置，通知平台回调业务方通知异步请求结果'"`
+	QuietHours     sqlx.JSONColumn[domain.QuietHours]         `gorm:"type:JSON;comment:'免打扰配置，{\"timezone\":\"Asia/Shanghai\",\"intervals\":[{\"start\":\"22:00\",\"end\":\"08:00\"}],\"businessTypes\":[1]}'"`
+	FrequencyCap   sqlx.JSONColumn[domain.FrequencyCapConfig] `gorm:"type:JSON;comment:'接收者发送频率上限，{\"rules\":[{\"channel\":\"SMS\",\"businessType\":1,\"limit\":3,\"windowSeconds\":86400,\"action\":\"DROP\"}]}'"`
 	Ctime          int64
 	Utime          int64
 }
@@ -112,6 +114,8 @@ func (b *businessConfigDAO) SaveConfig(ctx context.Context, config BusinessConfi
 			"rate_limit",
 			"quota",
 			"callback_config",
+			"quiet_hours",
+			"frequency_cap",
 			"utime",
 		}), // 只更新指定的非空列
 	}).Create(&config)
@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+)
+
+type DeliveryReceiptDAO interface {
+	// FindAwaiting 按ID升序查询已被供应商受理、还在等待回执的接收者发送结果，
+	// 只查询 ID 大于 startID 且发送时间在 [stime, etime] 内的记录
+	FindAwaiting(ctx context.Context, providers []string, startID uint64, stime, etime int64, limit int) ([]NotificationReceiverResult, error)
+	// FindByMessageIDs 根据供应商以及供应商返回的消息ID查询接收者发送结果
+	FindByMessageIDs(ctx context.Context, provider string, messageIDs []string) ([]NotificationReceiverResult, error)
+	// SaveReceipts 保存回执结果，只会更新还在等待回执的记录。
+	// 所有接收者都拿到回执的通知会更新为最终状态，并把回调记录重新标记为待回调，返回这些通知的ID
+	SaveReceipts(ctx context.Context, results []NotificationReceiverResult) ([]uint64, error)
+}
+
+type deliveryReceiptDAO struct {
+	db *egorm.Component
+}
+
+func NewDeliveryReceiptDAO(db *egorm.Component) DeliveryReceiptDAO {
+	return &deliveryReceiptDAO{db: db}
+}
+
+func (d *deliveryReceiptDAO) FindAwaiting(ctx context.Context, providers []string, startID uint64, stime, etime int64, limit int) ([]NotificationReceiverResult, error) {
+	var results []NotificationReceiverResult
+	if len(providers) == 0 {
+		return results, nil
+	}
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND id > ? AND provider IN ? AND message_id != '' AND utime BETWEEN ? AND ?",
+			domain.SendStatusSucceeded.String(), startID, providers, stime, etime).
+		Order("id ASC").
+		Limit(limit).
+		Find(&results).Error
+	return results, err
+}
+
+func (d *deliveryReceiptDAO) FindByMessageIDs(ctx context.Context, provider string, messageIDs []string) ([]NotificationReceiverResult, error) {
+	var results []NotificationReceiverResult
+	if len(messageIDs) == 0 {
+		return results, nil
+	}
+	err := d.db.WithContext(ctx).
+		Where("provider = ? AND message_id IN ?", provider, messageIDs).
+		Find(&results).Error
+	return results, err
+}
+
+func (d *deliveryReceiptDAO) SaveReceipts(ctx context.Context, results []NotificationReceiverResult) ([]uint64, error) {
+	if len(results) == 0 {
+		return nil, nil
+	}
+	var finalized []uint64
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		var notificationIDs []uint64
+		for i := range results {
+			res := tx.Model(&NotificationReceiverResult{}).
+				Where("id = ? AND status = ?", results[i].ID, domain.SendStatusSucceeded.String()).
+				Updates(map[string]any{
+					"status":  results[i].Status,
+					"code":    results[i].Code,
+					"message": results[i].Message,
+					"utime":   now,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			// 重复的回执不会更新任何记录
+			if res.RowsAffected > 0 && !slice.Contains(notificationIDs, results[i].NotificationID) {
+				notificationIDs = append(notificationIDs, results[i].NotificationID)
+			}
+		}
+		if len(notificationIDs) == 0 {
+			return nil
+		}
+
+		var all []NotificationReceiverResult
+		err := tx.Where("notification_id IN ?", notificationIDs).Find(&all).Error
+		if err != nil {
+			return err
+		}
+		resultsMap := make(map[uint64][]domain.ReceiverResult, len(notificationIDs))
+		for i := range all {
+			resultsMap[all[i].NotificationID] = append(resultsMap[all[i].NotificationID], domain.ReceiverResult{
+				Receiver: all[i].Receiver,
+				Status:   domain.SendStatus(all[i].Status),
+			})
+		}
+
+		for _, id := range notificationIDs {
+			status, final := domain.AggregateDeliveryStatus(resultsMap[id])
+			if !final {
+				continue
+			}
+			ok, err1 := d.finalize(tx, id, status, now)
+			if err1 != nil {
+				return err1
+			}
+			if ok {
+				finalized = append(finalized, id)
+			}
+		}
+		return nil
+	})
+	return finalized, err
+}
+
+// finalize 把通知更新为最终状态，并把回调记录重新标记为待回调
+func (d *deliveryReceiptDAO) finalize(tx *gorm.DB, notificationID uint64, status domain.SendStatus, now int64) (bool, error) {
+	res := tx.Model(&Notification{}).
+		Where("id = ? AND status IN ?", notificationID, []string{
+			domain.SendStatusSucceeded.String(),
+			domain.SendStatusPartialSuccess.String(),
+		}).
+		Updates(map[string]any{
+			"status":  status.String(),
+			"version": gorm.Expr("version + 1"),
+			"utime":   now,
+		})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return false, res.Error
+	}
+	err := tx.Model(&CallbackLog{}).
+		Where("notification_id = ?", notificationID).
+		Updates(map[string]any{
+			"status":          domain.CallbackLogStatusPending.String(),
+			"retry_count":     0,
+			"next_retry_time": now,
+			"utime":           now,
+		}).Error
+	return err == nil, err
+}
@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InboxMessage 站内信表
+type InboxMessage struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement;comment:'站内信ID'"`
+	BizID          int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_receiver_status,priority:1;comment:'业务配表ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;index:idx_biz_id_receiver_status,priority:2;uniqueIndex:idx_notification_id_receiver,priority:2;comment:'接收者，一般是用户ID'"`
+	NotificationID uint64 `gorm:"NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'通知ID'"`
+	Title          string `gorm:"type:VARCHAR(256);NOT NULL;comment:'标题'"`
+	Content        string `gorm:"type:TEXT;NOT NULL;comment:'渲染后的内容'"`
+	Status         string `gorm:"type:ENUM('UNREAD','READ');NOT NULL;DEFAULT:'UNREAD';index:idx_biz_id_receiver_status,priority:3;comment:'状态'"`
+	ReadTime       int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'已读时间'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表
+func (InboxMessage) TableName() string {
+	return "inbox_messages"
+}
+
+type InboxDAO interface {
+	// BatchCreate 批量创建站内信，同一通知同一接收者已存在时忽略
+	BatchCreate(ctx context.Context, msgs []InboxMessage) error
+	// Find 分页查询接收者的站内信，按ID倒序，status 为空时查询全部
+	Find(ctx context.Context, bizID int64, receiver, status string, offset, limit int) ([]InboxMessage, error)
+	// Count 统计接收者的站内信数量，status 为空时统计全部
+	Count(ctx context.Context, bizID int64, receiver, status string) (int64, error)
+	// UpdateStatus 更新站内信状态，ids 为空时更新接收者的全部站内信
+	UpdateStatus(ctx context.Context, bizID int64, receiver string, ids []uint64, status string) error
+	// Delete 删除接收者的站内信
+	Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error
+}
+
+type inboxDAO struct {
+	db *egorm.Component
+}
+
+func NewInboxDAO(db *egorm.Component) InboxDAO {
+	return &inboxDAO{db: db}
+}
+
+func (d *inboxDAO) BatchCreate(ctx context.Context, msgs []InboxMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	const batchSize = 100
+	now := time.Now().UnixMilli()
+	for i := range msgs {
+		msgs[i].Ctime, msgs[i].Utime = now, now
+		if msgs[i].Status == "" {
+			msgs[i].Status = domain.InboxMessageStatusUnread.String()
+		}
+	}
+	return d.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(msgs, batchSize).Error
+}
+
+func (d *inboxDAO) Find(ctx context.Context, bizID int64, receiver, status string, offset, limit int) ([]InboxMessage, error) {
+	var msgs []InboxMessage
+	err := d.whereReceiver(ctx, bizID, receiver, status).
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&msgs).Error
+	return msgs, err
+}
+
+func (d *inboxDAO) Count(ctx context.Context, bizID int64, receiver, status string) (int64, error) {
+	var cnt int64
+	err := d.whereReceiver(ctx, bizID, receiver, status).Count(&cnt).Error
+	return cnt, err
+}
+
+func (d *inboxDAO) whereReceiver(ctx context.Context, bizID int64, receiver, status string) *gorm.DB {
+	db := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Where("biz_id = ? AND receiver = ?", bizID, receiver)
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+	return db
+}
+
+func (d *inboxDAO) UpdateStatus(ctx context.Context, bizID int64, receiver string, ids []uint64, status string) error {
+	now := time.Now().UnixMilli()
+	updates := map[string]any{
+		"status": status,
+		"utime":  now,
+	}
+	if status == domain.InboxMessageStatusRead.String() {
+		updates["read_time"] = now
+	} else {
+		updates["read_time"] = 0
+	}
+	db := d.db.WithContext(ctx).Model(&InboxMessage{}).
+		Where("biz_id = ? AND receiver = ? AND status <> ?", bizID, receiver, status)
+	if len(ids) > 0 {
+		db = db.Where("id IN ?", ids)
+	}
+	return db.Updates(updates).Error
+}
+
+func (d *inboxDAO) Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error {
+	return d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver = ? AND id IN ?", bizID, receiver, ids).
+		Delete(&InboxMessage{}).Error
+}
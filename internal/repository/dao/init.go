@@ -12,6 +12,16 @@ func InitTables(db *egorm.Component) error {
 		&ChannelTemplate{},
 		&ChannelTemplateVersion{},
 		&ChannelTemplateProvider{},
+		&ChannelTemplateLocale{},
+		&ChannelTemplatePublishRecord{},
 		&Quota{},
+		&InboxMessage{},
+		&NotificationReceiverResult{},
+		&QuotaLedger{},
+		&NotificationSendAttempt{},
+		&RecurringNotification{},
+		&Suppression{},
+		&Audit{},
+		&AuditComment{},
 	)
 }
@@ -7,14 +7,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/ecodeclub/ekit/slice"
-
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 
 	"github.com/ego-component/egorm"
 	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type NotificationDAO interface {
@@ -42,16 +41,38 @@ type NotificationDAO interface {
 	// CASStatus 更新通知状态
 	CASStatus(ctx context.Context, notification Notification) error
 	UpdateStatus(ctx context.Context, notification Notification) error
+	// CASUpdate 修改 PENDING 通知的接收者、模版参数和计划发送时间，使用乐观锁控制并发
+	CASUpdate(ctx context.Context, notification Notification) error
+	// ClaimSending 发送前把 PENDING 或者 RETRYING 的通知 CAS 为 SENDING，
+	// 版本号不匹配或者状态已经改变时返回 errs.ErrNotificationVersionMismatch
+	ClaimSending(ctx context.Context, notification Notification) error
 
 	// BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败，使用乐观锁控制并发
 	// successNotifications: 更新为成功状态的通知列表，包含ID、Version和重试次数
 	// failedNotifications: 更新为失败状态的通知列表，包含ID、Version和重试次数
-	BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) error
+	// 只更新 SENDING 的通知，返回实际标记为失败的通知ID，只有这些通知需要归还额度
+	BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) (failedIDs []uint64, err error)
 
 	FindReadyNotifications(ctx context.Context, offset, limit int) ([]Notification, error)
+	// MarkSuccess 和 MarkFailed 只更新 SENDING 的通知，通知不是 SENDING 时返回 errs.ErrNotificationVersionMismatch
 	MarkSuccess(ctx context.Context, entity Notification) error
 	MarkFailed(ctx context.Context, entity Notification) error
-	MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error)
+	// MarkTimeoutSendingAsFailed 将超时的 SENDING 通知标记为失败，返回被标记的通知，只包含 ID、BizID 和 Channel，用于归还额度
+	MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) ([]Notification, error)
+	// MarkRetrying 发送失败但还可以重试，更新重试次数和下一次重试时间，并记录这一次发送尝试。
+	// 只更新 SENDING 或者 RETRYING 的通知
+	MarkRetrying(ctx context.Context, entity Notification) error
+	// FindRetryNotifications 查询已经到了重试时间的 RETRYING 通知
+	FindRetryNotifications(ctx context.Context, limit int) ([]Notification, error)
+	// MarkDeferred 把 SENDING 的通知重新放回 PENDING 状态并修改计划发送时间
+	MarkDeferred(ctx context.Context, entity Notification) error
+
+	// FindReceiverResults 查询通知中每个接收者的发送结果，键为通知ID
+	FindReceiverResults(ctx context.Context, notificationIDs []uint64) (map[uint64][]NotificationReceiverResult, error)
+	// FindSendAttempts 查询通知每一次失败的发送尝试，键为通知ID
+	FindSendAttempts(ctx context.Context, notificationIDs []uint64) (map[uint64][]NotificationSendAttempt, error)
+	// CountByTemplateVersion 统计 [startTime, endTime) 内创建的通知中，模版每个版本各个状态的通知数，键为版本ID和状态
+	CountByTemplateVersion(ctx context.Context, templateID, startTime, endTime int64) (map[int64]map[string]int64, error)
 }
 
 // Notification 通知记录表
@@ -61,15 +82,42 @@ type Notification struct {
 	Key               string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，区分同一个业务内的不同通知'"`
 	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
 	Channel           string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'发送渠道'"`
-	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板ID'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;index:idx_template_id_ctime,priority:1;comment:'模板ID'"`
 	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板版本ID'"`
 	TemplateParams    string `gorm:"NOT NULL;comment:'模版参数'"`
-	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_scheduled,priority:3;comment:'发送状态'"`
+	Status            string `gorm:"type:ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','PARTIAL_SUCCESS','DELIVERED','UNDELIVERED','RETRYING','SUPPRESSED');DEFAULT:'PENDING';index:idx_biz_id_status,priority:2;index:idx_scheduled,priority:3;index:idx_status_next_retry_time,priority:1;comment:'发送状态'"`
 	ScheduledSTime    int64  `gorm:"column:scheduled_stime;index:idx_scheduled,priority:1;comment:'计划发送开始时间'"`
 	ScheduledETime    int64  `gorm:"column:scheduled_etime;index:idx_scheduled,priority:2;comment:'计划发送结束时间'"`
 	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号，用于CAS操作'"`
-	Ctime             int64
+	SentChannel       string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';comment:'实际发送成功的渠道，跨渠道降级时与发送渠道不同'"`
+	RetryCount        int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'已经重试的次数'"`
+	NextRetryTime     int64  `gorm:"NOT NULL;DEFAULT:0;index:idx_status_next_retry_time,priority:2;comment:'下一次重试的时间，毫秒'"`
+	ErrorCode         string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'';comment:'最近一次发送失败的错误码，发送成功时为空'"`
+	ErrorMessage      string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'最近一次发送失败的原因'"`
+	Locale            string `gorm:"type:VARCHAR(35);NOT NULL;DEFAULT:'';comment:'发送使用的语言，为空时使用模版的默认内容'"`
+	ReceiverLocales   string `gorm:"type:TEXT;comment:'单独指定语言的接收者，JSON对象，键为接收者'"`
+	FailoverReceivers string `gorm:"type:TEXT;comment:'跨渠道降级时目标渠道使用的接收者，JSON对象，键为渠道'"`
+	Ctime             int64  `gorm:"index:idx_template_id_ctime,priority:2"`
 	Utime             int64
+
+	// ReceiverResults 每个接收者的发送结果，存储在 notification_receiver_results 表中
+	ReceiverResults []NotificationReceiverResult `gorm:"-"`
+	// Attempts 失败的发送尝试，存储在 notification_send_attempts 表中
+	Attempts []NotificationSendAttempt `gorm:"-"`
+}
+
+// SendingTimeout SENDING 的通知超过这个时间没有更新就标记为失败
+const SendingTimeout = time.Minute
+
+// TimeoutFailedUpdates 超时的 SENDING 通知标记为失败时更新的字段
+func TimeoutFailedUpdates(now time.Time) map[string]any {
+	return map[string]any{
+		"status":        domain.SendStatusFailed.String(),
+		"error_code":    domain.SendErrorCodeSendingTimeout.String(),
+		"error_message": "发送超时，没有得到发送结果",
+		"version":       gorm.Expr("version + 1"),
+		"utime":         now.UnixMilli(),
+	}
 }
 
 // CheckErrIsIDDuplicate 判断是否是主键冲突
@@ -77,6 +125,25 @@ func CheckErrIsIDDuplicate(id uint64, err error) bool {
 	return strings.Contains(err.Error(), fmt.Sprintf("%d", id))
 }
 
+var (
+	// ClaimableStatuses 可以开始发送的通知状态
+	ClaimableStatuses = []string{domain.SendStatusPending.String(), domain.SendStatusRetrying.String()}
+	// RetryableStatuses 可以标记为等待重试的通知状态，等待重试的通知也会因为免打扰推迟重试时间
+	RetryableStatuses = []string{domain.SendStatusSending.String(), domain.SendStatusRetrying.String()}
+)
+
+// CheckSendingUpdated 发送结果只更新已经开始发送的通知，没有更新到说明通知已经不在发送中，
+// 比如发送超时已经被标记为失败
+func CheckSendingUpdated(result *gorm.DB, id uint64) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected < 1 {
+		return fmt.Errorf("通知不在发送中 %w, id %d", errs.ErrNotificationVersionMismatch, id)
+	}
+	return nil
+}
+
 type notificationDAO struct {
 	db *egorm.Component
 
@@ -169,6 +236,10 @@ func (d *notificationDAO) create(ctx context.Context, db *gorm.DB, data Notifica
 			}
 			return err
 		}
+		// 创建时就有的发送结果是退订的接收者
+		if err := saveReceiverResults(tx, data); err != nil {
+			return err
+		}
 		if createCallbackLog {
 			if err := tx.Create(&CallbackLog{
 				NotificationID: data.ID,
@@ -229,6 +300,9 @@ func (d *notificationDAO) batchCreate(ctx context.Context, datas []Notification,
 			}
 			return err
 		}
+		if err := saveReceiverResults(tx, datas...); err != nil {
+			return err
+		}
 
 		if createCallbackLog {
 			// 创建回调记录
@@ -318,6 +392,46 @@ func (d *notificationDAO) CASStatus(ctx context.Context, notification Notificati
 	return nil
 }
 
+func (d *notificationDAO) CASUpdate(ctx context.Context, notification Notification) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusPending.String()).
+			Updates(map[string]any{
+				"receivers":       notification.Receivers,
+				"template_params": notification.TemplateParams,
+				"scheduled_stime": notification.ScheduledSTime,
+				"scheduled_etime": notification.ScheduledETime,
+				"version":         gorm.Expr("version + 1"),
+				"utime":           time.Now().UnixMilli(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected < 1 {
+			return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+		}
+		// 修改接收者时过滤掉的退订接收者
+		return saveReceiverResults(tx, notification)
+	})
+}
+
+func (d *notificationDAO) ClaimSending(ctx context.Context, notification Notification) error {
+	result := d.db.WithContext(ctx).Model(&Notification{}).
+		Where("id = ? AND version = ? AND status IN ?", notification.ID, notification.Version, ClaimableStatuses).
+		Updates(map[string]any{
+			"status":  domain.SendStatusSending.String(),
+			"version": gorm.Expr("version + 1"),
+			"utime":   time.Now().UnixMilli(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected < 1 {
+		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+	}
+	return nil
+}
+
 func (d *notificationDAO) UpdateStatus(ctx context.Context, notification Notification) error {
 	return d.db.WithContext(ctx).Model(&Notification{}).
 		Where("id = ?", notification.ID).
@@ -331,53 +445,126 @@ func (d *notificationDAO) UpdateStatus(ctx context.Context, notification Notific
 // BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败，使用乐观锁控制并发
 // successNotifications: 更新为成功状态的通知列表，包含ID、Version和重试次数
 // failedNotifications: 更新为失败状态的通知列表，包含ID、Version和重试次数
-func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) error {
+func (d *notificationDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []Notification) ([]uint64, error) {
 	if len(successNotifications) == 0 && len(failedNotifications) == 0 {
-		return nil
+		return nil, nil
 	}
 
-	successIDs := slice.Map(successNotifications, func(_ int, src Notification) uint64 {
-		return src.ID
-	})
-
-	failedIDs := slice.Map(failedNotifications, func(_ int, src Notification) uint64 {
-		return src.ID
-	})
-
+	var failedIDs []uint64
 	// 开启事务
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if len(successIDs) != 0 {
-			err := d.batchMarkSuccess(tx, successIDs)
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(successNotifications) != 0 {
+			err := d.batchMarkSuccess(tx, successNotifications)
 			if err != nil {
 				return err
 			}
 		}
 
-		if len(failedIDs) != 0 {
-			now := time.Now().Unix()
-			return tx.Model(&Notification{}).
-				Where("id IN ?", failedIDs).
-				Updates(map[string]any{
-					"version": gorm.Expr("version + 1"),
-					"utime":   now,
-					"status":  domain.SendStatusFailed.String(),
-				}).Error
+		if len(failedNotifications) != 0 {
+			var err error
+			failedIDs, err = d.batchMarkFailed(tx, failedNotifications)
+			if err != nil {
+				return err
+			}
 		}
-		return nil
+		if err := saveReceiverResults(tx, successNotifications...); err != nil {
+			return err
+		}
+		if err := saveReceiverResults(tx, failedNotifications...); err != nil {
+			return err
+		}
+		return saveSendAttempts(tx, failedNotifications...)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return failedIDs, nil
 }
 
-func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successIDs []uint64) error {
+// batchMarkFailed 批量标记为发送失败，按错误码和错误原因分组更新，返回实际更新的通知ID。
+// 先锁住还是 SENDING 的通知，已经被超时任务或者其他请求改掉的通知不更新
+func (d *notificationDAO) batchMarkFailed(tx *gorm.DB, failedNotifications []Notification) ([]uint64, error) {
 	now := time.Now().Unix()
+	ids := make([]uint64, 0, len(failedNotifications))
+	for i := range failedNotifications {
+		ids = append(ids, failedNotifications[i].ID)
+	}
+	var sendingIDs []uint64
 	err := tx.Model(&Notification{}).
-		Where("id IN ?", successIDs).
-		Updates(map[string]any{
-			"version": gorm.Expr("version + 1"),
-			"utime":   now,
-			"status":  domain.SendStatusSucceeded.String(),
-		}).Error
-	if err != nil {
-		return err
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ? AND status = ?", ids, domain.SendStatusSending.String()).
+		Pluck("id", &sendingIDs).Error
+	if err != nil || len(sendingIDs) == 0 {
+		return nil, err
+	}
+	sending := make(map[uint64]struct{}, len(sendingIDs))
+	for _, id := range sendingIDs {
+		sending[id] = struct{}{}
+	}
+
+	type group struct {
+		errorCode    string
+		errorMessage string
+	}
+	idsByGroup := make(map[group][]uint64, 1)
+	for i := range failedNotifications {
+		if _, ok := sending[failedNotifications[i].ID]; !ok {
+			continue
+		}
+		g := group{errorCode: failedNotifications[i].ErrorCode, errorMessage: failedNotifications[i].ErrorMessage}
+		idsByGroup[g] = append(idsByGroup[g], failedNotifications[i].ID)
+	}
+	for g, groupIDs := range idsByGroup {
+		err = tx.Model(&Notification{}).
+			Where("id IN ? AND status = ?", groupIDs, domain.SendStatusSending.String()).
+			Updates(map[string]any{
+				"version":         gorm.Expr("version + 1"),
+				"utime":           now,
+				"status":          domain.SendStatusFailed.String(),
+				"next_retry_time": 0,
+				"error_code":      g.errorCode,
+				"error_message":   g.errorMessage,
+			}).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sendingIDs, nil
+}
+
+// batchMarkSuccess 批量标记为发送成功，部分接收者发送成功的通知标记为 PARTIAL_SUCCESS
+func (d *notificationDAO) batchMarkSuccess(tx *gorm.DB, successNotifications []Notification) error {
+	now := time.Now().Unix()
+	// 按状态和实际发送渠道分组，同一组一起更新
+	type group struct {
+		status      string
+		sentChannel string
+	}
+	successIDs := make([]uint64, 0, len(successNotifications))
+	idsByGroup := make(map[group][]uint64, 2)
+	for i := range successNotifications {
+		status := successNotifications[i].Status
+		if status != domain.SendStatusPartialSuccess.String() {
+			status = domain.SendStatusSucceeded.String()
+		}
+		g := group{status: status, sentChannel: successNotifications[i].SentChannel}
+		successIDs = append(successIDs, successNotifications[i].ID)
+		idsByGroup[g] = append(idsByGroup[g], successNotifications[i].ID)
+	}
+	for g, ids := range idsByGroup {
+		err := tx.Model(&Notification{}).
+			Where("id IN ? AND status = ?", ids, domain.SendStatusSending.String()).
+			Updates(map[string]any{
+				"version":       gorm.Expr("version + 1"),
+				"utime":         now,
+				"status":        g.status,
+				"sent_channel":  g.sentChannel,
+				"error_code":    "",
+				"error_message": "",
+			}).Error
+		if err != nil {
+			return err
+		}
 	}
 
 	// 要更新 callback log 了
@@ -402,14 +589,20 @@ func (d *notificationDAO) FindReadyNotifications(ctx context.Context, offset, li
 func (d *notificationDAO) MarkSuccess(ctx context.Context, notification Notification) error {
 	now := time.Now().UnixMilli()
 	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Model(&Notification{}).
-			Where("id = ?", notification.ID).
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String()).
 			Updates(map[string]any{
-				"status":  notification.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
-			}).Error
-		if err != nil {
+				"status":        notification.Status,
+				"sent_channel":  notification.SentChannel,
+				"error_code":    "",
+				"error_message": "",
+				"utime":         now,
+				"version":       gorm.Expr("version + 1"),
+			})
+		if err := CheckSendingUpdated(result, notification.ID); err != nil {
+			return err
+		}
+		if err := saveReceiverResults(tx, notification); err != nil {
 			return err
 		}
 		// 要把 callback log 标记为可以发送了
@@ -446,52 +639,169 @@ func (d *notificationDAO) MarkFailedV1(ctx context.Context, notification Notific
 
 func (d *notificationDAO) MarkFailed(ctx context.Context, notification Notification) error {
 	now := time.Now().UnixMilli()
-	return d.db.WithContext(ctx).Model(&Notification{}).
-		Where("id = ?", notification.ID).
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String()).
+			Updates(map[string]any{
+				"status":          notification.Status,
+				"next_retry_time": 0,
+				"error_code":      notification.ErrorCode,
+				"error_message":   notification.ErrorMessage,
+				"utime":           now,
+				"version":         gorm.Expr("version + 1"),
+			})
+		if err := CheckSendingUpdated(result, notification.ID); err != nil {
+			return err
+		}
+		if err := saveReceiverResults(tx, notification); err != nil {
+			return err
+		}
+		return saveSendAttempts(tx, notification)
+	})
+}
+
+func (d *notificationDAO) MarkRetrying(ctx context.Context, notification Notification) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Notification{}).
+			Where("id = ? AND status IN ?", notification.ID, RetryableStatuses).
+			Updates(map[string]any{
+				"status":          domain.SendStatusRetrying.String(),
+				"retry_count":     notification.RetryCount,
+				"next_retry_time": notification.NextRetryTime,
+				"error_code":      notification.ErrorCode,
+				"error_message":   notification.ErrorMessage,
+				"utime":           now,
+				"version":         gorm.Expr("version + 1"),
+			})
+		if err := CheckSendingUpdated(result, notification.ID); err != nil {
+			return err
+		}
+		if err := saveReceiverResults(tx, notification); err != nil {
+			return err
+		}
+		return saveSendAttempts(tx, notification)
+	})
+}
+
+func (d *notificationDAO) MarkDeferred(ctx context.Context, notification Notification) error {
+	result := d.db.WithContext(ctx).Model(&Notification{}).
+		Where("id = ? AND status = ?", notification.ID, domain.SendStatusSending.String()).
 		Updates(map[string]any{
-			"status":  notification.Status,
-			"utime":   now,
-			"version": gorm.Expr("version + 1"),
-		}).Error
+			"status":          domain.SendStatusPending.String(),
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"utime":           time.Now().UnixMilli(),
+			"version":         gorm.Expr("version + 1"),
+		})
+	return CheckSendingUpdated(result, notification.ID)
+}
+
+func (d *notificationDAO) FindRetryNotifications(ctx context.Context, limit int) ([]Notification, error) {
+	var res []Notification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND next_retry_time <= ?", domain.SendStatusRetrying.String(), time.Now().UnixMilli()).
+		Order("next_retry_time ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
 }
 
-func (d *notificationDAO) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error) {
+func (d *notificationDAO) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) ([]Notification, error) {
 	now := time.Now()
-	ddl := now.Add(-time.Minute).UnixMilli()
-	var rowsAffected int64
+	ddl := now.Add(-SendingTimeout).UnixMilli()
+	var timeouts []Notification
 
 	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var idsToUpdate []uint64
-
-		// 查询需要更新的 ID
+		// 锁住查询到的通知，保证更新的就是查询到的这些，归还额度时不会多也不会少
 		err := tx.Model(&Notification{}).
-			Select("id").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "biz_id", "channel").
 			Where("status = ? AND utime <= ?", domain.SendStatusSending.String(), ddl).
 			Limit(batchSize).
-			Find(&idsToUpdate).Error
-
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			Find(&timeouts).Error
+		if err != nil {
 			return err
 		}
 
 		// 没有找到需要更新的记录，直接成功返回 (事务将提交)
-		if len(idsToUpdate) == 0 {
-			rowsAffected = 0
+		if len(timeouts) == 0 {
 			return nil
 		}
 
-		// 根据查询到的 ID 集合更新记录
-		res := tx.Model(&Notification{}).
-			Where("id IN ?", idsToUpdate).
-			Updates(map[string]any{
-				"status":  domain.SendStatusFailed.String(),
-				"version": gorm.Expr("version + 1"),
-				"utime":   now.UnixMilli(),
-			})
-
-		rowsAffected = res.RowsAffected
-		return res.Error
+		ids := make([]uint64, 0, len(timeouts))
+		for i := range timeouts {
+			ids = append(ids, timeouts[i].ID)
+		}
+		return tx.Model(&Notification{}).
+			Where("id IN ? AND status = ?", ids, domain.SendStatusSending.String()).
+			Updates(TimeoutFailedUpdates(now)).Error
 	})
+	if err != nil {
+		return nil, err
+	}
+	return timeouts, nil
+}
 
-	return rowsAffected, err
+func (d *notificationDAO) FindReceiverResults(ctx context.Context, notificationIDs []uint64) (map[uint64][]NotificationReceiverResult, error) {
+	res := make(map[uint64][]NotificationReceiverResult, len(notificationIDs))
+	if len(notificationIDs) == 0 {
+		return res, nil
+	}
+	var results []NotificationReceiverResult
+	err := d.db.WithContext(ctx).
+		Where("notification_id IN ?", notificationIDs).
+		Order("id ASC").
+		Find(&results).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		res[results[i].NotificationID] = append(res[results[i].NotificationID], results[i])
+	}
+	return res, nil
+}
+
+func (d *notificationDAO) FindSendAttempts(ctx context.Context, notificationIDs []uint64) (map[uint64][]NotificationSendAttempt, error) {
+	res := make(map[uint64][]NotificationSendAttempt, len(notificationIDs))
+	if len(notificationIDs) == 0 {
+		return res, nil
+	}
+	var attempts []NotificationSendAttempt
+	err := d.db.WithContext(ctx).
+		Where("notification_id IN ?", notificationIDs).
+		Order("attempt ASC").
+		Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+	for i := range attempts {
+		res[attempts[i].NotificationID] = append(res[attempts[i].NotificationID], attempts[i])
+	}
+	return res, nil
+}
+
+func (d *notificationDAO) CountByTemplateVersion(ctx context.Context, templateID, startTime, endTime int64) (map[int64]map[string]int64, error) {
+	type versionStatusCount struct {
+		TemplateVersionID int64
+		Status            string
+		Cnt               int64
+	}
+	var counts []versionStatusCount
+	err := d.db.WithContext(ctx).Model(&Notification{}).
+		Select("template_version_id, status, COUNT(*) AS cnt").
+		Where("template_id = ? AND ctime >= ? AND ctime < ?", templateID, startTime, endTime).
+		Group("template_version_id, status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]map[string]int64)
+	for _, c := range counts {
+		if res[c.TemplateVersionID] == nil {
+			res[c.TemplateVersionID] = make(map[string]int64)
+		}
+		res[c.TemplateVersionID][c.Status] = c.Cnt
+	}
+	return res, nil
 }
@@ -20,6 +20,8 @@ type Quota struct {
 	// 如果你要分开控制不同渠道的 Quota，那么就加一个 Channel 列
 	// 确保不同 Channel 使用不同的 Quota 来规避更新的锁竞争（CAS 等）
 	Quota int32
+	// 每日发送上限，0 表示不限制
+	DailyLimit int32 `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'每日发送上限，0表示不限制'"`
 
 	// 版本号，用于 CAS，你可以考虑使用 CAS 来更新
 	// Version int `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号，用于CAS操作'"`
@@ -28,9 +30,28 @@ type Quota struct {
 	Ctime int64
 }
 
+// QuotaLedger 额度流水表，只追加不修改
+type QuotaLedger struct {
+	ID       int64  `gorm:"primaryKey;autoIncrement"`
+	BizID    int64  `gorm:"type:BIGINT;NOT NULL;index:idx_biz_id_channel,priority:1"`
+	Channel  string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;index:idx_biz_id_channel,priority:2"`
+	Type     string `gorm:"type:ENUM('RESET','ADJUST');NOT NULL;comment:'RESET-每月重置，ADJUST-管理员充值或扣减'"`
+	Delta    int32  `gorm:"type:INT;NOT NULL;comment:'变化量'"`
+	Balance  int32  `gorm:"type:INT;NOT NULL;comment:'变化后的额度'"`
+	Reason   string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'变化原因'"`
+	Operator string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'操作人'"`
+	Ctime    int64
+}
+
 type QuotaDAO interface {
 	CreateOrUpdate(ctx context.Context, quota ...Quota) error
 	Find(ctx context.Context, bizID int64, channel string) (Quota, error)
+	// Reset 重置额度，同时在同一个事务中记录额度流水
+	Reset(ctx context.Context, quotas []Quota, ledgers []QuotaLedger) error
+	// CreateLedger 记录额度流水
+	CreateLedger(ctx context.Context, ledger QuotaLedger) error
+	// FindLedgers 按时间倒序分页查询额度流水，channel 为空时查询所有渠道，同时返回符合条件的总数
+	FindLedgers(ctx context.Context, bizID int64, channel string, offset, limit int) ([]QuotaLedger, int64, error)
 }
 
 type quotaDAO struct {
@@ -42,16 +63,55 @@ func NewQuotaDAO(db *gorm.DB) QuotaDAO {
 }
 
 func (d *quotaDAO) CreateOrUpdate(ctx context.Context, quota ...Quota) error {
+	return d.createOrUpdate(d.db.WithContext(ctx), quota)
+}
+
+func (d *quotaDAO) createOrUpdate(db *gorm.DB, quota []Quota) error {
 	now := time.Now().UnixMilli()
 	for i := range quota {
 		quota[i].Ctime = now
 		quota[i].Utime = now
 	}
-	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
-		DoUpdates: clause.AssignmentColumns([]string{"quota", "utime"}),
+	return db.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"quota", "daily_limit", "utime"}),
 	}).Create(&quota).Error
 }
 
+func (d *quotaDAO) Reset(ctx context.Context, quotas []Quota, ledgers []QuotaLedger) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := d.createOrUpdate(tx, quotas); err != nil {
+			return err
+		}
+		if len(ledgers) == 0 {
+			return nil
+		}
+		now := time.Now().UnixMilli()
+		for i := range ledgers {
+			ledgers[i].Ctime = now
+		}
+		return tx.Create(&ledgers).Error
+	})
+}
+
+func (d *quotaDAO) CreateLedger(ctx context.Context, ledger QuotaLedger) error {
+	ledger.Ctime = time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Create(&ledger).Error
+}
+
+func (d *quotaDAO) FindLedgers(ctx context.Context, bizID int64, channel string, offset, limit int) ([]QuotaLedger, int64, error) {
+	query := d.db.WithContext(ctx).Model(&QuotaLedger{}).Where("biz_id = ?", bizID)
+	if channel != "" {
+		query = query.Where("channel = ?", channel)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var ledgers []QuotaLedger
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&ledgers).Error
+	return ledgers, total, err
+}
+
 func (d *quotaDAO) Find(ctx context.Context, bizID int64, channel string) (Quota, error) {
 	var q Quota
 	err := d.db.WithContext(ctx).Where("biz_id = ? AND channel = ?", bizID, channel).First(&q).Error
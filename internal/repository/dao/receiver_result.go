@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationReceiverResult 通知中每个接收者的发送结果表
+type NotificationReceiverResult struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement;comment:'发送结果ID'"`
+	NotificationID uint64 `gorm:"NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:1;comment:'通知ID'"`
+	Receiver       string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_notification_id_receiver,priority:2;comment:'接收者(手机/邮箱/用户ID)'"`
+	Status         string `gorm:"type:ENUM('SUCCEEDED','FAILED','DELIVERED','UNDELIVERED','SUPPRESSED');NOT NULL;index:idx_status;comment:'该接收者的发送状态，SUCCEEDED 表示供应商已受理、等待回执，SUPPRESSED 表示接收者已退订'"`
+	Code           string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'供应商返回的状态码'"`
+	Message        string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'供应商返回的描述信息'"`
+	Provider       string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';index:idx_provider_message_id,priority:1;comment:'实际发送的供应商'"`
+	MessageID      string `gorm:"type:VARCHAR(128);NOT NULL;DEFAULT:'';index:idx_provider_message_id,priority:2;comment:'供应商返回的消息ID，用于匹配回执'"`
+	Ctime          int64
+	Utime          int64
+}
+
+// TableName 重命名表
+func (NotificationReceiverResult) TableName() string {
+	return "notification_receiver_results"
+}
+
+// saveReceiverResults 保存通知的接收者发送结果，同一通知同一接收者已存在时以最新结果为准
+func saveReceiverResults(tx *gorm.DB, notifications ...Notification) error {
+	now := time.Now().UnixMilli()
+	var results []NotificationReceiverResult
+	for i := range notifications {
+		for j := range notifications[i].ReceiverResults {
+			res := notifications[i].ReceiverResults[j]
+			res.NotificationID = notifications[i].ID
+			res.Ctime, res.Utime = now, now
+			results = append(results, res)
+		}
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	const batchSize = 100
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "notification_id"}, {Name: "receiver"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "code", "message", "provider", "message_id", "utime"}),
+	}).CreateInBatches(results, batchSize).Error
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/ego-component/egorm"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// RecurringNotification 周期通知表，每一次发送都会生成一条独立的通知记录
+type RecurringNotification struct {
+	ID                uint64 `gorm:"primaryKey;comment:'雪花算法ID'"`
+	BizID             int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_key,priority:1;comment:'业务配表ID'"`
+	Key               string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_key,priority:2;comment:'业务内唯一标识，每一次发送的通知 key 由它和发送时间组成'"`
+	Receivers         string `gorm:"type:TEXT;NOT NULL;comment:'接收者(手机/邮箱/用户ID)，JSON数组'"`
+	Channel           string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'发送渠道'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;comment:'模板版本ID'"`
+	TemplateParams    string `gorm:"NOT NULL;comment:'模版参数'"`
+	Cron              string `gorm:"type:VARCHAR(128);NOT NULL;comment:'cron 表达式'"`
+	Timezone          string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'cron 表达式所在的时区'"`
+	EndTime           int64  `gorm:"NOT NULL;DEFAULT:0;comment:'结束时间，0 表示不限制'"`
+	MaxOccurrences    int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'最多发送的次数，0 表示不限制'"`
+	Occurrences       int32  `gorm:"type:INT;NOT NULL;DEFAULT:0;comment:'已经生成的通知数量'"`
+	NextTime          int64  `gorm:"NOT NULL;index:idx_status_next_time,priority:2;comment:'下一次发送的时间'"`
+	Status            string `gorm:"type:ENUM('ACTIVE','FINISHED');NOT NULL;DEFAULT:'ACTIVE';index:idx_status_next_time,priority:1;comment:'状态'"`
+	Version           int    `gorm:"type:INT;NOT NULL;DEFAULT:1;comment:'版本号，用于CAS操作'"`
+	Ctime             int64
+	Utime             int64
+}
+
+type RecurringNotificationDAO interface {
+	Create(ctx context.Context, data RecurringNotification) (RecurringNotification, error)
+	// GetByKey 根据业务ID和业务内唯一标识获取周期通知
+	GetByKey(ctx context.Context, bizID int64, key string) (RecurringNotification, error)
+	// FindDue 查询下一次发送时间不晚于 before 的周期通知
+	FindDue(ctx context.Context, before int64, limit int) ([]RecurringNotification, error)
+	// CASAdvance 更新已经生成的通知数量、下一次发送时间和状态，使用乐观锁控制并发
+	CASAdvance(ctx context.Context, data RecurringNotification) error
+}
+
+type recurringNotificationDAO struct {
+	db *egorm.Component
+}
+
+func NewRecurringNotificationDAO(db *egorm.Component) RecurringNotificationDAO {
+	return &recurringNotificationDAO{db: db}
+}
+
+func (d *recurringNotificationDAO) Create(ctx context.Context, data RecurringNotification) (RecurringNotification, error) {
+	now := time.Now().UnixMilli()
+	data.Ctime, data.Utime = now, now
+	data.Version = 1
+	err := d.db.WithContext(ctx).Create(&data).Error
+	if err != nil {
+		if d.isUniqueConstraintError(err) {
+			return RecurringNotification{}, fmt.Errorf("%w", errs.ErrNotificationDuplicate)
+		}
+		return RecurringNotification{}, err
+	}
+	return data, nil
+}
+
+func (d *recurringNotificationDAO) GetByKey(ctx context.Context, bizID int64, key string) (RecurringNotification, error) {
+	var res RecurringNotification
+	err := d.db.WithContext(ctx).Where("biz_id = ? AND `key` = ?", bizID, key).First(&res).Error
+	if err != nil {
+		return RecurringNotification{}, fmt.Errorf("查询周期通知失败:bizID: %d, key %s %w", bizID, key, err)
+	}
+	return res, nil
+}
+
+func (d *recurringNotificationDAO) FindDue(ctx context.Context, before int64, limit int) ([]RecurringNotification, error) {
+	var res []RecurringNotification
+	err := d.db.WithContext(ctx).
+		Where("status = ? AND next_time <= ?", domain.RecurringStatusActive.String(), before).
+		Order("next_time ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *recurringNotificationDAO) CASAdvance(ctx context.Context, data RecurringNotification) error {
+	result := d.db.WithContext(ctx).Model(&RecurringNotification{}).
+		Where("id = ? AND version = ?", data.ID, data.Version).
+		Updates(map[string]any{
+			"occurrences": data.Occurrences,
+			"next_time":   data.NextTime,
+			"status":      data.Status,
+			"version":     gorm.Expr("version + 1"),
+			"utime":       time.Now().UnixMilli(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected < 1 {
+		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, data.ID)
+	}
+	return nil
+}
+
+func (d *recurringNotificationDAO) isUniqueConstraintError(err error) bool {
+	me := new(mysql.MySQLError)
+	if ok := errors.As(err, &me); ok {
+		const uniqueIndexErrNo uint16 = 1062
+		return me.Number == uniqueIndexErrNo
+	}
+	return false
+}
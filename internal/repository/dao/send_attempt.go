@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationSendAttempt 通知每一次失败的发送尝试，用于查询重试的过程
+type NotificationSendAttempt struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement;comment:'发送尝试ID'"`
+	NotificationID uint64 `gorm:"NOT NULL;uniqueIndex:idx_notification_id_attempt,priority:1;comment:'通知ID'"`
+	Attempt        int32  `gorm:"type:INT;NOT NULL;uniqueIndex:idx_notification_id_attempt,priority:2;comment:'第几次发送，从1开始'"`
+	Channel        string `gorm:"type:VARCHAR(16);NOT NULL;comment:'发送渠道'"`
+	Status         string `gorm:"type:ENUM('RETRYING','FAILED');NOT NULL;comment:'RETRYING 表示之后还会重试，FAILED 表示重试次数已经用完'"`
+	Message        string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'失败原因'"`
+	Ctime          int64
+}
+
+// TableName 重命名表
+func (NotificationSendAttempt) TableName() string {
+	return "notification_send_attempts"
+}
+
+// saveSendAttempts 保存通知的发送尝试，同一次尝试已经记录过时忽略
+func saveSendAttempts(tx *gorm.DB, notifications ...Notification) error {
+	now := time.Now().UnixMilli()
+	var attempts []NotificationSendAttempt
+	for i := range notifications {
+		for j := range notifications[i].Attempts {
+			attempt := notifications[i].Attempts[j]
+			attempt.NotificationID = notifications[i].ID
+			if attempt.Ctime == 0 {
+				attempt.Ctime = now
+			}
+			attempts = append(attempts, attempt)
+		}
+	}
+	if len(attempts) == 0 {
+		return nil
+	}
+	const batchSize = 100
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(attempts, batchSize).Error
+}
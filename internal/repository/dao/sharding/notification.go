@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type NotificationShardingDAO struct {
@@ -284,6 +285,57 @@ func (s *NotificationShardingDAO) CASStatus(ctx context.Context, notification da
 	return nil
 }
 
+func (s *NotificationShardingDAO) CASUpdate(ctx context.Context, notification dao.Notification) error {
+	dst := s.notificationShardingSvc.ShardWithID(int64(notification.ID))
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&dao.Notification{}).
+		Table(dst.Table).
+		Where("id = ? AND version = ? AND status = ?", notification.ID, notification.Version, domain.SendStatusPending.String()).
+		Updates(map[string]any{
+			"receivers":       notification.Receivers,
+			"template_params": notification.TemplateParams,
+			"scheduled_stime": notification.ScheduledSTime,
+			"scheduled_etime": notification.ScheduledETime,
+			"version":         gorm.Expr("version + 1"),
+			"utime":           time.Now().UnixMilli(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected < 1 {
+		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+	}
+	return nil
+}
+
+func (s *NotificationShardingDAO) ClaimSending(ctx context.Context, notification dao.Notification) error {
+	dst := s.notificationShardingSvc.ShardWithID(int64(notification.ID))
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&dao.Notification{}).
+		Table(dst.Table).
+		Where("id = ? AND version = ? AND status IN ?", notification.ID, notification.Version, dao.ClaimableStatuses).
+		Updates(map[string]any{
+			"status":  domain.SendStatusSending.String(),
+			"version": gorm.Expr("version + 1"),
+			"utime":   time.Now().UnixMilli(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected < 1 {
+		return fmt.Errorf("并发竞争失败 %w, id %d", errs.ErrNotificationVersionMismatch, notification.ID)
+	}
+	return nil
+}
+
 func (s *NotificationShardingDAO) UpdateStatus(ctx context.Context, notification dao.Notification) error {
 	dst := s.notificationShardingSvc.ShardWithID(int64(notification.ID))
 	gormDB, ok := s.dbs.Load(dst.DB)
@@ -301,9 +353,9 @@ func (s *NotificationShardingDAO) UpdateStatus(ctx context.Context, notification
 		}).Error
 }
 
-func (s *NotificationShardingDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []dao.Notification) error {
+func (s *NotificationShardingDAO) BatchUpdateStatusSucceededOrFailed(ctx context.Context, successNotifications, failedNotifications []dao.Notification) ([]uint64, error) {
 	if len(successNotifications) == 0 && len(failedNotifications) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	dbMap := make(map[string]map[string]*modifyIds)
@@ -356,7 +408,11 @@ func (s *NotificationShardingDAO) BatchUpdateStatusSucceededOrFailed(ctx context
 	}
 
 	// Process each database in parallel
-	var eg errgroup.Group
+	var (
+		eg        errgroup.Group
+		mu        sync.Mutex
+		failedIDs []uint64
+	)
 	for dbName, tableMap := range dbMap {
 		db := dbName
 		tables := tableMap
@@ -366,12 +422,22 @@ func (s *NotificationShardingDAO) BatchUpdateStatusSucceededOrFailed(ctx context
 				return fmt.Errorf("未知库名 %s", db)
 			}
 			return gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-				return s.batchMark(tx, tables)
+				ids, err := s.batchMark(tx, tables)
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				failedIDs = append(failedIDs, ids...)
+				mu.Unlock()
+				return nil
 			})
 		})
 	}
 
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return failedIDs, nil
 }
 
 // FindReadyNotifications 这个是循环任务用的不在这个dao中实现
@@ -389,16 +455,19 @@ func (s *NotificationShardingDAO) MarkSuccess(ctx context.Context, entity dao.No
 		return fmt.Errorf("未知库名 %s", dst.DB)
 	}
 	return gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.
+		result := tx.
 			Table(dst.Table).
 			Model(&dao.Notification{}).
-			Where("id = ?", entity.ID).
+			Where("id = ? AND status = ?", entity.ID, domain.SendStatusSending.String()).
 			Updates(map[string]any{
-				"status":  entity.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
-			}).Error
-		if err != nil {
+				"status":        entity.Status,
+				"sent_channel":  entity.SentChannel,
+				"error_code":    "",
+				"error_message": "",
+				"utime":         now,
+				"version":       gorm.Expr("version + 1"),
+			})
+		if err := dao.CheckSendingUpdated(result, entity.ID); err != nil {
 			return err
 		}
 		// 要把 callback log 标记为可以发送了
@@ -421,19 +490,70 @@ func (s *NotificationShardingDAO) MarkFailed(ctx context.Context, entity dao.Not
 		return fmt.Errorf("未知库名 %s", dst.DB)
 	}
 	return gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		return tx.
+		result := tx.
 			Model(&dao.Notification{}).
 			Table(dst.Table).
-			Where("id = ?", entity.ID).
+			Where("id = ? AND status = ?", entity.ID, domain.SendStatusSending.String()).
 			Updates(map[string]any{
-				"status":  entity.Status,
-				"utime":   now,
-				"version": gorm.Expr("version + 1"),
-			}).Error
+				"status":        entity.Status,
+				"error_code":    entity.ErrorCode,
+				"error_message": entity.ErrorMessage,
+				"utime":         now,
+				"version":       gorm.Expr("version + 1"),
+			})
+		return dao.CheckSendingUpdated(result, entity.ID)
 	})
 }
 
-func (s *NotificationShardingDAO) MarkTimeoutSendingAsFailed(_ context.Context, _ int) (int64, error) {
+func (s *NotificationShardingDAO) MarkTimeoutSendingAsFailed(_ context.Context, _ int) ([]dao.Notification, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (s *NotificationShardingDAO) MarkRetrying(ctx context.Context, entity dao.Notification) error {
+	dst := s.notificationShardingSvc.ShardWithID(int64(entity.ID))
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&dao.Notification{}).
+		Table(dst.Table).
+		Where("id = ? AND status IN ?", entity.ID, dao.RetryableStatuses).
+		Updates(map[string]any{
+			"status":          domain.SendStatusRetrying.String(),
+			"retry_count":     entity.RetryCount,
+			"next_retry_time": entity.NextRetryTime,
+			"error_code":      entity.ErrorCode,
+			"error_message":   entity.ErrorMessage,
+			"utime":           time.Now().UnixMilli(),
+			"version":         gorm.Expr("version + 1"),
+		})
+	return dao.CheckSendingUpdated(result, entity.ID)
+}
+
+func (s *NotificationShardingDAO) MarkDeferred(ctx context.Context, entity dao.Notification) error {
+	dst := s.notificationShardingSvc.ShardWithID(int64(entity.ID))
+	gormDB, ok := s.dbs.Load(dst.DB)
+	if !ok {
+		return fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&dao.Notification{}).
+		Table(dst.Table).
+		Where("id = ? AND status = ?", entity.ID, domain.SendStatusSending.String()).
+		Updates(map[string]any{
+			"status":          domain.SendStatusPending.String(),
+			"scheduled_stime": entity.ScheduledSTime,
+			"scheduled_etime": entity.ScheduledETime,
+			"utime":           time.Now().UnixMilli(),
+			"version":         gorm.Expr("version + 1"),
+		})
+	return dao.CheckSendingUpdated(result, entity.ID)
+}
+
+// FindRetryNotifications 这个是循环任务用的不在这个dao中实现
+func (s *NotificationShardingDAO) FindRetryNotifications(_ context.Context, _ int) ([]dao.Notification, error) {
 	// TODO implement me
 	panic("implement me")
 }
@@ -541,21 +661,35 @@ func (s *NotificationShardingDAO) getNotificationMap(datas []*dao.Notification)
 	return notiMap
 }
 
-func (s *NotificationShardingDAO) batchMark(tx *gorm.DB, ids map[string]*modifyIds) error {
+// batchMark 返回实际标记为失败的通知ID，先锁住还是 SENDING 的通知，只有这些通知需要归还额度
+func (s *NotificationShardingDAO) batchMark(tx *gorm.DB, ids map[string]*modifyIds) ([]uint64, error) {
 	now := time.Now().Unix()
 	sqls := make([]string, 0, len(ids))
+	var failedIDs []uint64
 	for notificationTab := range ids {
 		modifyID := ids[notificationTab]
+		if len(modifyID.failedIds) > 0 {
+			var sendingIDs []uint64
+			err := tx.Table(notificationTab).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id IN ? AND status = ?", modifyID.failedIds, domain.SendStatusSending.String()).
+				Pluck("id", &sendingIDs).Error
+			if err != nil {
+				return nil, err
+			}
+			modifyID.failedIds = sendingIDs
+			failedIDs = append(failedIDs, sendingIDs...)
+		}
 		if len(modifyID.successIds) > 0 {
-			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s' WHERE id IN (%s) ",
-				notificationTab, now, domain.SendStatusSucceeded.String(), modifyID.successToStr(),
+			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s' WHERE id IN (%s) AND `status` = '%s' ",
+				notificationTab, now, domain.SendStatusSucceeded.String(), modifyID.successToStr(), domain.SendStatusSending.String(),
 			)
 			callbackSQL := fmt.Sprintf("UPDATE %s SET `status` = '%s',utime = %d  WHERE notification_id IN (%s)", modifyID.callbackTab, domain.CallbackLogStatusPending.String(), now, modifyID.successToStr())
 			sqls = append(sqls, notificationSQL, callbackSQL)
 		}
 		if len(modifyID.failedIds) > 0 {
-			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s' WHERE id IN (%s) ",
-				notificationTab, now, domain.SendStatusFailed.String(), modifyID.failToStr(),
+			notificationSQL := fmt.Sprintf("UPDATE %s SET `version` = `version` + 1,`utime` = %d,`status` = '%s' WHERE id IN (%s) AND `status` = '%s' ",
+				notificationTab, now, domain.SendStatusFailed.String(), modifyID.failToStr(), domain.SendStatusSending.String(),
 			)
 			sqls = append(sqls, notificationSQL)
 		}
@@ -565,10 +699,10 @@ func (s *NotificationShardingDAO) batchMark(tx *gorm.DB, ids map[string]*modifyI
 		combinedSQL := strings.Join(sqls, "; ")
 		err := tx.Exec(combinedSQL).Error
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return failedIDs, nil
 }
 
 type modifyIds struct {
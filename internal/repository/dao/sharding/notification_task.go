@@ -3,7 +3,6 @@ package sharding
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
@@ -14,6 +13,7 @@ import (
 	"github.com/ego-component/egorm"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type NotificationTask struct {
@@ -71,12 +71,22 @@ func (n *NotificationTask) CASStatus(_ context.Context, _ dao.Notification) erro
 	panic("implement me")
 }
 
+func (n *NotificationTask) CASUpdate(_ context.Context, _ dao.Notification) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (n *NotificationTask) ClaimSending(_ context.Context, _ dao.Notification) error {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (n *NotificationTask) UpdateStatus(_ context.Context, _ dao.Notification) error {
 	// TODO implement me
 	panic("implement me")
 }
 
-func (n *NotificationTask) BatchUpdateStatusSucceededOrFailed(_ context.Context, _, _ []dao.Notification) error {
+func (n *NotificationTask) BatchUpdateStatusSucceededOrFailed(_ context.Context, _, _ []dao.Notification) ([]uint64, error) {
 	// TODO implement me
 	panic("implement me")
 }
@@ -110,54 +120,114 @@ func (n *NotificationTask) MarkFailed(_ context.Context, _ dao.Notification) err
 	panic("implement me")
 }
 
-func (n *NotificationTask) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error) {
+func (n *NotificationTask) FindReceiverResults(_ context.Context, _ []uint64) (map[uint64][]dao.NotificationReceiverResult, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (n *NotificationTask) FindSendAttempts(_ context.Context, _ []uint64) (map[uint64][]dao.NotificationSendAttempt, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (n *NotificationTask) CountByTemplateVersion(_ context.Context, _, _, _ int64) (map[int64]map[string]int64, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+// MarkRetrying 重试任务推迟落在免打扰时段内的重试，通知就在 ctx 中的分片上
+func (n *NotificationTask) MarkRetrying(ctx context.Context, entity dao.Notification) error {
+	dst, ok := sharding.DstFromCtx(ctx)
+	if !ok {
+		return errors.New("Dst 未找到，无法确定应该更新哪个表")
+	}
+	gormDB, ok := n.dbs.Load(dst.DB)
+	if !ok {
+		return fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	result := gormDB.WithContext(ctx).
+		Model(&dao.Notification{}).
+		Table(dst.Table).
+		Where("id = ? AND status IN ?", entity.ID, dao.RetryableStatuses).
+		Updates(map[string]any{
+			"status":          domain.SendStatusRetrying.String(),
+			"retry_count":     entity.RetryCount,
+			"next_retry_time": entity.NextRetryTime,
+			"error_code":      entity.ErrorCode,
+			"error_message":   entity.ErrorMessage,
+			"utime":           time.Now().UnixMilli(),
+			"version":         gorm.Expr("version + 1"),
+		})
+	return dao.CheckSendingUpdated(result, entity.ID)
+}
+
+func (n *NotificationTask) MarkDeferred(_ context.Context, _ dao.Notification) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+// FindRetryNotifications 查询 ctx 中分片上已经到了重试时间的通知
+func (n *NotificationTask) FindRetryNotifications(ctx context.Context, limit int) ([]dao.Notification, error) {
+	dst, ok := sharding.DstFromCtx(ctx)
+	if !ok {
+		return nil, errors.New("Dst 未找到，无法确定应该查询哪个表")
+	}
+	gormDB, ok := n.dbs.Load(dst.DB)
+	if !ok {
+		return nil, fmt.Errorf("未知库名 %s", dst.DB)
+	}
+	var res []dao.Notification
+	err := gormDB.WithContext(ctx).
+		Table(dst.Table).
+		Where("status = ? AND next_retry_time <= ?", domain.SendStatusRetrying.String(), time.Now().UnixMilli()).
+		Order("next_retry_time ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (n *NotificationTask) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) ([]dao.Notification, error) {
 	now := time.Now()
-	ddl := now.Add(-time.Minute).UnixMilli()
-	var rowsAffected int64
+	ddl := now.Add(-dao.SendingTimeout).UnixMilli()
 	dst, ok := sharding.DstFromCtx(ctx)
-	log.Println("xxxxxxxxxxxxxxxxxx", dst)
 	if !ok {
-		return 0, errors.New("Dst 未找到，无法确定应该查询哪个表")
+		return nil, errors.New("Dst 未找到，无法确定应该查询哪个表")
 	}
 	gormDB, ok := n.dbs.Load(dst.DB)
 	if !ok {
-		return rowsAffected, fmt.Errorf("未知库名 %s", dst.DB)
+		return nil, fmt.Errorf("未知库名 %s", dst.DB)
 	}
 
+	var timeouts []dao.Notification
 	err := gormDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var idsToUpdate []uint64
-
-		// 查询需要更新的 ID
+		// 锁住查询到的通知，保证更新的就是查询到的这些，归还额度时不会多也不会少
 		err := tx.Model(&dao.Notification{}).
 			Table(dst.Table).
-			Select("id").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "biz_id", "channel").
 			Where("status = ? AND utime <= ?", domain.SendStatusSending.String(), ddl).
 			Limit(batchSize).
-			Find(&idsToUpdate).Error
-
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			Find(&timeouts).Error
+		if err != nil {
 			return err
 		}
 
 		// 没有找到需要更新的记录，直接成功返回 (事务将提交)
-		if len(idsToUpdate) == 0 {
-			rowsAffected = 0
+		if len(timeouts) == 0 {
 			return nil
 		}
 
-		// 根据查询到的 ID 集合更新记录
-		res := tx.Model(&dao.Notification{}).
+		ids := make([]uint64, 0, len(timeouts))
+		for i := range timeouts {
+			ids = append(ids, timeouts[i].ID)
+		}
+		return tx.Model(&dao.Notification{}).
 			Table(dst.Table).
-			Where("id IN ?", idsToUpdate).
-			Updates(map[string]any{
-				"status":  domain.SendStatusFailed.String(),
-				"version": gorm.Expr("version + 1"),
-				"utime":   now.UnixMilli(),
-			})
-
-		rowsAffected = res.RowsAffected
-		return res.Error
+			Where("id IN ? AND status = ?", ids, domain.SendStatusSending.String()).
+			Updates(dao.TimeoutFailedUpdates(now)).Error
 	})
-
-	return rowsAffected, err
+	if err != nil {
+		return nil, err
+	}
+	return timeouts, nil
 }
@@ -143,7 +143,8 @@ func (t *TxNShardingDAO) UpdateStatus(ctx context.Context, bizID int64, key stri
 		return tx.WithContext(ctx).
 			Table(notificationDst.Table).
 			Model(&dao.Notification{}).
-			Where("biz_id = ? AND `key` = ? ", bizID, key).
+			// 所有接收者都退订的通知状态是 SUPPRESSED，提交之后也不发送
+			Where("biz_id = ? AND `key` = ? AND status = ?", bizID, key, domain.SendStatusPrepare.String()).
 			Updates(map[string]any{
 				"status": notificationStatus,
 				"utime":  now,
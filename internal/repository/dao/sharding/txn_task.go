@@ -82,7 +82,7 @@ func (t *TxnTaskDAO) UpdateCheckStatus(ctx context.Context, txNotifications []da
 				return tx.
 					Table(ntab).
 					WithContext(ctx).Model(&dao.Notification{}).
-					Where("id in ?", notificationIDs).
+					Where("id in ? AND status = ?", notificationIDs, domain.SendStatusPrepare.String()).
 					Update("status", status).Error
 			}
 			return nil
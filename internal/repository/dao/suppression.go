@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm/clause"
+)
+
+// Suppression 退订名单表，渠道为空表示所有渠道，业务类型为 0 表示所有业务类型
+type Suppression struct {
+	ID           int64  `gorm:"primaryKey;autoIncrement"`
+	BizID        int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_biz_id_receiver_channel_type,priority:1;comment:'业务配表ID'"`
+	Receiver     string `gorm:"type:VARCHAR(256);NOT NULL;uniqueIndex:idx_biz_id_receiver_channel_type,priority:2;comment:'接收者(手机/邮箱/用户ID)'"`
+	Channel      string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'';uniqueIndex:idx_biz_id_receiver_channel_type,priority:3;comment:'退订的渠道，为空表示所有渠道'"`
+	BusinessType int64  `gorm:"type:TINYINT;NOT NULL;DEFAULT:0;uniqueIndex:idx_biz_id_receiver_channel_type,priority:4;comment:'退订的业务类型，0表示所有业务类型'"`
+	Reason       string `gorm:"type:VARCHAR(256);NOT NULL;DEFAULT:'';comment:'退订原因'"`
+	Ctime        int64
+	Utime        int64
+}
+
+type SuppressionDAO interface {
+	// Upsert 添加退订记录，已经存在时更新退订原因
+	Upsert(ctx context.Context, data Suppression) error
+	// Delete 删除退订记录，记录不存在时返回 errs.ErrSuppressionNotFound
+	Delete(ctx context.Context, bizID int64, receiver, channel string, businessType int64) error
+	// FindByReceivers 查询接收者的所有退订记录
+	FindByReceivers(ctx context.Context, bizID int64, receivers []string) ([]Suppression, error)
+	// Find 按时间倒序分页查询退订记录，receiver 为空时查询所有接收者，同时返回符合条件的总数
+	Find(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]Suppression, int64, error)
+}
+
+type suppressionDAO struct {
+	db *egorm.Component
+}
+
+func NewSuppressionDAO(db *egorm.Component) SuppressionDAO {
+	return &suppressionDAO{db: db}
+}
+
+func (d *suppressionDAO) Upsert(ctx context.Context, data Suppression) error {
+	now := time.Now().UnixMilli()
+	data.Ctime, data.Utime = now, now
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "utime"}),
+	}).Create(&data).Error
+}
+
+func (d *suppressionDAO) Delete(ctx context.Context, bizID int64, receiver, channel string, businessType int64) error {
+	res := d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver = ? AND channel = ? AND business_type = ?", bizID, receiver, channel, businessType).
+		Delete(&Suppression{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return fmt.Errorf("%w: 接收者 %s", errs.ErrSuppressionNotFound, receiver)
+	}
+	return nil
+}
+
+func (d *suppressionDAO) FindByReceivers(ctx context.Context, bizID int64, receivers []string) ([]Suppression, error) {
+	var res []Suppression
+	err := d.db.WithContext(ctx).
+		Where("biz_id = ? AND receiver IN ?", bizID, receivers).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *suppressionDAO) Find(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]Suppression, int64, error) {
+	query := d.db.WithContext(ctx).Model(&Suppression{}).Where("biz_id = ?", bizID)
+	if receiver != "" {
+		query = query.Where("receiver = ?", receiver)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var res []Suppression
+	err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&res).Error
+	return res, total, err
+}
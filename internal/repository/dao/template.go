@@ -8,21 +8,24 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/sqlx"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/ego-component/egorm"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ChannelTemplate 渠道模板表
 type ChannelTemplate struct {
-	ID              int64  `gorm:"primaryKey;autoIncrement;comment:'渠道模版ID'"`
-	OwnerID         int64  `gorm:"type:BIGINT;NOT NULL;comment:'用户ID或部门ID'"`
-	OwnerType       string `gorm:"type:ENUM('person', 'organization');NOT NULL;comment:'业务方类型：person-个人,organization-组织'"`
-	Name            string `gorm:"type:VARCHAR(128);NOT NULL;comment:'模板名称'"`
-	Description     string `gorm:"type:VARCHAR(512);NOT NULL;comment:'模板描述'"`
-	Channel         string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'渠道类型'"`
-	BusinessType    int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:1;comment:'业务类型：1-推广营销、2-通知、3-验证码等'"`
-	ActiveVersionID int64  `gorm:"type:BIGINT;DEFAULT:0;index:idx_active_version;comment:'当前启用的版本ID，0表示无活跃版本'"`
+	ID              int64                                           `gorm:"primaryKey;autoIncrement;comment:'渠道模版ID'"`
+	OwnerID         int64                                           `gorm:"type:BIGINT;NOT NULL;comment:'用户ID或部门ID'"`
+	OwnerType       string                                          `gorm:"type:ENUM('person', 'organization');NOT NULL;comment:'业务方类型：person-个人,organization-组织'"`
+	Name            string                                          `gorm:"type:VARCHAR(128);NOT NULL;comment:'模板名称'"`
+	Description     string                                          `gorm:"type:VARCHAR(512);NOT NULL;comment:'模板描述'"`
+	Channel         string                                          `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;comment:'渠道类型'"`
+	BusinessType    int64                                           `gorm:"type:BIGINT;NOT NULL;DEFAULT:1;comment:'业务类型：1-推广营销、2-通知、3-验证码等'"`
+	ActiveVersionID int64                                           `gorm:"type:BIGINT;DEFAULT:0;index:idx_active_version;comment:'当前启用的版本ID，0表示无活跃版本'"`
+	VersionWeights  sqlx.JSONColumn[[]domain.TemplateVersionWeight] `gorm:"type:JSON;comment:'A/B测试时各个版本的流量权重，[{\"versionId\":1,\"weight\":90}]，为空时只使用活跃版本'"`
 	Ctime           int64
 	Utime           int64
 }
@@ -63,6 +66,7 @@ type ChannelTemplateProvider struct {
 	ProviderID               int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_template_version_provider,priority:3;comment:'供应商ID'"`
 	ProviderName             string `gorm:"type:VARCHAR(64);NOT NULL;uniqueIndex:idx_tmpl_ver_name_chan,priority:3;comment:'供应商名称'"`
 	ProviderChannel          string `gorm:"type:ENUM('SMS','EMAIL','IN_APP');NOT NULL;uniqueIndex:idx_tmpl_ver_name_chan,priority:4;comment:'渠道类型'"`
+	Locale                   string `gorm:"type:VARCHAR(35);NOT NULL;DEFAULT:'';uniqueIndex:idx_template_version_provider,priority:4;uniqueIndex:idx_tmpl_ver_name_chan,priority:5;comment:'本地化内容的语言，为空表示默认内容'"`
 	RequestID                string `gorm:"type:VARCHAR(256);index:idx_request_id;comment:'审核请求在供应商侧的ID，用于排查问题'"`
 	ProviderTemplateID       string `gorm:"type:VARCHAR(256);comment:'当前版本模版在供应商侧的ID，审核通过后才会有值'"`
 	AuditStatus              string `gorm:"type:ENUM('PENDING','IN_REVIEW','REJECTED','APPROVED');NOT NULL;DEFAULT:'PENDING';index:idx_audit_status;comment:'供应商侧模版审核状态，PENDING表示未提交审核；IN_REVIEW表示已提交审核；APPROVED表示审核通过；REJECTED表示审核未通过'"`
@@ -77,6 +81,40 @@ func (ChannelTemplateProvider) TableName() string {
 	return "channel_template_providers"
 }
 
+// ChannelTemplateLocale 模版版本的本地化内容表
+type ChannelTemplateLocale struct {
+	ID                int64  `gorm:"primaryKey;autoIncrement;comment:'本地化内容ID'"`
+	TemplateID        int64  `gorm:"type:BIGINT;NOT NULL;comment:'渠道模版ID'"`
+	TemplateVersionID int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:idx_version_locale,priority:1;comment:'渠道模版版本ID'"`
+	Locale            string `gorm:"type:VARCHAR(35);NOT NULL;uniqueIndex:idx_version_locale,priority:2;comment:'BCP-47语言标签，如zh-HK'"`
+	Signature         string `gorm:"type:VARCHAR(64);NOT NULL;DEFAULT:'';comment:'签名，为空时使用版本的签名'"`
+	Content           string `gorm:"type:TEXT;NOT NULL;comment:'本地化的模版内容，占位符和版本的默认内容一致'"`
+	Ctime             int64
+	Utime             int64
+}
+
+// TableName 重命名表
+func (ChannelTemplateLocale) TableName() string {
+	return "channel_template_locales"
+}
+
+// ChannelTemplatePublishRecord 模版活跃版本的切换记录表
+type ChannelTemplatePublishRecord struct {
+	ID            int64  `gorm:"primaryKey;autoIncrement;comment:'切换记录ID'"`
+	TemplateID    int64  `gorm:"type:BIGINT;NOT NULL;index:idx_template_id;comment:'渠道模版ID'"`
+	FromVersionID int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'切换前的活跃版本ID，0表示之前没有活跃版本'"`
+	ToVersionID   int64  `gorm:"type:BIGINT;NOT NULL;comment:'切换后的活跃版本ID'"`
+	Action        string `gorm:"type:ENUM('PUBLISH','ROLLBACK');NOT NULL;comment:'PUBLISH-发布，ROLLBACK-回滚'"`
+	OperatorID    int64  `gorm:"type:BIGINT;NOT NULL;DEFAULT:0;comment:'操作人ID'"`
+	Reason        string `gorm:"type:VARCHAR(512);NOT NULL;DEFAULT:'';comment:'回滚原因'"`
+	Ctime         int64
+}
+
+// TableName 重命名表
+func (ChannelTemplatePublishRecord) TableName() string {
+	return "channel_template_publish_records"
+}
+
 // ChannelTemplateDAO 提供模板数据访问对象接口
 type ChannelTemplateDAO interface {
 	// 模版相关方法
@@ -93,9 +131,19 @@ type ChannelTemplateDAO interface {
 	// UpdateTemplate 更新模板
 	UpdateTemplate(ctx context.Context, template ChannelTemplate) error
 
-	// SetTemplateActiveVersion 设置模板的活跃版本
+	// SetTemplateActiveVersion 设置模板的活跃版本，同时记录一次发布
 	SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error
 
+	// RollbackTemplateActiveVersion 把活跃版本从 record.FromVersionID 切换到 record.ToVersionID，同时记录一次回滚。
+	// 活跃版本已经不是 record.FromVersionID 时返回 errs.ErrTemplateActiveVersionChanged
+	RollbackTemplateActiveVersion(ctx context.Context, record ChannelTemplatePublishRecord) error
+
+	// GetPublishRecordsByTemplateID 按时间倒序获取模版活跃版本的切换记录
+	GetPublishRecordsByTemplateID(ctx context.Context, templateID int64) ([]ChannelTemplatePublishRecord, error)
+
+	// SetTemplateVersionWeights 设置 A/B 测试各个版本的流量权重，weights 为空时结束 A/B 测试
+	SetTemplateVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error
+
 	// 模版版本相关方法
 
 	// GetTemplateVersionsByTemplateIDs 根据模板ID列表获取对应的版本列表
@@ -107,21 +155,32 @@ type ChannelTemplateDAO interface {
 	// CreateTemplateVersion 创建模板版本
 	CreateTemplateVersion(ctx context.Context, version ChannelTemplateVersion) (ChannelTemplateVersion, error)
 
-	// ForkTemplateVersion 基于已有版本创建新版本
+	// ForkTemplateVersion 基于已有版本创建新版本，本地化内容一起拷贝
 	ForkTemplateVersion(ctx context.Context, versionID int64) (ChannelTemplateVersion, error)
 
+	// 本地化内容相关方法
+
+	// GetLocalesByVersionIDs 根据版本ID列表获取本地化内容
+	GetLocalesByVersionIDs(ctx context.Context, versionIDs []int64) ([]ChannelTemplateLocale, error)
+
+	// SaveTemplateLocale 创建或者更新版本某种语言的本地化内容，同时为该语言创建还没有的供应商关联
+	SaveTemplateLocale(ctx context.Context, locale ChannelTemplateLocale, providers []ChannelTemplateProvider) (ChannelTemplateLocale, error)
+
+	// DeleteTemplateLocale 删除版本某种语言的本地化内容以及该语言的供应商关联，没有该语言时返回 errs.ErrTemplateLocaleNotFound
+	DeleteTemplateLocale(ctx context.Context, versionID int64, locale string) error
+
 	// 供应商关联相关方法
 
 	// GetProvidersByVersionIDs 根据版本ID列表获取供应商列表
 	GetProvidersByVersionIDs(ctx context.Context, versionIDs []int64) ([]ChannelTemplateProvider, error)
 
-	// GetProviderByNameAndChannel 根据名称和渠道获取供应商
-	GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, providerName string, channel string) ([]ChannelTemplateProvider, error)
+	// GetProviderByNameAndChannel 根据名称和渠道获取某种语言已通过审核的供应商，locale 为空表示默认内容
+	GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, locale, providerName string, channel string) ([]ChannelTemplateProvider, error)
 
 	// BatchCreateTemplateProviders 批量创建模板供应商关联
 	BatchCreateTemplateProviders(ctx context.Context, providers []ChannelTemplateProvider) ([]ChannelTemplateProvider, error)
 
-	// GetApprovedProvidersByTemplateIDAndVersionID 获取已审核通过的供应商列表
+	// GetApprovedProvidersByTemplateIDAndVersionID 获取默认内容已审核通过的供应商列表
 	GetApprovedProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]ChannelTemplateProvider, error)
 
 	// GetProvidersByTemplateIDAndVersionID 获取模板和版本关联的所有供应商
@@ -213,14 +272,72 @@ func (d *channelTemplateDAO) UpdateTemplate(ctx context.Context, template Channe
 
 // SetTemplateActiveVersion 设置模板活跃版本
 func (d *channelTemplateDAO) SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var template ChannelTemplate
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", templateID).First(&template).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w", errs.ErrTemplateNotFound)
+		}
+		if err != nil {
+			return err
+		}
+		return d.switchActiveVersion(tx, ChannelTemplatePublishRecord{
+			TemplateID:    templateID,
+			FromVersionID: template.ActiveVersionID,
+			ToVersionID:   versionID,
+			Action:        domain.TemplatePublishActionPublish.String(),
+		})
+	})
+}
+
+// RollbackTemplateActiveVersion 回滚模版活跃版本
+func (d *channelTemplateDAO) RollbackTemplateActiveVersion(ctx context.Context, record ChannelTemplatePublishRecord) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return d.switchActiveVersion(tx, record)
+	})
+}
+
+// switchActiveVersion 用切换前的活跃版本做 CAS，避免覆盖并发的发布或者回滚。
+// 切换活跃版本的同时结束正在进行的 A/B 测试，回滚之后不会再有流量分到有问题的版本上
+func (d *channelTemplateDAO) switchActiveVersion(tx *gorm.DB, record ChannelTemplatePublishRecord) error {
+	now := time.Now().Unix()
+	res := tx.Model(&ChannelTemplate{}).
+		Where("id = ? AND active_version_id = ?", record.TemplateID, record.FromVersionID).
+		Updates(map[string]any{
+			"active_version_id": record.ToVersionID,
+			"version_weights":   nil,
+			"utime":             now,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected < 1 {
+		return fmt.Errorf("%w: 模版ID %d", errs.ErrTemplateActiveVersionChanged, record.TemplateID)
+	}
+	record.Ctime = now
+	return tx.Create(&record).Error
+}
+
+// SetTemplateVersionWeights 设置 A/B 测试各个版本的流量权重
+func (d *channelTemplateDAO) SetTemplateVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error {
 	return d.db.WithContext(ctx).Model(&ChannelTemplate{}).
 		Where("id = ?", templateID).
 		Updates(map[string]any{
-			"active_version_id": versionID,
-			"utime":             time.Now().Unix(),
+			"version_weights": sqlx.JSONColumn[[]domain.TemplateVersionWeight]{Val: weights, Valid: len(weights) > 0},
+			"utime":           time.Now().Unix(),
 		}).Error
 }
 
+// GetPublishRecordsByTemplateID 按时间倒序获取模版活跃版本的切换记录
+func (d *channelTemplateDAO) GetPublishRecordsByTemplateID(ctx context.Context, templateID int64) ([]ChannelTemplatePublishRecord, error) {
+	var records []ChannelTemplatePublishRecord
+	err := d.db.WithContext(ctx).
+		Where("template_id = ?", templateID).
+		Order("id DESC").
+		Find(&records).Error
+	return records, err
+}
+
 // 模版版本相关方法
 
 // GetTemplateVersionsByTemplateIDs 根据模板IDs获取版本列表
@@ -312,6 +429,7 @@ func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 				ProviderID:               src.ProviderID,
 				ProviderName:             src.ProviderName,
 				ProviderChannel:          src.ProviderChannel,
+				Locale:                   src.Locale,
 				RequestID:                "",
 				ProviderTemplateID:       "",
 				AuditStatus:              domain.AuditStatusPending.String(),
@@ -324,7 +442,27 @@ func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 		if err := tx.Create(&forkedProviders).Error; err != nil {
 			return err
 		}
-		return nil
+
+		// 拷贝本地化内容
+		var locales []ChannelTemplateLocale
+		if err := tx.Where("template_version_id = ?", old.ID).Find(&locales).Error; err != nil {
+			return err
+		}
+		if len(locales) == 0 {
+			return nil
+		}
+		forkedLocales := slice.Map(locales, func(_ int, src ChannelTemplateLocale) ChannelTemplateLocale {
+			return ChannelTemplateLocale{
+				TemplateID:        fork.ChannelTemplateID,
+				TemplateVersionID: fork.ID,
+				Locale:            src.Locale,
+				Signature:         src.Signature,
+				Content:           src.Content,
+				Ctime:             now,
+				Utime:             now,
+			}
+		})
+		return tx.Create(&forkedLocales).Error
 	})
 	if err != nil {
 		return ChannelTemplateVersion{}, err
@@ -332,6 +470,61 @@ func (d *channelTemplateDAO) ForkTemplateVersion(ctx context.Context, versionID
 	return created, nil
 }
 
+// 本地化内容相关方法
+
+func (d *channelTemplateDAO) GetLocalesByVersionIDs(ctx context.Context, versionIDs []int64) ([]ChannelTemplateLocale, error) {
+	if len(versionIDs) == 0 {
+		return []ChannelTemplateLocale{}, nil
+	}
+	var locales []ChannelTemplateLocale
+	err := d.db.WithContext(ctx).
+		Where("template_version_id IN ?", versionIDs).
+		Order("id ASC").
+		Find(&locales).Error
+	return locales, err
+}
+
+func (d *channelTemplateDAO) SaveTemplateLocale(ctx context.Context, locale ChannelTemplateLocale, providers []ChannelTemplateProvider) (ChannelTemplateLocale, error) {
+	now := time.Now().Unix()
+	locale.Ctime, locale.Utime = now, now
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "template_version_id"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"signature", "content", "utime"}),
+		}).Create(&locale).Error
+		if err != nil {
+			return err
+		}
+		// 更新时 MySQL 不会返回已有记录的ID，重新查询一次
+		if err = tx.Where("template_version_id = ? AND locale = ?", locale.TemplateVersionID, locale.Locale).
+			First(&locale).Error; err != nil {
+			return err
+		}
+		if len(providers) == 0 {
+			return nil
+		}
+		for i := range providers {
+			providers[i].Ctime, providers[i].Utime = now, now
+		}
+		// 已经有的供应商关联保持不变
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&providers).Error
+	})
+	return locale, err
+}
+
+func (d *channelTemplateDAO) DeleteTemplateLocale(ctx context.Context, versionID int64, locale string) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("template_version_id = ? AND locale = ?", versionID, locale).Delete(&ChannelTemplateLocale{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < 1 {
+			return fmt.Errorf("%w: versionID=%d, locale=%s", errs.ErrTemplateLocaleNotFound, versionID, locale)
+		}
+		return tx.Where("template_version_id = ? AND locale = ?", versionID, locale).Delete(&ChannelTemplateProvider{}).Error
+	})
+}
+
 // 供应商相关方法
 
 // GetProvidersByVersionIDs 根据版本IDs获取供应商关联
@@ -348,12 +541,12 @@ func (d *channelTemplateDAO) GetProvidersByVersionIDs(ctx context.Context, versi
 	return providers, nil
 }
 
-// GetProviderByNameAndChannel 根据名称和渠道获取已通过审核的供应商
-func (d *channelTemplateDAO) GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, providerName, channel string) ([]ChannelTemplateProvider, error) {
+// GetProviderByNameAndChannel 根据名称和渠道获取某种语言已通过审核的供应商
+func (d *channelTemplateDAO) GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, locale, providerName, channel string) ([]ChannelTemplateProvider, error) {
 	var providers []ChannelTemplateProvider
 	err := d.db.WithContext(ctx).Model(&ChannelTemplateProvider{}).
-		Where("template_id = ? AND template_version_id = ? AND provider_name = ? AND provider_channel = ? AND audit_status = ?",
-			templateID, versionID, providerName, channel, domain.AuditStatusApproved).Find(&providers).Error
+		Where("template_id = ? AND template_version_id = ? AND locale = ? AND provider_name = ? AND provider_channel = ? AND audit_status = ?",
+			templateID, versionID, locale, providerName, channel, domain.AuditStatusApproved).Find(&providers).Error
 	return providers, err
 }
 
@@ -376,11 +569,11 @@ func (d *channelTemplateDAO) BatchCreateTemplateProviders(ctx context.Context, p
 	return providers, nil
 }
 
-// GetApprovedProvidersByTemplateIDAndVersionID 根据模版ID和版本ID查找审核通过的供应商
+// GetApprovedProvidersByTemplateIDAndVersionID 根据模版ID和版本ID查找默认内容审核通过的供应商
 func (d *channelTemplateDAO) GetApprovedProvidersByTemplateIDAndVersionID(ctx context.Context, templateID, versionID int64) ([]ChannelTemplateProvider, error) {
 	var providers []ChannelTemplateProvider
 	err := d.db.WithContext(ctx).Model(&ChannelTemplateProvider{}).
-		Where("template_id = ? AND template_version_id = ? AND audit_status = ?",
+		Where("template_id = ? AND template_version_id = ? AND locale = '' AND audit_status = ?",
 			templateID, versionID, domain.AuditStatusApproved).Find(&providers).Error
 	return providers, err
 }
@@ -83,9 +83,10 @@ func (t *txNotificationDAO) UpdateStatus(ctx context.Context, bizID int64, key s
 		if res.RowsAffected == 0 {
 			return ErrUpdateStatusFailed
 		}
+		// 所有接收者都退订的通知状态是 SUPPRESSED，提交之后也不发送
 		return tx.WithContext(ctx).
 			Model(&Notification{}).
-			Where("biz_id = ? AND `key` = ? ", bizID, key).
+			Where("biz_id = ? AND `key` = ? AND status = ?", bizID, key, domain.SendStatusPrepare.String()).
 			Update("status", notificationStatus).Error
 	})
 }
@@ -108,6 +109,10 @@ func (t *txNotificationDAO) Prepare(ctx context.Context, txn TxNotification, not
 		if res.RowsAffected == 0 {
 			return nil
 		}
+		// 创建时就有的发送结果是退订的接收者
+		if err := saveReceiverResults(tx, notification); err != nil {
+			return err
+		}
 		txn.NotificationID = notification.ID
 		return tx.WithContext(ctx).Clauses(clause.OnConflict{
 			DoNothing: true,
@@ -205,7 +210,8 @@ func (t *txNotificationDAO) UpdateCheckStatus(ctx context.Context, txNotificatio
 				return err
 			}
 			if status != domain.SendStatusPrepare {
-				return tx.WithContext(ctx).Model(&Notification{}).Where("id in ?", notificationIDs).
+				return tx.WithContext(ctx).Model(&Notification{}).
+					Where("id in ? AND status = ?", notificationIDs, domain.SendStatusPrepare.String()).
 					Update("status", status).Error
 			}
 			return nil
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// DeliveryReceiptRepository 供应商回执仓储接口
+type DeliveryReceiptRepository interface {
+	// FindAwaiting 按ID升序查询已被供应商受理、还在等待回执的接收者
+	FindAwaiting(ctx context.Context, providers []string, startID uint64, stime, etime int64, limit int) ([]domain.AwaitingReceipt, error)
+	// Save 保存回执，返回所有接收者都拿到回执、变为最终状态的通知ID。
+	// 匹配不到接收者的回执以及重复的回执会被忽略
+	Save(ctx context.Context, receipts []domain.DeliveryReceipt) ([]uint64, error)
+}
+
+type deliveryReceiptRepository struct {
+	dao dao.DeliveryReceiptDAO
+}
+
+func NewDeliveryReceiptRepository(d dao.DeliveryReceiptDAO) DeliveryReceiptRepository {
+	return &deliveryReceiptRepository{dao: d}
+}
+
+func (r *deliveryReceiptRepository) FindAwaiting(ctx context.Context, providers []string, startID uint64, stime, etime int64, limit int) ([]domain.AwaitingReceipt, error) {
+	results, err := r.dao.FindAwaiting(ctx, providers, startID, stime, etime, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(results, func(_ int, src dao.NotificationReceiverResult) domain.AwaitingReceipt {
+		return domain.AwaitingReceipt{
+			ID:             src.ID,
+			NotificationID: src.NotificationID,
+			Receiver:       src.Receiver,
+			Provider:       src.Provider,
+			MessageID:      src.MessageID,
+			SendTime:       src.Utime,
+		}
+	}), nil
+}
+
+func (r *deliveryReceiptRepository) Save(ctx context.Context, receipts []domain.DeliveryReceipt) ([]uint64, error) {
+	// 同一个供应商的回执一起查询
+	messageIDs := make(map[string][]string)
+	for i := range receipts {
+		messageIDs[receipts[i].Provider] = append(messageIDs[receipts[i].Provider], receipts[i].MessageID)
+	}
+	rows := make(map[string]dao.NotificationReceiverResult)
+	for provider, ids := range messageIDs {
+		results, err := r.dao.FindByMessageIDs(ctx, provider, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			rows[r.key(results[i].Provider, results[i].MessageID, results[i].Receiver)] = results[i]
+		}
+	}
+
+	updates := make([]dao.NotificationReceiverResult, 0, len(receipts))
+	for i := range receipts {
+		row, ok := rows[r.key(receipts[i].Provider, receipts[i].MessageID, receipts[i].Receiver)]
+		if !ok {
+			continue
+		}
+		row.Status = domain.SendStatusUndelivered.String()
+		if receipts[i].Delivered {
+			row.Status = domain.SendStatusDelivered.String()
+		}
+		row.Code, row.Message = receipts[i].Code, truncateMessage(receipts[i].Message)
+		updates = append(updates, row)
+	}
+	return r.dao.SaveReceipts(ctx, updates)
+}
+
+// key 同一个消息ID可能对应多个手机号（如阿里云的 BizId），所以要带上接收者，
+// 回执中的手机号不带国家码，这里统一去掉 +86 前缀
+func (r *deliveryReceiptRepository) key(provider, messageID, receiver string) string {
+	return provider + ":" + messageID + ":" + strings.TrimPrefix(receiver, "+86")
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// InboxRepository 站内信仓储接口
+type InboxRepository interface {
+	// BatchCreate 批量保存站内信，同一通知同一接收者只会保存一次
+	BatchCreate(ctx context.Context, msgs []domain.InboxMessage) error
+	// Find 分页查询接收者的站内信，同时返回符合条件的总数
+	Find(ctx context.Context, bizID int64, receiver string, unreadOnly bool, offset, limit int) ([]domain.InboxMessage, int64, error)
+	// CountUnread 统计接收者的未读站内信数量
+	CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error)
+	// UpdateStatus 更新站内信状态，ids 为空时更新接收者的全部站内信
+	UpdateStatus(ctx context.Context, bizID int64, receiver string, ids []uint64, status domain.InboxMessageStatus) error
+	// Delete 删除接收者的站内信
+	Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error
+}
+
+type inboxRepository struct {
+	dao dao.InboxDAO
+}
+
+func NewInboxRepository(d dao.InboxDAO) InboxRepository {
+	return &inboxRepository{dao: d}
+}
+
+func (r *inboxRepository) BatchCreate(ctx context.Context, msgs []domain.InboxMessage) error {
+	return r.dao.BatchCreate(ctx, slice.Map(msgs, func(_ int, src domain.InboxMessage) dao.InboxMessage {
+		return r.toEntity(src)
+	}))
+}
+
+func (r *inboxRepository) Find(ctx context.Context, bizID int64, receiver string, unreadOnly bool, offset, limit int) ([]domain.InboxMessage, int64, error) {
+	status := ""
+	if unreadOnly {
+		status = domain.InboxMessageStatusUnread.String()
+	}
+	total, err := r.dao.Count(ctx, bizID, receiver, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []domain.InboxMessage{}, 0, nil
+	}
+	entities, err := r.dao.Find(ctx, bizID, receiver, status, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(entities, func(_ int, src dao.InboxMessage) domain.InboxMessage {
+		return r.toDomain(src)
+	}), total, nil
+}
+
+func (r *inboxRepository) CountUnread(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	return r.dao.Count(ctx, bizID, receiver, domain.InboxMessageStatusUnread.String())
+}
+
+func (r *inboxRepository) UpdateStatus(ctx context.Context, bizID int64, receiver string, ids []uint64, status domain.InboxMessageStatus) error {
+	return r.dao.UpdateStatus(ctx, bizID, receiver, ids, status.String())
+}
+
+func (r *inboxRepository) Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error {
+	return r.dao.Delete(ctx, bizID, receiver, ids)
+}
+
+func (r *inboxRepository) toEntity(msg domain.InboxMessage) dao.InboxMessage {
+	return dao.InboxMessage{
+		ID:             msg.ID,
+		BizID:          msg.BizID,
+		Receiver:       msg.Receiver,
+		NotificationID: msg.NotificationID,
+		Title:          msg.Title,
+		Content:        msg.Content,
+		Status:         msg.Status.String(),
+		ReadTime:       msg.ReadTime,
+	}
+}
+
+func (r *inboxRepository) toDomain(msg dao.InboxMessage) domain.InboxMessage {
+	return domain.InboxMessage{
+		ID:             msg.ID,
+		BizID:          msg.BizID,
+		NotificationID: msg.NotificationID,
+		Receiver:       msg.Receiver,
+		Title:          msg.Title,
+		Content:        msg.Content,
+		Status:         domain.InboxMessageStatus(msg.Status),
+		ReadTime:       msg.ReadTime,
+		Ctime:          msg.Ctime,
+		Utime:          msg.Utime,
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
+	"unicode/utf8"
 
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"github.com/gotomicro/ego/core/elog"
@@ -25,6 +27,8 @@ type NotificationRepository interface {
 	BatchCreate(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
 	// BatchCreateWithCallbackLog 批量创建通知记录，同时创建对应的回调记录
 	BatchCreateWithCallbackLog(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error)
+	// CreateSuppressed 创建所有接收者都已退订的通知记录，通知不会发送，所以不扣减额度
+	CreateSuppressed(ctx context.Context, notification domain.Notification) (domain.Notification, error)
 
 	// GetByID 根据ID获取通知
 	GetByID(ctx context.Context, id uint64) (domain.Notification, error)
@@ -38,21 +42,49 @@ type NotificationRepository interface {
 	// CASStatus 更新通知状态
 	CASStatus(ctx context.Context, notification domain.Notification) error
 	UpdateStatus(ctx context.Context, notification domain.Notification) error
+	// CASUpdate 修改 PENDING 通知的接收者、模版参数和计划发送时间，版本号不匹配时返回 errs.ErrNotificationVersionMismatch
+	CASUpdate(ctx context.Context, notification domain.Notification) error
+	// ClaimSending 发送前把 PENDING 或者 RETRYING 的通知 CAS 为 SENDING，返回状态和版本号更新后的通知。
+	// 通知已经被取消、修改或者被其他节点抢先发送时返回 errs.ErrNotificationVersionMismatch
+	ClaimSending(ctx context.Context, notification domain.Notification) (domain.Notification, error)
 
 	// BatchUpdateStatusSucceededOrFailed 批量更新通知状态为成功或失败
 	BatchUpdateStatusSucceededOrFailed(ctx context.Context, succeededNotifications, failedNotifications []domain.Notification) error
 
 	FindReadyNotifications(ctx context.Context, offset int, limit int) ([]domain.Notification, error)
+	// MarkSuccess 和 MarkFailed 只更新 SENDING 的通知，通知不在发送中时返回 errs.ErrNotificationVersionMismatch，也不归还额度
 	MarkSuccess(ctx context.Context, entity domain.Notification) error
 	MarkFailed(ctx context.Context, notification domain.Notification) error
 	// MarkTimeoutSendingAsFailed 将超时的 SENDING 状态的通知都标记为失败
 	MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error)
+	// MarkRetrying 发送失败但还可以重试，不归还额度，也不发起回调
+	MarkRetrying(ctx context.Context, notification domain.Notification) error
+	// FindRetryNotifications 查询已经到了重试时间的通知，分库分表时查询 ctx 中的分片
+	FindRetryNotifications(ctx context.Context, limit int) ([]domain.Notification, error)
+	// MarkDeferred 把通知重新放回待发送状态并修改计划发送时间，不归还额度，也不发起回调
+	MarkDeferred(ctx context.Context, notification domain.Notification) error
+	// GetTemplateVersionStats 统计 [startTime, endTime) 内创建的通知中模版每个版本的发送结果，按版本ID升序
+	GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error)
+	// TransferQuota 把创建通知时在 notification.Channel 上扣减的额度转到 to 渠道，跨渠道降级时使用。
+	// to 渠道额度不足时返回错误，原渠道的额度保持不变
+	TransferQuota(ctx context.Context, notification domain.Notification, to domain.Channel) error
 }
 
 const (
 	defaultQuotaNumber int32 = 1
+	// maxMessageLength 错误信息、接收者和发送尝试的描述信息的列都是 VARCHAR(512)，
+	// 供应商返回的错误可能很长，超长时 MySQL 严格模式下会直接写入失败
+	maxMessageLength = 512
 )
 
+// truncateMessage 按字符截断到列的长度，VARCHAR 的长度是字符数，不能按字节截断
+func truncateMessage(msg string) string {
+	if utf8.RuneCountInString(msg) <= maxMessageLength {
+		return msg
+	}
+	return string([]rune(msg)[:maxMessageLength])
+}
+
 // notificationRepository 通知仓储实现
 type notificationRepository struct {
 	dao        dao.NotificationDAO
@@ -72,14 +104,14 @@ func NewNotificationRepository(d dao.NotificationDAO, quotaCache cache.QuotaCach
 // Create 创建单条通知记录，但不创建对应的回调记录
 func (r *notificationRepository) Create(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
 	// 扣减额度
-	err := r.quotaCache.Decr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	err := r.mutiDecr(ctx, []domain.Notification{notification})
 	if err != nil {
 		return domain.Notification{}, err
 	}
 	ds, err := r.dao.Create(ctx, r.toEntity(notification))
 	if err != nil {
 		// 创建没成功把额度还回去
-		qerr := r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+		qerr := r.mutiIncr(ctx, []domain.Notification{notification})
 		if qerr != nil {
 			r.logger.Error("额度归还失败", elog.FieldErr(err),
 				elog.Int64("biz_id", notification.BizID),
@@ -91,10 +123,25 @@ func (r *notificationRepository) Create(ctx context.Context, notification domain
 	return r.toDomain(ds), nil
 }
 
+// CreateSuppressed 创建所有接收者都已退订的通知记录，不扣减额度
+func (r *notificationRepository) CreateSuppressed(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	ds, err := r.dao.Create(ctx, r.toEntity(notification))
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	return r.toDomain(ds), nil
+}
+
 // toEntity 将领域对象转换为DAO实体
 func (r *notificationRepository) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	receiverLocales, _ := notification.MarshalReceiverLocales()
+	failoverReceivers, _ := notification.MarshalFailoverReceivers()
+	var errorCode, errorMessage string
+	if notification.Error != nil {
+		errorCode, errorMessage = notification.Error.Code.String(), truncateMessage(notification.Error.Message)
+	}
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -108,6 +155,35 @@ func (r *notificationRepository) toEntity(notification domain.Notification) dao.
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
 		Version:           notification.Version,
+		Locale:            notification.Locale,
+		ReceiverLocales:   receiverLocales,
+		FailoverReceivers: failoverReceivers,
+		SentChannel:       notification.SentChannel.String(),
+		ErrorCode:         errorCode,
+		ErrorMessage:      errorMessage,
+		ReceiverResults: slice.Map(notification.ReceiverResults, func(_ int, src domain.ReceiverResult) dao.NotificationReceiverResult {
+			return dao.NotificationReceiverResult{
+				NotificationID: notification.ID,
+				Receiver:       src.Receiver,
+				Status:         src.Status.String(),
+				Code:           src.Code,
+				Message:        truncateMessage(src.Message),
+				Provider:       src.Provider,
+				MessageID:      src.MessageID,
+			}
+		}),
+		RetryCount:    notification.RetryCount,
+		NextRetryTime: notification.NextRetryTime,
+		Attempts: slice.Map(notification.Attempts, func(_ int, src domain.SendAttempt) dao.NotificationSendAttempt {
+			return dao.NotificationSendAttempt{
+				NotificationID: notification.ID,
+				Attempt:        src.Attempt,
+				Channel:        src.Channel.String(),
+				Status:         src.Status.String(),
+				Message:        truncateMessage(src.Message),
+				Ctime:          src.Ctime,
+			}
+		}),
 	}
 }
 
@@ -119,6 +195,20 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 	var receivers []string
 	_ = json.Unmarshal([]byte(n.Receivers), &receivers)
 
+	var receiverLocales map[string]string
+	if n.ReceiverLocales != "" {
+		_ = json.Unmarshal([]byte(n.ReceiverLocales), &receiverLocales)
+	}
+
+	var failoverReceivers map[domain.Channel][]string
+	if n.FailoverReceivers != "" {
+		_ = json.Unmarshal([]byte(n.FailoverReceivers), &failoverReceivers)
+	}
+
+	var sendErr *domain.SendError
+	if n.ErrorCode != "" {
+		sendErr = &domain.SendError{Code: domain.SendErrorCode(n.ErrorCode), Message: n.ErrorMessage}
+	}
 	return domain.Notification{
 		ID:        n.ID,
 		BizID:     n.BizID,
@@ -130,23 +220,88 @@ func (r *notificationRepository) toDomain(n dao.Notification) domain.Notificatio
 			VersionID: n.TemplateVersionID,
 			Params:    templateParams,
 		},
-		Status:         domain.SendStatus(n.Status),
-		ScheduledSTime: time.UnixMilli(n.ScheduledSTime),
-		ScheduledETime: time.UnixMilli(n.ScheduledETime),
-		Version:        n.Version,
+		Status:          domain.SendStatus(n.Status),
+		ScheduledSTime:  time.UnixMilli(n.ScheduledSTime),
+		ScheduledETime:  time.UnixMilli(n.ScheduledETime),
+		Version:         n.Version,
+		SentChannel:     domain.Channel(n.SentChannel),
+		RetryCount:      n.RetryCount,
+		NextRetryTime:   n.NextRetryTime,
+		Error:           sendErr,
+		Locale:          n.Locale,
+		ReceiverLocales: receiverLocales,
+
+		FailoverReceivers: failoverReceivers,
 	}
 }
 
+// fillReceiverResults 填充通知中每个接收者的发送结果
+func (r *notificationRepository) fillReceiverResults(ctx context.Context, notifications []domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	ids := slice.Map(notifications, func(_ int, src domain.Notification) uint64 {
+		return src.ID
+	})
+	resultMap, err := r.dao.FindReceiverResults(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("查询接收者发送结果失败: %w", err)
+	}
+	for i := range notifications {
+		results, ok := resultMap[notifications[i].ID]
+		if !ok {
+			continue
+		}
+		notifications[i].ReceiverResults = slice.Map(results, func(_ int, src dao.NotificationReceiverResult) domain.ReceiverResult {
+			return domain.ReceiverResult{
+				Receiver:  src.Receiver,
+				Status:    domain.SendStatus(src.Status),
+				Code:      src.Code,
+				Message:   src.Message,
+				Provider:  src.Provider,
+				MessageID: src.MessageID,
+			}
+		})
+	}
+	return nil
+}
+
+// fillSendAttempts 填充通知每一次失败的发送尝试，只在查询接口中使用
+func (r *notificationRepository) fillSendAttempts(ctx context.Context, notifications []domain.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	ids := slice.Map(notifications, func(_ int, src domain.Notification) uint64 {
+		return src.ID
+	})
+	attemptMap, err := r.dao.FindSendAttempts(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("查询发送尝试失败: %w", err)
+	}
+	for i := range notifications {
+		notifications[i].Attempts = slice.Map(attemptMap[notifications[i].ID], func(_ int, src dao.NotificationSendAttempt) domain.SendAttempt {
+			return domain.SendAttempt{
+				Attempt: src.Attempt,
+				Channel: domain.Channel(src.Channel),
+				Status:  domain.SendStatus(src.Status),
+				Message: src.Message,
+				Ctime:   src.Ctime,
+			}
+		})
+	}
+	return nil
+}
+
 // CreateWithCallbackLog 创建单条通知记录，同时创建对应的回调记录
 func (r *notificationRepository) CreateWithCallbackLog(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
 	// 扣减额度
-	err := r.quotaCache.Decr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	err := r.mutiDecr(ctx, []domain.Notification{notification})
 	if err != nil {
 		return domain.Notification{}, err
 	}
 	ds, err := r.dao.CreateWithCallbackLog(ctx, r.toEntity(notification))
 	if err != nil {
-		qerr := r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+		qerr := r.mutiIncr(ctx, []domain.Notification{notification})
 		if qerr != nil {
 			r.logger.Error("额度归还失败", elog.FieldErr(err),
 				elog.Int64("biz_id", notification.BizID),
@@ -204,6 +359,22 @@ func (r *notificationRepository) batchCreate(ctx context.Context, notifications
 	}), nil
 }
 
+func (r *notificationRepository) TransferQuota(ctx context.Context, notification domain.Notification, to domain.Channel) error {
+	target := notification
+	target.Channel = to
+	if err := r.mutiDecr(ctx, []domain.Notification{target}); err != nil {
+		return err
+	}
+	// 目标渠道已经扣减成功，归还原渠道的额度失败只记录日志
+	if err := r.mutiIncr(ctx, []domain.Notification{notification}); err != nil {
+		r.logger.Error("转移额度，归还原渠道额度失败", elog.FieldErr(err),
+			elog.Int64("biz_id", notification.BizID),
+			elog.String("channel", notification.Channel.String()),
+		)
+	}
+	return nil
+}
+
 func (r *notificationRepository) mutiDecr(ctx context.Context, notifications []domain.Notification) error {
 	return r.quotaCache.MutiDecr(ctx, r.getItems(notifications))
 }
@@ -245,7 +416,15 @@ func (r *notificationRepository) GetByID(ctx context.Context, id uint64) (domain
 	if err != nil {
 		return domain.Notification{}, err
 	}
-	return r.toDomain(n), nil
+	notifications := []domain.Notification{r.toDomain(n)}
+	if err = r.fillReceiverResults(ctx, notifications); err != nil {
+		return domain.Notification{}, err
+	}
+	if err = r.fillSendAttempts(ctx, notifications); err != nil {
+		return domain.Notification{}, err
+	}
+	const first = 0
+	return notifications[first], nil
 }
 
 func (r *notificationRepository) BatchGetByIDs(ctx context.Context, ids []uint64) (map[uint64]domain.Notification, error) {
@@ -254,17 +433,34 @@ func (r *notificationRepository) BatchGetByIDs(ctx context.Context, ids []uint64
 	if err != nil {
 		return nil, err
 	}
-	domainNotificationMap := make(map[uint64]domain.Notification, len(notificationMap))
+	notifications := make([]domain.Notification, 0, len(notificationMap))
 	for id := range notificationMap {
-		notification := notificationMap[id]
-		domainNotificationMap[id] = r.toDomain(notification)
+		notifications = append(notifications, r.toDomain(notificationMap[id]))
+	}
+	if err = r.fillReceiverResults(ctx, notifications); err != nil {
+		return nil, err
+	}
+	domainNotificationMap := make(map[uint64]domain.Notification, len(notifications))
+	for i := range notifications {
+		domainNotificationMap[notifications[i].ID] = notifications[i]
 	}
 	return domainNotificationMap, nil
 }
 
 func (r *notificationRepository) GetByKey(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
 	not, err := r.dao.GetByKey(ctx, bizID, key)
-	return r.toDomain(not), err
+	if err != nil {
+		return r.toDomain(not), err
+	}
+	notifications := []domain.Notification{r.toDomain(not)}
+	if err = r.fillReceiverResults(ctx, notifications); err != nil {
+		return domain.Notification{}, err
+	}
+	if err = r.fillSendAttempts(ctx, notifications); err != nil {
+		return domain.Notification{}, err
+	}
+	const first = 0
+	return notifications[first], nil
 }
 
 // GetByKeys 根据业务ID和业务内唯一标识获取通知列表
@@ -277,12 +473,42 @@ func (r *notificationRepository) GetByKeys(ctx context.Context, bizID int64, key
 	for i := range notifications {
 		result[i] = r.toDomain(notifications[i])
 	}
+	if err = r.fillReceiverResults(ctx, result); err != nil {
+		return nil, err
+	}
+	if err = r.fillSendAttempts(ctx, result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
-// CASStatus 更新通知状态
+// CASStatus 更新通知状态，取消通知时归还创建通知时扣减的额度
 func (r *notificationRepository) CASStatus(ctx context.Context, notification domain.Notification) error {
-	return r.dao.CASStatus(ctx, r.toEntity(notification))
+	err := r.dao.CASStatus(ctx, r.toEntity(notification))
+	if err != nil || notification.Status != domain.SendStatusCanceled {
+		return err
+	}
+	// 状态已经更新成功，归还额度失败只记录日志
+	if err = r.mutiIncr(ctx, []domain.Notification{notification}); err != nil {
+		r.logger.Error("取消通知，归还额度失败", elog.FieldErr(err),
+			elog.Int64("biz_id", notification.BizID),
+			elog.String("channel", notification.Channel.String()),
+		)
+	}
+	return nil
+}
+
+func (r *notificationRepository) CASUpdate(ctx context.Context, notification domain.Notification) error {
+	return r.dao.CASUpdate(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) ClaimSending(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	if err := r.dao.ClaimSending(ctx, r.toEntity(notification)); err != nil {
+		return domain.Notification{}, err
+	}
+	notification.Status = domain.SendStatusSending
+	notification.Version++
+	return notification, nil
 }
 
 func (r *notificationRepository) UpdateStatus(ctx context.Context, notification domain.Notification) error {
@@ -303,14 +529,26 @@ func (r *notificationRepository) BatchUpdateStatusSucceededOrFailed(ctx context.
 		failedItems[i] = r.toEntity(failedNotifications[i])
 	}
 
-	err := r.dao.BatchUpdateStatusSucceededOrFailed(ctx, successItems, failedItems)
+	failedIDs, err := r.dao.BatchUpdateStatusSucceededOrFailed(ctx, successItems, failedItems)
 	if err != nil {
 		return err
 	}
 
-	items := r.getItems(failedNotifications)
-	eerr := r.quotaCache.MutiIncr(ctx, items)
-	if eerr != nil {
+	// 只归还实际标记为失败的通知的额度，已经不是 SENDING 的通知额度已经被归还过或者已经发送成功了
+	updated := make(map[uint64]struct{}, len(failedIDs))
+	for _, id := range failedIDs {
+		updated[id] = struct{}{}
+	}
+	refunds := make([]domain.Notification, 0, len(failedIDs))
+	for i := range failedNotifications {
+		if _, ok := updated[failedNotifications[i].ID]; ok {
+			refunds = append(refunds, failedNotifications[i])
+		}
+	}
+	if len(refunds) == 0 {
+		return nil
+	}
+	if eerr := r.mutiIncr(ctx, refunds); eerr != nil {
 		elog.Error("发送失败，归还额度失败", elog.FieldErr(eerr))
 	}
 	return nil
@@ -332,9 +570,65 @@ func (r *notificationRepository) MarkFailed(ctx context.Context, notification do
 	if err != nil {
 		return err
 	}
-	return r.quotaCache.Incr(ctx, notification.BizID, notification.Channel, defaultQuotaNumber)
+	// 状态已经更新成功，归还额度失败只记录日志
+	err = r.mutiIncr(ctx, []domain.Notification{notification})
+	if err != nil {
+		r.logger.Error("发送失败，归还额度失败", elog.FieldErr(err),
+			elog.Int64("biz_id", notification.BizID),
+			elog.String("channel", notification.Channel.String()),
+		)
+	}
+	return nil
 }
 
 func (r *notificationRepository) MarkTimeoutSendingAsFailed(ctx context.Context, batchSize int) (int64, error) {
-	return r.dao.MarkTimeoutSendingAsFailed(ctx, batchSize)
+	timeouts, err := r.dao.MarkTimeoutSendingAsFailed(ctx, batchSize)
+	if err != nil {
+		return 0, err
+	}
+	notifications := slice.Map(timeouts, func(_ int, src dao.Notification) domain.Notification {
+		return domain.Notification{ID: src.ID, BizID: src.BizID, Channel: domain.Channel(src.Channel)}
+	})
+	// 状态已经更新成功，归还额度失败只记录日志
+	if err = r.mutiIncr(ctx, notifications); err != nil {
+		r.logger.Error("发送超时，归还额度失败", elog.FieldErr(err), elog.Int("count", len(notifications)))
+	}
+	return int64(len(timeouts)), nil
+}
+
+func (r *notificationRepository) MarkRetrying(ctx context.Context, notification domain.Notification) error {
+	return r.dao.MarkRetrying(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) MarkDeferred(ctx context.Context, notification domain.Notification) error {
+	return r.dao.MarkDeferred(ctx, r.toEntity(notification))
+}
+
+func (r *notificationRepository) FindRetryNotifications(ctx context.Context, limit int) ([]domain.Notification, error) {
+	nos, err := r.dao.FindRetryNotifications(ctx, limit)
+	return slice.Map(nos, func(_ int, src dao.Notification) domain.Notification {
+		return r.toDomain(src)
+	}), err
+}
+
+func (r *notificationRepository) GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error) {
+	counts, err := r.dao.CountByTemplateVersion(ctx, templateID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.TemplateVersionStats, 0, len(counts))
+	for versionID, statusCounts := range counts {
+		stats := domain.TemplateVersionStats{
+			VersionID: versionID,
+			Counts:    make(map[domain.SendStatus]int64, len(statusCounts)),
+		}
+		for status, cnt := range statusCounts {
+			stats.Counts[domain.SendStatus(status)] = cnt
+		}
+		res = append(res, stats)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].VersionID < res[j].VersionID
+	})
+	return res, nil
 }
@@ -0,0 +1,41 @@
+//go:build unit
+
+package repository
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepository_toEntityTruncateMessage(t *testing.T) {
+	t.Parallel()
+
+	// 供应商返回的错误很长，并且包含中文，要按字符截断
+	longMessage := "供应商错误：" + strings.Repeat("签名不可用", 200)
+	require.Greater(t, utf8.RuneCountInString(longMessage), maxMessageLength)
+
+	r := &notificationRepository{}
+	entity := r.toEntity(domain.Notification{
+		ID:    1,
+		Error: &domain.SendError{Code: domain.SendErrorCodeProviderFault, Message: longMessage},
+		ReceiverResults: []domain.ReceiverResult{
+			{Receiver: "13800000000", Status: domain.SendStatusFailed, Message: longMessage},
+			{Receiver: "13800000001", Status: domain.SendStatusSucceeded, Message: "OK"},
+		},
+		Attempts: []domain.SendAttempt{
+			{Attempt: 1, Channel: domain.ChannelSMS, Status: domain.SendStatusFailed, Message: longMessage},
+		},
+	})
+
+	assert.Equal(t, maxMessageLength, utf8.RuneCountInString(entity.ErrorMessage))
+	assert.True(t, strings.HasPrefix(longMessage, entity.ErrorMessage))
+	assert.True(t, utf8.ValidString(entity.ErrorMessage))
+	assert.Equal(t, maxMessageLength, utf8.RuneCountInString(entity.ReceiverResults[0].Message))
+	assert.Equal(t, "OK", entity.ReceiverResults[1].Message)
+	assert.Equal(t, maxMessageLength, utf8.RuneCountInString(entity.Attempts[0].Message))
+}
@@ -4,42 +4,140 @@ import (
 	"context"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
 )
 
 type QuotaRepository interface {
+	// CreateOrUpdate 重置额度，同时记录重置流水
 	CreateOrUpdate(ctx context.Context, quota ...domain.Quota) error
 	Find(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error)
+	// Adjust 按流水中的变化量调整额度，并记录流水，返回调整后的额度
+	Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.Quota, error)
+	// FindLedgers 按时间倒序分页查询额度流水，channel 为空时查询所有渠道
+	FindLedgers(ctx context.Context, bizID int64, channel domain.Channel, offset, limit int) ([]domain.QuotaLedger, int64, error)
 }
 
+const systemOperator = "system"
+
+// quotaRepository 发送时扣减的是缓存中的额度，数据库只记录重置时的额度以及额度流水
 type quotaRepository struct {
-	dao dao.QuotaDAO
+	dao    dao.QuotaDAO
+	cache  cache.QuotaCache
+	logger *elog.Component
 }
 
-func NewQuotaRepository(dao dao.QuotaDAO) QuotaRepository {
-	return &quotaRepository{dao: dao}
+func NewQuotaRepository(dao dao.QuotaDAO, cache cache.QuotaCache) QuotaRepository {
+	return &quotaRepository{dao: dao, cache: cache, logger: elog.DefaultLogger}
 }
 
 func (q *quotaRepository) CreateOrUpdate(ctx context.Context, quota ...domain.Quota) error {
 	qs := slice.Map(quota, func(_ int, src domain.Quota) dao.Quota {
 		return dao.Quota{
-			Quota:   src.Quota,
-			BizID:   src.BizID,
-			Channel: src.Channel.String(),
+			Quota:      src.Quota,
+			DailyLimit: src.DailyLimit,
+			BizID:      src.BizID,
+			Channel:    src.Channel.String(),
+		}
+	})
+	ledgers := slice.Map(quota, func(_ int, src domain.Quota) dao.QuotaLedger {
+		// 缓存中没有说明之前没有额度
+		var previous int32
+		if found, err := q.cache.Find(ctx, src.BizID, src.Channel); err == nil {
+			previous = found.Quota
+		}
+		return dao.QuotaLedger{
+			BizID:    src.BizID,
+			Channel:  src.Channel.String(),
+			Type:     domain.QuotaLedgerTypeReset.String(),
+			Delta:    src.Quota - previous,
+			Balance:  src.Quota,
+			Reason:   "重置额度",
+			Operator: systemOperator,
 		}
 	})
-	return q.dao.CreateOrUpdate(ctx, qs...)
+	err := q.dao.Reset(ctx, qs, ledgers)
+	if err != nil {
+		return err
+	}
+	return q.cache.CreateOrUpdate(ctx, quota...)
 }
 
+// Find 优先返回缓存中的剩余额度，缓存中没有时返回数据库中记录的额度
 func (q *quotaRepository) Find(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error) {
+	quota, err := q.cache.Find(ctx, bizID, channel)
+	if err == nil {
+		return quota, nil
+	}
 	found, err := q.dao.Find(ctx, bizID, channel.String())
 	if err != nil {
 		return domain.Quota{}, err
 	}
 	return domain.Quota{
-		BizID:   found.BizID,
-		Quota:   found.Quota,
-		Channel: domain.Channel(found.Channel),
+		BizID:      found.BizID,
+		Quota:      found.Quota,
+		DailyLimit: found.DailyLimit,
+		Channel:    domain.Channel(found.Channel),
 	}, nil
 }
+
+func (q *quotaRepository) Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.Quota, error) {
+	err := q.incr(ctx, ledger.BizID, ledger.Channel, ledger.Delta)
+	if err != nil {
+		return domain.Quota{}, err
+	}
+	quota, err := q.cache.Find(ctx, ledger.BizID, ledger.Channel)
+	if err != nil {
+		return domain.Quota{}, err
+	}
+	err = q.dao.CreateLedger(ctx, dao.QuotaLedger{
+		BizID:    ledger.BizID,
+		Channel:  ledger.Channel.String(),
+		Type:     domain.QuotaLedgerTypeAdjust.String(),
+		Delta:    ledger.Delta,
+		Balance:  quota.Quota,
+		Reason:   ledger.Reason,
+		Operator: ledger.Operator,
+	})
+	if err != nil {
+		// 流水记录失败，把额度改回去，保证每一次变化都有流水
+		if rerr := q.incr(ctx, ledger.BizID, ledger.Channel, -ledger.Delta); rerr != nil {
+			q.logger.Error("记录额度流水失败，回滚额度失败", elog.FieldErr(rerr),
+				elog.Int64("biz_id", ledger.BizID),
+				elog.String("channel", ledger.Channel.String()),
+				elog.Any("delta", ledger.Delta),
+			)
+		}
+		return domain.Quota{}, err
+	}
+	return quota, nil
+}
+
+func (q *quotaRepository) incr(ctx context.Context, bizID int64, channel domain.Channel, delta int32) error {
+	if delta < 0 {
+		return q.cache.Decr(ctx, bizID, channel, -delta)
+	}
+	return q.cache.Incr(ctx, bizID, channel, delta)
+}
+
+func (q *quotaRepository) FindLedgers(ctx context.Context, bizID int64, channel domain.Channel, offset, limit int) ([]domain.QuotaLedger, int64, error) {
+	ledgers, total, err := q.dao.FindLedgers(ctx, bizID, channel.String(), offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(ledgers, func(_ int, src dao.QuotaLedger) domain.QuotaLedger {
+		return domain.QuotaLedger{
+			ID:       src.ID,
+			BizID:    src.BizID,
+			Channel:  domain.Channel(src.Channel),
+			Type:     domain.QuotaLedgerType(src.Type),
+			Delta:    src.Delta,
+			Balance:  src.Balance,
+			Reason:   src.Reason,
+			Operator: src.Operator,
+			Ctime:    src.Ctime,
+		}
+	}), total, nil
+}
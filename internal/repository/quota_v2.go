@@ -22,3 +22,21 @@ func (q *quotaRepositoryV2) CreateOrUpdate(ctx context.Context, quota ...domain.
 func (q *quotaRepositoryV2) Find(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error) {
 	return q.ca.Find(ctx, bizID, channel)
 }
+
+func (q *quotaRepositoryV2) Adjust(ctx context.Context, ledger domain.QuotaLedger) (domain.Quota, error) {
+	var err error
+	if ledger.Delta < 0 {
+		err = q.ca.Decr(ctx, ledger.BizID, ledger.Channel, -ledger.Delta)
+	} else {
+		err = q.ca.Incr(ctx, ledger.BizID, ledger.Channel, ledger.Delta)
+	}
+	if err != nil {
+		return domain.Quota{}, err
+	}
+	return q.ca.Find(ctx, ledger.BizID, ledger.Channel)
+}
+
+// FindLedgers 只基于缓存的实现不记录额度流水
+func (q *quotaRepositoryV2) FindLedgers(_ context.Context, _ int64, _ domain.Channel, _, _ int) ([]domain.QuotaLedger, int64, error) {
+	return []domain.QuotaLedger{}, 0, nil
+}
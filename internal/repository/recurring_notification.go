@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+)
+
+// RecurringNotificationRepository 周期通知仓储接口
+type RecurringNotificationRepository interface {
+	// Create 创建周期通知，业务内 key 重复时返回 errs.ErrNotificationDuplicate
+	Create(ctx context.Context, r domain.RecurringNotification) (domain.RecurringNotification, error)
+	// GetByKey 根据业务ID和业务内唯一标识获取周期通知
+	GetByKey(ctx context.Context, bizID int64, key string) (domain.RecurringNotification, error)
+	// FindDue 查询下一次发送时间不晚于 before 的周期通知
+	FindDue(ctx context.Context, before time.Time, limit int) ([]domain.RecurringNotification, error)
+	// CASAdvance 推进周期通知，版本号不匹配时返回 errs.ErrNotificationVersionMismatch
+	CASAdvance(ctx context.Context, r domain.RecurringNotification) error
+}
+
+type recurringNotificationRepository struct {
+	dao dao.RecurringNotificationDAO
+}
+
+func NewRecurringNotificationRepository(d dao.RecurringNotificationDAO) RecurringNotificationRepository {
+	return &recurringNotificationRepository{dao: d}
+}
+
+func (r *recurringNotificationRepository) Create(ctx context.Context, rn domain.RecurringNotification) (domain.RecurringNotification, error) {
+	created, err := r.dao.Create(ctx, r.toEntity(rn))
+	if err != nil {
+		return domain.RecurringNotification{}, err
+	}
+	return r.toDomain(created), nil
+}
+
+func (r *recurringNotificationRepository) GetByKey(ctx context.Context, bizID int64, key string) (domain.RecurringNotification, error) {
+	found, err := r.dao.GetByKey(ctx, bizID, key)
+	if err != nil {
+		return domain.RecurringNotification{}, err
+	}
+	return r.toDomain(found), nil
+}
+
+func (r *recurringNotificationRepository) FindDue(ctx context.Context, before time.Time, limit int) ([]domain.RecurringNotification, error) {
+	found, err := r.dao.FindDue(ctx, before.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(found, func(_ int, src dao.RecurringNotification) domain.RecurringNotification {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *recurringNotificationRepository) CASAdvance(ctx context.Context, rn domain.RecurringNotification) error {
+	return r.dao.CASAdvance(ctx, r.toEntity(rn))
+}
+
+func (r *recurringNotificationRepository) toEntity(rn domain.RecurringNotification) dao.RecurringNotification {
+	n := rn.Notification
+	templateParams, _ := n.MarshalTemplateParams()
+	receivers, _ := n.MarshalReceivers()
+	var endTime int64
+	if !n.SendStrategyConfig.RecurringEndTime.IsZero() {
+		endTime = n.SendStrategyConfig.RecurringEndTime.UnixMilli()
+	}
+	return dao.RecurringNotification{
+		ID:                rn.ID,
+		BizID:             n.BizID,
+		Key:               n.Key,
+		Receivers:         receivers,
+		Channel:           n.Channel.String(),
+		TemplateID:        n.Template.ID,
+		TemplateVersionID: n.Template.VersionID,
+		TemplateParams:    templateParams,
+		Cron:              n.SendStrategyConfig.Cron,
+		Timezone:          n.SendStrategyConfig.Timezone,
+		EndTime:           endTime,
+		MaxOccurrences:    n.SendStrategyConfig.MaxOccurrences,
+		Occurrences:       rn.Occurrences,
+		NextTime:          rn.NextTime.UnixMilli(),
+		Status:            rn.Status.String(),
+		Version:           rn.Version,
+	}
+}
+
+func (r *recurringNotificationRepository) toDomain(rn dao.RecurringNotification) domain.RecurringNotification {
+	var templateParams map[string]string
+	_ = json.Unmarshal([]byte(rn.TemplateParams), &templateParams)
+
+	var receivers []string
+	_ = json.Unmarshal([]byte(rn.Receivers), &receivers)
+
+	cfg := domain.SendStrategyConfig{
+		Type:           domain.SendStrategyRecurring,
+		Cron:           rn.Cron,
+		Timezone:       rn.Timezone,
+		MaxOccurrences: rn.MaxOccurrences,
+	}
+	if rn.EndTime > 0 {
+		cfg.RecurringEndTime = time.UnixMilli(rn.EndTime)
+	}
+	return domain.RecurringNotification{
+		ID: rn.ID,
+		Notification: domain.Notification{
+			ID:        rn.ID,
+			BizID:     rn.BizID,
+			Key:       rn.Key,
+			Receivers: receivers,
+			Channel:   domain.Channel(rn.Channel),
+			Template: domain.Template{
+				ID:        rn.TemplateID,
+				VersionID: rn.TemplateVersionID,
+				Params:    templateParams,
+			},
+			SendStrategyConfig: cfg,
+		},
+		Occurrences: rn.Occurrences,
+		NextTime:    time.UnixMilli(rn.NextTime),
+		Status:      domain.RecurringStatus(rn.Status),
+		Version:     rn.Version,
+		Ctime:       time.UnixMilli(rn.Ctime),
+		Utime:       time.UnixMilli(rn.Utime),
+	}
+}
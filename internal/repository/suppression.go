@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// SuppressionRepository 退订名单仓储接口
+type SuppressionRepository interface {
+	// Add 添加退订记录，已经存在时更新退订原因
+	Add(ctx context.Context, s domain.Suppression) error
+	// Remove 删除退订记录，记录不存在时返回 errs.ErrSuppressionNotFound
+	Remove(ctx context.Context, s domain.Suppression) error
+	// List 按时间倒序分页查询退订记录，receiver 为空时查询所有接收者，同时返回符合条件的总数
+	List(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]domain.Suppression, int64, error)
+	// FindByReceivers 查询接收者的所有退订记录，键为接收者，没有退订的接收者不在结果中
+	FindByReceivers(ctx context.Context, bizID int64, receivers []string) (map[string][]domain.Suppression, error)
+	// IncrReceiverFaults 接收者在渠道上因为自身问题发送失败的次数加一，返回统计窗口内的次数
+	IncrReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string, window time.Duration) (int64, error)
+	// ResetReceiverFaults 清空接收者在渠道上发送失败的次数
+	ResetReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string) error
+}
+
+// suppressionRepository 发送时按接收者查询缓存，退订记录变化时删除缓存
+type suppressionRepository struct {
+	dao    dao.SuppressionDAO
+	cache  cache.SuppressionCache
+	logger *elog.Component
+}
+
+func NewSuppressionRepository(d dao.SuppressionDAO, c cache.SuppressionCache) SuppressionRepository {
+	return &suppressionRepository{dao: d, cache: c, logger: elog.DefaultLogger}
+}
+
+func (r *suppressionRepository) Add(ctx context.Context, s domain.Suppression) error {
+	err := r.dao.Upsert(ctx, r.toEntity(s))
+	if err != nil {
+		return err
+	}
+	return r.cache.Del(ctx, s.BizID, s.Receiver)
+}
+
+func (r *suppressionRepository) Remove(ctx context.Context, s domain.Suppression) error {
+	err := r.dao.Delete(ctx, s.BizID, s.Receiver, s.Channel.String(), s.BusinessType.ToInt64())
+	if err != nil {
+		return err
+	}
+	return r.cache.Del(ctx, s.BizID, s.Receiver)
+}
+
+func (r *suppressionRepository) List(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]domain.Suppression, int64, error) {
+	found, total, err := r.dao.Find(ctx, bizID, receiver, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return slice.Map(found, func(_ int, src dao.Suppression) domain.Suppression {
+		return r.toDomain(src)
+	}), total, nil
+}
+
+// FindByReceivers 优先查询缓存，没有命中的接收者查询数据库之后回写缓存
+func (r *suppressionRepository) FindByReceivers(ctx context.Context, bizID int64, receivers []string) (map[string][]domain.Suppression, error) {
+	cached, err := r.cache.Get(ctx, bizID, receivers)
+	if err != nil {
+		r.logger.Warn("查询退订名单缓存失败", elog.Int64("bizID", bizID), elog.FieldErr(err))
+		cached = make(map[string][]domain.Suppression)
+	}
+	missed := slice.FilterMap(receivers, func(_ int, src string) (string, bool) {
+		_, ok := cached[src]
+		return src, !ok
+	})
+
+	result := make(map[string][]domain.Suppression, len(receivers))
+	for receiver, found := range cached {
+		if len(found) > 0 {
+			result[receiver] = found
+		}
+	}
+	if len(missed) == 0 {
+		return result, nil
+	}
+
+	entities, err := r.dao.FindByReceivers(ctx, bizID, missed)
+	if err != nil {
+		return nil, err
+	}
+	loaded := make(map[string][]domain.Suppression, len(missed))
+	for i := range missed {
+		loaded[missed[i]] = []domain.Suppression{}
+	}
+	for i := range entities {
+		s := r.toDomain(entities[i])
+		loaded[s.Receiver] = append(loaded[s.Receiver], s)
+		result[s.Receiver] = append(result[s.Receiver], s)
+	}
+	if err = r.cache.Set(ctx, bizID, loaded); err != nil {
+		r.logger.Warn("回写退订名单缓存失败", elog.Int64("bizID", bizID), elog.FieldErr(err))
+	}
+	return result, nil
+}
+
+func (r *suppressionRepository) toEntity(s domain.Suppression) dao.Suppression {
+	return dao.Suppression{
+		ID:           s.ID,
+		BizID:        s.BizID,
+		Receiver:     s.Receiver,
+		Channel:      s.Channel.String(),
+		BusinessType: s.BusinessType.ToInt64(),
+		Reason:       s.Reason,
+	}
+}
+
+func (r *suppressionRepository) toDomain(s dao.Suppression) domain.Suppression {
+	return domain.Suppression{
+		ID:           s.ID,
+		BizID:        s.BizID,
+		Channel:      domain.Channel(s.Channel),
+		BusinessType: domain.BusinessType(s.BusinessType),
+		Receiver:     s.Receiver,
+		Reason:       s.Reason,
+		Ctime:        s.Ctime,
+		Utime:        s.Utime,
+	}
+}
+
+func (r *suppressionRepository) IncrReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string, window time.Duration) (int64, error) {
+	return r.cache.IncrReceiverFaults(ctx, bizID, channel, receiver, window)
+}
+
+func (r *suppressionRepository) ResetReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, receiver string) error {
+	return r.cache.DelReceiverFaults(ctx, bizID, channel, receiver)
+}
@@ -27,6 +27,15 @@ type ChannelTemplateRepository interface {
 	// SetTemplateActiveVersion 设置模板的活跃版本
 	SetTemplateActiveVersion(ctx context.Context, templateID, versionID int64) error
 
+	// RollbackTemplateActiveVersion 回滚模版的活跃版本并记录
+	RollbackTemplateActiveVersion(ctx context.Context, record domain.TemplatePublishRecord) error
+
+	// GetPublishRecordsByTemplateID 按时间倒序获取模版活跃版本的切换记录
+	GetPublishRecordsByTemplateID(ctx context.Context, templateID int64) ([]domain.TemplatePublishRecord, error)
+
+	// SetTemplateVersionWeights 设置 A/B 测试各个版本的流量权重，weights 为空时结束 A/B 测试
+	SetTemplateVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error
+
 	// 模版版本相关方法
 
 	// GetTemplateVersionByID 根据ID获取模板版本
@@ -38,10 +47,18 @@ type ChannelTemplateRepository interface {
 	// ForkTemplateVersion 基于已有版本创建新版本
 	ForkTemplateVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error)
 
+	// 本地化内容相关方法
+
+	// SaveTemplateLocale 创建或者更新版本某种语言的本地化内容，providers 是该语言还没有的供应商关联
+	SaveTemplateLocale(ctx context.Context, locale domain.ChannelTemplateLocale, providers []domain.ChannelTemplateProvider) (domain.ChannelTemplateLocale, error)
+
+	// DeleteTemplateLocale 删除版本某种语言的本地化内容以及该语言的供应商关联
+	DeleteTemplateLocale(ctx context.Context, versionID int64, locale string) error
+
 	// 供应商相关方法
 
-	// GetProviderByNameAndChannel 根据名称和渠道获取供应商
-	GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, providerName string, channel domain.Channel) ([]domain.ChannelTemplateProvider, error)
+	// GetProviderByNameAndChannel 根据名称和渠道获取某种语言的供应商，locale 为空时获取默认内容的供应商
+	GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, locale, providerName string, channel domain.Channel) ([]domain.ChannelTemplateProvider, error)
 
 	// BatchCreateTemplateProviders 批量创建模板供应商关联
 	BatchCreateTemplateProviders(ctx context.Context, providers []domain.ChannelTemplateProvider) ([]domain.ChannelTemplateProvider, error)
@@ -109,6 +126,32 @@ func (r *channelTemplateRepository) getTemplates(ctx context.Context, templates
 		return nil, err
 	}
 
+	domainVersions, err := r.getVersions(ctx, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	// 构建模板ID到版本列表的映射
+	templateToVersions := make(map[int64][]domain.ChannelTemplateVersion)
+	for i := range domainVersions {
+		templateToVersions[domainVersions[i].ChannelTemplateID] = append(templateToVersions[domainVersions[i].ChannelTemplateID], domainVersions[i])
+	}
+
+	// 构建最终的领域模型列表
+	result := make([]domain.ChannelTemplate, len(templates))
+	for i, t := range templates {
+		domainTemplate := r.toTemplateDomain(t)
+		// 添加模板关联的版本
+		domainTemplate.Versions = templateToVersions[t.ID]
+		result[i] = domainTemplate
+	}
+
+	return result, nil
+}
+
+// getVersions 转换版本并且加上版本的供应商关联和本地化内容，
+// 默认内容的供应商放在版本上，本地化内容的供应商放在对应的本地化内容上
+func (r *channelTemplateRepository) getVersions(ctx context.Context, versions []dao.ChannelTemplateVersion) ([]domain.ChannelTemplateVersion, error) {
 	// 提取版本IDs
 	versionIDs := make([]int64, len(versions))
 	for i := range versions {
@@ -120,32 +163,39 @@ func (r *channelTemplateRepository) getTemplates(ctx context.Context, templates
 	if err != nil {
 		return nil, err
 	}
+	// 获取所有版本的本地化内容
+	locales, err := r.dao.GetLocalesByVersionIDs(ctx, versionIDs)
+	if err != nil {
+		return nil, err
+	}
 
-	// 构建版本ID到供应商列表的映射
-	versionToProviders := make(map[int64][]domain.ChannelTemplateProvider)
+	// 构建版本ID和语言到供应商列表的映射
+	type versionLocale struct {
+		versionID int64
+		locale    string
+	}
+	localeToProviders := make(map[versionLocale][]domain.ChannelTemplateProvider)
 	for i := range providers {
-		domainProvider := r.toProviderDomain(providers[i])
-		versionToProviders[providers[i].TemplateVersionID] = append(versionToProviders[providers[i].TemplateVersionID], domainProvider)
+		key := versionLocale{versionID: providers[i].TemplateVersionID, locale: providers[i].Locale}
+		localeToProviders[key] = append(localeToProviders[key], r.toProviderDomain(providers[i]))
 	}
 
-	// 构建模板ID到版本列表的映射
-	templateToVersions := make(map[int64][]domain.ChannelTemplateVersion)
-	for i := range versions {
-		domainVersion := r.toVersionDomain(versions[i])
-		// 添加版本关联的供应商
-		domainVersion.Providers = versionToProviders[versions[i].ID]
-		templateToVersions[versions[i].ChannelTemplateID] = append(templateToVersions[versions[i].ChannelTemplateID], domainVersion)
+	// 构建版本ID到本地化内容列表的映射
+	versionToLocales := make(map[int64][]domain.ChannelTemplateLocale)
+	for i := range locales {
+		domainLocale := r.toLocaleDomain(locales[i])
+		domainLocale.Providers = localeToProviders[versionLocale{versionID: locales[i].TemplateVersionID, locale: locales[i].Locale}]
+		versionToLocales[locales[i].TemplateVersionID] = append(versionToLocales[locales[i].TemplateVersionID], domainLocale)
 	}
 
-	// 构建最终的领域模型列表
-	result := make([]domain.ChannelTemplate, len(templates))
-	for i, t := range templates {
-		domainTemplate := r.toTemplateDomain(t)
-		// 添加模板关联的版本
-		domainTemplate.Versions = templateToVersions[t.ID]
-		result[i] = domainTemplate
+	result := make([]domain.ChannelTemplateVersion, len(versions))
+	for i := range versions {
+		domainVersion := r.toVersionDomain(versions[i])
+		// 添加版本关联的供应商和本地化内容
+		domainVersion.Providers = localeToProviders[versionLocale{versionID: versions[i].ID}]
+		domainVersion.Locales = versionToLocales[versions[i].ID]
+		result[i] = domainVersion
 	}
-
 	return result, nil
 }
 
@@ -185,6 +235,40 @@ func (r *channelTemplateRepository) SetTemplateActiveVersion(ctx context.Context
 	return r.dao.SetTemplateActiveVersion(ctx, templateID, versionID)
 }
 
+func (r *channelTemplateRepository) RollbackTemplateActiveVersion(ctx context.Context, record domain.TemplatePublishRecord) error {
+	return r.dao.RollbackTemplateActiveVersion(ctx, dao.ChannelTemplatePublishRecord{
+		TemplateID:    record.TemplateID,
+		FromVersionID: record.FromVersionID,
+		ToVersionID:   record.ToVersionID,
+		Action:        record.Action.String(),
+		OperatorID:    record.OperatorID,
+		Reason:        record.Reason,
+	})
+}
+
+func (r *channelTemplateRepository) SetTemplateVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error {
+	return r.dao.SetTemplateVersionWeights(ctx, templateID, weights)
+}
+
+func (r *channelTemplateRepository) GetPublishRecordsByTemplateID(ctx context.Context, templateID int64) ([]domain.TemplatePublishRecord, error) {
+	records, err := r.dao.GetPublishRecordsByTemplateID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(records, func(_ int, src dao.ChannelTemplatePublishRecord) domain.TemplatePublishRecord {
+		return domain.TemplatePublishRecord{
+			ID:            src.ID,
+			TemplateID:    src.TemplateID,
+			FromVersionID: src.FromVersionID,
+			ToVersionID:   src.ToVersionID,
+			Action:        domain.TemplatePublishAction(src.Action),
+			OperatorID:    src.OperatorID,
+			Reason:        src.Reason,
+			Ctime:         src.Ctime,
+		}
+	}), nil
+}
+
 // 模版版本相关方法
 
 func (r *channelTemplateRepository) GetTemplateVersionByID(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error) {
@@ -192,18 +276,12 @@ func (r *channelTemplateRepository) GetTemplateVersionByID(ctx context.Context,
 	if err != nil {
 		return domain.ChannelTemplateVersion{}, err
 	}
-	providers, err := r.dao.GetProvidersByVersionIDs(ctx, []int64{versionID})
+	versions, err := r.getVersions(ctx, []dao.ChannelTemplateVersion{version})
 	if err != nil {
 		return domain.ChannelTemplateVersion{}, err
 	}
-	domainProviders := make([]domain.ChannelTemplateProvider, 0, len(providers))
-	for i := range providers {
-		domainProviders = append(domainProviders, r.toProviderDomain(providers[i]))
-	}
-
-	domainVersion := r.toVersionDomain(version)
-	domainVersion.Providers = domainProviders
-	return domainVersion, nil
+	const first = 0
+	return versions[first], nil
 }
 
 // CreateTemplateVersion 创建模板版本
@@ -227,25 +305,34 @@ func (r *channelTemplateRepository) ForkTemplateVersion(ctx context.Context, ver
 	if err != nil {
 		return domain.ChannelTemplateVersion{}, err
 	}
-
-	version := r.toVersionDomain(v)
-
-	providers, err := r.dao.GetProvidersByTemplateIDAndVersionID(ctx, v.ChannelTemplateID, v.ID)
+	versions, err := r.getVersions(ctx, []dao.ChannelTemplateVersion{v})
 	if err != nil {
 		return domain.ChannelTemplateVersion{}, err
 	}
+	const first = 0
+	return versions[first], nil
+}
 
-	version.Providers = slice.Map(providers, func(_ int, src dao.ChannelTemplateProvider) domain.ChannelTemplateProvider {
-		return r.toProviderDomain(src)
-	})
+// 本地化内容相关方法
 
-	return version, nil
+func (r *channelTemplateRepository) SaveTemplateLocale(ctx context.Context, locale domain.ChannelTemplateLocale, providers []domain.ChannelTemplateProvider) (domain.ChannelTemplateLocale, error) {
+	saved, err := r.dao.SaveTemplateLocale(ctx, r.toLocaleEntity(locale), slice.Map(providers, func(_ int, src domain.ChannelTemplateProvider) dao.ChannelTemplateProvider {
+		return r.toProviderEntity(src)
+	}))
+	if err != nil {
+		return domain.ChannelTemplateLocale{}, err
+	}
+	return r.toLocaleDomain(saved), nil
+}
+
+func (r *channelTemplateRepository) DeleteTemplateLocale(ctx context.Context, versionID int64, locale string) error {
+	return r.dao.DeleteTemplateLocale(ctx, versionID, locale)
 }
 
 // 供应商相关方法
 
-func (r *channelTemplateRepository) GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, providerName string, channel domain.Channel) ([]domain.ChannelTemplateProvider, error) {
-	providers, err := r.dao.GetProviderByNameAndChannel(ctx, templateID, versionID, providerName, channel.String())
+func (r *channelTemplateRepository) GetProviderByNameAndChannel(ctx context.Context, templateID, versionID int64, locale, providerName string, channel domain.Channel) ([]domain.ChannelTemplateProvider, error) {
+	providers, err := r.dao.GetProviderByNameAndChannel(ctx, templateID, versionID, locale, providerName, channel.String())
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +436,7 @@ func (r *channelTemplateRepository) toTemplateDomain(daoTemplate dao.ChannelTemp
 		Channel:         domain.Channel(daoTemplate.Channel),
 		BusinessType:    domain.BusinessType(daoTemplate.BusinessType),
 		ActiveVersionID: daoTemplate.ActiveVersionID,
+		VersionWeights:  daoTemplate.VersionWeights.Val,
 		Ctime:           daoTemplate.Ctime,
 		Utime:           daoTemplate.Utime,
 	}
@@ -381,6 +469,7 @@ func (r *channelTemplateRepository) toProviderDomain(daoProvider dao.ChannelTemp
 		ProviderID:               daoProvider.ProviderID,
 		ProviderName:             daoProvider.ProviderName,
 		ProviderChannel:          domain.Channel(daoProvider.ProviderChannel),
+		Locale:                   daoProvider.Locale,
 		RequestID:                daoProvider.RequestID,
 		ProviderTemplateID:       daoProvider.ProviderTemplateID,
 		AuditStatus:              domain.AuditStatus(daoProvider.AuditStatus),
@@ -429,6 +518,7 @@ func (r *channelTemplateRepository) toProviderEntity(domainProvider domain.Chann
 		ProviderID:               domainProvider.ProviderID,
 		ProviderName:             domainProvider.ProviderName,
 		ProviderChannel:          domainProvider.ProviderChannel.String(),
+		Locale:                   domainProvider.Locale,
 		RequestID:                domainProvider.RequestID,
 		ProviderTemplateID:       domainProvider.ProviderTemplateID,
 		AuditStatus:              domainProvider.AuditStatus.String(),
@@ -436,3 +526,27 @@ func (r *channelTemplateRepository) toProviderEntity(domainProvider domain.Chann
 		LastReviewSubmissionTime: domainProvider.LastReviewSubmissionTime,
 	}
 }
+
+func (r *channelTemplateRepository) toLocaleDomain(daoLocale dao.ChannelTemplateLocale) domain.ChannelTemplateLocale {
+	return domain.ChannelTemplateLocale{
+		ID:                daoLocale.ID,
+		TemplateID:        daoLocale.TemplateID,
+		TemplateVersionID: daoLocale.TemplateVersionID,
+		Locale:            daoLocale.Locale,
+		Signature:         daoLocale.Signature,
+		Content:           daoLocale.Content,
+		Ctime:             daoLocale.Ctime,
+		Utime:             daoLocale.Utime,
+	}
+}
+
+func (r *channelTemplateRepository) toLocaleEntity(domainLocale domain.ChannelTemplateLocale) dao.ChannelTemplateLocale {
+	return dao.ChannelTemplateLocale{
+		ID:                domainLocale.ID,
+		TemplateID:        domainLocale.TemplateID,
+		TemplateVersionID: domainLocale.TemplateVersionID,
+		Locale:            domainLocale.Locale,
+		Signature:         domainLocale.Signature,
+		Content:           domainLocale.Content,
+	}
+}
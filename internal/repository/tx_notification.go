@@ -5,6 +5,7 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"github.com/ecodeclub/ekit/slice"
 )
 
 type TxNotificationRepository interface {
@@ -45,6 +46,12 @@ func (t *txNotificationRepo) Create(ctx context.Context, txn domain.TxNotificati
 func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Notification {
 	templateParams, _ := notification.MarshalTemplateParams()
 	receivers, _ := notification.MarshalReceivers()
+	receiverLocales, _ := notification.MarshalReceiverLocales()
+	failoverReceivers, _ := notification.MarshalFailoverReceivers()
+	var errorCode, errorMessage string
+	if notification.Error != nil {
+		errorCode, errorMessage = notification.Error.Code.String(), truncateMessage(notification.Error.Message)
+	}
 	return dao.Notification{
 		ID:                notification.ID,
 		BizID:             notification.BizID,
@@ -58,6 +65,21 @@ func (t *txNotificationRepo) toEntity(notification domain.Notification) dao.Noti
 		ScheduledSTime:    notification.ScheduledSTime.UnixMilli(),
 		ScheduledETime:    notification.ScheduledETime.UnixMilli(),
 		Version:           notification.Version,
+		Locale:            notification.Locale,
+		ReceiverLocales:   receiverLocales,
+		FailoverReceivers: failoverReceivers,
+		ErrorCode:         errorCode,
+		ErrorMessage:      errorMessage,
+		// 准备时就有的发送结果是退订的接收者
+		ReceiverResults: slice.Map(notification.ReceiverResults, func(_ int, src domain.ReceiverResult) dao.NotificationReceiverResult {
+			return dao.NotificationReceiverResult{
+				NotificationID: notification.ID,
+				Receiver:       src.Receiver,
+				Status:         src.Status.String(),
+				Code:           src.Code,
+				Message:        truncateMessage(src.Message),
+			}
+		}),
 	}
 }
 
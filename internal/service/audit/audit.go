@@ -2,22 +2,174 @@ package audit
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"github.com/gotomicro/ego/core/elog"
 )
 
+const maxPageSize = 100
+
+// Service 内置的审核服务，审核结果通过 audit_result_events 通知模版服务，
+// 和外部审核系统的结果走同一条链路
+//
 //go:generate mockgen -source=./audit.go -destination=./mocks/audit.mock.go -package=auditmocks -typed Service
 type Service interface {
+	// CreateAudit 创建审核记录并且分配审核人，返回审核记录ID
 	CreateAudit(ctx context.Context, req domain.Audit) (int64, error)
+	// GetByID 查询审核记录以及所有审核意见
+	GetByID(ctx context.Context, id int64) (domain.Audit, error)
+	// ListByReviewer 按时间倒序分页查询分配给审核人的记录，status 为空时查询所有状态，同时返回符合条件的总数
+	ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error)
+	// Assign 重新分配审核人，只能分配审核中的记录
+	Assign(ctx context.Context, id, reviewerID int64) error
+	// Approve 审核通过，comment 不为空时一起保存审核意见
+	Approve(ctx context.Context, id, reviewerID int64, comment string) error
+	// Reject 审核拒绝，必须提供拒绝原因
+	Reject(ctx context.Context, id, reviewerID int64, reason string) error
+	// Comment 添加审核意见，不改变审核状态
+	Comment(ctx context.Context, id, reviewerID int64, content string) (domain.AuditComment, error)
+}
+
+// Config 内置审核的配置
+type Config struct {
+	// Reviewers 审核人ID，新的审核记录分配给手上审核中的记录最少的审核人，为空时不分配，任何审核人都可以审核
+	Reviewers []int64 `yaml:"reviewers"`
+}
+
+type service struct {
+	repo     repository.AuditRepository
+	producer auditevt.ResultCallbackEventProducer
+	cfg      Config
+	logger   *elog.Component
+}
+
+func NewService(repo repository.AuditRepository, producer auditevt.ResultCallbackEventProducer, cfg Config) Service {
+	return &service{repo: repo, producer: producer, cfg: cfg, logger: elog.DefaultLogger}
+}
+
+func (s *service) CreateAudit(ctx context.Context, req domain.Audit) (int64, error) {
+	if req.ResourceID <= 0 || !req.ResourceType.IsTemplate() {
+		return 0, fmt.Errorf("%w: 资源ID %d 资源类型 %s", errs.ErrInvalidParameter, req.ResourceID, req.ResourceType)
+	}
+	req.ReviewerID = s.pickReviewer(ctx)
+	req.Status = domain.AuditStatusInReview
+	created, err := s.repo.Create(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("创建审核记录失败: %w", err)
+	}
+	return created.ID, nil
+}
+
+// pickReviewer 选择手上审核中的记录最少的审核人，数量相同时按配置的顺序。
+// 统计失败时不分配审核人，不影响提交审核
+func (s *service) pickReviewer(ctx context.Context) int64 {
+	if len(s.cfg.Reviewers) == 0 {
+		return 0
+	}
+	counts, err := s.repo.CountInReviewByReviewers(ctx, s.cfg.Reviewers)
+	if err != nil {
+		s.logger.Warn("统计审核人手上的审核记录失败", elog.FieldErr(err))
+		return 0
+	}
+	picked := s.cfg.Reviewers[0]
+	for _, reviewerID := range s.cfg.Reviewers[1:] {
+		if counts[reviewerID] < counts[picked] {
+			picked = reviewerID
+		}
+	}
+	return picked
+}
+
+func (s *service) GetByID(ctx context.Context, id int64) (domain.Audit, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+func (s *service) ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error) {
+	if offset < 0 || limit <= 0 || limit > maxPageSize {
+		return nil, 0, fmt.Errorf("%w: offset = %d, limit = %d", errs.ErrInvalidParameter, offset, limit)
+	}
+	if status != "" && !status.IsValid() {
+		return nil, 0, fmt.Errorf("%w: 审核状态 %s", errs.ErrInvalidParameter, status)
+	}
+	return s.repo.ListByReviewer(ctx, reviewerID, status, offset, limit)
 }
 
-type service struct{}
+func (s *service) Assign(ctx context.Context, id, reviewerID int64) error {
+	if reviewerID <= 0 {
+		return fmt.Errorf("%w: 审核人ID %d", errs.ErrInvalidParameter, reviewerID)
+	}
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !a.Status.IsInReview() {
+		return fmt.Errorf("%w: 审核记录ID %d 状态 %s", errs.ErrAuditAlreadyDecided, id, a.Status)
+	}
+	return s.repo.UpdateReviewer(ctx, id, reviewerID)
+}
+
+func (s *service) Approve(ctx context.Context, id, reviewerID int64, comment string) error {
+	return s.decide(ctx, id, reviewerID, domain.AuditStatusApproved, "", comment)
+}
+
+func (s *service) Reject(ctx context.Context, id, reviewerID int64, reason string) error {
+	if reason == "" {
+		return fmt.Errorf("%w: 拒绝原因不能为空", errs.ErrInvalidParameter)
+	}
+	return s.decide(ctx, id, reviewerID, domain.AuditStatusRejected, reason, "")
+}
 
-func NewService() Service {
-	return &service{}
+func (s *service) decide(ctx context.Context, id, reviewerID int64, status domain.AuditStatus, reason, comment string) error {
+	if reviewerID <= 0 {
+		return fmt.Errorf("%w: 审核人ID %d", errs.ErrInvalidParameter, reviewerID)
+	}
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	// 同一个审核人重复提交相同的结果，说明上一次保存成功但是发送事件失败，只重新发送事件
+	if a.Status != status || a.ReviewerID != reviewerID {
+		if err = a.CanBeDecidedBy(reviewerID); err != nil {
+			return err
+		}
+		a.ReviewerID = reviewerID
+		a.Status = status
+		a.RejectReason = reason
+		a.AuditTime = time.Now().Unix()
+		if err = s.repo.Decide(ctx, a, comment); err != nil {
+			return fmt.Errorf("保存审核结果失败: %w", err)
+		}
+	}
+	err = s.producer.Produce(ctx, auditevt.CallbackResultEvent{
+		ResourceID:   a.ResourceID,
+		ResourceType: a.ResourceType,
+		AuditID:      a.ID,
+		AuditorID:    a.ReviewerID,
+		AuditTime:    a.AuditTime,
+		AuditStatus:  a.Status.String(),
+		RejectReason: a.RejectReason,
+	})
+	if err != nil {
+		return fmt.Errorf("审核结果已保存，发送审核结果事件失败，重新提交审核结果即可: %w", err)
+	}
+	return nil
 }
 
-func (s *service) CreateAudit(_ context.Context, _ domain.Audit) (int64, error) {
-	// TODO implement me
-	panic("implement me")
+func (s *service) Comment(ctx context.Context, id, reviewerID int64, content string) (domain.AuditComment, error) {
+	if reviewerID <= 0 || content == "" {
+		return domain.AuditComment{}, fmt.Errorf("%w: 审核人ID %d，审核意见不能为空", errs.ErrInvalidParameter, reviewerID)
+	}
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return domain.AuditComment{}, err
+	}
+	return s.repo.AddComment(ctx, domain.AuditComment{
+		AuditID:    id,
+		ReviewerID: reviewerID,
+		Content:    content,
+	})
 }
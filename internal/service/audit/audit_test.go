@@ -0,0 +1,248 @@
+//go:build unit
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	evtmocks "gitee.com/flycash/notification-platform/internal/event/mocks"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_CreateAudit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		reviewers    []int64
+		counts       map[int64]int64
+		countErr     error
+		wantReviewer int64
+	}{
+		{
+			name:         "分配给审核中的记录最少的审核人",
+			reviewers:    []int64{1, 2, 3},
+			counts:       map[int64]int64{1: 3, 2: 1, 3: 2},
+			wantReviewer: 2,
+		},
+		{
+			name:         "没有审核中的记录的审核人优先",
+			reviewers:    []int64{1, 2, 3},
+			counts:       map[int64]int64{1: 3, 2: 1},
+			wantReviewer: 3,
+		},
+		{
+			name:         "没有配置审核人",
+			wantReviewer: 0,
+		},
+		{
+			name:         "统计失败时不分配审核人",
+			reviewers:    []int64{1, 2},
+			countErr:     errors.New("mock db error"),
+			wantReviewer: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := newFakeAuditRepo()
+			repo.counts, repo.countErr = tc.counts, tc.countErr
+			svc := NewService(repo, nil, Config{Reviewers: tc.reviewers})
+
+			id, err := svc.CreateAudit(t.Context(), domain.Audit{
+				ResourceID:   10,
+				ResourceType: domain.ResourceTypeTemplate,
+				Content:      "{}",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantReviewer, repo.audits[id].ReviewerID)
+			assert.Equal(t, domain.AuditStatusInReview, repo.audits[id].Status)
+		})
+	}
+}
+
+func TestService_Decide(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		audit     domain.Audit
+		decide    func(svc Service) error
+		produce   func(p *evtmocks.MockResultCallbackEventProducer)
+		wantErr   error
+		wantAudit domain.Audit
+		wantSaves int
+	}{
+		{
+			name:  "审核通过",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusInReview},
+			decide: func(svc Service) error {
+				return svc.Approve(context.Background(), 1, 100, "内容没有问题")
+			},
+			produce: func(p *evtmocks.MockResultCallbackEventProducer) {
+				p.EXPECT().Produce(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, evt auditevt.CallbackResultEvent) error {
+						assert.Equal(t, int64(10), evt.ResourceID)
+						assert.Equal(t, domain.ResourceTypeTemplate, evt.ResourceType)
+						assert.Equal(t, int64(1), evt.AuditID)
+						assert.Equal(t, int64(100), evt.AuditorID)
+						assert.Equal(t, domain.AuditStatusApproved.String(), evt.AuditStatus)
+						assert.NotZero(t, evt.AuditTime)
+						return nil
+					})
+			},
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusApproved},
+			wantSaves: 1,
+		},
+		{
+			name:  "没有分配审核人时任何审核人都可以审核",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, Status: domain.AuditStatusInReview},
+			decide: func(svc Service) error {
+				return svc.Reject(context.Background(), 1, 200, "签名不符合规范")
+			},
+			produce: func(p *evtmocks.MockResultCallbackEventProducer) {
+				p.EXPECT().Produce(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, evt auditevt.CallbackResultEvent) error {
+						assert.Equal(t, domain.AuditStatusRejected.String(), evt.AuditStatus)
+						assert.Equal(t, "签名不符合规范", evt.RejectReason)
+						return nil
+					})
+			},
+			wantAudit: domain.Audit{ReviewerID: 200, Status: domain.AuditStatusRejected, RejectReason: "签名不符合规范"},
+			wantSaves: 1,
+		},
+		{
+			name:  "拒绝必须提供原因",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusInReview},
+			decide: func(svc Service) error {
+				return svc.Reject(context.Background(), 1, 100, "")
+			},
+			wantErr:   errs.ErrInvalidParameter,
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusInReview},
+		},
+		{
+			name:  "不能审核分配给别人的记录",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusInReview},
+			decide: func(svc Service) error {
+				return svc.Approve(context.Background(), 1, 200, "")
+			},
+			wantErr:   errs.ErrAuditReviewerMismatch,
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusInReview},
+		},
+		{
+			name:  "已经有审核结果",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusRejected},
+			decide: func(svc Service) error {
+				return svc.Approve(context.Background(), 1, 100, "")
+			},
+			wantErr:   errs.ErrAuditAlreadyDecided,
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusRejected},
+		},
+		{
+			name:  "重复提交相同的结果只重新发送事件",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusApproved, AuditTime: 1000},
+			decide: func(svc Service) error {
+				return svc.Approve(context.Background(), 1, 100, "")
+			},
+			produce: func(p *evtmocks.MockResultCallbackEventProducer) {
+				p.EXPECT().Produce(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, evt auditevt.CallbackResultEvent) error {
+						assert.Equal(t, int64(1000), evt.AuditTime)
+						return nil
+					})
+			},
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusApproved},
+		},
+		{
+			name:  "发送事件失败",
+			audit: domain.Audit{ID: 1, ResourceID: 10, ResourceType: domain.ResourceTypeTemplate, ReviewerID: 100, Status: domain.AuditStatusInReview},
+			decide: func(svc Service) error {
+				return svc.Approve(context.Background(), 1, 100, "")
+			},
+			produce: func(p *evtmocks.MockResultCallbackEventProducer) {
+				p.EXPECT().Produce(gomock.Any(), gomock.Any()).Return(errors.New("mock kafka error"))
+			},
+			wantErr:   errors.New("mock kafka error"),
+			wantAudit: domain.Audit{ReviewerID: 100, Status: domain.AuditStatusApproved},
+			wantSaves: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			producer := evtmocks.NewMockResultCallbackEventProducer(ctrl)
+			if tc.produce != nil {
+				tc.produce(producer)
+			}
+			repo := newFakeAuditRepo()
+			repo.audits[tc.audit.ID] = tc.audit
+			err := tc.decide(NewService(repo, producer, Config{}))
+			switch {
+			case tc.wantErr == nil:
+				require.NoError(t, err)
+			case errors.Is(tc.wantErr, errs.ErrInvalidParameter),
+				errors.Is(tc.wantErr, errs.ErrAuditReviewerMismatch),
+				errors.Is(tc.wantErr, errs.ErrAuditAlreadyDecided):
+				assert.ErrorIs(t, err, tc.wantErr)
+			default:
+				assert.ErrorContains(t, err, tc.wantErr.Error())
+			}
+			got := repo.audits[tc.audit.ID]
+			assert.Equal(t, tc.wantAudit.ReviewerID, got.ReviewerID)
+			assert.Equal(t, tc.wantAudit.Status, got.Status)
+			assert.Equal(t, tc.wantAudit.RejectReason, got.RejectReason)
+			assert.Equal(t, tc.wantSaves, repo.saves)
+		})
+	}
+}
+
+// fakeAuditRepo 只实现审核服务用到的方法
+type fakeAuditRepo struct {
+	repository.AuditRepository
+	audits   map[int64]domain.Audit
+	counts   map[int64]int64
+	countErr error
+	saves    int
+}
+
+func newFakeAuditRepo() *fakeAuditRepo {
+	return &fakeAuditRepo{audits: make(map[int64]domain.Audit)}
+}
+
+func (f *fakeAuditRepo) Create(_ context.Context, a domain.Audit) (domain.Audit, error) {
+	a.ID = int64(len(f.audits) + 1)
+	f.audits[a.ID] = a
+	return a, nil
+}
+
+func (f *fakeAuditRepo) GetByID(_ context.Context, id int64) (domain.Audit, error) {
+	a, ok := f.audits[id]
+	if !ok {
+		return domain.Audit{}, fmt.Errorf("%w: %d", errs.ErrAuditNotFound, id)
+	}
+	return a, nil
+}
+
+func (f *fakeAuditRepo) CountInReviewByReviewers(_ context.Context, _ []int64) (map[int64]int64, error) {
+	return f.counts, f.countErr
+}
+
+func (f *fakeAuditRepo) Decide(_ context.Context, a domain.Audit, _ string) error {
+	f.saves++
+	f.audits[a.ID] = a
+	return nil
+}
@@ -21,7 +21,6 @@ import (
 type MockService struct {
 	ctrl     *gomock.Controller
 	recorder *MockServiceMockRecorder
-	isgomock struct{}
 }
 
 // MockServiceMockRecorder is the mock recorder for MockService.
@@ -41,6 +40,121 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// Approve mocks base method.
+func (m *MockService) Approve(ctx context.Context, id, reviewerID int64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, id, reviewerID, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockServiceMockRecorder) Approve(ctx, id, reviewerID, comment any) *MockServiceApproveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockService)(nil).Approve), ctx, id, reviewerID, comment)
+	return &MockServiceApproveCall{Call: call}
+}
+
+// MockServiceApproveCall wrap *gomock.Call
+type MockServiceApproveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceApproveCall) Return(arg0 error) *MockServiceApproveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceApproveCall) Do(f func(context.Context, int64, int64, string) error) *MockServiceApproveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceApproveCall) DoAndReturn(f func(context.Context, int64, int64, string) error) *MockServiceApproveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Assign mocks base method.
+func (m *MockService) Assign(ctx context.Context, id, reviewerID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, id, reviewerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockServiceMockRecorder) Assign(ctx, id, reviewerID any) *MockServiceAssignCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockService)(nil).Assign), ctx, id, reviewerID)
+	return &MockServiceAssignCall{Call: call}
+}
+
+// MockServiceAssignCall wrap *gomock.Call
+type MockServiceAssignCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceAssignCall) Return(arg0 error) *MockServiceAssignCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceAssignCall) Do(f func(context.Context, int64, int64) error) *MockServiceAssignCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceAssignCall) DoAndReturn(f func(context.Context, int64, int64) error) *MockServiceAssignCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Comment mocks base method.
+func (m *MockService) Comment(ctx context.Context, id, reviewerID int64, content string) (domain.AuditComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Comment", ctx, id, reviewerID, content)
+	ret0, _ := ret[0].(domain.AuditComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Comment indicates an expected call of Comment.
+func (mr *MockServiceMockRecorder) Comment(ctx, id, reviewerID, content any) *MockServiceCommentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Comment", reflect.TypeOf((*MockService)(nil).Comment), ctx, id, reviewerID, content)
+	return &MockServiceCommentCall{Call: call}
+}
+
+// MockServiceCommentCall wrap *gomock.Call
+type MockServiceCommentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCommentCall) Return(arg0 domain.AuditComment, arg1 error) *MockServiceCommentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCommentCall) Do(f func(context.Context, int64, int64, string) (domain.AuditComment, error)) *MockServiceCommentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCommentCall) DoAndReturn(f func(context.Context, int64, int64, string) (domain.AuditComment, error)) *MockServiceCommentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateAudit mocks base method.
 func (m *MockService) CreateAudit(ctx context.Context, req domain.Audit) (int64, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +193,120 @@ func (c *MockServiceCreateAuditCall) DoAndReturn(f func(context.Context, domain.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetByID mocks base method.
+func (m *MockService) GetByID(ctx context.Context, id int64) (domain.Audit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(domain.Audit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockServiceMockRecorder) GetByID(ctx, id any) *MockServiceGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockService)(nil).GetByID), ctx, id)
+	return &MockServiceGetByIDCall{Call: call}
+}
+
+// MockServiceGetByIDCall wrap *gomock.Call
+type MockServiceGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetByIDCall) Return(arg0 domain.Audit, arg1 error) *MockServiceGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetByIDCall) Do(f func(context.Context, int64) (domain.Audit, error)) *MockServiceGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetByIDCall) DoAndReturn(f func(context.Context, int64) (domain.Audit, error)) *MockServiceGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListByReviewer mocks base method.
+func (m *MockService) ListByReviewer(ctx context.Context, reviewerID int64, status domain.AuditStatus, offset, limit int) ([]domain.Audit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByReviewer", ctx, reviewerID, status, offset, limit)
+	ret0, _ := ret[0].([]domain.Audit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByReviewer indicates an expected call of ListByReviewer.
+func (mr *MockServiceMockRecorder) ListByReviewer(ctx, reviewerID, status, offset, limit any) *MockServiceListByReviewerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByReviewer", reflect.TypeOf((*MockService)(nil).ListByReviewer), ctx, reviewerID, status, offset, limit)
+	return &MockServiceListByReviewerCall{Call: call}
+}
+
+// MockServiceListByReviewerCall wrap *gomock.Call
+type MockServiceListByReviewerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceListByReviewerCall) Return(arg0 []domain.Audit, arg1 int64, arg2 error) *MockServiceListByReviewerCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceListByReviewerCall) Do(f func(context.Context, int64, domain.AuditStatus, int, int) ([]domain.Audit, int64, error)) *MockServiceListByReviewerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceListByReviewerCall) DoAndReturn(f func(context.Context, int64, domain.AuditStatus, int, int) ([]domain.Audit, int64, error)) *MockServiceListByReviewerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reject mocks base method.
+func (m *MockService) Reject(ctx context.Context, id, reviewerID int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, id, reviewerID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockServiceMockRecorder) Reject(ctx, id, reviewerID, reason any) *MockServiceRejectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockService)(nil).Reject), ctx, id, reviewerID, reason)
+	return &MockServiceRejectCall{Call: call}
+}
+
+// MockServiceRejectCall wrap *gomock.Call
+type MockServiceRejectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceRejectCall) Return(arg0 error) *MockServiceRejectCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceRejectCall) Do(f func(context.Context, int64, int64, string) error) *MockServiceRejectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceRejectCall) DoAndReturn(f func(context.Context, int64, int64, string) error) *MockServiceRejectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
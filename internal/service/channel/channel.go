@@ -6,6 +6,7 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
 )
 
 // Channel 渠道接口
@@ -14,6 +15,8 @@ import (
 type Channel interface {
 	// Send 发送通知
 	Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error)
+	// Pick 按当前的供应商选择器选出发送时会使用的供应商，不会真正发送
+	Pick(ctx context.Context, notification domain.Notification) (provider.Provider, error)
 }
 
 // Dispatcher 渠道分发器，对外伪装成Channel，作为统一入口
@@ -35,3 +38,11 @@ func (d *Dispatcher) Send(ctx context.Context, notification domain.Notification)
 	}
 	return channel.Send(ctx, notification)
 }
+
+func (d *Dispatcher) Pick(ctx context.Context, notification domain.Notification) (provider.Provider, error) {
+	channel, ok := d.channels[notification.Channel]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errs.ErrNoAvailableChannel, notification.Channel)
+	}
+	return channel.Pick(ctx, notification)
+}
@@ -10,6 +10,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 	channelmocks "gitee.com/flycash/notification-platform/internal/service/channel/mocks"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/mock/gomock"
@@ -204,3 +205,25 @@ func (s *ChannelTestSuite) TestDispatcherSend() {
 		})
 	}
 }
+
+func (s *ChannelTestSuite) TestDispatcherPick() {
+	t := s.T()
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProvider := providermocks.NewMockProvider(ctrl)
+	mockChannel := channelmocks.NewMockChannel(ctrl)
+	mockChannel.EXPECT().Pick(gomock.Any(), gomock.Any()).Return(mockProvider, nil)
+	dispatcher := NewDispatcher(map[domain.Channel]Channel{
+		domain.ChannelEmail: mockChannel,
+	})
+
+	p, err := dispatcher.Pick(t.Context(), domain.Notification{Channel: domain.ChannelEmail})
+	assert.NoError(t, err)
+	assert.Equal(t, mockProvider, p)
+
+	_, err = dispatcher.Pick(t.Context(), domain.Notification{Channel: domain.ChannelSMS})
+	assert.ErrorIs(t, err, errs.ErrNoAvailableChannel)
+}
@@ -0,0 +1,17 @@
+package channel
+
+import (
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+)
+
+type emailChannel struct {
+	baseChannel
+}
+
+func NewEmailChannel(builder provider.SelectorBuilder) Channel {
+	return &emailChannel{
+		baseChannel{
+			builder: builder,
+		},
+	}
+}
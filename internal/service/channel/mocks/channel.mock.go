@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "gitee.com/flycash/notification-platform/internal/domain"
+	provider "gitee.com/flycash/notification-platform/internal/service/provider"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -21,7 +22,6 @@ import (
 type MockChannel struct {
 	ctrl     *gomock.Controller
 	recorder *MockChannelMockRecorder
-	isgomock struct{}
 }
 
 // MockChannelMockRecorder is the mock recorder for MockChannel.
@@ -41,6 +41,45 @@ func (m *MockChannel) EXPECT() *MockChannelMockRecorder {
 	return m.recorder
 }
 
+// Pick mocks base method.
+func (m *MockChannel) Pick(ctx context.Context, notification domain.Notification) (provider.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pick", ctx, notification)
+	ret0, _ := ret[0].(provider.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pick indicates an expected call of Pick.
+func (mr *MockChannelMockRecorder) Pick(ctx, notification any) *MockChannelPickCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockChannel)(nil).Pick), ctx, notification)
+	return &MockChannelPickCall{Call: call}
+}
+
+// MockChannelPickCall wrap *gomock.Call
+type MockChannelPickCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelPickCall) Return(arg0 provider.Provider, arg1 error) *MockChannelPickCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelPickCall) Do(f func(context.Context, domain.Notification) (provider.Provider, error)) *MockChannelPickCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelPickCall) DoAndReturn(f func(context.Context, domain.Notification) (provider.Provider, error)) *MockChannelPickCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Send mocks base method.
 func (m *MockChannel) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,7 @@ package channel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
@@ -19,12 +20,18 @@ func (s *baseChannel) Send(ctx context.Context, notification domain.Notification
 		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
+	// 最后一次失败的发送结果，其中可能带有每个接收者的失败原因
+	var lastResp domain.SendResponse
+	var lastErr error
 	for {
 		// 获取供应商
 		p, err1 := selector.Next(ctx, notification)
 		if err1 != nil {
-			// 没有可用的供应商
-			return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err1)
+			// 没有可用的供应商，带上最后一次失败的原因，便于区分供应商故障和限流
+			if lastErr != nil {
+				return lastResp, fmt.Errorf("%w: %w: %w", errs.ErrSendNotificationFailed, err1, lastErr)
+			}
+			return lastResp, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err1)
 		}
 
 		// 使用当前供应商发送
@@ -32,7 +39,24 @@ func (s *baseChannel) Send(ctx context.Context, notification domain.Notification
 		if err2 == nil {
 			return resp, nil
 		}
+		// 接收者本身的问题，换供应商也不会成功
+		if errors.Is(err2, errs.ErrInvalidReceiver) {
+			return resp, err2
+		}
+		lastResp, lastErr = resp, err2
+	}
+}
+
+func (s *baseChannel) Pick(ctx context.Context, notification domain.Notification) (provider.Provider, error) {
+	selector, err := s.builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	// 预览不应该影响真正发送时的供应商选择
+	if peeker, ok := selector.(provider.Peeker); ok {
+		return peeker.Peek(ctx, notification)
 	}
+	return selector.Next(ctx, notification)
 }
 
 type smsChannel struct {
@@ -187,6 +187,26 @@ func (s *SMSTestSuite) TestSMSChannelSend() {
 			},
 			assertFunc: assert.NoError,
 		},
+		{
+			name: "接收者无效不再尝试其他供应商",
+			setupMocks: func(ctrl *gomock.Controller) (provider.SelectorBuilder, *providermocks.MockSelector, []*providermocks.MockProvider) {
+				mockBuilder := providermocks.NewMockSelectorBuilder(ctrl)
+				mockSelector := providermocks.NewMockSelector(ctrl)
+				failedProvider := providermocks.NewMockProvider(ctrl)
+
+				mockBuilder.EXPECT().Build().Return(mockSelector, nil)
+
+				// 只会选择一次供应商
+				mockSelector.EXPECT().Next(gomock.Any(), testNotification).Return(failedProvider, nil).Times(1)
+				failedProvider.EXPECT().Send(gomock.Any(), testNotification).
+					Return(domain.SendResponse{}, fmt.Errorf("%w: 供应商1", errs.ErrInvalidReceiver))
+				return mockBuilder, mockSelector, []*providermocks.MockProvider{failedProvider}
+			},
+			expectedResp: domain.SendResponse{},
+			assertFunc: func(t assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, errs.ErrInvalidReceiver, msgAndArgs...)
+			},
+		},
 		{
 			name: "所有供应商都失败",
 			setupMocks: func(ctrl *gomock.Controller) (provider.SelectorBuilder, *providermocks.MockSelector, []*providermocks.MockProvider) {
@@ -216,6 +236,28 @@ func (s *SMSTestSuite) TestSMSChannelSend() {
 				return assert.ErrorIs(t, err, ErrNoAvailableProvider, msgAndArgs...)
 			},
 		},
+		{
+			name: "没有更多供应商时保留最后一个供应商的错误",
+			setupMocks: func(ctrl *gomock.Controller) (provider.SelectorBuilder, *providermocks.MockSelector, []*providermocks.MockProvider) {
+				mockBuilder := providermocks.NewMockSelectorBuilder(ctrl)
+				mockSelector := providermocks.NewMockSelector(ctrl)
+				throttledProvider := providermocks.NewMockProvider(ctrl)
+
+				mockBuilder.EXPECT().Build().Return(mockSelector, nil)
+
+				mockSelector.EXPECT().Next(gomock.Any(), testNotification).Return(throttledProvider, nil)
+				throttledProvider.EXPECT().Send(gomock.Any(), testNotification).
+					Return(domain.SendResponse{}, fmt.Errorf("%w: 供应商1", errs.ErrProviderThrottled))
+				mockSelector.EXPECT().Next(gomock.Any(), testNotification).
+					Return(nil, fmt.Errorf("%w", ErrNoAvailableProvider))
+				return mockBuilder, mockSelector, []*providermocks.MockProvider{throttledProvider}
+			},
+			expectedResp: domain.SendResponse{},
+			assertFunc: func(t assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(t, err, ErrNoAvailableProvider, msgAndArgs...) &&
+					assert.ErrorIs(t, err, errs.ErrProviderThrottled, msgAndArgs...)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -238,3 +280,78 @@ func (s *SMSTestSuite) TestSMSChannelSend() {
 		})
 	}
 }
+
+func (s *SMSTestSuite) TestSMSChannelPick() {
+	t := s.T()
+	t.Parallel()
+
+	ErrBuildSelectorFailed := errors.New("构建选择器失败")
+
+	testNotification := domain.Notification{
+		BizID:   1,
+		Channel: domain.ChannelSMS,
+		Template: domain.Template{
+			ID:     1,
+			Params: map[string]string{"code": "123456"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(ctrl *gomock.Controller) provider.SelectorBuilder
+		wantName   string
+		wantErr    error
+	}{
+		{
+			name: "选出供应商且不发送",
+			setupMocks: func(ctrl *gomock.Controller) provider.SelectorBuilder {
+				mockBuilder := providermocks.NewMockSelectorBuilder(ctrl)
+				mockSelector := providermocks.NewMockSelector(ctrl)
+				mockProvider := providermocks.NewMockProvider(ctrl)
+				mockBuilder.EXPECT().Build().Return(mockSelector, nil)
+				mockSelector.EXPECT().Next(gomock.Any(), testNotification).Return(mockProvider, nil)
+				mockProvider.EXPECT().Name().Return("aliyun")
+				return mockBuilder
+			},
+			wantName: "aliyun",
+		},
+		{
+			name: "构建选择器失败",
+			setupMocks: func(ctrl *gomock.Controller) provider.SelectorBuilder {
+				mockBuilder := providermocks.NewMockSelectorBuilder(ctrl)
+				mockBuilder.EXPECT().Build().Return(nil, ErrBuildSelectorFailed)
+				return mockBuilder
+			},
+			wantErr: ErrBuildSelectorFailed,
+		},
+		{
+			name: "没有可用供应商",
+			setupMocks: func(ctrl *gomock.Controller) provider.SelectorBuilder {
+				mockBuilder := providermocks.NewMockSelectorBuilder(ctrl)
+				mockSelector := providermocks.NewMockSelector(ctrl)
+				mockBuilder.EXPECT().Build().Return(mockSelector, nil)
+				mockSelector.EXPECT().Next(gomock.Any(), testNotification).Return(nil, errs.ErrNoAvailableProvider)
+				return mockBuilder
+			},
+			wantErr: errs.ErrNoAvailableProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			channel := NewSMSChannel(tt.setupMocks(ctrl))
+			p, err := channel.Pick(t.Context(), testNotification)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, p.Name())
+		})
+	}
+}
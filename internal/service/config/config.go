@@ -81,6 +81,16 @@ func (b *BusinessConfigServiceV1) SaveConfig(ctx context.Context, config domain.
 	if config.ID <= 0 {
 		return ErrIDNotSet
 	}
+	if config.QuietHours != nil {
+		if err := config.QuietHours.Validate(); err != nil {
+			return err
+		}
+	}
+	if config.FrequencyCap != nil {
+		if err := config.FrequencyCap.Validate(); err != nil {
+			return err
+		}
+	}
 	// 调用仓库层保存方法
 	return b.repo.SaveConfig(ctx, config)
 }
@@ -0,0 +1,108 @@
+package frequencycap
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository/cache"
+	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// Result 频率上限的检查结果
+type Result struct {
+	// Allowed 可以发送的接收者
+	Allowed []string
+	// Capped 超过上限不再发送的接收者的发送结果
+	Capped []domain.ReceiverResult
+	// DeferUntil 非零值表示整条通知需要推迟到这个时间再发送
+	DeferUntil time.Time
+}
+
+func (r Result) Deferred() bool {
+	return !r.DeferUntil.IsZero()
+}
+
+// Service 接收者发送频率上限服务，按业务方配置的规则统计每个接收者在滑动窗口内收到的通知数量
+//
+//go:generate mockgen -source=./frequency_cap.go -destination=./mocks/frequency_cap.mock.go -package=frequencycapmocks -typed Service
+type Service interface {
+	// Check 检查并记录通知的每个接收者的发送次数，
+	// 返回的可以发送的接收者已经计入了发送次数
+	Check(ctx context.Context, n domain.Notification) Result
+}
+
+type service struct {
+	configSvc   configsvc.BusinessConfigService
+	templateSvc manage.ChannelTemplateService
+	cache       cache.FrequencyCapCache
+	logger      *elog.Component
+}
+
+func NewService(configSvc configsvc.BusinessConfigService,
+	templateSvc manage.ChannelTemplateService,
+	cache cache.FrequencyCapCache,
+) Service {
+	return &service{
+		configSvc:   configSvc,
+		templateSvc: templateSvc,
+		cache:       cache,
+		logger:      elog.DefaultLogger,
+	}
+}
+
+// Check 查询配置、模版或者 Redis 失败时所有接收者都可以发送，频率上限不能影响正常发送
+func (s *service) Check(ctx context.Context, n domain.Notification) Result {
+	all := Result{Allowed: n.Receivers}
+	rule, ok := s.matchRule(ctx, n)
+	if !ok {
+		return all
+	}
+	nextTimes, err := s.cache.Record(ctx, n.BizID, rule, n.ID, n.Receivers, rule.IsDefer())
+	if err != nil {
+		s.logger.Warn("记录接收者发送次数失败，不检查频率上限", elog.Any("notificationID", n.ID), elog.FieldErr(err))
+		return all
+	}
+
+	var res Result
+	for i := range n.Receivers {
+		if nextTimes[i].IsZero() {
+			res.Allowed = append(res.Allowed, n.Receivers[i])
+			continue
+		}
+		if nextTimes[i].After(res.DeferUntil) {
+			res.DeferUntil = nextTimes[i]
+		}
+		res.Capped = append(res.Capped, domain.ReceiverResult{
+			Receiver: n.Receivers[i],
+			Status:   domain.SendStatusFailed,
+			Code:     domain.ReceiverResultCodeFrequencyCapped,
+			Message:  "超过接收者发送频率上限",
+		})
+	}
+	if !rule.IsDefer() || len(res.Capped) == 0 {
+		res.DeferUntil = time.Time{}
+		return res
+	}
+	// 推迟整条通知，所有接收者都没有计入发送次数
+	return Result{Allowed: n.Receivers, DeferUntil: res.DeferUntil}
+}
+
+func (s *service) matchRule(ctx context.Context, n domain.Notification) (domain.FrequencyCapRule, bool) {
+	cfg, err := s.configSvc.GetByID(ctx, n.BizID)
+	if err != nil {
+		s.logger.Warn("查询业务配置失败，不检查频率上限", elog.Int64("bizID", n.BizID), elog.FieldErr(err))
+		return domain.FrequencyCapRule{}, false
+	}
+	if cfg.FrequencyCap == nil || len(cfg.FrequencyCap.Rules) == 0 {
+		return domain.FrequencyCapRule{}, false
+	}
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		s.logger.Warn("查询模版失败，不检查频率上限", elog.Int64("templateID", n.Template.ID), elog.FieldErr(err))
+		return domain.FrequencyCapRule{}, false
+	}
+	return cfg.FrequencyCap.Match(n.Channel, tmpl.BusinessType)
+}
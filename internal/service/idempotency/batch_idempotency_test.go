@@ -65,6 +65,48 @@ func (m *MockNotificationRepository) MarkFailed(ctx context.Context, notificatio
 	return args.Error(0)
 }
 
+func (m *MockNotificationRepository) CASUpdate(ctx context.Context, notification domain.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkRetrying(ctx context.Context, notification domain.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) MarkDeferred(ctx context.Context, notification domain.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error) {
+	args := m.Called(ctx, templateID, startTime, endTime)
+	return args.Get(0).([]domain.TemplateVersionStats), args.Error(1)
+}
+
+func (m *MockNotificationRepository) ClaimSending(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	args := m.Called(ctx, notification)
+	return args.Get(0).(domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) TransferQuota(ctx context.Context, notification domain.Notification, to domain.Channel) error {
+	args := m.Called(ctx, notification, to)
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindRetryNotifications(ctx context.Context, limit int) ([]domain.Notification, error) {
+	args := m.Called(ctx, limit)
+	if err := args.Error(1); err != nil {
+		return nil, err
+	}
+	result, ok := args.Get(0).([]domain.Notification)
+	if !ok {
+		return nil, fmt.Errorf("type assertion failed")
+	}
+	return result, nil
+}
+
 func (m *MockNotificationRepository) GetByKeys(ctx context.Context, bizID int64, keys ...string) ([]domain.Notification, error) {
 	args := m.Called(ctx, bizID, keys)
 	if err := args.Error(1); err != nil {
@@ -173,6 +215,18 @@ func (m *MockNotificationRepository) Create(ctx context.Context, notification do
 	return result, nil
 }
 
+func (m *MockNotificationRepository) CreateSuppressed(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	args := m.Called(ctx, notification)
+	if err := args.Error(1); err != nil {
+		return domain.Notification{}, err
+	}
+	result, ok := args.Get(0).(domain.Notification)
+	if !ok {
+		return domain.Notification{}, fmt.Errorf("type assertion failed")
+	}
+	return result, nil
+}
+
 func (m *MockNotificationRepository) BatchCreate(ctx context.Context, notifications []domain.Notification) ([]domain.Notification, error) {
 	args := m.Called(ctx, notifications)
 	if err := args.Error(1); err != nil {
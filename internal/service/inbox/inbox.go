@@ -0,0 +1,110 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+)
+
+const maxPageSize = 100
+
+// Service 站内信服务
+//
+//go:generate mockgen -source=./inbox.go -destination=./mocks/inbox.mock.go -package=inboxmocks -typed Service
+type Service interface {
+	// Save 保存站内信，同一通知同一接收者只会保存一次
+	Save(ctx context.Context, msgs []domain.InboxMessage) error
+	// List 分页查询接收者的站内信，按创建时间倒序，同时返回符合条件的总数
+	List(ctx context.Context, bizID int64, receiver string, unreadOnly bool, offset, limit int) ([]domain.InboxMessage, int64, error)
+	// MarkRead 标记为已读，ids 为空时将接收者的全部站内信标记为已读
+	MarkRead(ctx context.Context, bizID int64, receiver string, ids []uint64) error
+	// MarkUnread 标记为未读
+	MarkUnread(ctx context.Context, bizID int64, receiver string, ids []uint64) error
+	// Delete 删除站内信
+	Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error
+	// UnreadCount 未读站内信数量
+	UnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error)
+}
+
+type service struct {
+	repo repository.InboxRepository
+}
+
+// NewService 创建站内信服务
+func NewService(repo repository.InboxRepository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) Save(ctx context.Context, msgs []domain.InboxMessage) error {
+	return s.repo.BatchCreate(ctx, msgs)
+}
+
+func (s *service) List(ctx context.Context, bizID int64, receiver string, unreadOnly bool, offset, limit int) ([]domain.InboxMessage, int64, error) {
+	if err := s.validateReceiver(bizID, receiver); err != nil {
+		return nil, 0, err
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("%w: offset不能小于0", errs.ErrInvalidParameter)
+	}
+	if limit <= 0 || limit > maxPageSize {
+		return nil, 0, fmt.Errorf("%w: limit取值范围为(0, %d]", errs.ErrInvalidParameter, maxPageSize)
+	}
+	return s.repo.Find(ctx, bizID, receiver, unreadOnly, offset, limit)
+}
+
+func (s *service) MarkRead(ctx context.Context, bizID int64, receiver string, ids []uint64) error {
+	if err := s.validateReceiver(bizID, receiver); err != nil {
+		return err
+	}
+	if len(ids) > maxPageSize {
+		return fmt.Errorf("%w: 站内信ID数量不能超过%d", errs.ErrInvalidParameter, maxPageSize)
+	}
+	return s.repo.UpdateStatus(ctx, bizID, receiver, ids, domain.InboxMessageStatusRead)
+}
+
+func (s *service) MarkUnread(ctx context.Context, bizID int64, receiver string, ids []uint64) error {
+	if err := s.validate(bizID, receiver, ids); err != nil {
+		return err
+	}
+	return s.repo.UpdateStatus(ctx, bizID, receiver, ids, domain.InboxMessageStatusUnread)
+}
+
+func (s *service) Delete(ctx context.Context, bizID int64, receiver string, ids []uint64) error {
+	if err := s.validate(bizID, receiver, ids); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, bizID, receiver, ids)
+}
+
+func (s *service) UnreadCount(ctx context.Context, bizID int64, receiver string) (int64, error) {
+	if err := s.validateReceiver(bizID, receiver); err != nil {
+		return 0, err
+	}
+	return s.repo.CountUnread(ctx, bizID, receiver)
+}
+
+func (s *service) validate(bizID int64, receiver string, ids []uint64) error {
+	if err := s.validateReceiver(bizID, receiver); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: 站内信ID不能为空", errs.ErrInvalidParameter)
+	}
+	if len(ids) > maxPageSize {
+		return fmt.Errorf("%w: 站内信ID数量不能超过%d", errs.ErrInvalidParameter, maxPageSize)
+	}
+	return nil
+}
+
+func (s *service) validateReceiver(bizID int64, receiver string) error {
+	if bizID <= 0 {
+		return fmt.Errorf("%w: 业务ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if receiver == "" {
+		return fmt.Errorf("%w: 接收者不能为空", errs.ErrInvalidParameter)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/retry"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/ecodeclub/ekit/syncx"
 	"github.com/gotomicro/ego/client/egrpc"
 	"github.com/gotomicro/ego/core/elog"
@@ -175,23 +176,64 @@ func (c *service) buildRequest(notification domain.Notification) *clientv1.Handl
 		NotificationId: notification.ID,
 		OriginalRequest: &notificationv1.SendNotificationRequest{
 			Notification: &notificationv1.Notification{
-				Key:            notification.Key,
-				Receivers:      notification.Receivers,
-				Channel:        c.getChannel(notification),
-				TemplateId:     fmt.Sprintf("%d", notification.Template.ID),
-				TemplateParams: templateParams,
+				Key:             notification.Key,
+				Receivers:       notification.Receivers,
+				Channel:         c.getChannel(notification.Channel),
+				TemplateId:      fmt.Sprintf("%d", notification.Template.ID),
+				TemplateParams:  templateParams,
+				Locale:          notification.Locale,
+				ReceiverLocales: notification.ReceiverLocales,
+
+				FailoverReceivers: c.getFailoverReceivers(notification.FailoverReceivers),
 			},
 		},
 		Result: &notificationv1.SendNotificationResponse{
 			NotificationId: notification.ID,
-			Status:         c.getStatus(notification),
+			Status:         c.getStatus(notification.Status),
+			SentChannel:    c.getChannel(notification.SentChannel),
+			ErrorCode:      c.getErrorCode(notification.Error),
+			ErrorMessage:   c.getErrorMessage(notification.Error),
+			ReceiverResults: slice.Map(notification.ReceiverResults, func(_ int, src domain.ReceiverResult) *notificationv1.ReceiverResult {
+				return &notificationv1.ReceiverResult{
+					Receiver: src.Receiver,
+					Status:   c.getStatus(src.Status),
+					Code:     src.Code,
+					Message:  src.Message,
+					Provider: src.Provider,
+				}
+			}),
 		},
 	}
 }
 
-func (c *service) getChannel(notification domain.Notification) notificationv1.Channel {
+func (c *service) getErrorCode(sendErr *domain.SendError) notificationv1.ErrorCode {
+	if sendErr == nil {
+		return notificationv1.ErrorCode_ERROR_CODE_UNSPECIFIED
+	}
+	return sendErr.Code.ToAPI()
+}
+
+func (c *service) getErrorMessage(sendErr *domain.SendError) string {
+	if sendErr == nil {
+		return ""
+	}
+	return sendErr.Message
+}
+
+func (c *service) getFailoverReceivers(receivers map[domain.Channel][]string) []*notificationv1.FailoverReceivers {
+	if len(receivers) == 0 {
+		return nil
+	}
+	res := make([]*notificationv1.FailoverReceivers, 0, len(receivers))
+	for ch, val := range receivers {
+		res = append(res, &notificationv1.FailoverReceivers{Channel: c.getChannel(ch), Receivers: val})
+	}
+	return res
+}
+
+func (c *service) getChannel(ch domain.Channel) notificationv1.Channel {
 	var channel notificationv1.Channel
-	switch notification.Channel {
+	switch ch {
 	case domain.ChannelSMS:
 		channel = notificationv1.Channel_SMS
 	case domain.ChannelEmail:
@@ -204,19 +246,29 @@ func (c *service) getChannel(notification domain.Notification) notificationv1.Ch
 	return channel
 }
 
-func (c *service) getStatus(notification domain.Notification) notificationv1.SendStatus {
+func (c *service) getStatus(sendStatus domain.SendStatus) notificationv1.SendStatus {
 	var status notificationv1.SendStatus
-	switch notification.Status {
+	switch sendStatus {
 	case domain.SendStatusSucceeded:
 		status = notificationv1.SendStatus_SUCCEEDED
 	case domain.SendStatusFailed:
 		status = notificationv1.SendStatus_FAILED
+	case domain.SendStatusPartialSuccess:
+		status = notificationv1.SendStatus_PARTIAL_SUCCESS
+	case domain.SendStatusDelivered:
+		status = notificationv1.SendStatus_DELIVERED
+	case domain.SendStatusUndelivered:
+		status = notificationv1.SendStatus_UNDELIVERED
 	case domain.SendStatusPrepare:
 		status = notificationv1.SendStatus_PREPARE
 	case domain.SendStatusCanceled:
 		status = notificationv1.SendStatus_CANCELED
 	case domain.SendStatusPending:
 		status = notificationv1.SendStatus_PENDING
+	case domain.SendStatusRetrying:
+		status = notificationv1.SendStatus_RETRYING
+	case domain.SendStatusSuppressed:
+		status = notificationv1.SendStatus_SUPPRESSED
 	case domain.SendStatusSending:
 		status = notificationv1.SendStatus_SEND_STATUS_UNSPECIFIED
 	default:
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	domain "gitee.com/flycash/notification-platform/internal/domain"
+	notification "gitee.com/flycash/notification-platform/internal/service/notification"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -21,7 +22,6 @@ import (
 type MockService struct {
 	ctrl     *gomock.Controller
 	recorder *MockServiceMockRecorder
-	isgomock struct{}
 }
 
 // MockServiceMockRecorder is the mock recorder for MockService.
@@ -41,6 +41,89 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// BatchCancel mocks base method.
+func (m *MockService) BatchCancel(ctx context.Context, bizID int64, keys ...string) ([]notification.CancelResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, bizID}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchCancel", varargs...)
+	ret0, _ := ret[0].([]notification.CancelResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchCancel indicates an expected call of BatchCancel.
+func (mr *MockServiceMockRecorder) BatchCancel(ctx, bizID any, keys ...any) *MockServiceBatchCancelCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, bizID}, keys...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchCancel", reflect.TypeOf((*MockService)(nil).BatchCancel), varargs...)
+	return &MockServiceBatchCancelCall{Call: call}
+}
+
+// MockServiceBatchCancelCall wrap *gomock.Call
+type MockServiceBatchCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceBatchCancelCall) Return(arg0 []notification.CancelResult, arg1 error) *MockServiceBatchCancelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceBatchCancelCall) Do(f func(context.Context, int64, ...string) ([]notification.CancelResult, error)) *MockServiceBatchCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceBatchCancelCall) DoAndReturn(f func(context.Context, int64, ...string) ([]notification.CancelResult, error)) *MockServiceBatchCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Cancel mocks base method.
+func (m *MockService) Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, bizID, key)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockServiceMockRecorder) Cancel(ctx, bizID, key any) *MockServiceCancelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockService)(nil).Cancel), ctx, bizID, key)
+	return &MockServiceCancelCall{Call: call}
+}
+
+// MockServiceCancelCall wrap *gomock.Call
+type MockServiceCancelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceCancelCall) Return(arg0 domain.Notification, arg1 error) *MockServiceCancelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceCancelCall) Do(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceCancelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceCancelCall) DoAndReturn(f func(context.Context, int64, string) (domain.Notification, error)) *MockServiceCancelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindReadyNotifications mocks base method.
 func (m *MockService) FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error) {
 	m.ctrl.T.Helper()
@@ -123,3 +206,120 @@ func (c *MockServiceGetByKeysCall) DoAndReturn(f func(context.Context, int64, ..
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetTemplateVersionStats mocks base method.
+func (m *MockService) GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersionStats", ctx, templateID, startTime, endTime)
+	ret0, _ := ret[0].([]domain.TemplateVersionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersionStats indicates an expected call of GetTemplateVersionStats.
+func (mr *MockServiceMockRecorder) GetTemplateVersionStats(ctx, templateID, startTime, endTime any) *MockServiceGetTemplateVersionStatsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersionStats", reflect.TypeOf((*MockService)(nil).GetTemplateVersionStats), ctx, templateID, startTime, endTime)
+	return &MockServiceGetTemplateVersionStatsCall{Call: call}
+}
+
+// MockServiceGetTemplateVersionStatsCall wrap *gomock.Call
+type MockServiceGetTemplateVersionStatsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceGetTemplateVersionStatsCall) Return(arg0 []domain.TemplateVersionStats, arg1 error) *MockServiceGetTemplateVersionStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceGetTemplateVersionStatsCall) Do(f func(context.Context, int64, int64, int64) ([]domain.TemplateVersionStats, error)) *MockServiceGetTemplateVersionStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceGetTemplateVersionStatsCall) DoAndReturn(f func(context.Context, int64, int64, int64) ([]domain.TemplateVersionStats, error)) *MockServiceGetTemplateVersionStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveSuppressed mocks base method.
+func (m *MockService) SaveSuppressed(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSuppressed", ctx, n)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSuppressed indicates an expected call of SaveSuppressed.
+func (mr *MockServiceMockRecorder) SaveSuppressed(ctx, n any) *MockServiceSaveSuppressedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSuppressed", reflect.TypeOf((*MockService)(nil).SaveSuppressed), ctx, n)
+	return &MockServiceSaveSuppressedCall{Call: call}
+}
+
+// MockServiceSaveSuppressedCall wrap *gomock.Call
+type MockServiceSaveSuppressedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceSaveSuppressedCall) Return(arg0 domain.Notification, arg1 error) *MockServiceSaveSuppressedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceSaveSuppressedCall) Do(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockServiceSaveSuppressedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceSaveSuppressedCall) DoAndReturn(f func(context.Context, domain.Notification) (domain.Notification, error)) *MockServiceSaveSuppressedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m *MockService) Update(ctx context.Context, update domain.NotificationUpdate) (domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, update)
+	ret0, _ := ret[0].(domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockServiceMockRecorder) Update(ctx, update any) *MockServiceUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, update)
+	return &MockServiceUpdateCall{Call: call}
+}
+
+// MockServiceUpdateCall wrap *gomock.Call
+type MockServiceUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServiceUpdateCall) Return(arg0 domain.Notification, arg1 error) *MockServiceUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServiceUpdateCall) Do(f func(context.Context, domain.NotificationUpdate) (domain.Notification, error)) *MockServiceUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServiceUpdateCall) DoAndReturn(f func(context.Context, domain.NotificationUpdate) (domain.Notification, error)) *MockServiceUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
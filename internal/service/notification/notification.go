@@ -2,12 +2,16 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/errs"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
 )
 
 // Service 通知服务接口
@@ -19,17 +23,50 @@ type Service interface {
 	FindReadyNotifications(ctx context.Context, offset, limit int) ([]domain.Notification, error)
 	// GetByKeys 根据业务ID和业务内唯一标识获取通知列表
 	GetByKeys(ctx context.Context, bizID int64, keys ...string) ([]domain.Notification, error)
+	// Cancel 取消还没有开始发送的通知，已经在发送或者已经结束时返回 errs.ErrNotificationNotCancelable
+	Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error)
+	// BatchCancel 批量取消通知，按 keys 的顺序返回每个通知的取消结果
+	BatchCancel(ctx context.Context, bizID int64, keys ...string) ([]CancelResult, error)
+	// Update 修改还没有开始发送的通知，已经在发送或者已经结束时返回 errs.ErrNotificationNotEditable
+	Update(ctx context.Context, update domain.NotificationUpdate) (domain.Notification, error)
+	// GetTemplateVersionStats 统计 [startTime, endTime) 内创建的通知中模版每个版本的发送结果，时间单位为毫秒，
+	// 用于比较 A/B 测试中各个版本的效果
+	GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error)
+	// SaveSuppressed 保存所有接收者都已退订的通知，状态为 SUPPRESSED，不扣减额度。
+	// 业务方重试时返回已经保存的通知
+	SaveSuppressed(ctx context.Context, n domain.Notification) (domain.Notification, error)
+}
+
+// maxStatsRange 版本统计一次最多查询的时间范围
+const maxStatsRange = 31 * 24 * time.Hour
+
+// CancelResult 单个通知的取消结果
+type CancelResult struct {
+	Key string
+	// Notification 取消成功时状态为 CANCELED，取消失败时是通知当前的状态
+	Notification domain.Notification
+	Err          error
 }
 
 // notificationService 通知服务实现
 type notificationService struct {
-	repo repository.NotificationRepository
+	repo            repository.NotificationRepository
+	templateSvc     manage.ChannelTemplateService
+	suppressionSvc  suppression.Service
+	localeFallbacks domain.LocaleFallbacks
 }
 
 // NewNotificationService 创建通知服务实例
-func NewNotificationService(repo repository.NotificationRepository) Service {
+func NewNotificationService(repo repository.NotificationRepository,
+	templateSvc manage.ChannelTemplateService,
+	suppressionSvc suppression.Service,
+	localeFallbacks domain.LocaleFallbacks,
+) Service {
 	return &notificationService{
-		repo: repo,
+		repo:            repo,
+		templateSvc:     templateSvc,
+		suppressionSvc:  suppressionSvc,
+		localeFallbacks: localeFallbacks,
 	}
 }
 
@@ -50,3 +87,171 @@ func (s *notificationService) GetByKeys(ctx context.Context, bizID int64, keys .
 	}
 	return notifications, nil
 }
+
+// Cancel 取消还没有开始发送的通知
+func (s *notificationService) Cancel(ctx context.Context, bizID int64, key string) (domain.Notification, error) {
+	results, err := s.BatchCancel(ctx, bizID, key)
+	if err != nil {
+		return domain.Notification{}, err
+	}
+	const first = 0
+	return results[first].Notification, results[first].Err
+}
+
+// BatchCancel 批量取消通知，每个通知单独通过 CAS 更新状态，某个通知取消失败不影响其他通知
+func (s *notificationService) BatchCancel(ctx context.Context, bizID int64, keys ...string) ([]CancelResult, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: 业务内唯一标识列表空", errs.ErrInvalidParameter)
+	}
+	notifications, err := s.repo.GetByKeys(ctx, bizID, keys...)
+	if err != nil {
+		return nil, fmt.Errorf("获取通知列表失败: %w", err)
+	}
+	notificationMap := make(map[string]domain.Notification, len(notifications))
+	for i := range notifications {
+		notificationMap[notifications[i].Key] = notifications[i]
+	}
+
+	results := make([]CancelResult, 0, len(keys))
+	for _, key := range keys {
+		n, ok := notificationMap[key]
+		if !ok {
+			results = append(results, CancelResult{
+				Key: key,
+				Err: fmt.Errorf("%w: key = %s", errs.ErrNotificationNotFound, key),
+			})
+			continue
+		}
+		results = append(results, s.cancel(ctx, n))
+	}
+	return results, nil
+}
+
+func (s *notificationService) cancel(ctx context.Context, n domain.Notification) CancelResult {
+	res := CancelResult{Key: n.Key, Notification: n}
+	if !n.IsCancelable() {
+		res.Err = fmt.Errorf("%w: 当前状态 %s", errs.ErrNotificationNotCancelable, n.Status)
+		return res
+	}
+	canceled := n
+	canceled.Status = domain.SendStatusCanceled
+	err := s.repo.CASStatus(ctx, canceled)
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		// 查询之后通知被调度发送了
+		res.Err = fmt.Errorf("%w: 通知状态已经变化", errs.ErrNotificationNotCancelable)
+		return res
+	}
+	if err != nil {
+		res.Err = fmt.Errorf("取消通知失败: %w", err)
+		return res
+	}
+	canceled.Version++
+	res.Notification = canceled
+	return res
+}
+
+// Update 修改还没有开始发送的通知，通过版本号避免和调度发送并发修改
+func (s *notificationService) Update(ctx context.Context, update domain.NotificationUpdate) (domain.Notification, error) {
+	if update.Key == "" {
+		return domain.Notification{}, fmt.Errorf("%w: 业务内唯一标识为空", errs.ErrInvalidParameter)
+	}
+	notifications, err := s.repo.GetByKeys(ctx, update.BizID, update.Key)
+	if err != nil {
+		return domain.Notification{}, fmt.Errorf("获取通知失败: %w", err)
+	}
+	if len(notifications) == 0 {
+		return domain.Notification{}, fmt.Errorf("%w: key = %s", errs.ErrNotificationNotFound, update.Key)
+	}
+	const first = 0
+	n := notifications[first]
+	if !n.IsEditable() {
+		return n, fmt.Errorf("%w: 当前状态 %s", errs.ErrNotificationNotEditable, n.Status)
+	}
+	if err = n.ApplyUpdate(update); err != nil {
+		return domain.Notification{}, err
+	}
+	if update.TemplateParams != nil || update.Receivers != nil {
+		if err = s.validateParams(ctx, n); err != nil {
+			return domain.Notification{}, err
+		}
+	}
+	if update.Receivers != nil {
+		if err = s.filterSuppressed(ctx, &n); err != nil {
+			return domain.Notification{}, err
+		}
+	}
+	err = s.repo.CASUpdate(ctx, n)
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		// 查询之后通知被调度发送或者被其他请求修改了
+		return domain.Notification{}, fmt.Errorf("%w: 通知已经变化，请重新查询", errs.ErrNotificationNotEditable)
+	}
+	if err != nil {
+		return domain.Notification{}, fmt.Errorf("修改通知失败: %w", err)
+	}
+	n.Version++
+	return n, nil
+}
+
+// validateParams 和创建通知时一样，按通知使用的模版版本校验参数，接收者的语言变化也会影响用到的本地化内容
+func (s *notificationService) validateParams(ctx context.Context, n domain.Notification) error {
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		return fmt.Errorf("获取模版失败: %w", err)
+	}
+	version := tmpl.GetVersion(n.Template.VersionID)
+	if version == nil {
+		return fmt.Errorf("%w: 模板ID: %d 版本ID: %d", errs.ErrTemplateVersionNotFound, n.Template.ID, n.Template.VersionID)
+	}
+	if err = version.ValidateParams(s.localeFallbacks, n); err != nil {
+		return fmt.Errorf("%w: 模板ID: %d %w", errs.ErrInvalidParameter, n.Template.ID, err)
+	}
+	return nil
+}
+
+// filterSuppressed 和创建通知时一样过滤退订的接收者，退订的接收者的结果和通知一起保存。
+// 修改后所有接收者都已退订时不允许修改，业务方应该取消通知
+func (s *notificationService) filterSuppressed(ctx context.Context, n *domain.Notification) error {
+	allowed, suppressed, err := s.suppressionSvc.Filter(ctx, *n)
+	if err != nil {
+		return fmt.Errorf("过滤退订的接收者失败: %w", err)
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("%w: 所有接收者都已退订", errs.ErrInvalidParameter)
+	}
+	n.Receivers = allowed
+	n.ReceiverResults = suppressed
+	return nil
+}
+
+// GetTemplateVersionStats 统计模版每个版本的发送结果
+func (s *notificationService) GetTemplateVersionStats(ctx context.Context, templateID, startTime, endTime int64) ([]domain.TemplateVersionStats, error) {
+	if templateID <= 0 {
+		return nil, fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if startTime <= 0 || endTime <= startTime || endTime-startTime > maxStatsRange.Milliseconds() {
+		return nil, fmt.Errorf("%w: 统计时间范围 [%d, %d) 不合法，最多统计 %s", errs.ErrInvalidParameter, startTime, endTime, maxStatsRange)
+	}
+	stats, err := s.repo.GetTemplateVersionStats(ctx, templateID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("统计模版版本发送结果失败: %w", err)
+	}
+	return stats, nil
+}
+
+// SaveSuppressed 保存所有接收者都已退订的通知
+func (s *notificationService) SaveSuppressed(ctx context.Context, n domain.Notification) (domain.Notification, error) {
+	n.MarkSuppressed()
+	created, err := s.repo.CreateSuppressed(ctx, n)
+	if err == nil {
+		return created, nil
+	}
+	if !errors.Is(err, errs.ErrNotificationDuplicate) {
+		return domain.Notification{}, fmt.Errorf("保存退订的通知失败: %w", err)
+	}
+	// 唯一索引冲突表示业务方重试
+	found, err := s.repo.GetByKey(ctx, n.BizID, n.Key)
+	if err != nil {
+		return domain.Notification{}, fmt.Errorf("获取通知失败: %w", err)
+	}
+	return found, nil
+}
@@ -0,0 +1,345 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	suppressionmocks "gitee.com/flycash/notification-platform/internal/service/suppression/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationService_BatchCancel(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	repo := &fakeNotificationRepo{
+		notifications: []domain.Notification{
+			{ID: 1, BizID: bizID, Key: "pending", Status: domain.SendStatusPending, Version: 1},
+			{ID: 2, BizID: bizID, Key: "sending", Status: domain.SendStatusSending, Version: 1},
+			{ID: 3, BizID: bizID, Key: "succeeded", Status: domain.SendStatusSucceeded, Version: 1},
+			{ID: 4, BizID: bizID, Key: "retrying", Status: domain.SendStatusRetrying, Version: 1},
+			// 查询之后被调度发送了
+			{ID: 5, BizID: bizID, Key: "scheduled", Status: domain.SendStatusPending, Version: 1},
+		},
+		conflicts: map[uint64]bool{5: true},
+	}
+	svc := NewNotificationService(repo, nil, nil, nil)
+
+	results, err := svc.BatchCancel(t.Context(), bizID, "pending", "sending", "succeeded", "retrying", "scheduled", "unknown")
+	require.NoError(t, err)
+	require.Len(t, results, 6)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, domain.SendStatusCanceled, results[0].Notification.Status)
+	assert.Equal(t, 2, results[0].Notification.Version)
+
+	assert.ErrorIs(t, results[1].Err, errs.ErrNotificationNotCancelable)
+	assert.Equal(t, domain.SendStatusSending, results[1].Notification.Status)
+	assert.ErrorIs(t, results[2].Err, errs.ErrNotificationNotCancelable)
+
+	assert.NoError(t, results[3].Err)
+	assert.Equal(t, domain.SendStatusCanceled, results[3].Notification.Status)
+
+	assert.ErrorIs(t, results[4].Err, errs.ErrNotificationNotCancelable)
+	assert.Equal(t, domain.SendStatusPending, results[4].Notification.Status)
+
+	assert.Equal(t, "unknown", results[5].Key)
+	assert.ErrorIs(t, results[5].Err, errs.ErrNotificationNotFound)
+
+	assert.ElementsMatch(t, []uint64{1, 4}, repo.canceled)
+
+	_, err = svc.BatchCancel(t.Context(), bizID)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
+
+func TestNotificationService_Update(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(1)
+	scheduledTime := time.Now().Add(time.Hour)
+	scheduled := domain.SendStrategyConfig{Type: domain.SendStrategyScheduled, ScheduledTime: scheduledTime}
+
+	testCases := []struct {
+		name    string
+		update  domain.NotificationUpdate
+		wantErr error
+		check   func(t *testing.T, n domain.Notification)
+	}{
+		{
+			name: "修改发送时间、参数和接收者",
+			update: domain.NotificationUpdate{
+				BizID:              bizID,
+				Key:                "pending",
+				SendStrategyConfig: &scheduled,
+				TemplateParams:     map[string]string{"code": "654321"},
+				Receivers:          []string{"user-2"},
+			},
+			check: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, scheduledTime.UnixMilli(), n.ScheduledETime.UnixMilli())
+				assert.Equal(t, map[string]string{"code": "654321"}, n.Template.Params)
+				assert.Equal(t, []string{"user-2"}, n.Receivers)
+				assert.Equal(t, 2, n.Version)
+			},
+		},
+		{
+			name:   "只修改参数",
+			update: domain.NotificationUpdate{BizID: bizID, Key: "pending", TemplateParams: map[string]string{"code": "1"}},
+			check: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, []string{"user-1"}, n.Receivers)
+				assert.Equal(t, int64(100), n.ScheduledSTime.UnixMilli())
+			},
+		},
+		{
+			name:    "参数和模版的占位符不一致",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "pending", TemplateParams: map[string]string{"cdoe": "1"}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "清空模版参数",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "pending", TemplateParams: map[string]string{}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:   "部分接收者已经退订",
+			update: domain.NotificationUpdate{BizID: bizID, Key: "pending", Receivers: []string{"user-2", "suppressed"}},
+			check: func(t *testing.T, n domain.Notification) {
+				assert.Equal(t, []string{"user-2"}, n.Receivers)
+				require.Len(t, n.ReceiverResults, 1)
+				assert.Equal(t, "suppressed", n.ReceiverResults[0].Receiver)
+				assert.Equal(t, domain.SendStatusSuppressed, n.ReceiverResults[0].Status)
+			},
+		},
+		{
+			name:    "所有接收者都已退订",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "pending", Receivers: []string{"suppressed"}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "已经开始发送",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "sending", Receivers: []string{"user-2"}},
+			wantErr: errs.ErrNotificationNotEditable,
+		},
+		{
+			name:    "调度并发修改",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "scheduled", Receivers: []string{"user-2"}},
+			wantErr: errs.ErrNotificationNotEditable,
+		},
+		{
+			name:    "接收者为空",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "pending", Receivers: []string{}},
+			wantErr: errs.ErrInvalidParameter,
+		},
+		{
+			name:    "通知不存在",
+			update:  domain.NotificationUpdate{BizID: bizID, Key: "unknown"},
+			wantErr: errs.ErrNotificationNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(1)).Return(domain.ChannelTemplate{
+				ID:       1,
+				Versions: []domain.ChannelTemplateVersion{{ID: 10, Content: "验证码 ${code}"}},
+			}, nil).AnyTimes()
+			// suppressed 已经退订
+			suppressionSvc := suppressionmocks.NewMockService(ctrl)
+			suppressionSvc.EXPECT().Filter(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, n domain.Notification) ([]string, []domain.ReceiverResult, error) {
+					if !slices.Contains(n.Receivers, "suppressed") {
+						return n.Receivers, nil, nil
+					}
+					allowed := slices.DeleteFunc(slices.Clone(n.Receivers), func(r string) bool { return r == "suppressed" })
+					return allowed, []domain.ReceiverResult{{
+						Receiver: "suppressed",
+						Status:   domain.SendStatusSuppressed,
+						Code:     domain.ReceiverResultCodeSuppressed,
+					}}, nil
+				}).AnyTimes()
+			repo := &fakeNotificationRepo{
+				notifications: []domain.Notification{
+					{
+						ID: 1, BizID: bizID, Key: "pending", Status: domain.SendStatusPending, Version: 1,
+						Template:       domain.Template{ID: 1, VersionID: 10, Params: map[string]string{"code": "123456"}},
+						Receivers:      []string{"user-1"},
+						ScheduledSTime: time.UnixMilli(100),
+						ScheduledETime: time.UnixMilli(200),
+					},
+					{ID: 2, BizID: bizID, Key: "sending", Status: domain.SendStatusSending, Version: 1},
+					{
+						ID: 3, BizID: bizID, Key: "scheduled", Status: domain.SendStatusPending, Version: 1,
+						Template: domain.Template{ID: 1, VersionID: 10, Params: map[string]string{"code": "123456"}},
+					},
+				},
+				conflicts: map[uint64]bool{3: true},
+			}
+			svc := NewNotificationService(repo, templateSvc, suppressionSvc, domain.LocaleFallbacks{})
+			n, err := svc.Update(t.Context(), tc.update)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				assert.Empty(t, repo.updated)
+				return
+			}
+			require.Len(t, repo.updated, 1)
+			tc.check(t, n)
+		})
+	}
+}
+
+func TestNotificationService_GetTemplateVersionStats(t *testing.T) {
+	t.Parallel()
+
+	end := time.Now().UnixMilli()
+	start := end - time.Hour.Milliseconds()
+	testCases := []struct {
+		name       string
+		templateID int64
+		start      int64
+		end        int64
+		wantErr    error
+		check      func(t *testing.T, stats []domain.TemplateVersionStats)
+	}{
+		{
+			name:       "按版本统计发送成功率和送达率",
+			templateID: 1,
+			start:      start,
+			end:        end,
+			check: func(t *testing.T, stats []domain.TemplateVersionStats) {
+				t.Helper()
+				require.Len(t, stats, 2)
+				assert.Equal(t, int64(10), stats[0].VersionID)
+				assert.InDelta(t, 0.8, stats[0].SuccessRate(), 0.0001)
+				assert.InDelta(t, 0.75, stats[0].DeliveredRate(), 0.0001)
+				assert.Equal(t, int64(11), stats[1].VersionID)
+				assert.InDelta(t, 0.5, stats[1].SuccessRate(), 0.0001)
+				assert.Zero(t, stats[1].DeliveredRate())
+			},
+		},
+		{
+			name:       "模版ID不合法",
+			templateID: 0,
+			start:      start,
+			end:        end,
+			wantErr:    errs.ErrInvalidParameter,
+		},
+		{
+			name:       "结束时间早于开始时间",
+			templateID: 1,
+			start:      end,
+			end:        start,
+			wantErr:    errs.ErrInvalidParameter,
+		},
+		{
+			name:       "时间范围太大",
+			templateID: 1,
+			start:      end - (maxStatsRange + time.Hour).Milliseconds(),
+			end:        end,
+			wantErr:    errs.ErrInvalidParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo := &fakeNotificationRepo{
+				stats: []domain.TemplateVersionStats{
+					{VersionID: 10, Counts: map[domain.SendStatus]int64{
+						domain.SendStatusDelivered:   6,
+						domain.SendStatusUndelivered: 1,
+						domain.SendStatusSucceeded:   1,
+						domain.SendStatusFailed:      2,
+						// 还在发送流程中的不影响比率
+						domain.SendStatusPending: 5,
+					}},
+					{VersionID: 11, Counts: map[domain.SendStatus]int64{
+						domain.SendStatusSucceeded: 1,
+						domain.SendStatusFailed:    1,
+					}},
+				},
+			}
+			stats, err := NewNotificationService(repo, nil, nil, nil).GetTemplateVersionStats(t.Context(), tc.templateID, tc.start, tc.end)
+			assert.ErrorIs(t, err, tc.wantErr)
+			if err != nil {
+				return
+			}
+			tc.check(t, stats)
+		})
+	}
+}
+
+// fakeNotificationRepo 按 key 返回通知，conflicts 中的通知在 CAS 时版本不匹配
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+	notifications []domain.Notification
+	conflicts     map[uint64]bool
+	canceled      []uint64
+	updated       []domain.Notification
+	stats         []domain.TemplateVersionStats
+	// suppressed 创建时所有接收者都已退订的通知
+	suppressed []domain.Notification
+}
+
+func (f *fakeNotificationRepo) GetByKeys(_ context.Context, bizID int64, keys ...string) ([]domain.Notification, error) {
+	var res []domain.Notification
+	for _, key := range keys {
+		for i := range f.notifications {
+			if f.notifications[i].BizID == bizID && f.notifications[i].Key == key {
+				res = append(res, f.notifications[i])
+			}
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeNotificationRepo) CASStatus(_ context.Context, notification domain.Notification) error {
+	if f.conflicts[notification.ID] {
+		return errs.ErrNotificationVersionMismatch
+	}
+	f.canceled = append(f.canceled, notification.ID)
+	return nil
+}
+
+func (f *fakeNotificationRepo) CASUpdate(_ context.Context, notification domain.Notification) error {
+	if f.conflicts[notification.ID] {
+		return errs.ErrNotificationVersionMismatch
+	}
+	f.updated = append(f.updated, notification)
+	return nil
+}
+
+func (f *fakeNotificationRepo) GetTemplateVersionStats(_ context.Context, _, _, _ int64) ([]domain.TemplateVersionStats, error) {
+	return f.stats, nil
+}
+
+func (f *fakeNotificationRepo) CreateSuppressed(_ context.Context, notification domain.Notification) (domain.Notification, error) {
+	if _, err := f.GetByKey(context.Background(), notification.BizID, notification.Key); err == nil {
+		return domain.Notification{}, errs.ErrNotificationDuplicate
+	}
+	f.notifications = append(f.notifications, notification)
+	f.suppressed = append(f.suppressed, notification)
+	return notification, nil
+}
+
+func (f *fakeNotificationRepo) GetByKey(_ context.Context, bizID int64, key string) (domain.Notification, error) {
+	for i := range f.notifications {
+		if f.notifications[i].BizID == bizID && f.notifications[i].Key == key {
+			return f.notifications[i], nil
+		}
+	}
+	return domain.Notification{}, errs.ErrNotificationNotFound
+}
@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	"gitee.com/flycash/notification-platform/internal/service/channel"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+)
+
+// PreviewService 通知预览服务，按接收者最终看到的样子渲染模版，不落库、不扣额度、不回调
+//
+//go:generate mockgen -source=./preview.go -destination=./mocks/preview.mock.go -package=notificationmocks -typed PreviewService
+type PreviewService interface {
+	// Preview 渲染模版并选出会使用的供应商，versionID 为0时使用模版的活跃版本
+	Preview(ctx context.Context, bizID, templateID, versionID int64, params map[string]string) (domain.Preview, error)
+}
+
+type previewService struct {
+	templateSvc manage.ChannelTemplateService
+	channel     channel.Channel
+}
+
+// NewPreviewService 创建通知预览服务
+func NewPreviewService(templateSvc manage.ChannelTemplateService, channel channel.Channel) PreviewService {
+	return &previewService{
+		templateSvc: templateSvc,
+		channel:     channel,
+	}
+}
+
+func (p *previewService) Preview(ctx context.Context, bizID, templateID, versionID int64, params map[string]string) (domain.Preview, error) {
+	if templateID <= 0 {
+		return domain.Preview{}, fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if versionID < 0 {
+		return domain.Preview{}, fmt.Errorf("%w: 版本ID不能小于0", errs.ErrInvalidParameter)
+	}
+
+	tmpl, err := p.templateSvc.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return domain.Preview{}, err
+	}
+
+	// 未指定版本时使用活跃版本，便于在发布前预览任意版本
+	version := tmpl.ActiveVersion()
+	if versionID > 0 {
+		version = tmpl.GetVersion(versionID)
+	}
+	if version == nil {
+		return domain.Preview{}, fmt.Errorf("%w: templateID=%d, versionID=%d", errs.ErrTemplateVersionNotFound, templateID, versionID)
+	}
+
+	res := domain.Preview{
+		TemplateID: tmpl.ID,
+		VersionID:  version.ID,
+		Channel:    tmpl.Channel,
+		Signature:  version.Signature,
+		Content:    version.Content,
+	}
+	// 与发送时一致，只有使用 ${name} 占位符的模版才由平台渲染，其余原样交给供应商
+	if render.UsesPlaceholders(version.Content) {
+		parsed, err := render.Parse(version.Content)
+		if err != nil {
+			return domain.Preview{}, fmt.Errorf("%w: %w", errs.ErrInvalidParameter, err)
+		}
+		res.Content, err = parsed.Execute(params)
+		if err != nil {
+			return domain.Preview{}, fmt.Errorf("%w: %w", errs.ErrInvalidParameter, err)
+		}
+		res.Placeholders = parsed.Placeholders()
+	}
+	// 邮件、站内信与供应商保持一致，从内容中拆分出标题
+	if !tmpl.Channel.IsSMS() {
+		res.Title, res.Content = render.SplitTitle(tmpl.Name, res.Content)
+	}
+
+	pro, err := p.channel.Pick(ctx, domain.Notification{
+		BizID:   bizID,
+		Channel: tmpl.Channel,
+		Template: domain.Template{
+			ID:        tmpl.ID,
+			VersionID: version.ID,
+			Params:    params,
+		},
+	})
+	// 没有可用的渠道或供应商不影响预览内容
+	if err == nil {
+		res.ProviderName = pro.Name()
+	}
+	return res, nil
+}
@@ -0,0 +1,201 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	channelmocks "gitee.com/flycash/notification-platform/internal/service/channel/mocks"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPreviewService_Preview(t *testing.T) {
+	t.Parallel()
+
+	const bizID, templateID = int64(1), int64(10)
+
+	newTemplate := func(channel domain.Channel) domain.ChannelTemplate {
+		return domain.ChannelTemplate{
+			ID:              templateID,
+			Name:            "注册通知",
+			Channel:         channel,
+			ActiveVersionID: 1,
+			Versions: []domain.ChannelTemplateVersion{
+				{
+					ID:                1,
+					ChannelTemplateID: templateID,
+					Signature:         "通知平台",
+					Content:           "您的验证码是${code}",
+					AuditStatus:       domain.AuditStatusApproved,
+				},
+				{
+					ID:                2,
+					ChannelTemplateID: templateID,
+					Signature:         "通知平台",
+					Content:           "${name}，欢迎注册\n您的验证码是${code}",
+					AuditStatus:       domain.AuditStatusPending,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		versionID int64
+		params    map[string]string
+		setupMock func(ctrl *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel)
+		want      domain.Preview
+		wantErr   error
+	}{
+		{
+			name:   "短信使用活跃版本",
+			params: map[string]string{"code": "123456"},
+			setupMock: func(ctrl *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelSMS), nil)
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Name().Return("aliyun")
+				ch.EXPECT().Pick(gomock.Any(), domain.Notification{
+					BizID:    bizID,
+					Channel:  domain.ChannelSMS,
+					Template: domain.Template{ID: templateID, VersionID: 1, Params: map[string]string{"code": "123456"}},
+				}).Return(p, nil)
+			},
+			want: domain.Preview{
+				TemplateID:   templateID,
+				VersionID:    1,
+				Channel:      domain.ChannelSMS,
+				Signature:    "通知平台",
+				Content:      "您的验证码是123456",
+				Placeholders: []string{"code"},
+				ProviderName: "aliyun",
+			},
+		},
+		{
+			name:      "邮件预览未发布的版本",
+			versionID: 2,
+			params:    map[string]string{"name": "Alice", "code": "123456"},
+			setupMock: func(ctrl *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelEmail), nil)
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Name().Return("smtp")
+				ch.EXPECT().Pick(gomock.Any(), gomock.Any()).Return(p, nil)
+			},
+			want: domain.Preview{
+				TemplateID:   templateID,
+				VersionID:    2,
+				Channel:      domain.ChannelEmail,
+				Signature:    "通知平台",
+				Title:        "Alice，欢迎注册",
+				Content:      "您的验证码是123456",
+				Placeholders: []string{"name", "code"},
+				ProviderName: "smtp",
+			},
+		},
+		{
+			name:   "无可用供应商不影响预览",
+			params: map[string]string{"code": "123456"},
+			setupMock: func(_ *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelInApp), nil)
+				ch.EXPECT().Pick(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNoAvailableProvider)
+			},
+			want: domain.Preview{
+				TemplateID:   templateID,
+				VersionID:    1,
+				Channel:      domain.ChannelInApp,
+				Signature:    "通知平台",
+				Title:        "注册通知",
+				Content:      "您的验证码是123456",
+				Placeholders: []string{"code"},
+			},
+		},
+		{
+			name:   "渠道不可用",
+			params: map[string]string{"code": "123456"},
+			setupMock: func(_ *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelInApp), nil)
+				ch.EXPECT().Pick(gomock.Any(), gomock.Any()).Return(nil, errs.ErrNoAvailableChannel)
+			},
+			want: domain.Preview{
+				TemplateID:   templateID,
+				VersionID:    1,
+				Channel:      domain.ChannelInApp,
+				Signature:    "通知平台",
+				Title:        "注册通知",
+				Content:      "您的验证码是123456",
+				Placeholders: []string{"code"},
+			},
+		},
+		{
+			name:   "供应商渲染的模版原样返回",
+			params: map[string]string{"1": "123456"},
+			setupMock: func(ctrl *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, ch *channelmocks.MockChannel) {
+				tmpl := newTemplate(domain.ChannelSMS)
+				tmpl.Versions[0].Content = "您的验证码是{1}"
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(tmpl, nil)
+				p := providermocks.NewMockProvider(ctrl)
+				p.EXPECT().Name().Return("tencentcloud")
+				ch.EXPECT().Pick(gomock.Any(), gomock.Any()).Return(p, nil)
+			},
+			want: domain.Preview{
+				TemplateID:   templateID,
+				VersionID:    1,
+				Channel:      domain.ChannelSMS,
+				Signature:    "通知平台",
+				Content:      "您的验证码是{1}",
+				ProviderName: "tencentcloud",
+			},
+		},
+		{
+			name:   "缺少参数",
+			params: map[string]string{"cdoe": "123456"},
+			setupMock: func(_ *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, _ *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelSMS), nil)
+			},
+			wantErr: render.ErrMissingParams,
+		},
+		{
+			name:      "版本不存在",
+			versionID: 3,
+			setupMock: func(_ *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, _ *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(newTemplate(domain.ChannelSMS), nil)
+			},
+			wantErr: errs.ErrTemplateVersionNotFound,
+		},
+		{
+			name: "模版不存在",
+			setupMock: func(_ *gomock.Controller, templateSvc *templatemocks.MockChannelTemplateService, _ *channelmocks.MockChannel) {
+				templateSvc.EXPECT().GetTemplateByID(gomock.Any(), templateID).Return(domain.ChannelTemplate{}, errs.ErrTemplateNotFound)
+			},
+			wantErr: errs.ErrTemplateNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			ch := channelmocks.NewMockChannel(ctrl)
+			tt.setupMock(ctrl, templateSvc, ch)
+
+			svc := NewPreviewService(templateSvc, ch)
+			got, err := svc.Preview(context.Background(), bizID, templateID, tt.versionID, tt.params)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
+	"gitee.com/flycash/notification-platform/internal/service/sender"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+)
+
+// RetryTask 按分片找出已经到了重试时间的通知重新发送，
+// 再次失败时由发送器按渠道重试策略决定继续重试还是最终失败。
+// 落在免打扰时段内的重试推迟到时段结束之后。
+// 分库分表时 repo 使用 sharding.NotificationTask，按 ctx 中的分片查询和推迟重试，和 SendingTimeoutTaskV2 一样
+type RetryTask struct {
+	dclient    dlock.Client
+	repo       repository.NotificationRepository
+	sender     sender.NotificationSender
+	quietHours quiethours.Service
+	sem        loopjob.ResourceSemaphore
+	str        sharding.ShardingStrategy
+	batchSize  int
+	logger     *elog.Component
+}
+
+func NewRetryTask(dclient dlock.Client,
+	repo repository.NotificationRepository,
+	sender sender.NotificationSender,
+	quietHours quiethours.Service,
+	sem loopjob.ResourceSemaphore,
+	str sharding.ShardingStrategy,
+	batchSize int,
+) *RetryTask {
+	return &RetryTask{
+		dclient:    dclient,
+		repo:       repo,
+		sender:     sender,
+		quietHours: quietHours,
+		sem:        sem,
+		str:        str,
+		batchSize:  batchSize,
+		logger:     elog.DefaultLogger,
+	}
+}
+
+func (r *RetryTask) Start(ctx context.Context) {
+	const key = "notification_handling_retry"
+	lj := loopjob.NewShardingLoopJob(r.dclient, key, r.HandleRetry, r.str, r.sem)
+	go lj.Run(ctx)
+}
+
+func (r *RetryTask) HandleRetry(ctx context.Context) error {
+	const defaultSleepTime = time.Second
+	notifications, err := r.repo.FindRetryNotifications(ctx, r.batchSize)
+	if err != nil {
+		return err
+	}
+	cnt := len(notifications)
+	notifications = r.deferQuietHours(ctx, notifications)
+	if len(notifications) > 0 {
+		if _, err = r.sender.BatchSend(ctx, notifications); err != nil {
+			return err
+		}
+	}
+	// 说明到期的重试不多，可以休息一下
+	if cnt < r.batchSize {
+		time.Sleep(defaultSleepTime)
+	}
+	return nil
+}
+
+// deferQuietHours 推迟现在落在免打扰时段内的重试，返回剩下可以重试的通知
+func (r *RetryTask) deferQuietHours(ctx context.Context, notifications []domain.Notification) []domain.Notification {
+	now := time.Now()
+	res := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		t, ok := r.quietHours.NextAllowedTime(ctx, notifications[i], now)
+		if !ok {
+			res = append(res, notifications[i])
+			continue
+		}
+		notifications[i].NextRetryTime = t.UnixMilli()
+		if err := r.repo.MarkRetrying(ctx, notifications[i]); err != nil {
+			r.logger.Warn("免打扰推迟重试失败", elog.Any("notificationID", notifications[i].ID), elog.FieldErr(err))
+		}
+	}
+	return res
+}
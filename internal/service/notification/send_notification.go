@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
 
@@ -11,6 +12,7 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 )
 
 // SendService 负责处理发送
@@ -33,15 +35,21 @@ type sendService struct {
 	templateSvc     manage.ChannelTemplateService
 	idGenerator     *idgen.Generator
 	sendStrategy    sendstrategy.SendStrategy
+	suppressionSvc  suppression.Service
 }
 
 // NewSendService 创建执行器实例
-func NewSendService(templateSvc manage.ChannelTemplateService, notificationSvc Service, sendStrategy sendstrategy.SendStrategy) SendService {
+func NewSendService(templateSvc manage.ChannelTemplateService,
+	notificationSvc Service,
+	sendStrategy sendstrategy.SendStrategy,
+	suppressionSvc suppression.Service,
+) SendService {
 	return &sendService{
 		notificationSvc: notificationSvc,
 		templateSvc:     templateSvc,
 		idGenerator:     idgen.NewGenerator(),
 		sendStrategy:    sendStrategy,
+		suppressionSvc:  suppressionSvc,
 	}
 }
 
@@ -59,6 +67,16 @@ func (e *sendService) SendNotification(ctx context.Context, n domain.Notificatio
 	// 生成通知ID，后续考虑分库分表
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
 	n.ID = uint64(id)
+
+	// 过滤退订的接收者
+	ok, err := e.filterSuppressed(ctx, &n)
+	if err != nil {
+		return resp, err
+	}
+	if !ok {
+		return e.saveSuppressed(ctx, n)
+	}
+
 	// 发送通知
 	response, err := e.sendStrategy.Send(ctx, n)
 	// 处理策略错误
@@ -66,7 +84,7 @@ func (e *sendService) SendNotification(ctx context.Context, n domain.Notificatio
 		// 通用的发送失败错误
 		return resp, fmt.Errorf("%w, 发送通知失败，原因：%w", errs.ErrSendNotificationFailed, err)
 	}
-	return response, nil
+	return e.withSuppressed(response, n.ReceiverResults), nil
 }
 
 // SendNotificationAsync 异步单条发送
@@ -78,11 +96,23 @@ func (e *sendService) SendNotificationAsync(ctx context.Context, n domain.Notifi
 	// 生成通知ID
 	id := e.idGenerator.GenerateID(n.BizID, n.Key)
 	n.ID = uint64(id)
+	// 过滤退订的接收者
+	ok, err := e.filterSuppressed(ctx, &n)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	if !ok {
+		return e.saveSuppressed(ctx, n)
+	}
 
 	// 使用异步接口但要立即发送，修改为延时发送
 	// 本质上这是一个不怎好的用法，但是业务方可能不清楚，所以我们兼容一下
 	n.ReplaceAsyncImmediate()
-	return e.sendStrategy.Send(ctx, n)
+	response, err := e.sendStrategy.Send(ctx, n)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	return e.withSuppressed(response, n.ReceiverResults), nil
 }
 
 // BatchSendNotifications 同步批量发送
@@ -105,16 +135,56 @@ func (e *sendService) BatchSendNotifications(ctx context.Context, notifications
 		notifications[i].ID = uint64(id)
 	}
 
-	// 发送通知，这里有一个隐含的假设，就是发送策略必须是相同的。
-	results, err := e.sendStrategy.BatchSend(ctx, notifications)
-	response.Results = results
+	// 过滤退订的接收者，所有接收者都退订的通知不再发送
+	sending, suppressed, err := e.batchFilterSuppressed(ctx, notifications)
 	if err != nil {
-		return response, fmt.Errorf("%w", errs.ErrSendNotificationFailed)
+		return response, err
+	}
+	suppressedResults := make(map[int]domain.SendResponse, len(suppressed))
+	for _, idx := range suppressed {
+		suppressedResults[idx], err = e.saveSuppressed(ctx, notifications[idx])
+		if err != nil {
+			return response, err
+		}
+	}
+	var results []domain.SendResponse
+	if len(sending) > 0 {
+		// 发送通知，这里有一个隐含的假设，就是发送策略必须是相同的。
+		results, err = e.sendStrategy.BatchSend(ctx, sending)
+		if err != nil {
+			response.Results = results
+			return response, fmt.Errorf("%w", errs.ErrSendNotificationFailed)
+		}
+	}
+	// 发送策略返回的结果不保证和请求的顺序一致，按通知ID匹配，再按请求的顺序放回退订的结果
+	resultMap := make(map[uint64]domain.SendResponse, len(results))
+	for i := range results {
+		resultMap[results[i].NotificationID] = results[i]
+	}
+	response.Results = make([]domain.SendResponse, 0, len(notifications))
+	for i := range notifications {
+		if res, ok := suppressedResults[i]; ok {
+			response.Results = append(response.Results, res)
+			continue
+		}
+		result, ok := resultMap[notifications[i].ID]
+		if !ok {
+			result = e.missingResponse(notifications[i])
+		}
+		response.Results = append(response.Results, e.withSuppressed(result, notifications[i].ReceiverResults))
 	}
-	// 从响应获取结果
 	return response, nil
 }
 
+// missingResponse 发送策略没有返回该通知的结果，例如通知在发送前被其他节点抢占
+func (e *sendService) missingResponse(n domain.Notification) domain.SendResponse {
+	return domain.SendResponse{
+		NotificationID: n.ID,
+		Status:         domain.SendStatusFailed,
+		Error:          &domain.SendError{Code: domain.SendErrorCodeUnknown, Message: "没有获取到发送结果"},
+	}
+}
+
 // BatchSendNotificationsAsync 异步批量发送
 func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notifications ...domain.Notification) (domain.BatchSendAsyncResponse, error) {
 	// 参数校验
@@ -135,8 +205,24 @@ func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notificat
 		notifications[i].ReplaceAsyncImmediate()
 	}
 
+	// 过滤退订的接收者，所有接收者都退订的通知直接保存为 SUPPRESSED，不再发送
+	sending, suppressed, err := e.batchFilterSuppressed(ctx, notifications)
+	if err != nil {
+		return domain.BatchSendAsyncResponse{}, err
+	}
+	for _, idx := range suppressed {
+		res, err1 := e.saveSuppressed(ctx, notifications[idx])
+		if err1 != nil {
+			return domain.BatchSendAsyncResponse{}, err1
+		}
+		ids[idx] = res.NotificationID
+	}
+	if len(sending) == 0 {
+		return domain.BatchSendAsyncResponse{NotificationIDs: ids}, nil
+	}
+
 	// 发送通知，隐含假设这一批的发送策略是一样的。
-	_, err := e.sendStrategy.BatchSend(ctx, notifications)
+	_, err = e.sendStrategy.BatchSend(ctx, sending)
 	if err != nil {
 		return domain.BatchSendAsyncResponse{}, fmt.Errorf("发送失败 %w", errs.ErrSendNotificationFailed)
 	}
@@ -144,3 +230,64 @@ func (e *sendService) BatchSendNotificationsAsync(ctx context.Context, notificat
 		NotificationIDs: ids,
 	}, nil
 }
+
+// filterSuppressed 过滤退订的接收者，通知中只保留没有退订的接收者，退订的接收者的结果放在 ReceiverResults 中，
+// 创建通知时一起保存。所有接收者都退订时返回 false，不再发送通知
+func (e *sendService) filterSuppressed(ctx context.Context, n *domain.Notification) (bool, error) {
+	allowed, suppressed, err := e.suppressionSvc.Filter(ctx, *n)
+	if err != nil {
+		return false, fmt.Errorf("%w, 发送通知失败，原因：%w", errs.ErrSendNotificationFailed, err)
+	}
+	n.Receivers = allowed
+	n.ReceiverResults = suppressed
+	return len(allowed) > 0, nil
+}
+
+// batchFilterSuppressed 过滤每一条通知中退订的接收者，返回需要发送的通知，以及所有接收者都退订的通知的下标
+func (e *sendService) batchFilterSuppressed(ctx context.Context, notifications []domain.Notification) (sending []domain.Notification, suppressed []int, err error) {
+	sending = make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		ok, err1 := e.filterSuppressed(ctx, &notifications[i])
+		if err1 != nil {
+			return nil, nil, err1
+		}
+		if !ok {
+			suppressed = append(suppressed, i)
+			continue
+		}
+		sending = append(sending, notifications[i])
+	}
+	return sending, suppressed, nil
+}
+
+// saveSuppressed 所有接收者都退订的通知保存为 SUPPRESSED，不扣减额度，业务方可以查询到退订的结果。
+// 业务方重试时返回已经保存的通知的结果
+func (e *sendService) saveSuppressed(ctx context.Context, n domain.Notification) (domain.SendResponse, error) {
+	saved, err := e.notificationSvc.SaveSuppressed(ctx, n)
+	if err != nil {
+		return domain.SendResponse{Status: domain.SendStatusFailed},
+			fmt.Errorf("%w, 发送通知失败，原因：%w", errs.ErrSendNotificationFailed, err)
+	}
+	resp := domain.SendResponse{
+		NotificationID:  saved.ID,
+		Status:          saved.Status,
+		ReceiverResults: saved.ReceiverResults,
+	}
+	if saved.Error != nil {
+		resp.Error = saved.Error
+	}
+	return resp, nil
+}
+
+// withSuppressed 把退订的接收者的结果放到响应中，
+// 幂等请求返回的是已经保存的结果，其中已经有退订的接收者，不再重复添加
+func (e *sendService) withSuppressed(resp domain.SendResponse, suppressed []domain.ReceiverResult) domain.SendResponse {
+	for i := range suppressed {
+		if !slices.ContainsFunc(resp.ReceiverResults, func(src domain.ReceiverResult) bool {
+			return src.Receiver == suppressed[i].Receiver
+		}) {
+			resp.ReceiverResults = append(resp.ReceiverResults, suppressed[i])
+		}
+	}
+	return resp
+}
@@ -0,0 +1,172 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	sendstrategymocks "gitee.com/flycash/notification-platform/internal/service/sendstrategy/mocks"
+	suppressionmocks "gitee.com/flycash/notification-platform/internal/service/suppression/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendService_Suppression(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 13800000000 已经退订
+	suppressionSvc := suppressionmocks.NewMockService(ctrl)
+	suppressionSvc.EXPECT().Filter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification) ([]string, []domain.ReceiverResult, error) {
+			allowed := slices.DeleteFunc(slices.Clone(n.Receivers), func(r string) bool { return r == "13800000000" })
+			if len(allowed) == len(n.Receivers) {
+				return allowed, nil, nil
+			}
+			return allowed, []domain.ReceiverResult{{
+				Receiver: "13800000000",
+				Status:   domain.SendStatusSuppressed,
+				Code:     domain.ReceiverResultCodeSuppressed,
+			}}, nil
+		}).AnyTimes()
+	strategy := sendstrategymocks.NewMockSendStrategy(ctrl)
+	repo := &fakeNotificationRepo{}
+	svc := NewSendService(nil, NewNotificationService(repo, nil, nil, nil), strategy, suppressionSvc)
+
+	newNotification := func(key string, receivers ...string) domain.Notification {
+		return domain.Notification{
+			BizID:              1,
+			Key:                key,
+			Receivers:          receivers,
+			Channel:            domain.ChannelSMS,
+			Template:           domain.Template{ID: 1, VersionID: 1},
+			SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+		}
+	}
+
+	t.Run("部分接收者退订", func(t *testing.T) {
+		strategy.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+				// 退订的接收者不发送，但是结果要随通知一起保存
+				assert.Equal(t, []string{"13800000001"}, n.Receivers)
+				require.Len(t, n.ReceiverResults, 1)
+				return domain.SendResponse{
+					NotificationID: n.ID,
+					Status:         domain.SendStatusSucceeded,
+					ReceiverResults: []domain.ReceiverResult{
+						{Receiver: "13800000001", Status: domain.SendStatusSucceeded},
+					},
+				}, nil
+			})
+		resp, err := svc.SendNotification(t.Context(), newNotification("partial", "13800000000", "13800000001"))
+		require.NoError(t, err)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		assert.Equal(t, []domain.ReceiverResult{
+			{Receiver: "13800000001", Status: domain.SendStatusSucceeded},
+			{Receiver: "13800000000", Status: domain.SendStatusSuppressed, Code: domain.ReceiverResultCodeSuppressed},
+		}, resp.ReceiverResults)
+	})
+
+	t.Run("所有接收者退订保存为SUPPRESSED但不发送", func(t *testing.T) {
+		resp, err := svc.SendNotification(t.Context(), newNotification("all", "13800000000"))
+		require.NoError(t, err)
+		assert.NotZero(t, resp.NotificationID)
+		assert.Equal(t, domain.SendStatusSuppressed, resp.Status)
+		require.Len(t, resp.ReceiverResults, 1)
+		assert.Equal(t, domain.SendStatusSuppressed, resp.ReceiverResults[0].Status)
+		var sendErr *domain.SendError
+		require.ErrorAs(t, resp.Error, &sendErr)
+		assert.Equal(t, domain.SendErrorCodeSuppressed, sendErr.Code)
+
+		// 保存的通知带有退订的接收者和结果，不经过发送策略，所以不扣减额度
+		saved, err := repo.GetByKey(t.Context(), 1, "all")
+		require.NoError(t, err)
+		assert.Equal(t, resp.NotificationID, saved.ID)
+		assert.Equal(t, domain.SendStatusSuppressed, saved.Status)
+		assert.Equal(t, []string{"13800000000"}, saved.Receivers)
+		assert.Len(t, saved.ReceiverResults, 1)
+
+		// 业务方重试返回已经保存的通知
+		retry, err := svc.SendNotification(t.Context(), newNotification("all", "13800000000"))
+		require.NoError(t, err)
+		assert.Equal(t, resp.NotificationID, retry.NotificationID)
+		assert.Equal(t, domain.SendStatusSuppressed, retry.Status)
+	})
+
+	t.Run("批量发送按请求的顺序返回", func(t *testing.T) {
+		strategy.EXPECT().BatchSend(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, ns []domain.Notification) ([]domain.SendResponse, error) {
+				require.Len(t, ns, 2)
+				res := make([]domain.SendResponse, 0, len(ns))
+				for i := range ns {
+					res = append(res, domain.SendResponse{NotificationID: ns[i].ID, Status: domain.SendStatusSucceeded})
+				}
+				return res, nil
+			})
+		resp, err := svc.BatchSendNotifications(t.Context(),
+			newNotification("batch-1", "13800000001"),
+			newNotification("batch-2", "13800000000"),
+			newNotification("batch-3", "13800000000", "13800000002"),
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Results[0].Status)
+		assert.NotZero(t, resp.Results[0].NotificationID)
+		assert.Equal(t, domain.SendStatusSuppressed, resp.Results[1].Status)
+		assert.NotZero(t, resp.Results[1].NotificationID)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Results[2].Status)
+		require.Len(t, resp.Results[2].ReceiverResults, 1)
+		assert.Equal(t, "13800000000", resp.Results[2].ReceiverResults[0].Receiver)
+	})
+
+	t.Run("批量发送结果乱序时按通知ID匹配", func(t *testing.T) {
+		strategy.EXPECT().BatchSend(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, ns []domain.Notification) ([]domain.SendResponse, error) {
+				require.Len(t, ns, 3)
+				// 发送器先返回成功的结果再返回失败的结果，和请求的顺序不一致，最后一条没有结果
+				return []domain.SendResponse{
+					{NotificationID: ns[1].ID, Status: domain.SendStatusSucceeded},
+					{NotificationID: ns[0].ID, Status: domain.SendStatusFailed},
+				}, nil
+			})
+		resp, err := svc.BatchSendNotifications(t.Context(),
+			newNotification("out-of-order-1", "13800000001"),
+			newNotification("out-of-order-2", "13800000002"),
+			newNotification("out-of-order-3", "13800000003"),
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 3)
+		assert.Equal(t, domain.SendStatusFailed, resp.Results[0].Status)
+		assert.NoError(t, resp.Results[0].Error)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Results[1].Status)
+		assert.Equal(t, domain.SendStatusFailed, resp.Results[2].Status)
+		var sendErr *domain.SendError
+		require.ErrorAs(t, resp.Results[2].Error, &sendErr)
+		assert.Equal(t, domain.SendErrorCodeUnknown, sendErr.Code)
+		assert.NotEqual(t, resp.Results[0].NotificationID, resp.Results[1].NotificationID)
+		assert.NotZero(t, resp.Results[2].NotificationID)
+	})
+
+	t.Run("异步批量发送全部退订的通知也返回ID", func(t *testing.T) {
+		strategy.EXPECT().BatchSend(gomock.Any(), gomock.Any()).Return(nil, nil)
+		n1 := newNotification("async-1", "13800000000")
+		n2 := newNotification("async-2", "13800000001")
+		n1.SendStrategyConfig = domain.SendStrategyConfig{Type: domain.SendStrategyDelayed, Delay: time.Minute}
+		n2.SendStrategyConfig = n1.SendStrategyConfig
+		resp, err := svc.BatchSendNotificationsAsync(t.Context(), n1, n2)
+		require.NoError(t, err)
+		require.Len(t, resp.NotificationIDs, 2)
+		saved, err := repo.GetByKey(t.Context(), 1, "async-1")
+		require.NoError(t, err)
+		assert.Equal(t, saved.ID, resp.NotificationIDs[0])
+		assert.Equal(t, domain.SendStatusSuppressed, saved.Status)
+		assert.NotZero(t, resp.NotificationIDs[1])
+	})
+}
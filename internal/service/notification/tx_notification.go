@@ -13,6 +13,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 	"github.com/gotomicro/ego/core/elog"
 	"github.com/meoying/dlock-go"
 )
@@ -34,6 +35,8 @@ type txNotificationService struct {
 	logger    *elog.Component
 	lock      dlock.Client
 	sender    sender.NotificationSender
+	// suppressionSvc 过滤退订的接收者
+	suppressionSvc suppression.Service
 }
 
 func NewTxNotificationService(
@@ -42,14 +45,16 @@ func NewTxNotificationService(
 	notiRepo repository.NotificationRepository,
 	lock dlock.Client,
 	sender sender.NotificationSender,
+	suppressionSvc suppression.Service,
 ) TxNotificationService {
 	return &txNotificationService{
-		repo:      repo,
-		configSvc: configSvc,
-		logger:    elog.DefaultLogger,
-		notiRepo:  notiRepo,
-		lock:      lock,
-		sender:    sender,
+		repo:           repo,
+		configSvc:      configSvc,
+		logger:         elog.DefaultLogger,
+		notiRepo:       notiRepo,
+		lock:           lock,
+		sender:         sender,
+		suppressionSvc: suppressionSvc,
 	}
 }
 
@@ -74,6 +79,17 @@ func (t *txNotificationService) Prepare(ctx context.Context, notification domain
 	// todo
 	notification.Status = domain.SendStatusPrepare
 	notification.SetSendTime()
+	// 和直接发送一样过滤退订的接收者，退订的结果随通知一起保存。
+	// 所有接收者都退订的通知保存为 SUPPRESSED，提交之后也不会发送
+	allowed, suppressed, err := t.suppressionSvc.Filter(ctx, notification)
+	if err != nil {
+		return 0, err
+	}
+	notification.Receivers = allowed
+	notification.ReceiverResults = suppressed
+	if len(allowed) == 0 {
+		notification.MarkSuppressed()
+	}
 	txn := domain.TxNotification{
 		Notification: notification,
 		Key:          notification.Key,
@@ -101,7 +117,8 @@ func (t *txNotificationService) Commit(ctx context.Context, bizID int64, key str
 	if err != nil {
 		return err
 	}
-	if notification.IsImmediate() {
+	// 所有接收者都退订的通知提交之后状态仍然是 SUPPRESSED，不需要发送
+	if notification.Status == domain.SendStatusPending && notification.IsImmediate() {
 		_, err = t.sender.Send(ctx, notification)
 	}
 	return err
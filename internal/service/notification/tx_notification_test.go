@@ -0,0 +1,91 @@
+//go:build unit
+
+package notification
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	configmocks "gitee.com/flycash/notification-platform/internal/service/config/mocks"
+	suppressionmocks "gitee.com/flycash/notification-platform/internal/service/suppression/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTxNotificationService_PrepareSuppression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		receivers     []string
+		wantStatus    domain.SendStatus
+		wantReceivers []string
+	}{
+		{
+			name:          "部分接收者退订",
+			receivers:     []string{"13800000000", "13800000001"},
+			wantStatus:    domain.SendStatusPrepare,
+			wantReceivers: []string{"13800000001"},
+		},
+		{
+			name:          "所有接收者退订",
+			receivers:     []string{"13800000000"},
+			wantStatus:    domain.SendStatusSuppressed,
+			wantReceivers: []string{"13800000000"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 13800000000 已经退订
+			suppressionSvc := suppressionmocks.NewMockService(ctrl)
+			suppressionSvc.EXPECT().Filter(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, n domain.Notification) ([]string, []domain.ReceiverResult, error) {
+					allowed := slices.DeleteFunc(slices.Clone(n.Receivers), func(r string) bool { return r == "13800000000" })
+					return allowed, []domain.ReceiverResult{{
+						Receiver: "13800000000",
+						Status:   domain.SendStatusSuppressed,
+						Code:     domain.ReceiverResultCodeSuppressed,
+					}}, nil
+				})
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			configSvc.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(domain.BusinessConfig{}, nil)
+			repo := &fakeTxNotificationRepo{}
+			svc := NewTxNotificationService(repo, configSvc, nil, nil, nil, suppressionSvc)
+
+			_, err := svc.Prepare(t.Context(), domain.Notification{
+				BizID:              1,
+				Key:                "tx",
+				Receivers:          tc.receivers,
+				Channel:            domain.ChannelSMS,
+				Template:           domain.Template{ID: 1, VersionID: 1},
+				SendStrategyConfig: domain.SendStrategyConfig{Type: domain.SendStrategyImmediate},
+			})
+			require.NoError(t, err)
+			require.Len(t, repo.created, 1)
+			n := repo.created[0].Notification
+			assert.Equal(t, tc.wantStatus, n.Status)
+			assert.Equal(t, tc.wantReceivers, n.Receivers)
+			require.Len(t, n.ReceiverResults, 1)
+			assert.Equal(t, domain.SendStatusSuppressed, n.ReceiverResults[0].Status)
+		})
+	}
+}
+
+type fakeTxNotificationRepo struct {
+	repository.TxNotificationRepository
+	created []domain.TxNotification
+}
+
+func (f *fakeTxNotificationRepo) Create(_ context.Context, txn domain.TxNotification) (uint64, error) {
+	f.created = append(f.created, txn)
+	return 1, nil
+}
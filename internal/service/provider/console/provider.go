@@ -19,6 +19,10 @@ func NewProvider() *Provider {
 	}
 }
 
+func (p *Provider) Name() string {
+	return "console"
+}
+
 func (p *Provider) Send(_ context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	p.logger.Info("发送通知", elog.Any("notification", notification))
 	return domain.SendResponse{Status: domain.SendStatusSucceeded}, nil
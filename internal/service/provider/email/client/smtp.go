@@ -0,0 +1,193 @@
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultSMTPTimeout = 10 * time.Second
+	// base64 编码后每行的最大长度，见 RFC 2045
+	base64LineLen = 76
+)
+
+var _ Client = (*SMTPClient)(nil)
+
+// SMTPConfig SMTP 服务器配置
+type SMTPConfig struct {
+	Host     string // 服务器地址
+	Port     int    // 服务器端口，一般为 25 或 587
+	Username string // 认证用户名，为空时不做认证
+	Password string // 认证密码
+	From     string // 发件地址，为空时使用 Username
+	// RequireTLS 为 true 时服务器必须支持 STARTTLS，否则拒绝发送，避免明文传输账号密码
+	RequireTLS bool
+	// TLSConfig 为空时使用 ServerName = Host 的默认配置
+	TLSConfig *tls.Config
+	// Timeout 单次发送（含建连）的超时时间，为 0 时使用默认值
+	Timeout time.Duration
+}
+
+// SMTPClient 基于 SMTP 协议的邮件客户端，支持 STARTTLS 与 PLAIN 认证
+type SMTPClient struct {
+	cfg  SMTPConfig
+	addr string
+}
+
+// NewSMTPClient 创建 SMTP 邮件客户端
+func NewSMTPClient(cfg SMTPConfig) (*SMTPClient, error) {
+	if cfg.Host == "" || cfg.Port <= 0 {
+		return nil, fmt.Errorf("%w: SMTP服务器地址", ErrInvalidParameter)
+	}
+	if cfg.From == "" {
+		cfg.From = cfg.Username
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("%w: 发件地址 %w", ErrInvalidParameter, err)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultSMTPTimeout
+	}
+	return &SMTPClient{
+		cfg:  cfg,
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+	}, nil
+}
+
+func (s *SMTPClient) Send(req SendReq) (SendResp, error) {
+	if len(req.To) == 0 {
+		return SendResp{}, fmt.Errorf("%w: 收件人不能为空", ErrInvalidParameter)
+	}
+	for _, to := range req.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return SendResp{}, fmt.Errorf("%w: 收件地址 %s", ErrInvalidParameter, to)
+		}
+	}
+
+	messageID, err := s.newMessageID()
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	msg := s.buildMessage(messageID, req)
+
+	conn, err := net.DialTimeout("tcp", s.addr, s.cfg.Timeout)
+	if err != nil {
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(s.cfg.Timeout))
+
+	c, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	defer c.Close()
+
+	if err = s.send(c, req.To, msg); err != nil {
+		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
+	}
+	return SendResp{MessageID: messageID}, nil
+}
+
+func (s *SMTPClient) send(c *smtp.Client, to []string, msg []byte) error {
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(s.tlsConfig()); err != nil {
+			return err
+		}
+	} else if s.cfg.RequireTLS {
+		return fmt.Errorf("服务器 %s 不支持STARTTLS", s.addr)
+	}
+
+	if s.cfg.Username != "" {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("服务器 %s 不支持AUTH", s.addr)
+		}
+		if err := c.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(s.cfg.From); err != nil {
+		return err
+	}
+	for i := range to {
+		if err := c.Rcpt(to[i]); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (s *SMTPClient) tlsConfig() *tls.Config {
+	if s.cfg.TLSConfig != nil {
+		return s.cfg.TLSConfig
+	}
+	return &tls.Config{ServerName: s.cfg.Host, MinVersion: tls.VersionTLS12}
+}
+
+func (s *SMTPClient) newMessageID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), s.cfg.Host), nil
+}
+
+// buildMessage 构造 RFC 5322 格式的邮件，主题使用 RFC 2047 编码，正文使用 base64 编码
+func (s *SMTPClient) buildMessage(messageID string, req SendReq) []byte {
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = ContentTypePlain
+	}
+	from := (&mail.Address{Name: req.FromName, Address: s.cfg.From}).String()
+
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", from},
+		{"To", strings.Join(req.To, ", ")},
+		{"Subject", mime.BEncoding.Encode("UTF-8", req.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", contentType},
+		{"Content-Transfer-Encoding", "base64"},
+	}
+	for _, h := range headers {
+		buf.WriteString(h[0])
+		buf.WriteString(": ")
+		buf.WriteString(h[1])
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(req.Body))
+	for len(encoded) > base64LineLen {
+		buf.WriteString(encoded[:base64LineLen])
+		buf.WriteString("\r\n")
+		encoded = encoded[base64LineLen:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,358 @@
+//go:build unit
+
+package client
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedMail SMTP 替身服务器收到的邮件
+type receivedMail struct {
+	From   string
+	To     []string
+	Data   string
+	TLS    bool
+	Authed bool
+}
+
+// smtpStandIn 本地 SMTP 替身服务器，只实现发送邮件需要的最小指令集
+type smtpStandIn struct {
+	ln        net.Listener
+	tlsConfig *tls.Config // 为 nil 时不支持 STARTTLS
+	username  string
+	password  string
+
+	mu    sync.Mutex
+	mails []receivedMail
+}
+
+func newSMTPStandIn(t *testing.T, tlsConfig *tls.Config, username, password string) *smtpStandIn {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &smtpStandIn{ln: ln, tlsConfig: tlsConfig, username: username, password: password}
+	go s.serve()
+	t.Cleanup(func() { _ = ln.Close() })
+	return s
+}
+
+func (s *smtpStandIn) Port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) Mails() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail(nil), s.mails...)
+}
+
+func (s *smtpStandIn) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(lines ...string) {
+		for _, l := range lines {
+			_, _ = io.WriteString(conn, l+"\r\n")
+		}
+	}
+	reply("220 127.0.0.1 ESMTP stand-in")
+
+	var (
+		current receivedMail
+		isTLS   bool
+		authed  bool
+	)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"):
+			lines := []string{"250-127.0.0.1"}
+			if s.tlsConfig != nil && !isTLS {
+				lines = append(lines, "250-STARTTLS")
+			}
+			reply(append(lines, "250 AUTH PLAIN")...)
+		case cmd == "STARTTLS":
+			reply("220 2.0.0 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if tlsConn.Handshake() != nil {
+				return
+			}
+			conn, r, isTLS = tlsConn, bufio.NewReader(tlsConn), true
+		case strings.HasPrefix(cmd, "AUTH PLAIN "):
+			raw, _ := base64.StdEncoding.DecodeString(line[len("AUTH PLAIN "):])
+			if string(raw) == "\x00"+s.username+"\x00"+s.password {
+				authed = true
+				reply("235 2.7.0 Authentication successful")
+			} else {
+				reply("535 5.7.8 Authentication credentials invalid")
+			}
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			current = receivedMail{From: strings.Trim(line[len("MAIL FROM:"):], "<> "), TLS: isTLS, Authed: authed}
+			reply("250 2.1.0 Ok")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			current.To = append(current.To, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			reply("250 2.1.5 Ok")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err1 := r.ReadString('\n')
+				if err1 != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			current.Data = data.String()
+			s.mu.Lock()
+			s.mails = append(s.mails, current)
+			s.mu.Unlock()
+			reply("250 2.0.0 Ok: queued")
+		case cmd == "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			reply("502 5.5.2 Error: command not recognized")
+		}
+	}
+}
+
+// newSelfSignedTLS 生成 127.0.0.1 的自签名证书，返回服务端与客户端的 TLS 配置
+func newSelfSignedTLS(t *testing.T) (server, client *tls.Config) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	server = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}
+	client = &tls.Config{RootCAs: pool, ServerName: "127.0.0.1", MinVersion: tls.VersionTLS12}
+	return server, client
+}
+
+func TestNewSMTPClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     SMTPConfig
+		wantErr error
+	}{
+		{
+			name: "创建成功",
+			cfg:  SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "noreply@example.com"},
+		},
+		{
+			name:    "服务器地址为空",
+			cfg:     SMTPConfig{Port: 587, Username: "noreply@example.com"},
+			wantErr: ErrInvalidParameter,
+		},
+		{
+			name:    "发件地址非法",
+			cfg:     SMTPConfig{Host: "smtp.example.com", Port: 587, Username: "noreply"},
+			wantErr: ErrInvalidParameter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := NewSMTPClient(tt.cfg)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err == nil {
+				assert.NotNil(t, c)
+			}
+		})
+	}
+}
+
+func TestSMTPClient_Send(t *testing.T) {
+	t.Parallel()
+
+	serverTLS, clientTLS := newSelfSignedTLS(t)
+
+	req := SendReq{
+		FromName:    "通知平台",
+		To:          []string{"alice@example.com", "bob@example.com"},
+		Subject:     "您的验证码",
+		Body:        "您的验证码是：123456，5分钟内有效。",
+		ContentType: ContentTypePlain,
+	}
+
+	tests := []struct {
+		name      string
+		serverTLS *tls.Config
+		cfg       func(port int) SMTPConfig
+		req       SendReq
+		wantErr   error
+		assertFn  func(t *testing.T, mails []receivedMail)
+	}{
+		{
+			name:      "STARTTLS并认证后发送成功",
+			serverTLS: serverTLS,
+			cfg: func(port int) SMTPConfig {
+				return SMTPConfig{
+					Host: "127.0.0.1", Port: port,
+					Username: "noreply@example.com", Password: "secret",
+					RequireTLS: true, TLSConfig: clientTLS,
+				}
+			},
+			req: req,
+			assertFn: func(t *testing.T, mails []receivedMail) {
+				t.Helper()
+				require.Len(t, mails, 1)
+				m := mails[0]
+				assert.True(t, m.TLS)
+				assert.True(t, m.Authed)
+				assert.Equal(t, "noreply@example.com", m.From)
+				assert.Equal(t, req.To, m.To)
+
+				msg, err := mail.ReadMessage(strings.NewReader(m.Data))
+				require.NoError(t, err)
+				subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+				require.NoError(t, err)
+				assert.Equal(t, req.Subject, subject)
+				from, err := msg.Header.AddressList("From")
+				require.NoError(t, err)
+				assert.Equal(t, req.FromName, from[0].Name)
+				assert.Equal(t, ContentTypePlain, msg.Header.Get("Content-Type"))
+				assert.NotEmpty(t, msg.Header.Get("Message-ID"))
+				raw, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+				require.NoError(t, err)
+				assert.Equal(t, req.Body, string(raw))
+			},
+		},
+		{
+			name:      "服务器不支持STARTTLS时不认证也能发送",
+			serverTLS: nil,
+			cfg: func(port int) SMTPConfig {
+				return SMTPConfig{Host: "127.0.0.1", Port: port, From: "noreply@example.com"}
+			},
+			req: req,
+			assertFn: func(t *testing.T, mails []receivedMail) {
+				t.Helper()
+				require.Len(t, mails, 1)
+				assert.False(t, mails[0].TLS)
+				assert.False(t, mails[0].Authed)
+			},
+		},
+		{
+			name:      "要求TLS但服务器不支持STARTTLS",
+			serverTLS: nil,
+			cfg: func(port int) SMTPConfig {
+				return SMTPConfig{
+					Host: "127.0.0.1", Port: port,
+					Username: "noreply@example.com", Password: "secret",
+					RequireTLS: true,
+				}
+			},
+			req:     req,
+			wantErr: ErrSendFailed,
+			assertFn: func(t *testing.T, mails []receivedMail) {
+				t.Helper()
+				assert.Empty(t, mails)
+			},
+		},
+		{
+			name:      "认证失败",
+			serverTLS: serverTLS,
+			cfg: func(port int) SMTPConfig {
+				return SMTPConfig{
+					Host: "127.0.0.1", Port: port,
+					Username: "noreply@example.com", Password: "wrong",
+					TLSConfig: clientTLS,
+				}
+			},
+			req:     req,
+			wantErr: ErrSendFailed,
+			assertFn: func(t *testing.T, mails []receivedMail) {
+				t.Helper()
+				assert.Empty(t, mails)
+			},
+		},
+		{
+			name:      "收件地址非法",
+			serverTLS: serverTLS,
+			cfg: func(port int) SMTPConfig {
+				return SMTPConfig{Host: "127.0.0.1", Port: port, From: "noreply@example.com"}
+			},
+			req:     SendReq{To: []string{"13800138000"}, Subject: "s", Body: "b"},
+			wantErr: ErrInvalidParameter,
+			assertFn: func(t *testing.T, mails []receivedMail) {
+				t.Helper()
+				assert.Empty(t, mails)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newSMTPStandIn(t, tt.serverTLS, "noreply@example.com", "secret")
+			c, err := NewSMTPClient(tt.cfg(server.Port()))
+			require.NoError(t, err)
+
+			resp, err := c.Send(tt.req)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if err == nil {
+				assert.NotEmpty(t, resp.MessageID)
+			}
+			tt.assertFn(t, server.Mails())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"errors"
+)
+
+// 通用错误定义
+var (
+	ErrSendFailed       = errors.New("发送邮件失败")
+	ErrInvalidParameter = errors.New("参数无效")
+)
+
+const (
+	ContentTypePlain = "text/plain; charset=UTF-8"
+	ContentTypeHTML  = "text/html; charset=UTF-8"
+)
+
+// Client 邮件客户端接口 (抽象)
+//
+//go:generate mockgen -source=./types.go -destination=./mocks/email.mock.go -package=emailmocks -typed Client
+type Client interface {
+	// Send 发送邮件
+	Send(req SendReq) (SendResp, error)
+}
+
+// SendReq 发送邮件请求参数
+type SendReq struct {
+	FromName    string   // 发件人名称，为空时仅使用发件地址
+	To          []string // 收件人邮箱地址
+	Subject     string   // 邮件主题
+	Body        string   // 邮件正文
+	ContentType string   // 正文类型，为空时默认为纯文本
+}
+
+// SendResp 发送邮件响应参数
+type SendResp struct {
+	MessageID string // 邮件的 Message-ID
+}
@@ -0,0 +1,106 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+)
+
+// emailProvider 邮件供应商
+type emailProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	client      client.Client
+}
+
+// NewEmailProvider 邮件供应商
+func NewEmailProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client) provider.Provider {
+	return &emailProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		client:      client,
+	}
+}
+
+func (p *emailProvider) Name() string {
+	return p.name
+}
+
+// Send 发送邮件，接收者使用不同语言时按语言分组，每组使用该语言的模版分别发送
+func (p *emailProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	groups := notification.ReceiversByLocale()
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	var firstErr error
+	failedGroups := 0
+	for _, group := range groups {
+		if err := p.send(ctx, notification, group); err != nil {
+			// 一组发送失败不影响其他语言的接收者，该组的接收者都标记为可以重试的失败
+			failedGroups++
+			if firstErr == nil {
+				firstErr = err
+			}
+			groupResults := domain.NewReceiverResults(group.Receivers, domain.SendStatusFailed, p.name, "", err.Error())
+			for i := range groupResults {
+				groupResults[i].ErrorKind = domain.ProviderErrorKindRetryable
+			}
+			results = append(results, groupResults...)
+			continue
+		}
+		results = append(results, domain.NewReceiverResults(group.Receivers, domain.SendStatusSucceeded, p.name, "", "")...)
+	}
+	if failedGroups == len(groups) {
+		return domain.SendResponse{}, firstErr
+	}
+
+	return domain.SendResponse{
+		NotificationID:  notification.ID,
+		Status:          domain.AggregateSendStatus(results),
+		ReceiverResults: results,
+	}, nil
+}
+
+// send 使用同一种语言的模版给一组接收者发送邮件
+func (p *emailProvider) send(ctx context.Context, notification domain.Notification, group domain.LocaleReceivers) error {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Template.VersionID, group.Locale, p.name, domain.ChannelEmail)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	version := tmpl.VersionOrActive(notification.Template.VersionID)
+	if version == nil {
+		return fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+
+	rendered, err := render.Render(version.Content, notification.Template.Params)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	subject, body := render.SplitTitle(tmpl.Name, rendered)
+	_, err = p.client.Send(client.SendReq{
+		FromName:    version.Signature,
+		To:          group.Receivers,
+		Subject:     subject,
+		Body:        body,
+		ContentType: contentType(body),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+	return nil
+}
+
+func contentType(body string) string {
+	if strings.HasPrefix(http.DetectContentType([]byte(body)), "text/html") {
+		return client.ContentTypeHTML
+	}
+	return client.ContentTypePlain
+}
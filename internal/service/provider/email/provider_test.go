@@ -0,0 +1,152 @@
+//go:build unit
+
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	"gitee.com/flycash/notification-platform/internal/service/provider/email/client"
+	emailmocks "gitee.com/flycash/notification-platform/internal/service/provider/email/client/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEmailProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	ErrGetTemplateFailed := errors.New("获取模板失败")
+	ErrSendEmailFailed := errors.New("发送邮件失败")
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		Channel: domain.ChannelEmail,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"name": "Alice", "code": "123456"},
+		},
+		Receivers: []string{"alice@example.com"},
+	}
+
+	newTemplate := func(content string) domain.ChannelTemplate {
+		return domain.ChannelTemplate{
+			ID:              testNotification.Template.ID,
+			Name:            "验证码邮件",
+			Channel:         domain.ChannelEmail,
+			ActiveVersionID: 1,
+			Versions: []domain.ChannelTemplateVersion{
+				{
+					ID:                1,
+					ChannelTemplateID: testNotification.Template.ID,
+					Signature:         "通知平台",
+					Content:           content,
+					AuditStatus:       domain.AuditStatusApproved,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient)
+		wantErr   error
+	}{
+		{
+			name: "获取模板失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(domain.ChannelTemplate{}, fmt.Errorf("%w: 供应商%d", ErrGetTemplateFailed, 1))
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "无已发布模版",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(domain.ChannelTemplate{ID: testNotification.Template.ID, Channel: domain.ChannelEmail}, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "模版参数不匹配",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(newTemplate("您的验证码是：${code}"), nil)
+			},
+			wantErr: render.ErrUnknownParams,
+		},
+		{
+			name: "发送邮件失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(newTemplate("${name}，您的验证码是：${code}"), nil)
+				cli.EXPECT().Send(gomock.Any()).Return(client.SendResp{}, ErrSendEmailFailed)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "单行内容以模版名称为主题",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(newTemplate("${name}，您的验证码是：${code}"), nil)
+				cli.EXPECT().Send(client.SendReq{
+					FromName:    "通知平台",
+					To:          testNotification.Receivers,
+					Subject:     "验证码邮件",
+					Body:        "Alice，您的验证码是：123456",
+					ContentType: client.ContentTypePlain,
+				}).Return(client.SendResp{MessageID: "<1@example.com>"}, nil)
+			},
+		},
+		{
+			name: "多行内容首行为主题",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *emailmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "smtp", domain.ChannelEmail).
+					Return(newTemplate("${name}，欢迎注册\r\n<html><body>验证码：${code}</body></html>"), nil)
+				cli.EXPECT().Send(client.SendReq{
+					FromName:    "通知平台",
+					To:          testNotification.Receivers,
+					Subject:     "Alice，欢迎注册",
+					Body:        "<html><body>验证码：123456</body></html>",
+					ContentType: client.ContentTypeHTML,
+				}).Return(client.SendResp{MessageID: "<1@example.com>"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockClient := emailmocks.NewMockClient(ctrl)
+			tt.setupMock(mockTemplateSvc, mockClient)
+
+			p := NewEmailProvider("smtp", mockTemplateSvc, mockClient)
+			resp, err := p.Send(context.Background(), testNotification)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testNotification.ID, resp.NotificationID)
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package inapp
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+)
+
+// inAppProvider 站内信供应商，将渲染后的内容按接收者写入收件箱
+type inAppProvider struct {
+	name        string
+	templateSvc manage.ChannelTemplateService
+	inboxSvc    inbox.Service
+}
+
+// NewInAppProvider 站内信供应商
+func NewInAppProvider(name string, templateSvc manage.ChannelTemplateService, inboxSvc inbox.Service) provider.Provider {
+	return &inAppProvider{
+		name:        name,
+		templateSvc: templateSvc,
+		inboxSvc:    inboxSvc,
+	}
+}
+
+func (p *inAppProvider) Name() string {
+	return p.name
+}
+
+// Send 发送站内信，接收者使用不同语言时每个接收者收到该语言的内容
+func (p *inAppProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	msgs := make([]domain.InboxMessage, 0, len(notification.Receivers))
+	for _, group := range notification.ReceiversByLocale() {
+		title, content, err := p.render(ctx, notification, group.Locale)
+		if err != nil {
+			return domain.SendResponse{}, err
+		}
+		for i := range group.Receivers {
+			msgs = append(msgs, domain.InboxMessage{
+				BizID:          notification.BizID,
+				NotificationID: notification.ID,
+				Receiver:       group.Receivers[i],
+				Title:          title,
+				Content:        content,
+				Status:         domain.InboxMessageStatusUnread,
+			})
+		}
+	}
+	if err := p.inboxSvc.Save(ctx, msgs); err != nil {
+		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	return domain.SendResponse{
+		NotificationID:  notification.ID,
+		Status:          domain.SendStatusSucceeded,
+		ReceiverResults: domain.NewReceiverResults(notification.Receivers, domain.SendStatusSucceeded, p.name, "", ""),
+	}, nil
+}
+
+// render 使用指定语言的模版渲染站内信的标题和内容
+func (p *inAppProvider) render(ctx context.Context, notification domain.Notification, locale string) (title, content string, err error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Template.VersionID, locale, p.name, domain.ChannelInApp)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	version := tmpl.VersionOrActive(notification.Template.VersionID)
+	if version == nil {
+		return "", "", fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	}
+
+	rendered, err := render.Render(version.Content, notification.Template.Params)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+	}
+
+	title, content = render.SplitTitle(tmpl.Name, rendered)
+	return title, content, nil
+}
@@ -0,0 +1,153 @@
+//go:build unit
+
+package inapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
+	inboxmocks "gitee.com/flycash/notification-platform/internal/service/inbox/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInAppProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	ErrGetTemplateFailed := errors.New("获取模板失败")
+	ErrSaveFailed := errors.New("保存站内信失败")
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		BizID:   1,
+		Channel: domain.ChannelInApp,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"name": "Alice", "order": "A001"},
+		},
+		Receivers: []string{"user-1", "user-2"},
+	}
+
+	newTemplate := func(content string) domain.ChannelTemplate {
+		return domain.ChannelTemplate{
+			ID:              testNotification.Template.ID,
+			Name:            "订单通知",
+			Channel:         domain.ChannelInApp,
+			ActiveVersionID: 1,
+			Versions: []domain.ChannelTemplateVersion{
+				{
+					ID:                1,
+					ChannelTemplateID: testNotification.Template.ID,
+					Content:           content,
+					AuditStatus:       domain.AuditStatusApproved,
+				},
+			},
+		}
+	}
+
+	newMessages := func(title, content string) []domain.InboxMessage {
+		msgs := make([]domain.InboxMessage, 0, len(testNotification.Receivers))
+		for _, r := range testNotification.Receivers {
+			msgs = append(msgs, domain.InboxMessage{
+				BizID:          testNotification.BizID,
+				NotificationID: testNotification.ID,
+				Receiver:       r,
+				Title:          title,
+				Content:        content,
+				Status:         domain.InboxMessageStatusUnread,
+			})
+		}
+		return msgs
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService)
+		wantErr   error
+	}{
+		{
+			name: "获取模板失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(domain.ChannelTemplate{}, ErrGetTemplateFailed)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "无已发布模版",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(domain.ChannelTemplate{ID: testNotification.Template.ID, Channel: domain.ChannelInApp}, nil)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "模版参数不匹配",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, _ *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(newTemplate("${name}，订单${order}已${status}"), nil)
+			},
+			wantErr: render.ErrMissingParams,
+		},
+		{
+			name: "保存站内信失败",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(newTemplate("${name}，订单${order}已发货"), nil)
+				inboxSvc.EXPECT().Save(gomock.Any(), gomock.Any()).Return(ErrSaveFailed)
+			},
+			wantErr: errs.ErrSendNotificationFailed,
+		},
+		{
+			name: "单行内容以模版名称为标题",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(newTemplate("${name}，订单${order}已发货"), nil)
+				inboxSvc.EXPECT().Save(gomock.Any(), newMessages("订单通知", "Alice，订单A001已发货")).Return(nil)
+			},
+		},
+		{
+			name: "多行内容首行为标题",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, inboxSvc *inboxmocks.MockService) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "inbox", domain.ChannelInApp).
+					Return(newTemplate("${name}，您的订单已发货\n订单${order}已发货，请注意查收"), nil)
+				inboxSvc.EXPECT().Save(gomock.Any(), newMessages("Alice，您的订单已发货", "订单A001已发货，请注意查收")).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockInboxSvc := inboxmocks.NewMockService(ctrl)
+			tt.setupMock(mockTemplateSvc, mockInboxSvc)
+
+			p := NewInAppProvider("inbox", mockTemplateSvc, mockInboxSvc)
+			resp, err := p.Send(context.Background(), testNotification)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testNotification.ID, resp.NotificationID)
+			assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+		})
+	}
+}
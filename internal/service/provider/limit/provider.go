@@ -0,0 +1,39 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+var _ provider.Provider = (*Provider)(nil)
+
+// Provider 在真正发送前占用供应商的一次请求机会。
+// 选择器和预览只查看供应商是否饱和，请求机会只在这里消耗
+type Provider struct {
+	provider.Provider
+	limiter provider.Limiter
+}
+
+// NewProvider limiter 为 nil 时不限流
+func NewProvider(p provider.Provider, limiter provider.Limiter) provider.Provider {
+	if limiter == nil {
+		return p
+	}
+	return &Provider{Provider: p, limiter: limiter}
+}
+
+func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	limited, err := p.limiter.Limit(ctx, p.Provider)
+	if err != nil {
+		// 限流器出错时照常发送，交给供应商自身的限流兜底
+		elog.DefaultLogger.Warn("供应商限流判断失败", elog.String("provider", p.Name()), elog.FieldErr(err))
+	} else if limited {
+		return domain.SendResponse{}, fmt.Errorf("%w: %s", errs.ErrProviderThrottled, p.Name())
+	}
+	return p.Provider.Send(ctx, notification)
+}
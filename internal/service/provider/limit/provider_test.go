@@ -0,0 +1,65 @@
+//go:build unit
+
+package limit
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvider_Send(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		mock     func(p *providermocks.MockProvider, l *providermocks.MockLimiter)
+		wantResp domain.SendResponse
+		wantErr  error
+	}{
+		{
+			name: "占用请求机会后发送",
+			mock: func(p *providermocks.MockProvider, l *providermocks.MockLimiter) {
+				l.EXPECT().Limit(gomock.Any(), p).Return(false, nil)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{NotificationID: 1}, nil)
+			},
+			wantResp: domain.SendResponse{NotificationID: 1},
+		},
+		{
+			name: "已经饱和时不发送",
+			mock: func(p *providermocks.MockProvider, l *providermocks.MockLimiter) {
+				l.EXPECT().Limit(gomock.Any(), p).Return(true, nil)
+			},
+			wantErr: errs.ErrProviderThrottled,
+		},
+		{
+			name: "限流器出错时照常发送",
+			mock: func(p *providermocks.MockProvider, l *providermocks.MockLimiter) {
+				l.EXPECT().Limit(gomock.Any(), p).Return(false, assert.AnError)
+				p.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{NotificationID: 1}, nil)
+			},
+			wantResp: domain.SendResponse{NotificationID: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			p := providermocks.NewMockProvider(ctrl)
+			p.EXPECT().Name().Return("aliyun").AnyTimes()
+			l := providermocks.NewMockLimiter(ctrl)
+			tc.mock(p, l)
+
+			resp, err := NewProvider(p, l).Send(t.Context(), domain.Notification{})
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantResp, resp)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/pkg/ratelimit"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ provider.Limiter = (*redisLimiter)(nil)
+
+type limiters struct {
+	id    int64
+	qps   ratelimit.Limiter
+	daily ratelimit.Limiter
+}
+
+func (l limiters) qpsKey() string {
+	return fmt.Sprintf("provider:%d:qps", l.id)
+}
+
+func (l limiters) dailyKey() string {
+	return fmt.Sprintf("provider:%d:daily", l.id)
+}
+
+// redisLimiter 按 providers 表中的 QPSLimit 和 DailyLimit 限流，同一个渠道的供应商名称唯一，
+// 所以按名称找到对应的供应商配置，限流的键使用供应商ID
+type redisLimiter struct {
+	providers map[string]limiters
+}
+
+// NewRedisLimiter 创建同一个渠道下的供应商限流器，没有配置限制的供应商不限流
+func NewRedisLimiter(cmd redis.Cmdable, providers []domain.Provider) provider.Limiter {
+	res := make(map[string]limiters, len(providers))
+	for i := range providers {
+		l := limiters{id: providers[i].ID}
+		if providers[i].QPSLimit > 0 {
+			l.qps = ratelimit.NewRedisSlidingWindowLimiter(cmd, time.Second, providers[i].QPSLimit)
+		}
+		if providers[i].DailyLimit > 0 {
+			l.daily = ratelimit.NewRedisFixedWindowLimiter(cmd, 24*time.Hour, providers[i].DailyLimit)
+		}
+		res[providers[i].Name] = l
+	}
+	return &redisLimiter{providers: res}
+}
+
+func (r *redisLimiter) Limit(ctx context.Context, p provider.Provider) (bool, error) {
+	l, ok := r.providers[p.Name()]
+	if !ok {
+		return false, nil
+	}
+	// 先判断 QPS，被每日上限拦截时浪费的只是当前这一秒的请求机会
+	if l.qps != nil {
+		limited, err := l.qps.Limit(ctx, l.qpsKey())
+		if err != nil || limited {
+			return limited, err
+		}
+	}
+	if l.daily != nil {
+		return l.daily.Limit(ctx, l.dailyKey())
+	}
+	return false, nil
+}
+
+func (r *redisLimiter) Peek(ctx context.Context, p provider.Provider) (bool, error) {
+	l, ok := r.providers[p.Name()]
+	if !ok {
+		return false, nil
+	}
+	if l.qps != nil {
+		limited, err := l.qps.Peek(ctx, l.qpsKey())
+		if err != nil || limited {
+			return limited, err
+		}
+	}
+	if l.daily != nil {
+		return l.daily.Peek(ctx, l.dailyKey())
+	}
+	return false, nil
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// Limiter 供应商限流器，选择器通过 Peek 跳过已经达到 QPS 或每日请求数上限的供应商，
+// 避免请求打到供应商后才被供应商限流；真正发送前再通过 Limit 占用请求机会
+//
+//go:generate mockgen -source=./limiter.go -destination=./mocks/limiter.mock.go -package=providermocks -typed Limiter
+type Limiter interface {
+	// Limit 判断供应商是否已经饱和，返回 false 时已经占用了该供应商的一次请求机会
+	Limit(ctx context.Context, p Provider) (bool, error)
+	// Peek 判断供应商是否已经饱和，不占用请求机会，用于选择供应商和预览
+	Peek(ctx context.Context, p Provider) (bool, error)
+}
+
+// Saturated 供应商是否已经饱和。limiter 为 nil 表示不限流，
+// 限流器出错时不跳过供应商，交给供应商自身的限流兜底。
+// 这里只是查看，不会占用供应商的请求机会
+func Saturated(ctx context.Context, limiter Limiter, p Provider) bool {
+	if limiter == nil {
+		return false
+	}
+	limited, err := limiter.Peek(ctx, p)
+	if err != nil {
+		elog.DefaultLogger.Warn("供应商限流判断失败", elog.String("provider", p.Name()), elog.FieldErr(err))
+		return false
+	}
+	return limited
+}
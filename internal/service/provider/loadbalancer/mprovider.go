@@ -2,12 +2,14 @@ package loadbalancer
 
 import (
 	"context"
+	"errors"
 	"math/bits"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
 )
 
@@ -36,7 +38,12 @@ type mprovider struct {
 
 func (s *mprovider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	res, err := s.Provider.Send(ctx, notification)
-	if err != nil {
+	// 被限流的请求没有真正到达供应商，不影响供应商的健康状态
+	if errors.Is(err, errs.ErrProviderThrottled) {
+		return res, err
+	}
+	// 接收者本身的问题说明供应商是正常的，不计入失败
+	if err != nil && !errors.Is(err, errs.ErrInvalidReceiver) {
 		s.markFail()
 		v := s.getFailed()
 		if v > s.failThreshold {
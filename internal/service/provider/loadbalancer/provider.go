@@ -47,6 +47,11 @@ func NewProvider(providers []provider.Provider, bufferLen int) *Provider {
 	}
 }
 
+// Name 每次发送时才会选出具体的供应商，这里只返回负载均衡器本身的名称
+func (p *Provider) Name() string {
+	return "loadbalancer"
+}
+
 // Send 轮询查找健康的provider来发送通知
 // 如果所有provider都不健康，则返回错误
 // 前提：p.providers的长度在使用过程中不会改变
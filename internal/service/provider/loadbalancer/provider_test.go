@@ -5,12 +5,14 @@ package loadbalancer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,6 +38,10 @@ func NewMockHealthAwareProvider(name string, shouldFail bool) *MockHealthAwarePr
 	return m
 }
 
+func (m *MockHealthAwareProvider) Name() string {
+	return m.name
+}
+
 func (m *MockHealthAwareProvider) Send(_ context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	m.callCount.Add(1)
 
@@ -153,3 +159,49 @@ func TestProviderLoadBalancingAndHealthRecovery(t *testing.T) {
 	assert.Greater(t, provider2.GetCallCount(), int32(0))
 	assert.Greater(t, provider3.GetCallCount(), int32(0))
 }
+
+// errProvider 每次发送都返回同一个错误
+type errProvider struct {
+	err error
+}
+
+func (p *errProvider) Name() string {
+	return "err"
+}
+
+func (p *errProvider) Send(_ context.Context, _ domain.Notification) (domain.SendResponse, error) {
+	return domain.SendResponse{}, p.err
+}
+
+func TestMprovider_SendReceiverFault(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		err        error
+		wantFailed int
+	}{
+		{
+			name:       "供应商的问题计入失败",
+			err:        fmt.Errorf("%w: Code = isv.AMOUNT_NOT_ENOUGH", errs.ErrSendNotificationFailed),
+			wantFailed: 3,
+		},
+		{
+			name:       "接收者的问题不计入失败",
+			err:        fmt.Errorf("%w: %w", errs.ErrInvalidReceiver, errs.ErrSendNotificationFailed),
+			wantFailed: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			mp := newMprovider(&errProvider{err: tc.err}, 1)
+			for i := 0; i < 3; i++ {
+				_, err := mp.Send(t.Context(), domain.Notification{})
+				assert.ErrorIs(t, err, tc.err)
+			}
+			assert.Equal(t, tc.wantFailed, mp.getFailed())
+			assert.True(t, mp.isHealthy())
+		})
+	}
+}
@@ -10,9 +10,10 @@ import (
 )
 
 type Selector struct {
-	providers []*mprovider  // 被封装的provider列表
-	count     int64         // 轮询计数器
-	mu        *sync.RWMutex // 保护providers的并发访问
+	providers []*mprovider     // 被封装的provider列表
+	count     int64            // 轮询计数器
+	mu        *sync.RWMutex    // 保护providers的并发访问
+	limiter   provider.Limiter // 供应商限流器，为 nil 时不限流
 }
 
 func NewSelector(providers []provider.Provider, bufferLen int) *Selector {
@@ -34,7 +35,7 @@ func NewSelector(providers []provider.Provider, bufferLen int) *Selector {
 	}
 }
 
-func (s *Selector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
+func (s *Selector) Next(ctx context.Context, _ domain.Notification) (provider.Provider, error) {
 	s.mu.RLock()
 	providers := s.providers
 	s.mu.RUnlock()
@@ -46,9 +47,87 @@ func (s *Selector) Next(_ context.Context, _ domain.Notification) (provider.Prov
 	for i := 0; i < providerLen; i++ {
 		idx := (int(current) + i) % providerLen
 		pro := providers[idx]
-		if pro != nil && pro.isHealthy() {
+		if pro != nil && pro.isHealthy() && !provider.Saturated(ctx, s.limiter, pro) {
 			return pro, nil
 		}
 	}
 	return nil, ErrNoHealthyProvider
 }
+
+// Peek 返回下一次 Next 会选出的供应商，不推进轮询计数器
+func (s *Selector) Peek(ctx context.Context, _ domain.Notification) (provider.Provider, error) {
+	s.mu.RLock()
+	providers := s.providers
+	s.mu.RUnlock()
+	providerLen := len(providers)
+	if providerLen == 0 {
+		return nil, ErrNoProvidersAvailable
+	}
+	current := atomic.LoadInt64(&s.count) + 1
+	for i := 0; i < providerLen; i++ {
+		pro := providers[(int(current)+i)%providerLen]
+		if pro != nil && pro.isHealthy() && !provider.Saturated(ctx, s.limiter, pro) {
+			return pro, nil
+		}
+	}
+	return nil, ErrNoHealthyProvider
+}
+
+var (
+	_ provider.Selector        = (*Selector)(nil)
+	_ provider.Peeker          = (*Selector)(nil)
+	_ provider.SelectorBuilder = (*SelectorBuilder)(nil)
+)
+
+// SelectorBuilder 负载均衡选择器的构造器
+// 所有 Build 出来的选择器共享同一组 mprovider，因此供应商的健康状态在多次发送之间是共享的
+type SelectorBuilder struct {
+	selector *Selector
+}
+
+// NewSelectorBuilder 创建负载均衡选择器的构造器，limiter 为 nil 时不限流
+func NewSelectorBuilder(providers []provider.Provider, bufferLen int, limiter provider.Limiter) *SelectorBuilder {
+	selector := NewSelector(providers, bufferLen)
+	selector.limiter = limiter
+	return &SelectorBuilder{selector: selector}
+}
+
+// Build 构造一次发送使用的选择器，同一个选择器中每个供应商最多只会被选中一次
+func (b *SelectorBuilder) Build() (provider.Selector, error) {
+	return &onceSelector{
+		Selector: b.selector,
+		tried:    make(map[*mprovider]struct{}, len(b.selector.providers)),
+	}, nil
+}
+
+type onceSelector struct {
+	*Selector
+	tried map[*mprovider]struct{}
+}
+
+func (s *onceSelector) Next(ctx context.Context, _ domain.Notification) (provider.Provider, error) {
+	s.mu.RLock()
+	providers := s.providers
+	s.mu.RUnlock()
+	providerLen := len(providers)
+	if providerLen == 0 {
+		return nil, ErrNoProvidersAvailable
+	}
+	current := atomic.AddInt64(&s.count, 1)
+	for i := 0; i < providerLen; i++ {
+		pro := providers[(int(current)+i)%providerLen]
+		if pro == nil || !pro.isHealthy() {
+			continue
+		}
+		if _, ok := s.tried[pro]; ok {
+			continue
+		}
+		s.tried[pro] = struct{}{}
+		// 已经饱和的供应商在本次发送中不再尝试
+		if provider.Saturated(ctx, s.limiter, pro) {
+			continue
+		}
+		return pro, nil
+	}
+	return nil, ErrNoHealthyProvider
+}
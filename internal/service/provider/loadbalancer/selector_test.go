@@ -0,0 +1,121 @@
+//go:build unit
+
+package loadbalancer
+
+import (
+	"context"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectorBuilder(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{ID: 123, Channel: domain.ChannelEmail}
+
+	t.Run("没有供应商", func(t *testing.T) {
+		t.Parallel()
+		selector, err := NewSelectorBuilder(nil, 10, nil).Build()
+		require.NoError(t, err)
+		_, err = selector.Next(t.Context(), notification)
+		assert.ErrorIs(t, err, ErrNoProvidersAvailable)
+	})
+
+	t.Run("同一个选择器中每个供应商只会被选中一次", func(t *testing.T) {
+		t.Parallel()
+		provider1 := NewMockHealthAwareProvider("provider1", true)
+		provider2 := NewMockHealthAwareProvider("provider2", true)
+		builder := NewSelectorBuilder([]provider.Provider{provider1, provider2}, 10, nil)
+
+		selector, err := builder.Build()
+		require.NoError(t, err)
+		for i := 0; i < 2; i++ {
+			p, err1 := selector.Next(t.Context(), notification)
+			require.NoError(t, err1)
+			_, err1 = p.Send(t.Context(), notification)
+			assert.Error(t, err1)
+		}
+		_, err = selector.Next(t.Context(), notification)
+		assert.ErrorIs(t, err, ErrNoHealthyProvider)
+		assert.Equal(t, int32(1), provider1.GetCallCount())
+		assert.Equal(t, int32(1), provider2.GetCallCount())
+	})
+
+	t.Run("多个选择器之间轮询并共享健康状态", func(t *testing.T) {
+		t.Parallel()
+		provider1 := NewMockHealthAwareProvider("provider1", false)
+		provider2 := NewMockHealthAwareProvider("provider2", true)
+		builder := NewSelectorBuilder([]provider.Provider{provider1, provider2}, 1, nil)
+
+		// 缓冲区长度为 1 时，失败超过 6 次 provider2 就会被标记为不健康
+		for i := 0; i < 20; i++ {
+			selector, err := builder.Build()
+			require.NoError(t, err)
+			p, err := selector.Next(t.Context(), notification)
+			require.NoError(t, err)
+			_, _ = p.Send(t.Context(), notification)
+		}
+		assert.Equal(t, int32(7), provider2.GetCallCount())
+
+		provider1.ResetCallCount()
+		for i := 0; i < 5; i++ {
+			selector, err := builder.Build()
+			require.NoError(t, err)
+			p, err := selector.Next(t.Context(), notification)
+			require.NoError(t, err)
+			_, err = p.Send(t.Context(), notification)
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(5), provider1.GetCallCount())
+	})
+	t.Run("跳过已经饱和的供应商", func(t *testing.T) {
+		t.Parallel()
+		provider1 := NewMockHealthAwareProvider("provider1", false)
+		provider2 := NewMockHealthAwareProvider("provider2", false)
+		builder := NewSelectorBuilder([]provider.Provider{provider1, provider2}, 10, saturatedLimiter{"provider1": true})
+
+		for i := 0; i < 4; i++ {
+			selector, err := builder.Build()
+			require.NoError(t, err)
+			p, err := selector.Next(t.Context(), notification)
+			require.NoError(t, err)
+			assert.Equal(t, "provider2", p.Name())
+			// provider2 也已经被选过，本次发送没有其他供应商可选
+			_, err = selector.Next(t.Context(), notification)
+			assert.ErrorIs(t, err, ErrNoHealthyProvider)
+		}
+	})
+	t.Run("Peek不推进轮询位置", func(t *testing.T) {
+		t.Parallel()
+		provider1 := NewMockHealthAwareProvider("provider1", false)
+		provider2 := NewMockHealthAwareProvider("provider2", false)
+		builder := NewSelectorBuilder([]provider.Provider{provider1, provider2}, 10, nil)
+
+		selector, err := builder.Build()
+		require.NoError(t, err)
+		peeker, ok := selector.(provider.Peeker)
+		require.True(t, ok)
+		peeked, err := peeker.Peek(t.Context(), notification)
+		require.NoError(t, err)
+		_, err = peeker.Peek(t.Context(), notification)
+		require.NoError(t, err)
+		p, err := selector.Next(t.Context(), notification)
+		require.NoError(t, err)
+		assert.Equal(t, peeked.Name(), p.Name())
+	})
+}
+
+// saturatedLimiter 按供应商名称指定是否饱和
+type saturatedLimiter map[string]bool
+
+func (s saturatedLimiter) Limit(_ context.Context, p provider.Provider) (bool, error) {
+	return s[p.Name()], nil
+}
+
+func (s saturatedLimiter) Peek(ctx context.Context, p provider.Provider) (bool, error) {
+	return s.Limit(ctx, p)
+}
@@ -80,6 +80,10 @@ func NewProvider(name string, p provider.Provider) *Provider {
 	}
 }
 
+func (p *Provider) Name() string {
+	return p.name
+}
+
 // Send 发送通知并记录指标
 func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	// 开始计时
@@ -22,7 +22,6 @@ import (
 type MockProvider struct {
 	ctrl     *gomock.Controller
 	recorder *MockProviderMockRecorder
-	isgomock struct{}
 }
 
 // MockProviderMockRecorder is the mock recorder for MockProvider.
@@ -42,6 +41,44 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// Name mocks base method.
+func (m *MockProvider) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockProviderMockRecorder) Name() *MockProviderNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockProvider)(nil).Name))
+	return &MockProviderNameCall{Call: call}
+}
+
+// MockProviderNameCall wrap *gomock.Call
+type MockProviderNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProviderNameCall) Return(arg0 string) *MockProviderNameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProviderNameCall) Do(f func() string) *MockProviderNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProviderNameCall) DoAndReturn(f func() string) *MockProviderNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Send mocks base method.
 func (m *MockProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	m.ctrl.T.Helper()
@@ -85,7 +122,6 @@ func (c *MockProviderSendCall) DoAndReturn(f func(context.Context, domain.Notifi
 type MockSelector struct {
 	ctrl     *gomock.Controller
 	recorder *MockSelectorMockRecorder
-	isgomock struct{}
 }
 
 // MockSelectorMockRecorder is the mock recorder for MockSelector.
@@ -148,7 +184,6 @@ func (c *MockSelectorNextCall) DoAndReturn(f func(context.Context, domain.Notifi
 type MockSelectorBuilder struct {
 	ctrl     *gomock.Controller
 	recorder *MockSelectorBuilderMockRecorder
-	isgomock struct{}
 }
 
 // MockSelectorBuilderMockRecorder is the mock recorder for MockSelectorBuilder.
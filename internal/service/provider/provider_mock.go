@@ -15,6 +15,10 @@ func NewMockProvider() *MockProvider {
 	return &MockProvider{}
 }
 
+func (m *MockProvider) Name() string {
+	return "mock"
+}
+
 func (m *MockProvider) Send(_ context.Context, _ domain.Notification) (domain.SendResponse, error) {
 	v := atomic.AddInt64(&m.count, 1)
 	// 随机睡眠1-2秒
@@ -14,32 +14,37 @@ var (
 	_ provider.SelectorBuilder = (*SelectorBuilder)(nil)
 )
 
-// selector 供应商顺序选择器
+// selector 供应商顺序选择器，已经饱和的供应商会被跳过
 type selector struct {
 	idx       int
 	providers []provider.Provider
+	limiter   provider.Limiter
 }
 
-func (r *selector) Next(_ context.Context, _ domain.Notification) (provider.Provider, error) {
-	if len(r.providers) == r.idx {
-		return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
+func (r *selector) Next(ctx context.Context, _ domain.Notification) (provider.Provider, error) {
+	for r.idx < len(r.providers) {
+		p := r.providers[r.idx]
+		r.idx++
+		if !provider.Saturated(ctx, r.limiter, p) {
+			return p, nil
+		}
 	}
-
-	p := r.providers[r.idx]
-	r.idx++
-	return p, nil
+	return nil, fmt.Errorf("%w", errs.ErrNoAvailableProvider)
 }
 
 type SelectorBuilder struct {
 	providers []provider.Provider
+	limiter   provider.Limiter
 }
 
-func NewSelectorBuilder(providers []provider.Provider) *SelectorBuilder {
-	return &SelectorBuilder{providers: providers}
+// NewSelectorBuilder limiter 为 nil 时不限流
+func NewSelectorBuilder(providers []provider.Provider, limiter provider.Limiter) *SelectorBuilder {
+	return &SelectorBuilder{providers: providers, limiter: limiter}
 }
 
 func (s *SelectorBuilder) Build() (provider.Selector, error) {
 	return &selector{
 		providers: s.providers,
+		limiter:   s.limiter,
 	}, nil
 }
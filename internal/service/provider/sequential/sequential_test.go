@@ -56,7 +56,7 @@ func TestNewSelectorBuilder(t *testing.T) {
 
 			providers := tt.getProvidersFunc(ctrl)
 
-			builder := NewSelectorBuilder(providers)
+			builder := NewSelectorBuilder(providers, nil)
 			assert.NotNil(t, builder)
 		})
 	}
@@ -97,7 +97,7 @@ func TestSelectorBuilder_Build(t *testing.T) {
 			defer ctrl.Finish()
 
 			providers := tt.setupFunc(ctrl)
-			builder := NewSelectorBuilder(providers)
+			builder := NewSelectorBuilder(providers, nil)
 
 			selector, err := builder.Build()
 			tt.errorAssertFunc(t, err)
@@ -190,7 +190,7 @@ func TestSelector_Next(t *testing.T) {
 			providers := tt.getProvidersFunc(ctrl)
 
 			// 创建选择器
-			builder := NewSelectorBuilder(providers)
+			builder := NewSelectorBuilder(providers, nil)
 			selector, err := builder.Build()
 			require.NoError(t, err)
 			require.NotNil(t, selector)
@@ -224,3 +224,32 @@ func TestSelector_Next(t *testing.T) {
 		})
 	}
 }
+
+func TestSelector_NextSkipSaturated(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	provider1 := providermocks.NewMockProvider(ctrl)
+	provider2 := providermocks.NewMockProvider(ctrl)
+	provider3 := providermocks.NewMockProvider(ctrl)
+	provider1.EXPECT().Name().Return("provider1").AnyTimes()
+	provider2.EXPECT().Name().Return("provider2").AnyTimes()
+
+	limiter := providermocks.NewMockLimiter(ctrl)
+	// provider1 已经饱和，provider2 限流器出错时不跳过，provider3 正常
+	limiter.EXPECT().Peek(gomock.Any(), provider1).Return(true, nil)
+	limiter.EXPECT().Peek(gomock.Any(), provider2).Return(false, assert.AnError)
+	limiter.EXPECT().Peek(gomock.Any(), provider3).Return(true, nil)
+
+	selector, err := NewSelectorBuilder([]provider.Provider{provider1, provider2, provider3}, limiter).Build()
+	require.NoError(t, err)
+
+	p, err := selector.Next(t.Context(), domain.Notification{})
+	require.NoError(t, err)
+	assert.Equal(t, provider2, p)
+
+	_, err = selector.Next(t.Context(), domain.Notification{})
+	assert.ErrorIs(t, err, errs.ErrNoAvailableProvider)
+}
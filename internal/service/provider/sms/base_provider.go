@@ -28,14 +28,20 @@ func (p *baseProvider) send(_ context.Context,
 		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
-	for _, status := range resp.PhoneNumbers {
-		if status.Code != "OK" {
-			return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
-		}
+	results := receiverResults("", p.client.ErrorCodes(), notification.Receivers, resp)
+	status := domain.AggregateSendStatus(results)
+	if status == domain.SendStatusFailed {
+		// 全部号码发送失败，仍然带上每个号码的失败原因
+		return domain.SendResponse{
+			NotificationID:  notification.ID,
+			Status:          status,
+			ReceiverResults: results,
+		}, sendError(results)
 	}
 
 	return domain.SendResponse{
-		NotificationID: notification.ID,
-		Status:         domain.SendStatusSucceeded,
+		NotificationID:  notification.ID,
+		Status:          status,
+		ReceiverResults: results,
 	}, nil
 }
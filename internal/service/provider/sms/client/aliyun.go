@@ -3,6 +3,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
@@ -222,13 +223,15 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 		return SendResp{}, fmt.Errorf("%w: %w", ErrSendFailed, err)
 	}
 
-	if response.Body == nil || response.Body.Code == nil || *response.Body.Code != OK {
+	// 业务错误时阿里云也会返回错误码，例如空号，所以这里只处理没有错误码的响应，
+	// 错误码放到每个手机号的状态中，由上层按错误码分类处理
+	if response.Body == nil || response.Body.Code == nil {
 		return SendResp{}, fmt.Errorf("%w: %v", ErrSendFailed, "响应异常")
 	}
 
 	// 构建新的响应格式
 	result := SendResp{
-		RequestID:    *response.Body.RequestId,
+		RequestID:    tea.StringValue(response.Body.RequestId),
 		PhoneNumbers: make(map[string]SendRespStatus),
 	}
 
@@ -239,8 +242,57 @@ func (a *AliyunSMS) Send(req SendReq) (SendResp, error) {
 		cleanPhone := strings.TrimPrefix(phone, "+86")
 		result.PhoneNumbers[cleanPhone] = SendRespStatus{
 			Code:    *response.Body.Code,
-			Message: *response.Body.Message,
+			Message: tea.StringValue(response.Body.Message),
+			BizID:   tea.StringValue(response.Body.BizId),
 		}
 	}
 	return result, nil
 }
+
+func (a *AliyunSMS) QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error) {
+	// https://help.aliyun.com/zh/sms/developer-reference/api-dysmsapi-2017-05-25-querysenddetails
+	if req.PhoneNumber == "" || req.SendDate == "" {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %v", ErrInvalidParameter, "手机号码和发送日期不能为空")
+	}
+
+	request := &dysmsapi.QuerySendDetailsRequest{
+		PhoneNumber: tea.String(strings.TrimPrefix(req.PhoneNumber, "+86")),
+		SendDate:    tea.String(req.SendDate),
+		PageSize:    tea.Int64(int64(req.PageSize)),
+		CurrentPage: tea.Int64(int64(req.CurrentPage)),
+	}
+	if req.BizID != "" {
+		request.BizId = tea.String(req.BizID)
+	}
+
+	response, err := a.client.QuerySendDetails(request)
+	if err != nil {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+
+	if response.Body == nil || response.Body.Code == nil || *response.Body.Code != OK {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %v", ErrQuerySendDetails, "响应异常")
+	}
+
+	totalCount, _ := strconv.Atoi(tea.StringValue(response.Body.TotalCount))
+	result := QuerySendDetailsResp{
+		RequestID:  tea.StringValue(response.Body.RequestId),
+		TotalCount: totalCount,
+	}
+	if response.Body.SmsSendDetailDTOs == nil {
+		return result, nil
+	}
+	for _, dto := range response.Body.SmsSendDetailDTOs.SmsSendDetailDTO {
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, SendDetail{
+			PhoneNum:     tea.StringValue(dto.PhoneNum),
+			SendStatus:   int(tea.Int64Value(dto.SendStatus)),
+			Content:      tea.StringValue(dto.Content),
+			TemplateCode: tea.StringValue(dto.TemplateCode),
+			SendDate:     tea.StringValue(dto.SendDate),
+			ReceiveDate:  tea.StringValue(dto.ReceiveDate),
+			ErrCode:      tea.StringValue(dto.ErrCode),
+			OutID:        tea.StringValue(dto.OutId),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package client
+
+import "gitee.com/flycash/notification-platform/internal/domain"
+
+// ErrorCodeTable 供应商错误码到错误分类的映射，没有登记的错误码按可重试处理
+type ErrorCodeTable map[string]domain.ProviderErrorKind
+
+// Classify 对错误码分类
+func (t ErrorCodeTable) Classify(code string) domain.ProviderErrorKind {
+	if kind, ok := t[code]; ok {
+		return kind
+	}
+	return domain.ProviderErrorKindRetryable
+}
+
+var (
+	// aliyunErrorCodes https://help.aliyun.com/zh/sms/developer-reference/api-error-codes
+	aliyunErrorCodes = ErrorCodeTable{
+		// 号码本身的问题
+		"isv.MOBILE_NUMBER_ILLEGAL": domain.ProviderErrorKindReceiver,
+		// 账号、签名、模版的问题
+		"isv.AMOUNT_NOT_ENOUGH":     domain.ProviderErrorKindProvider,
+		"isv.OUT_OF_SERVICE":        domain.ProviderErrorKindProvider,
+		"isv.ACCOUNT_NOT_EXISTS":    domain.ProviderErrorKindProvider,
+		"isv.ACCOUNT_ABNORMAL":      domain.ProviderErrorKindProvider,
+		"isv.PRODUCT_UN_SUBSCRIPT":  domain.ProviderErrorKindProvider,
+		"isv.SMS_SIGNATURE_ILLEGAL": domain.ProviderErrorKindProvider,
+		"isv.SMS_TEMPLATE_ILLEGAL":  domain.ProviderErrorKindProvider,
+		"isp.RAM_PERMISSION_DENY":   domain.ProviderErrorKindProvider,
+		// 流控和系统繁忙
+		"isv.BUSINESS_LIMIT_CONTROL": domain.ProviderErrorKindThrottled,
+		"isv.DAY_LIMIT_CONTROL":      domain.ProviderErrorKindThrottled,
+		"isp.SYSTEM_ERROR":           domain.ProviderErrorKindRetryable,
+	}
+
+	// tencentCloudErrorCodes https://cloud.tencent.com/document/product/382/59229
+	tencentCloudErrorCodes = ErrorCodeTable{
+		// 号码本身的问题
+		"InvalidParameterValue.IncorrectPhoneNumber": domain.ProviderErrorKindReceiver,
+		"FailedOperation.PhoneNumberInBlacklist":     domain.ProviderErrorKindReceiver,
+		"FailedOperation.PhoneNumberParseFail":       domain.ProviderErrorKindReceiver,
+		// 账号、签名、模版的问题
+		"FailedOperation.InsufficientBalanceInSmsPackage": domain.ProviderErrorKindProvider,
+		"FailedOperation.SignatureIncorrectOrUnapproved":  domain.ProviderErrorKindProvider,
+		"FailedOperation.TemplateIncorrectOrUnapproved":   domain.ProviderErrorKindProvider,
+		"FailedOperation.MissingSignature":                domain.ProviderErrorKindProvider,
+		"UnauthorizedOperation.SmsSdkAppIdVerifyFail":     domain.ProviderErrorKindProvider,
+		"AuthFailure.SecretIdNotFound":                    domain.ProviderErrorKindProvider,
+		// 流控和系统繁忙
+		"LimitExceeded.PhoneNumberThirtySecondLimit": domain.ProviderErrorKindThrottled,
+		"LimitExceeded.PhoneNumberOneHourLimit":      domain.ProviderErrorKindThrottled,
+		"LimitExceeded.PhoneNumberDailyLimit":        domain.ProviderErrorKindThrottled,
+		"LimitExceeded.DeliveryFrequencyLimit":       domain.ProviderErrorKindThrottled,
+		"InternalError.Timeout":                      domain.ProviderErrorKindRetryable,
+	}
+)
+
+// ErrorCodes 阿里云的错误码映射表
+func (a *AliyunSMS) ErrorCodes() ErrorCodeTable {
+	return aliyunErrorCodes
+}
+
+// ErrorCodes 腾讯云的错误码映射表
+func (t *TencentCloudSMS) ErrorCodes() ErrorCodeTable {
+	return tencentCloudErrorCodes
+}
@@ -0,0 +1,43 @@
+//go:build unit
+
+package client
+
+import (
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		client Client
+		code   string
+		want   domain.ProviderErrorKind
+	}{
+		{name: "阿里云非法手机号", client: &AliyunSMS{}, code: "isv.MOBILE_NUMBER_ILLEGAL", want: domain.ProviderErrorKindReceiver},
+		{name: "阿里云余额不足", client: &AliyunSMS{}, code: "isv.AMOUNT_NOT_ENOUGH", want: domain.ProviderErrorKindProvider},
+		{name: "阿里云限流", client: &AliyunSMS{}, code: "isv.BUSINESS_LIMIT_CONTROL", want: domain.ProviderErrorKindThrottled},
+		{name: "阿里云系统繁忙", client: &AliyunSMS{}, code: "isp.SYSTEM_ERROR", want: domain.ProviderErrorKindRetryable},
+		{name: "腾讯云号码错误", client: &TencentCloudSMS{}, code: "InvalidParameterValue.IncorrectPhoneNumber", want: domain.ProviderErrorKindReceiver},
+		{name: "腾讯云签名未审核", client: &TencentCloudSMS{}, code: "FailedOperation.SignatureIncorrectOrUnapproved", want: domain.ProviderErrorKindProvider},
+		{name: "没有登记的错误码", client: &AliyunSMS{}, code: "isv.UNKNOWN", want: domain.ProviderErrorKindRetryable},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.client.ErrorCodes().Classify(tc.code))
+		})
+	}
+}
+
+func TestErrorCodeTable_ClassifyWithoutTable(t *testing.T) {
+	t.Parallel()
+
+	// 没有映射表的供应商所有错误码都按可重试处理
+	var table ErrorCodeTable
+	assert.Equal(t, domain.ProviderErrorKindRetryable, table.Classify("isv.MOBILE_NUMBER_ILLEGAL"))
+}
@@ -20,7 +20,6 @@ import (
 type MockClient struct {
 	ctrl     *gomock.Controller
 	recorder *MockClientMockRecorder
-	isgomock struct{}
 }
 
 // MockClientMockRecorder is the mock recorder for MockClient.
@@ -118,6 +117,83 @@ func (c *MockClientCreateTemplateCall) DoAndReturn(f func(client.CreateTemplateR
 	return c
 }
 
+// ErrorCodes mocks base method.
+func (m *MockClient) ErrorCodes() client.ErrorCodeTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ErrorCodes")
+	ret0, _ := ret[0].(client.ErrorCodeTable)
+	return ret0
+}
+
+// ErrorCodes indicates an expected call of ErrorCodes.
+func (mr *MockClientMockRecorder) ErrorCodes() *MockClientErrorCodesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorCodes", reflect.TypeOf((*MockClient)(nil).ErrorCodes))
+	return &MockClientErrorCodesCall{Call: call}
+}
+
+// MockClientErrorCodesCall wrap *gomock.Call
+type MockClientErrorCodesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientErrorCodesCall) Return(arg0 client.ErrorCodeTable) *MockClientErrorCodesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientErrorCodesCall) Do(f func() client.ErrorCodeTable) *MockClientErrorCodesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientErrorCodesCall) DoAndReturn(f func() client.ErrorCodeTable) *MockClientErrorCodesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// QuerySendDetails mocks base method.
+func (m *MockClient) QuerySendDetails(req client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySendDetails", req)
+	ret0, _ := ret[0].(client.QuerySendDetailsResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuerySendDetails indicates an expected call of QuerySendDetails.
+func (mr *MockClientMockRecorder) QuerySendDetails(req any) *MockClientQuerySendDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySendDetails", reflect.TypeOf((*MockClient)(nil).QuerySendDetails), req)
+	return &MockClientQuerySendDetailsCall{Call: call}
+}
+
+// MockClientQuerySendDetailsCall wrap *gomock.Call
+type MockClientQuerySendDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientQuerySendDetailsCall) Return(arg0 client.QuerySendDetailsResp, arg1 error) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientQuerySendDetailsCall) Do(f func(client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error)) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientQuerySendDetailsCall) DoAndReturn(f func(client.QuerySendDetailsReq) (client.QuerySendDetailsResp, error)) *MockClientQuerySendDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Send mocks base method.
 func (m *MockClient) Send(req client.SendReq) (client.SendResp, error) {
 	m.ctrl.T.Helper()
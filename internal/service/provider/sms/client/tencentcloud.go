@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/alibabacloud-go/tea/tea"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	sms "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sms/v20210111"
@@ -169,7 +170,68 @@ func (t *TencentCloudSMS) Send(req SendReq) (SendResp, error) {
 		result.PhoneNumbers[strings.TrimPrefix(*status.PhoneNumber, "+86")] = SendRespStatus{
 			Code:    *status.Code,
 			Message: *status.Message,
+			BizID:   *status.SerialNo,
 		}
 	}
 	return result, nil
 }
+
+func (t *TencentCloudSMS) QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error) {
+	// https://cloud.tencent.com/document/product/382/55970
+	if req.PhoneNumber == "" {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: 手机号码不能为空", ErrInvalidParameter)
+	}
+
+	request := sms.NewPullSmsSendStatusByPhoneNumberRequest()
+	phoneNumber := req.PhoneNumber
+	if !strings.HasPrefix(phoneNumber, "+") {
+		phoneNumber = "+86" + phoneNumber
+	}
+	request.PhoneNumber = &phoneNumber
+	request.SmsSdkAppId = t.appID
+	// 拉取起止时间，UNIX 时间戳（秒），最大可拉取当前时期前7天的数据
+	beginTime, endTime := uint64(req.BeginTime), uint64(req.EndTime)
+	request.BeginTime = &beginTime
+	request.EndTime = &endTime
+	request.Offset = &req.Offset
+	request.Limit = &req.Limit
+
+	response, err := t.client.PullSmsSendStatusByPhoneNumber(request)
+	if err != nil {
+		return QuerySendDetailsResp{}, fmt.Errorf("%w: %w", ErrQuerySendDetails, err)
+	}
+
+	// 供应商返回的字段都可能为空，不能直接解引用
+	result := QuerySendDetailsResp{
+		RequestID: tea.StringValue(response.Response.RequestId),
+	}
+	for _, status := range response.Response.PullSmsSendStatusSet {
+		if status == nil {
+			continue
+		}
+		serialNo := tea.StringValue(status.SerialNo)
+		// 腾讯云按手机号拉取，通过 SerialNo 过滤出指定的那一条
+		if req.BizID != "" && serialNo != req.BizID {
+			continue
+		}
+		// 腾讯云拉取到的都是已经有回执的记录，SUCCESS 表示用户接收成功
+		sendStatus := SendStatusFailed
+		if tea.StringValue(status.ReportStatus) == "SUCCESS" {
+			sendStatus = SendStatusSuccess
+		}
+		var receiveTime string
+		if status.UserReceiveTime != nil {
+			receiveTime = strconv.FormatUint(tea.Uint64Value(status.UserReceiveTime), 10)
+		}
+		result.SmsSendDetailDTOs = append(result.SmsSendDetailDTOs, SendDetail{
+			PhoneNum:        strings.TrimPrefix(tea.StringValue(status.PhoneNumber), "+86"),
+			SendStatus:      int(sendStatus),
+			ErrCode:         tea.StringValue(status.Description),
+			SerialNo:        serialNo,
+			ReportStatus:    int(sendStatus),
+			UserReceiveTime: receiveTime,
+		})
+	}
+	result.TotalCount = len(result.SmsSendDetailDTOs)
+	return result, nil
+}
@@ -76,6 +76,10 @@ type Client interface {
 	BatchQueryTemplateStatus(req BatchQueryTemplateStatusReq) (BatchQueryTemplateStatusResp, error)
 	// Send 发送短信
 	Send(req SendReq) (SendResp, error)
+	// QuerySendDetails 查询短信发送详情，用于获取短信的回执状态
+	QuerySendDetails(req QuerySendDetailsReq) (QuerySendDetailsResp, error)
+	// ErrorCodes 供应商错误码的映射表，返回 nil 时所有错误码都按可重试处理
+	ErrorCodes() ErrorCodeTable
 }
 
 // CreateTemplateReq 创建短信模板请求参数
@@ -127,6 +131,7 @@ type SendResp struct {
 type SendRespStatus struct {
 	Code    string
 	Message string
+	BizID   string // 发送回执 ID, 阿里云为 BizId, 腾讯云为 SerialNo, 用于查询发送详情以及匹配回执
 }
 
 // QuerySendDetailsReq 查询短信发送详情请求参数
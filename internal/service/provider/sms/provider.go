@@ -11,6 +11,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/gotomicro/ego/core/elog"
 )
 
 // smsProvider SMS供应商
@@ -18,50 +19,149 @@ type smsProvider struct {
 	name        string
 	templateSvc manage.ChannelTemplateService
 	client      client.Client
+	errorCodes  client.ErrorCodeTable
 
 	// baseProvider
 }
 
 // NewSMSProvider SMS供应商
 func NewSMSProvider(name string, templateSvc manage.ChannelTemplateService, client client.Client) provider.Provider {
+	errorCodes := client.ErrorCodes()
+	if errorCodes == nil {
+		elog.DefaultLogger.Warn("短信供应商没有错误码映射表，所有错误码都按可重试处理", elog.String("provider", name))
+	}
 	return &smsProvider{
 		name:        name,
 		templateSvc: templateSvc,
 		client:      client,
+		errorCodes:  errorCodes,
 	}
 }
 
-// Send 发送短信
+func (p *smsProvider) Name() string {
+	return p.name
+}
+
+// Send 发送短信，接收者使用不同语言时按语言分组，每组使用该语言的模版分别发送
 func (p *smsProvider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, p.name, domain.ChannelSMS)
+	groups := notification.ReceiversByLocale()
+	results := make([]domain.ReceiverResult, 0, len(notification.Receivers))
+	var firstErr error
+	failedGroups := 0
+	for _, group := range groups {
+		groupResults, err := p.send(ctx, notification, group)
+		if err != nil {
+			// 一组发送失败不影响其他语言的接收者，该组的接收者都标记为可以重试的失败
+			failedGroups++
+			if firstErr == nil {
+				firstErr = err
+			}
+			groupResults = domain.NewReceiverResults(group.Receivers, domain.SendStatusFailed, p.name, "", err.Error())
+			for i := range groupResults {
+				groupResults[i].ErrorKind = domain.ProviderErrorKindRetryable
+			}
+		}
+		results = append(results, groupResults...)
+	}
+	if failedGroups == len(groups) {
+		return domain.SendResponse{}, firstErr
+	}
+
+	status := domain.AggregateSendStatus(results)
+	if status == domain.SendStatusFailed {
+		// 全部号码发送失败，仍然带上每个号码的失败原因
+		return domain.SendResponse{
+			NotificationID:  notification.ID,
+			Status:          status,
+			ReceiverResults: results,
+		}, sendError(results)
+	}
+
+	return domain.SendResponse{
+		NotificationID:  notification.ID,
+		Status:          status,
+		ReceiverResults: results,
+	}, nil
+}
+
+// send 使用同一种语言的模版给一组接收者发送短信
+func (p *smsProvider) send(ctx context.Context, notification domain.Notification, group domain.LocaleReceivers) ([]domain.ReceiverResult, error) {
+	tmpl, err := p.templateSvc.GetTemplateByIDAndProviderInfo(ctx, notification.Template.ID, notification.Template.VersionID, group.Locale, p.name, domain.ChannelSMS)
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+		return nil, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
 
-	activeVersion := tmpl.ActiveVersion()
-	if activeVersion == nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
+	version := tmpl.VersionOrActive(notification.Template.VersionID)
+	if version == nil {
+		return nil, fmt.Errorf("%w: 无已发布模版", errs.ErrSendNotificationFailed)
 	}
 
 	const first = 0
 	resp, err := p.client.Send(client.SendReq{
-		PhoneNumbers:  notification.Receivers,
-		SignName:      activeVersion.Signature,
-		TemplateID:    activeVersion.Providers[first].ProviderTemplateID,
+		PhoneNumbers:  group.Receivers,
+		SignName:      version.Signature,
+		TemplateID:    version.Providers[first].ProviderTemplateID,
 		TemplateParam: notification.Template.Params,
 	})
 	if err != nil {
-		return domain.SendResponse{}, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
+		return nil, fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, err)
 	}
+	return receiverResults(p.name, p.errorCodes, group.Receivers, resp), nil
+}
 
-	for _, status := range resp.PhoneNumbers {
-		if !strings.EqualFold(status.Code, "OK") {
-			return domain.SendResponse{}, fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, status.Code, status.Message)
+// receiverResults 将供应商返回的每个号码的状态转换为接收者的发送结果，
+// 供应商没有返回状态的号码视为发送失败
+func receiverResults(providerName string, errorCodes client.ErrorCodeTable, receivers []string, resp client.SendResp) []domain.ReceiverResult {
+	results := make([]domain.ReceiverResult, 0, len(receivers))
+	for _, receiver := range receivers {
+		res := domain.ReceiverResult{
+			Receiver: receiver,
+			Status:   domain.SendStatusFailed,
+			Provider: providerName,
+		}
+		status, ok := resp.PhoneNumbers[strings.TrimPrefix(receiver, "+86")]
+		if !ok {
+			res.Message = "供应商未返回该号码的发送结果"
+			res.ErrorKind = domain.ProviderErrorKindRetryable
+			results = append(results, res)
+			continue
 		}
+		if strings.EqualFold(status.Code, client.OK) {
+			res.Status = domain.SendStatusSucceeded
+		} else {
+			res.ErrorKind = errorCodes.Classify(status.Code)
+		}
+		res.Code, res.Message, res.MessageID = status.Code, status.Message, status.BizID
+		results = append(results, res)
 	}
+	return results
+}
 
-	return domain.SendResponse{
-		NotificationID: notification.ID,
-		Status:         domain.SendStatusSucceeded,
-	}, nil
+// sendError 全部号码发送失败时的错误，按每个号码的错误分类带上更具体的错误：
+// 都是号码本身的问题时带上 errs.ErrInvalidReceiver，换供应商或者重试都不会成功；
+// 有供应商的问题时带上 errs.ErrProviderFault；都是限流时带上 errs.ErrProviderThrottled
+func sendError(results []domain.ReceiverResult) error {
+	const first = 0
+	err := fmt.Errorf("%w: Code = %s, Message = %s", errs.ErrSendNotificationFailed, results[first].Code, results[first].Message)
+	var receiverFaults, providerFaults, throttled int
+	for i := range results {
+		switch kind := results[i].ErrorKind; {
+		case kind.IsReceiverFault():
+			receiverFaults++
+		case kind.IsProviderFault():
+			providerFaults++
+		case kind.IsThrottled():
+			throttled++
+		}
+	}
+	switch {
+	case receiverFaults == len(results):
+		return fmt.Errorf("%w: %w", errs.ErrInvalidReceiver, err)
+	case providerFaults > 0:
+		return fmt.Errorf("%w: %w", errs.ErrProviderFault, err)
+	case throttled == len(results):
+		return fmt.Errorf("%w: %w", errs.ErrProviderThrottled, err)
+	default:
+		return err
+	}
 }
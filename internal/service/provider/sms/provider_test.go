@@ -43,6 +43,7 @@ func TestNewSMSProvider(t *testing.T) {
 
 			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
 			mockClient := smsmocks.NewMockClient(ctrl)
+			mockClient.EXPECT().ErrorCodes().Return(nil)
 
 			provider := NewSMSProvider(tt.providerName, mockTemplateSvc, mockClient)
 
@@ -80,7 +81,7 @@ func TestSmsProvider_Send(t *testing.T) {
 
 				// 模拟获取模板失败
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
 					Return(domain.ChannelTemplate{}, fmt.Errorf("%w: 供应商%d", ErrGetTemplateFailed, 1))
 			},
 			wantErr: errs.ErrSendNotificationFailed,
@@ -92,7 +93,7 @@ func TestSmsProvider_Send(t *testing.T) {
 
 				// 模拟返回没有活跃版本的模板
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
 					Return(domain.ChannelTemplate{
 						ID:       testNotification.Template.ID,
 						Channel:  domain.ChannelSMS,
@@ -128,7 +129,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -175,7 +176,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -229,7 +230,7 @@ func TestSmsProvider_Send(t *testing.T) {
 				}
 
 				mockTemplateSvc.EXPECT().
-					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, "aliyun", domain.ChannelSMS).
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
 					Return(domain.ChannelTemplate{
 						ID:              testNotification.Template.ID,
 						Channel:         domain.ChannelSMS,
@@ -256,6 +257,59 @@ func TestSmsProvider_Send(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "A/B 测试时使用通知上记录的版本",
+			setupMock: func(ctrl *gomock.Controller, provider *smsProvider) {
+				mockTemplateSvc := provider.templateSvc.(*templatemocks.MockChannelTemplateService)
+				mockClient := provider.client.(*smsmocks.MockClient)
+
+				// 通知上记录的版本不是活跃版本
+				experimentVersion := domain.ChannelTemplateVersion{
+					ID:                1,
+					ChannelTemplateID: testNotification.Template.ID,
+					Name:              "验证码模板-实验组",
+					Signature:         "实验签名",
+					Content:           "验证码：${code}，5分钟内有效",
+					AuditStatus:       domain.AuditStatusApproved,
+					Providers: []domain.ChannelTemplateProvider{
+						{
+							ID:                 2,
+							TemplateID:         1,
+							TemplateVersionID:  1,
+							ProviderName:       "aliyun",
+							ProviderTemplateID: "SMS_654321",
+							AuditStatus:        domain.AuditStatusApproved,
+						},
+					},
+				}
+
+				mockTemplateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
+					Return(domain.ChannelTemplate{
+						ID:              testNotification.Template.ID,
+						Channel:         domain.ChannelSMS,
+						Versions:        []domain.ChannelTemplateVersion{experimentVersion},
+						ActiveVersionID: 2,
+					}, nil)
+
+				mockClient.EXPECT().
+					Send(client.SendReq{
+						PhoneNumbers:  testNotification.Receivers,
+						SignName:      experimentVersion.Signature,
+						TemplateID:    experimentVersion.Providers[0].ProviderTemplateID,
+						TemplateParam: testNotification.Template.Params,
+					}).
+					Return(client.SendResp{
+						PhoneNumbers: map[string]client.SendRespStatus{
+							"13800138000": {
+								Code:    "OK",
+								Message: "发送成功",
+							},
+						},
+					}, nil)
+			},
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -292,3 +346,278 @@ func TestSmsProvider_Send(t *testing.T) {
 		})
 	}
 }
+
+func TestSmsProvider_SendReceiverResults(t *testing.T) {
+	t.Parallel()
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		Channel: domain.ChannelSMS,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"code": "123456"},
+		},
+		Receivers: []string{"13800138000", "+8613800138001", "13800138002"},
+	}
+
+	activeVersion := domain.ChannelTemplateVersion{
+		ID:                1,
+		ChannelTemplateID: testNotification.Template.ID,
+		Name:              "验证码模板",
+		Signature:         "测试签名",
+		Content:           "您的验证码是：${code}",
+		AuditStatus:       domain.AuditStatusApproved,
+		Providers: []domain.ChannelTemplateProvider{
+			{
+				ID:                 1,
+				TemplateID:         1,
+				TemplateVersionID:  1,
+				ProviderName:       "aliyun",
+				ProviderTemplateID: "SMS_123456",
+				AuditStatus:        domain.AuditStatusApproved,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		phones      map[string]client.SendRespStatus
+		wantErr     error
+		wantStatus  domain.SendStatus
+		wantResults []domain.ReceiverResult
+	}{
+		{
+			name: "全部号码发送成功",
+			phones: map[string]client.SendRespStatus{
+				"13800138000": {Code: "OK", Message: "发送成功"},
+				"13800138001": {Code: "OK", Message: "发送成功"},
+				"13800138002": {Code: "OK", Message: "发送成功"},
+			},
+			wantStatus: domain.SendStatusSucceeded,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "+8613800138001", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "13800138002", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+			},
+		},
+		{
+			name: "部分号码发送成功",
+			phones: map[string]client.SendRespStatus{
+				"13800138000": {Code: "OK", Message: "发送成功"},
+				"13800138001": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+			},
+			wantStatus: domain.SendStatusPartialSuccess,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "+8613800138001", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+				{Receiver: "13800138002", Status: domain.SendStatusFailed, Message: "供应商未返回该号码的发送结果", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindRetryable},
+			},
+		},
+		{
+			name: "全部号码发送失败",
+			phones: map[string]client.SendRespStatus{
+				"13800138000": {Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流"},
+				"13800138001": {Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流"},
+				"13800138002": {Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流"},
+			},
+			wantErr:    errs.ErrProviderThrottled,
+			wantStatus: domain.SendStatusFailed,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusFailed, Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindThrottled},
+				{Receiver: "+8613800138001", Status: domain.SendStatusFailed, Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindThrottled},
+				{Receiver: "13800138002", Status: domain.SendStatusFailed, Code: "isv.BUSINESS_LIMIT_CONTROL", Message: "业务限流", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindThrottled},
+			},
+		},
+		{
+			name: "全部号码都是无效号码",
+			phones: map[string]client.SendRespStatus{
+				"13800138000": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+				"13800138001": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+				"13800138002": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+			},
+			wantErr:    errs.ErrInvalidReceiver,
+			wantStatus: domain.SendStatusFailed,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+				{Receiver: "+8613800138001", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+				{Receiver: "13800138002", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+			},
+		},
+		{
+			name: "部分号码无效其他号码是供应商的问题",
+			phones: map[string]client.SendRespStatus{
+				"13800138000": {Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号"},
+				"13800138001": {Code: "isv.AMOUNT_NOT_ENOUGH", Message: "账户余额不足"},
+				"13800138002": {Code: "isv.AMOUNT_NOT_ENOUGH", Message: "账户余额不足"},
+			},
+			wantErr:    errs.ErrProviderFault,
+			wantStatus: domain.SendStatusFailed,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+				{Receiver: "+8613800138001", Status: domain.SendStatusFailed, Code: "isv.AMOUNT_NOT_ENOUGH", Message: "账户余额不足", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindProvider},
+				{Receiver: "13800138002", Status: domain.SendStatusFailed, Code: "isv.AMOUNT_NOT_ENOUGH", Message: "账户余额不足", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindProvider},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockClient := smsmocks.NewMockClient(ctrl)
+
+			mockTemplateSvc.EXPECT().
+				GetTemplateByIDAndProviderInfo(gomock.Any(), testNotification.Template.ID, testNotification.Template.VersionID, testNotification.Locale, "aliyun", domain.ChannelSMS).
+				Return(domain.ChannelTemplate{
+					ID:              testNotification.Template.ID,
+					Channel:         domain.ChannelSMS,
+					Versions:        []domain.ChannelTemplateVersion{activeVersion},
+					ActiveVersionID: activeVersion.ID,
+				}, nil)
+			mockClient.EXPECT().
+				Send(gomock.Any()).
+				Return(client.SendResp{PhoneNumbers: tt.phones}, nil)
+
+			mockClient.EXPECT().ErrorCodes().Return((&client.AliyunSMS{}).ErrorCodes())
+			provider := NewSMSProvider("aliyun", mockTemplateSvc, mockClient)
+			resp, err := provider.Send(context.Background(), testNotification)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if !errors.Is(tt.wantErr, errs.ErrInvalidReceiver) {
+				assert.NotErrorIs(t, err, errs.ErrInvalidReceiver)
+			}
+			assert.Equal(t, testNotification.ID, resp.NotificationID)
+			assert.Equal(t, tt.wantStatus, resp.Status)
+			assert.Equal(t, tt.wantResults, resp.ReceiverResults)
+		})
+	}
+}
+
+func TestSmsProvider_SendByLocale(t *testing.T) {
+	t.Parallel()
+
+	testNotification := domain.Notification{
+		ID:      uint64(12345),
+		Channel: domain.ChannelSMS,
+		Template: domain.Template{
+			ID:        1,
+			VersionID: 1,
+			Params:    map[string]string{"code": "123456"},
+		},
+		Receivers:       []string{"13800138000", "13800138001", "13800138002"},
+		Locale:          "zh-CN",
+		ReceiverLocales: map[string]string{"13800138001": "en-US"},
+	}
+
+	newTemplate := func(signature, providerTemplateID string) domain.ChannelTemplate {
+		return domain.ChannelTemplate{
+			ID:              testNotification.Template.ID,
+			Channel:         domain.ChannelSMS,
+			ActiveVersionID: 1,
+			Versions: []domain.ChannelTemplateVersion{
+				{
+					ID:                1,
+					ChannelTemplateID: testNotification.Template.ID,
+					Signature:         signature,
+					AuditStatus:       domain.AuditStatusApproved,
+					Providers: []domain.ChannelTemplateProvider{
+						{ProviderName: "aliyun", ProviderTemplateID: providerTemplateID, AuditStatus: domain.AuditStatusApproved},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(templateSvc *templatemocks.MockChannelTemplateService, cli *smsmocks.MockClient)
+		wantStatus  domain.SendStatus
+		wantResults []domain.ReceiverResult
+	}{
+		{
+			name: "按语言分组使用各自的模版发送",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *smsmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), int64(1), "zh-CN", "aliyun", domain.ChannelSMS).
+					Return(newTemplate("测试签名", "SMS_ZH"), nil)
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), int64(1), "en-US", "aliyun", domain.ChannelSMS).
+					Return(newTemplate("Test", "SMS_EN"), nil)
+				cli.EXPECT().Send(client.SendReq{
+					PhoneNumbers:  []string{"13800138000", "13800138002"},
+					SignName:      "测试签名",
+					TemplateID:    "SMS_ZH",
+					TemplateParam: testNotification.Template.Params,
+				}).Return(client.SendResp{PhoneNumbers: map[string]client.SendRespStatus{
+					"13800138000": {Code: "OK", Message: "发送成功"},
+					"13800138002": {Code: "OK", Message: "发送成功"},
+				}}, nil)
+				cli.EXPECT().Send(client.SendReq{
+					PhoneNumbers:  []string{"13800138001"},
+					SignName:      "Test",
+					TemplateID:    "SMS_EN",
+					TemplateParam: testNotification.Template.Params,
+				}).Return(client.SendResp{PhoneNumbers: map[string]client.SendRespStatus{
+					"13800138001": {Code: "OK", Message: "发送成功"},
+				}}, nil)
+			},
+			wantStatus: domain.SendStatusSucceeded,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "13800138002", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "13800138001", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+			},
+		},
+		{
+			name: "一种语言没有可用的模版不影响其他语言",
+			setupMock: func(templateSvc *templatemocks.MockChannelTemplateService, cli *smsmocks.MockClient) {
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), int64(1), "zh-CN", "aliyun", domain.ChannelSMS).
+					Return(newTemplate("测试签名", "SMS_ZH"), nil)
+				templateSvc.EXPECT().
+					GetTemplateByIDAndProviderInfo(gomock.Any(), int64(1), int64(1), "en-US", "aliyun", domain.ChannelSMS).
+					Return(domain.ChannelTemplate{}, errs.ErrProviderNotFound)
+				cli.EXPECT().Send(gomock.Any()).Return(client.SendResp{PhoneNumbers: map[string]client.SendRespStatus{
+					"13800138000": {Code: "OK", Message: "发送成功"},
+					"13800138002": {Code: "OK", Message: "发送成功"},
+				}}, nil)
+			},
+			wantStatus: domain.SendStatusPartialSuccess,
+			wantResults: []domain.ReceiverResult{
+				{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{Receiver: "13800138002", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+				{
+					Receiver:  "13800138001",
+					Status:    domain.SendStatusFailed,
+					Message:   fmt.Errorf("%w: %w", errs.ErrSendNotificationFailed, errs.ErrProviderNotFound).Error(),
+					Provider:  "aliyun",
+					ErrorKind: domain.ProviderErrorKindRetryable,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTemplateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			mockClient := smsmocks.NewMockClient(ctrl)
+			tt.setupMock(mockTemplateSvc, mockClient)
+
+			mockClient.EXPECT().ErrorCodes().Return((&client.AliyunSMS{}).ErrorCodes())
+			provider := NewSMSProvider("aliyun", mockTemplateSvc, mockClient)
+			resp, err := provider.Send(context.Background(), testNotification)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.Status)
+			assert.Equal(t, tt.wantResults, resp.ReceiverResults)
+		})
+	}
+}
@@ -29,6 +29,10 @@ func NewSmsProviderV2(name string, templateSvc manage.ChannelTemplateService, cl
 	}
 }
 
+func (p *smsProviderV2) Name() string {
+	return p.name
+}
+
 // Send 发送短信
 func (p *smsProviderV2) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	// tmpl, err := p.templateSvc.GetTemplateV1(ctx,
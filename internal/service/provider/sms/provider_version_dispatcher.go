@@ -12,6 +12,10 @@ type ProviderDispatcher struct {
 	ps map[string]provider.Provider
 }
 
+func (p *ProviderDispatcher) Name() string {
+	return "version-dispatcher"
+}
+
 func (p *ProviderDispatcher) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	version, _ := ctx.Value("version").(string)
 	return p.ps[version].Send(ctx, notification)
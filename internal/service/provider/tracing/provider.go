@@ -29,6 +29,10 @@ func NewProvider(p provider.Provider, name string) *Provider {
 	}
 }
 
+func (p *Provider) Name() string {
+	return p.name
+}
+
 func (p *Provider) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
 	ctx, span := p.tracer.Start(ctx, "Provider.Send",
 		trace.WithAttributes(
@@ -10,6 +10,8 @@ import (
 //
 //go:generate mockgen -source=./types.go -destination=./mocks/provider.mock.go -package=providermocks -typed Provider
 type Provider interface {
+	// Name 供应商名称，与 providers 表中的 name 一致
+	Name() string
 	// Send 发送消息
 	Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error)
 }
@@ -20,6 +22,12 @@ type Selector interface {
 	Next(ctx context.Context, notification domain.Notification) (Provider, error)
 }
 
+// Peeker 选择器可以选实现的接口，返回下一次 Next 会选出的供应商，但是不改变选择器的状态，
+// 比如不推进轮询的位置。预览发送时使用
+type Peeker interface {
+	Peek(ctx context.Context, notification domain.Notification) (Provider, error)
+}
+
 // SelectorBuilder 供应商选择器的构造器
 type SelectorBuilder interface {
 	// Build 构造选择器，可以在Build方法上添加参数来构建更复杂的选择器
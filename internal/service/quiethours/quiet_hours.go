@@ -0,0 +1,55 @@
+package quiethours
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// Service 免打扰服务，按业务方的免打扰配置和模版的业务类型判断通知是否需要推迟发送
+//
+//go:generate mockgen -source=./quiet_hours.go -destination=./mocks/quiet_hours.mock.go -package=quiethoursmocks -typed Service
+type Service interface {
+	// NextAllowedTime 通知在 t 发送时落在免打扰时段内，返回时段结束的时间和 true，
+	// 不需要推迟时返回 t 和 false
+	NextAllowedTime(ctx context.Context, n domain.Notification, t time.Time) (time.Time, bool)
+}
+
+type service struct {
+	configSvc   configsvc.BusinessConfigService
+	templateSvc manage.ChannelTemplateService
+	logger      *elog.Component
+}
+
+func NewService(configSvc configsvc.BusinessConfigService, templateSvc manage.ChannelTemplateService) Service {
+	return &service{
+		configSvc:   configSvc,
+		templateSvc: templateSvc,
+		logger:      elog.DefaultLogger,
+	}
+}
+
+// NextAllowedTime 查询配置或者模版失败时不推迟，免打扰不能影响正常发送
+func (s *service) NextAllowedTime(ctx context.Context, n domain.Notification, t time.Time) (time.Time, bool) {
+	cfg, err := s.configSvc.GetByID(ctx, n.BizID)
+	if err != nil {
+		s.logger.Warn("查询业务配置失败，不检查免打扰", elog.Int64("bizID", n.BizID), elog.FieldErr(err))
+		return t, false
+	}
+	if cfg.QuietHours == nil || len(cfg.QuietHours.Intervals) == 0 {
+		return t, false
+	}
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		s.logger.Warn("查询模版失败，不检查免打扰", elog.Int64("templateID", n.Template.ID), elog.FieldErr(err))
+		return t, false
+	}
+	if !cfg.QuietHours.AppliesTo(tmpl.BusinessType) {
+		return t, false
+	}
+	return cfg.QuietHours.NextAllowedTime(t)
+}
@@ -0,0 +1,117 @@
+//go:build unit
+
+package quiethours
+
+import (
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	configmocks "gitee.com/flycash/notification-platform/internal/service/config/mocks"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_NextAllowedTime(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	assert.NoError(t, err)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 1, day, hour, minute, 0, 0, loc)
+	}
+	nightly := &domain.QuietHours{
+		Timezone: "Asia/Shanghai",
+		Intervals: []domain.QuietInterval{
+			{Start: "22:00", End: "08:00"},
+			{Start: "08:00", End: "08:30"},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		quietHours   *domain.QuietHours
+		businessType domain.BusinessType
+		sendTime     time.Time
+		wantTime     time.Time
+		wantDeferred bool
+	}{
+		{
+			name:         "深夜的营销短信推迟到第二天",
+			quietHours:   nightly,
+			businessType: domain.BusinessTypePromotion,
+			sendTime:     at(1, 23, 0),
+			// 08:00 结束之后紧接着另一个时段
+			wantTime:     at(2, 8, 30),
+			wantDeferred: true,
+		},
+		{
+			name:         "凌晨的营销短信推迟到当天早上",
+			quietHours:   nightly,
+			businessType: domain.BusinessTypePromotion,
+			sendTime:     at(2, 3, 0),
+			wantTime:     at(2, 8, 30),
+			wantDeferred: true,
+		},
+		{
+			name:         "按其他时区发送的时间判断",
+			quietHours:   nightly,
+			businessType: domain.BusinessTypePromotion,
+			sendTime:     time.Date(2026, 1, 1, 15, 0, 0, 0, time.UTC),
+			wantTime:     at(2, 8, 30),
+			wantDeferred: true,
+		},
+		{
+			name:         "白天不推迟",
+			quietHours:   nightly,
+			businessType: domain.BusinessTypePromotion,
+			sendTime:     at(1, 12, 0),
+			wantTime:     at(1, 12, 0),
+		},
+		{
+			name:         "验证码默认不受限制",
+			quietHours:   nightly,
+			businessType: domain.BusinessTypeVerificationCode,
+			sendTime:     at(1, 23, 0),
+			wantTime:     at(1, 23, 0),
+		},
+		{
+			name: "只对配置的业务类型生效",
+			quietHours: &domain.QuietHours{
+				Timezone:      "Asia/Shanghai",
+				Intervals:     nightly.Intervals,
+				BusinessTypes: []domain.BusinessType{domain.BusinessTypePromotion},
+			},
+			businessType: domain.BusinessTypeNotification,
+			sendTime:     at(1, 23, 0),
+			wantTime:     at(1, 23, 0),
+		},
+		{
+			name:         "没有配置免打扰",
+			businessType: domain.BusinessTypePromotion,
+			sendTime:     at(1, 23, 0),
+			wantTime:     at(1, 23, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+				Return(domain.BusinessConfig{ID: 100, QuietHours: tc.quietHours}, nil)
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).
+				Return(domain.ChannelTemplate{ID: 10, BusinessType: tc.businessType}, nil).AnyTimes()
+
+			n := domain.Notification{BizID: 100, Channel: domain.ChannelSMS, Template: domain.Template{ID: 10}}
+			got, deferred := NewService(configSvc, templateSvc).NextAllowedTime(t.Context(), n, tc.sendTime)
+			assert.Equal(t, tc.wantDeferred, deferred)
+			assert.True(t, tc.wantTime.Equal(got), "want %s, got %s", tc.wantTime, got)
+		})
+	}
+}
@@ -16,14 +16,24 @@ package quota
 
 import (
 	"context"
+	"fmt"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository"
 )
 
+const maxLedgerPageSize = 100
+
 type Service interface {
+	// ResetQuota 按业务配置重置每月额度，并记录重置流水
 	ResetQuota(ctx context.Context, biz domain.BusinessConfig) error
+	// GetQuota 查询业务方在某个渠道上的剩余额度
+	GetQuota(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error)
+	// AdjustQuota 管理员充值或扣减额度，delta 为正数表示充值，负数表示扣减，返回调整后的额度
+	AdjustQuota(ctx context.Context, bizID int64, channel domain.Channel, delta int32, reason, operator string) (domain.Quota, error)
+	// ListLedgers 按时间倒序分页查询额度流水，channel 为空时查询所有渠道，同时返回符合条件的总数
+	ListLedgers(ctx context.Context, bizID int64, channel domain.Channel, offset, limit int) ([]domain.QuotaLedger, int64, error)
 }
 
 type service struct {
@@ -35,18 +45,57 @@ func NewService(repo repository.QuotaRepository) Service {
 }
 
 func (s *service) ResetQuota(ctx context.Context, biz domain.BusinessConfig) error {
-	if biz.Quota == nil {
+	if biz.Quota == nil || len(biz.Quota.Monthly) == 0 {
 		return errs.ErrNoQuotaConfig
 	}
-	sms := domain.Quota{
-		BizID:   biz.ID,
-		Quota:   int32(biz.Quota.Monthly.SMS),
-		Channel: domain.ChannelSMS,
+	quotas := make([]domain.Quota, 0, len(biz.Quota.Monthly))
+	for channel, monthly := range biz.Quota.Monthly {
+		if !channel.IsValid() {
+			return fmt.Errorf("%w: 渠道 %s", errs.ErrInvalidParameter, channel)
+		}
+		quotas = append(quotas, domain.Quota{
+			BizID:      biz.ID,
+			Quota:      monthly,
+			Channel:    channel,
+			DailyLimit: biz.Quota.Daily[channel],
+		})
+	}
+	return s.repo.CreateOrUpdate(ctx, quotas...)
+}
+
+func (s *service) GetQuota(ctx context.Context, bizID int64, channel domain.Channel) (domain.Quota, error) {
+	if !channel.IsValid() {
+		return domain.Quota{}, fmt.Errorf("%w: 渠道 %s", errs.ErrInvalidParameter, channel)
+	}
+	return s.repo.Find(ctx, bizID, channel)
+}
+
+func (s *service) AdjustQuota(ctx context.Context, bizID int64, channel domain.Channel, delta int32, reason, operator string) (domain.Quota, error) {
+	if !channel.IsValid() {
+		return domain.Quota{}, fmt.Errorf("%w: 渠道 %s", errs.ErrInvalidParameter, channel)
+	}
+	if delta == 0 {
+		return domain.Quota{}, fmt.Errorf("%w: 调整量不能为0", errs.ErrInvalidParameter)
+	}
+	if reason == "" || operator == "" {
+		return domain.Quota{}, fmt.Errorf("%w: 调整原因和操作人不能为空", errs.ErrInvalidParameter)
+	}
+	return s.repo.Adjust(ctx, domain.QuotaLedger{
+		BizID:    bizID,
+		Channel:  channel,
+		Type:     domain.QuotaLedgerTypeAdjust,
+		Delta:    delta,
+		Reason:   reason,
+		Operator: operator,
+	})
+}
+
+func (s *service) ListLedgers(ctx context.Context, bizID int64, channel domain.Channel, offset, limit int) ([]domain.QuotaLedger, int64, error) {
+	if channel != "" && !channel.IsValid() {
+		return nil, 0, fmt.Errorf("%w: 渠道 %s", errs.ErrInvalidParameter, channel)
 	}
-	email := domain.Quota{
-		BizID:   biz.ID,
-		Quota:   int32(biz.Quota.Monthly.EMAIL),
-		Channel: domain.ChannelEmail,
+	if offset < 0 || limit <= 0 || limit > maxLedgerPageSize {
+		return nil, 0, fmt.Errorf("%w: offset = %d, limit = %d", errs.ErrInvalidParameter, offset, limit)
 	}
-	return s.repo.CreateOrUpdate(ctx, sms, email)
+	return s.repo.FindLedgers(ctx, bizID, channel, offset, limit)
 }
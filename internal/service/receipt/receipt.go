@@ -0,0 +1,144 @@
+package receipt
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	// 阿里云、腾讯云最多只能查询最近7天的回执，超过2天还没有回执的基本就不会再有了
+	syncWindow = 48 * time.Hour
+	// 给供应商留出推送回执的时间，刚发送的记录先不主动查询
+	syncDelay = time.Minute
+)
+
+// Service 供应商回执服务，负责处理供应商推送的回执以及主动查询回执
+//
+//go:generate mockgen -source=./receipt.go -destination=./mocks/receipt.mock.go -package=receiptmocks -typed Service
+type Service interface {
+	// HandleReceipts 处理回执，所有接收者都拿到回执的通知会更新为 DELIVERED 或 UNDELIVERED 等最终状态，并再次回调业务方
+	HandleReceipts(ctx context.Context, receipts []domain.DeliveryReceipt) error
+	// SyncReceipts 主动向供应商查询 ID 大于 startID 的一批等待回执的记录，返回下一批的起始ID和本批次的记录数
+	SyncReceipts(ctx context.Context, startID uint64, limit int) (nextStartID uint64, count int, err error)
+}
+
+type service struct {
+	repo             repository.DeliveryReceiptRepository
+	notificationRepo repository.NotificationRepository
+	callbackSvc      callback.Service
+	clients          map[string]client.Client
+	logger           *elog.Component
+}
+
+func NewService(
+	repo repository.DeliveryReceiptRepository,
+	notificationRepo repository.NotificationRepository,
+	callbackSvc callback.Service,
+	clients map[string]client.Client,
+) Service {
+	return &service{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		callbackSvc:      callbackSvc,
+		clients:          clients,
+		logger:           elog.DefaultLogger.With(elog.FieldComponent("receipt")),
+	}
+}
+
+func (s *service) HandleReceipts(ctx context.Context, receipts []domain.DeliveryReceipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+	ids, err := s.repo.Save(ctx, receipts)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	notifications, err := s.notificationRepo.BatchGetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	list := make([]domain.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		list = append(list, n)
+	}
+	// 回调失败时回调记录已经被重新标记为待回调，由异步回调任务兜底
+	err = s.callbackSvc.SendCallbackByNotifications(ctx, list)
+	if err != nil {
+		s.logger.Warn("回执触发回调失败", elog.FieldErr(err))
+	}
+	return nil
+}
+
+func (s *service) SyncReceipts(ctx context.Context, startID uint64, limit int) (nextStartID uint64, count int, err error) {
+	providers := make([]string, 0, len(s.clients))
+	for name := range s.clients {
+		providers = append(providers, name)
+	}
+	now := time.Now()
+	awaiting, err := s.repo.FindAwaiting(ctx, providers, startID,
+		now.Add(-syncWindow).UnixMilli(), now.Add(-syncDelay).UnixMilli(), limit)
+	if err != nil || len(awaiting) == 0 {
+		return startID, 0, err
+	}
+
+	receipts := make([]domain.DeliveryReceipt, 0, len(awaiting))
+	for i := range awaiting {
+		receipt, ok, err1 := s.query(awaiting[i])
+		if err1 != nil {
+			// 单条查询失败不影响其他记录，下一轮再查
+			s.logger.Warn("查询短信回执失败",
+				elog.String("provider", awaiting[i].Provider),
+				elog.String("messageID", awaiting[i].MessageID),
+				elog.FieldErr(err1))
+			continue
+		}
+		if ok {
+			receipts = append(receipts, receipt)
+		}
+	}
+	return awaiting[len(awaiting)-1].ID, len(awaiting), s.HandleReceipts(ctx, receipts)
+}
+
+// query 查询单个接收者的回执，还在等待回执时返回 false
+func (s *service) query(awaiting domain.AwaitingReceipt) (domain.DeliveryReceipt, bool, error) {
+	const pageSize = 10
+	sendTime := time.UnixMilli(awaiting.SendTime)
+	resp, err := s.clients[awaiting.Provider].QuerySendDetails(client.QuerySendDetailsReq{
+		PhoneNumber: awaiting.Receiver,
+		BizID:       awaiting.MessageID,
+		SendDate:    sendTime.Format("20060102"),
+		PageSize:    pageSize,
+		CurrentPage: 1,
+		BeginTime:   sendTime.Add(-syncDelay).Unix(),
+		EndTime:     time.Now().Unix(),
+		Limit:       pageSize,
+	})
+	if err != nil {
+		return domain.DeliveryReceipt{}, false, err
+	}
+	phone := strings.TrimPrefix(awaiting.Receiver, "+86")
+	for _, detail := range resp.SmsSendDetailDTOs {
+		if strings.TrimPrefix(detail.PhoneNum, "+86") != phone {
+			continue
+		}
+		switch client.SendStatus(detail.SendStatus) {
+		case client.SendStatusSuccess, client.SendStatusFailed:
+			return domain.DeliveryReceipt{
+				Provider:  awaiting.Provider,
+				MessageID: awaiting.MessageID,
+				Receiver:  awaiting.Receiver,
+				Delivered: client.SendStatus(detail.SendStatus) == client.SendStatusSuccess,
+				Code:      detail.ErrCode,
+			}, true, nil
+		default:
+		}
+	}
+	return domain.DeliveryReceipt{}, false, nil
+}
@@ -0,0 +1,55 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"github.com/meoying/dlock-go"
+)
+
+// SyncTask 主动向供应商查询回执，作为供应商推送回执的兜底
+type SyncTask struct {
+	dclient dlock.Client
+	svc     Service
+}
+
+func NewSyncTask(dclient dlock.Client, svc Service) *SyncTask {
+	return &SyncTask{dclient: dclient, svc: svc}
+}
+
+func (s *SyncTask) Start(ctx context.Context) {
+	const key = "notification_handling_sync_delivery_receipt"
+	lj := loopjob.NewInfiniteLoop(s.dclient, s.HandleSyncReceipts, key)
+	lj.Run(ctx)
+}
+
+func (s *SyncTask) HandleSyncReceipts(ctx context.Context) error {
+	const batchSize = 100
+	// 供应商查询接口有频率限制，不需要太频繁
+	const minDuration = 30 * time.Second
+
+	now := time.Now()
+
+	var startID uint64
+	for {
+		nextStartID, count, err := s.svc.SyncReceipts(ctx, startID, batchSize)
+		if err != nil {
+			return fmt.Errorf("同步供应商回执失败: %w", err)
+		}
+
+		if count < batchSize {
+			break
+		}
+		startID = nextStartID
+	}
+
+	// 确保任务至少运行minDuration时间，避免过快重复执行
+	duration := time.Since(now)
+	if duration < minDuration {
+		time.Sleep(minDuration - duration)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+// deferQuietHours 把现在落在免打扰时段内的通知推迟到时段结束之后，返回剩下可以发送的通知。
+// 推迟失败的通知本轮也不发送，下一轮调度会再次检查
+func deferQuietHours(ctx context.Context,
+	quietHours quiethours.Service,
+	notifications []domain.Notification,
+	reschedule func(ctx context.Context, n domain.Notification) error,
+) []domain.Notification {
+	now := time.Now()
+	res := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		t, ok := quietHours.NextAllowedTime(ctx, notifications[i], now)
+		if !ok {
+			res = append(res, notifications[i])
+			continue
+		}
+		notifications[i].DeferSendTime(t)
+		if err := reschedule(ctx, notifications[i]); err != nil {
+			elog.DefaultLogger.Warn("免打扰推迟通知失败",
+				elog.Any("notificationID", notifications[i].ID),
+				elog.FieldErr(err))
+		}
+	}
+	return res
+}
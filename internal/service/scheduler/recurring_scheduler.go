@@ -0,0 +1,100 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	idgen "gitee.com/flycash/notification-platform/internal/pkg/id_generator"
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/meoying/dlock-go"
+)
+
+// RecurringScheduler 周期通知调度器，把快要到发送时间的周期通知生成为独立的通知，
+// 生成的通知使用时间窗口发送策略，之后由通知调度器按时间发送
+type RecurringScheduler struct {
+	repo         repository.RecurringNotificationRepository
+	sendStrategy sendstrategy.SendStrategy
+	idGenerator  *idgen.Generator
+	dclient      dlock.Client
+	logger       *elog.Component
+
+	// lookahead 提前生成通知的时间，避免错过发送的时间窗口
+	lookahead time.Duration
+	batchSize int
+}
+
+// NewRecurringScheduler 创建周期通知调度器
+func NewRecurringScheduler(
+	repo repository.RecurringNotificationRepository,
+	sendStrategy sendstrategy.SendStrategy,
+	dclient dlock.Client,
+) *RecurringScheduler {
+	const (
+		defaultLookahead = time.Minute
+		defaultBatchSize = 10
+	)
+	return &RecurringScheduler{
+		repo:         repo,
+		sendStrategy: sendStrategy,
+		idGenerator:  idgen.NewGenerator(),
+		dclient:      dclient,
+		logger:       elog.DefaultLogger,
+		lookahead:    defaultLookahead,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+// Start 启动调度服务
+// 当 ctx 被取消的或者关闭的时候，就会结束循环
+func (s *RecurringScheduler) Start(ctx context.Context) {
+	const key = "notification_handling_recurring"
+	lj := loopjob.NewInfiniteLoop(s.dclient, s.processRecurringNotifications, key)
+	lj.Run(ctx)
+}
+
+// processRecurringNotifications 处理快要到发送时间的周期通知
+func (s *RecurringScheduler) processRecurringNotifications(ctx context.Context) error {
+	const defaultTimeout = 3 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	recurring, err := s.repo.FindDue(ctx, time.Now().Add(s.lookahead), s.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(recurring) == 0 {
+		time.Sleep(time.Second)
+		return nil
+	}
+	for i := range recurring {
+		s.materialize(ctx, recurring[i])
+	}
+	return nil
+}
+
+// materialize 生成本次发送的通知并推进到下一次发送时间。
+// 生成失败（例如额度不足）时跳过本次发送，不影响之后的发送
+func (s *RecurringScheduler) materialize(ctx context.Context, r domain.RecurringNotification) {
+	occurrence := r.Occurrence()
+	occurrence.ID = uint64(s.idGenerator.GenerateID(occurrence.BizID, occurrence.Key))
+	_, err := s.sendStrategy.Send(ctx, occurrence)
+	// 唯一索引冲突说明上一次生成之后推进失败了，这一次直接推进就可以
+	if err != nil && !errors.Is(err, errs.ErrNotificationDuplicate) {
+		s.logger.Error("生成周期通知失败，跳过本次发送",
+			elog.Any("recurringID", r.ID),
+			elog.String("key", occurrence.Key),
+			elog.FieldErr(err))
+	}
+	if err = r.Advance(); err != nil {
+		s.logger.Error("计算周期通知下一次发送时间失败", elog.Any("recurringID", r.ID), elog.FieldErr(err))
+		return
+	}
+	if err = s.repo.CASAdvance(ctx, r); err != nil {
+		s.logger.Error("更新周期通知失败", elog.Any("recurringID", r.ID), elog.FieldErr(err))
+	}
+}
@@ -0,0 +1,127 @@
+//go:build unit
+
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	sendstrategymocks "gitee.com/flycash/notification-platform/internal/service/sendstrategy/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecurringScheduler_ProcessRecurringNotifications(t *testing.T) {
+	t.Parallel()
+
+	// 每天九点发送
+	nextTime := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	recurring := func(key string, maxOccurrences int32) domain.RecurringNotification {
+		return domain.RecurringNotification{
+			ID: 1,
+			Notification: domain.Notification{
+				BizID:     100,
+				Key:       key,
+				Receivers: []string{"user-1"},
+				Channel:   domain.ChannelSMS,
+				Template:  domain.Template{ID: 10, VersionID: 11},
+				SendStrategyConfig: domain.SendStrategyConfig{
+					Type:           domain.SendStrategyRecurring,
+					Cron:           "0 9 * * *",
+					Timezone:       "UTC",
+					MaxOccurrences: maxOccurrences,
+				},
+			},
+			NextTime: nextTime,
+			Status:   domain.RecurringStatusActive,
+			Version:  1,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		recurring domain.RecurringNotification
+		sendErr   error
+		check     func(t *testing.T, sent domain.Notification, advanced domain.RecurringNotification)
+	}{
+		{
+			name:      "生成本次通知并推进到第二天",
+			recurring: recurring("daily", 0),
+			check: func(t *testing.T, sent domain.Notification, advanced domain.RecurringNotification) {
+				assert.Equal(t, "daily:20260101090000", sent.Key)
+				assert.NotZero(t, sent.ID)
+				assert.Equal(t, domain.SendStrategyTimeWindow, sent.SendStrategyConfig.Type)
+				assert.Equal(t, nextTime, sent.SendStrategyConfig.StartTime)
+				assert.Equal(t, int32(1), advanced.Occurrences)
+				assert.Equal(t, nextTime.Add(24*time.Hour), advanced.NextTime.UTC())
+				assert.Equal(t, domain.RecurringStatusActive, advanced.Status)
+			},
+		},
+		{
+			name:      "达到最大发送次数",
+			recurring: recurring("once", 1),
+			check: func(t *testing.T, _ domain.Notification, advanced domain.RecurringNotification) {
+				assert.Equal(t, int32(1), advanced.Occurrences)
+				assert.Equal(t, domain.RecurringStatusFinished, advanced.Status)
+			},
+		},
+		{
+			name:      "本次通知已经生成过",
+			recurring: recurring("daily", 0),
+			sendErr:   errs.ErrNotificationDuplicate,
+			check: func(t *testing.T, _ domain.Notification, advanced domain.RecurringNotification) {
+				assert.Equal(t, int32(1), advanced.Occurrences)
+			},
+		},
+		{
+			name:      "额度不足时跳过本次发送",
+			recurring: recurring("daily", 0),
+			sendErr:   errs.ErrNoQuota,
+			check: func(t *testing.T, _ domain.Notification, advanced domain.RecurringNotification) {
+				assert.Equal(t, nextTime.Add(24*time.Hour), advanced.NextTime.UTC())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var sent domain.Notification
+			strategy := sendstrategymocks.NewMockSendStrategy(ctrl)
+			strategy.EXPECT().Send(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+					sent = n
+					return domain.SendResponse{NotificationID: n.ID}, tc.sendErr
+				})
+			repo := &fakeRecurringRepo{due: []domain.RecurringNotification{tc.recurring}}
+			s := NewRecurringScheduler(repo, strategy, nil)
+
+			require.NoError(t, s.processRecurringNotifications(t.Context()))
+			require.Len(t, repo.advanced, 1)
+			tc.check(t, sent, repo.advanced[0])
+		})
+	}
+}
+
+type fakeRecurringRepo struct {
+	repository.RecurringNotificationRepository
+	due      []domain.RecurringNotification
+	advanced []domain.RecurringNotification
+}
+
+func (f *fakeRecurringRepo) FindDue(_ context.Context, _ time.Time, _ int) ([]domain.RecurringNotification, error) {
+	return f.due, nil
+}
+
+func (f *fakeRecurringRepo) CASAdvance(_ context.Context, r domain.RecurringNotification) error {
+	f.advanced = append(f.advanced, r)
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
 	"github.com/meoying/dlock-go"
 
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
@@ -22,6 +24,7 @@ type NotificationScheduler interface {
 type staticScheduler struct {
 	notificationSvc notificationsvc.Service
 	sender          sender.NotificationSender
+	quietHours      quiethours.Service
 	dclient         dlock.Client
 
 	batchSize int
@@ -31,12 +34,14 @@ type staticScheduler struct {
 func NewScheduler(
 	notificationSvc notificationsvc.Service,
 	dispatcher sender.NotificationSender,
+	quietHours quiethours.Service,
 	dclient dlock.Client,
 ) NotificationScheduler {
 	const defaultBatchSize = 10
 	return &staticScheduler{
 		notificationSvc: notificationSvc,
 		sender:          dispatcher,
+		quietHours:      quietHours,
 		batchSize:       defaultBatchSize,
 		dclient:         dclient,
 	}
@@ -64,6 +69,25 @@ func (s *staticScheduler) processPendingNotifications(ctx context.Context) error
 		time.Sleep(time.Second)
 		return nil
 	}
+	notifications = deferQuietHours(ctx, s.quietHours, notifications, s.reschedule)
+	if len(notifications) == 0 {
+		return nil
+	}
+	// 发送器先把通知从 PENDING CAS 为 SENDING 并增加版本号，查询之后被取消或者修改的通知不会再发送
 	_, err = s.sender.BatchSend(ctx, notifications)
 	return err
 }
+
+// reschedule 修改通知的发送时间窗口，和取消、修改通知一样通过版本号控制并发
+func (s *staticScheduler) reschedule(ctx context.Context, n domain.Notification) error {
+	_, err := s.notificationSvc.Update(ctx, domain.NotificationUpdate{
+		BizID: n.BizID,
+		Key:   n.Key,
+		SendStrategyConfig: &domain.SendStrategyConfig{
+			Type:      domain.SendStrategyTimeWindow,
+			StartTime: n.ScheduledSTime,
+			EndTime:   n.ScheduledETime,
+		},
+	})
+	return err
+}
@@ -11,6 +11,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
 	"gitee.com/flycash/notification-platform/internal/pkg/sharding"
 	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"github.com/meoying/dlock-go"
 )
@@ -19,6 +20,7 @@ import (
 type ShardingScheduler struct {
 	repo              repository.NotificationRepository
 	sender            sender.NotificationSender
+	quietHours        quiethours.Service
 	minLoopDuration   time.Duration
 	batchSize         atomic.Uint64
 	batchSizeAdjuster batchsize.Adjuster
@@ -31,6 +33,7 @@ type ShardingScheduler struct {
 func NewShardingScheduler(
 	repo repository.NotificationRepository,
 	notificationSender sender.NotificationSender,
+	quietHours quiethours.Service,
 	dclient dlock.Client,
 	shardingStrategy sharding.ShardingStrategy,
 	sem loopjob.ResourceSemaphore,
@@ -43,6 +46,7 @@ func NewShardingScheduler(
 	s := &ShardingScheduler{
 		repo:              repo,
 		sender:            notificationSender,
+		quietHours:        quietHours,
 		minLoopDuration:   minLoopDuration,
 		batchSizeAdjuster: batchSizeAdjuster,
 		errorEvents:       errorEvents,
@@ -107,6 +111,12 @@ func (s *ShardingScheduler) batchSendReadyNotifications(ctx context.Context) (in
 		return 0, nil
 	}
 
+	cnt := len(notifications)
+	notifications = deferQuietHours(loopCtx, s.quietHours, notifications, s.repo.CASUpdate)
+	if len(notifications) == 0 {
+		return cnt, nil
+	}
+	// 发送器先把通知从 PENDING CAS 为 SENDING 并增加版本号，查询之后被取消或者修改的通知不会再发送
 	_, err = s.sender.BatchSend(ctx, notifications)
-	return len(notifications), err
+	return cnt, err
 }
@@ -130,17 +130,21 @@ func (m *MetricsSender) BatchSend(ctx context.Context, notifications []domain.No
 
 	// 记录各状态的数量
 	if err == nil && len(responses) > 0 {
-		var succeeded, failed int
+		var succeeded, partialSucceeded, failed int
 		for _, resp := range responses {
-			if resp.Status == domain.SendStatusSucceeded {
+			switch resp.Status {
+			case domain.SendStatusSucceeded:
 				succeeded++
-			} else {
+			case domain.SendStatusPartialSuccess:
+				partialSucceeded++
+			default:
 				failed++
 			}
 		}
 
-		// 记录成功和失败的通知数量
+		// 记录成功、部分成功和失败的通知数量
 		m.notificationSentStatus.WithLabelValues(channel, string(domain.SendStatusSucceeded)).Add(float64(succeeded))
+		m.notificationSentStatus.WithLabelValues(channel, string(domain.SendStatusPartialSuccess)).Add(float64(partialSucceeded))
 		m.notificationSentStatus.WithLabelValues(channel, string(domain.SendStatusFailed)).Add(float64(failed))
 	}
 
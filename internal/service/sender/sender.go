@@ -2,15 +2,20 @@ package sender
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/elog"
 )
@@ -32,6 +37,10 @@ type sender struct {
 	callbackSvc callback.Service
 	channel     channel.Channel
 	taskPool    pool.TaskPool
+	// frequencyCap 为 nil 时不检查接收者发送频率上限
+	frequencyCap frequencycap.Service
+	// suppressionSvc 为 nil 时不自动退订无效的接收者
+	suppressionSvc suppression.Service
 
 	logger *elog.Component
 }
@@ -43,35 +52,69 @@ func NewSender(
 	callbackSvc callback.Service,
 	channel channel.Channel,
 	taskPool pool.TaskPool,
+	frequencyCap frequencycap.Service,
+	suppressionSvc suppression.Service,
 ) NotificationSender {
 	return &sender{
-		repo:        repo,
-		configSvc:   configSvc,
-		callbackSvc: callbackSvc,
-		channel:     channel,
-		taskPool:    taskPool,
-		logger:      elog.DefaultLogger,
+		repo:           repo,
+		configSvc:      configSvc,
+		callbackSvc:    callbackSvc,
+		channel:        channel,
+		taskPool:       taskPool,
+		frequencyCap:   frequencyCap,
+		suppressionSvc: suppressionSvc,
+		logger:         elog.DefaultLogger,
 	}
 }
 
 // Send 单条发送通知
 func (d *sender) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	resp := domain.SendResponse{
-		NotificationID: notification.ID,
+	notification, err := d.claim(ctx, notification)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	var sendResp domain.SendResponse
+	if notification.IsDeadlinePassed(time.Now()) {
+		err = d.deadlinePassedError(notification)
+	} else {
+		capResult := d.checkFrequencyCap(ctx, notification)
+		if capResult.Deferred() {
+			return d.deferNotification(ctx, notification, capResult.DeferUntil)
+		}
+		sendResp, err = d.send(ctx, notification, capResult)
 	}
-	_, err := d.channel.Send(ctx, notification)
+	resp := d.buildResponse(notification, sendResp, err)
+	d.reportReceiverFaults(ctx, notification, resp)
+	notification.Status = resp.Status
+	notification.ReceiverResults = resp.ReceiverResults
+	notification.SentChannel = resp.SentChannel
+	notification.Error = domain.NewSendError(err)
 	if err != nil {
 		d.logger.Error("发送失败 %w", elog.FieldErr(err))
-		resp.Status = domain.SendStatusFailed
-		notification.Status = domain.SendStatusFailed
-		// 如果是 FAILED，你需要把 quota 加回去
+		notification.SetNextRetryTimeAndStatus(d.retryChannelConfig(ctx, notification.BizID, err), err.Error())
+		if notification.Status == domain.SendStatusRetrying {
+			// 等待重试，额度不归还，也不回调，由重试任务再次发送
+			if err = d.repo.MarkRetrying(ctx, notification); err != nil {
+				return domain.SendResponse{}, err
+			}
+			resp.Status = domain.SendStatusRetrying
+			return resp, nil
+		}
+		// MarkFailed 会归还创建通知时扣减的额度
 		err = d.repo.MarkFailed(ctx, notification)
 	} else {
-		resp.Status = domain.SendStatusSucceeded
-		notification.Status = domain.SendStatusSucceeded
 		err = d.repo.MarkSuccess(ctx, notification)
 	}
 
+	// 发送耗时超过了 SENDING 的超时时间，通知已经被超时任务标记为失败并且归还了额度。
+	// 消息已经发出去了，把真实的发送结果返回给调用方，只记录日志
+	if errors.Is(err, errs.ErrNotificationVersionMismatch) {
+		d.logger.Warn("通知已经被超时任务标记为失败，发送结果没有保存",
+			elog.Any("notificationID", notification.ID),
+			elog.String("status", resp.Status.String()),
+			elog.FieldErr(err))
+		return resp, nil
+	}
 	// 更新发送状态
 	if err != nil {
 		return domain.SendResponse{}, err
@@ -84,15 +127,229 @@ func (d *sender) Send(ctx context.Context, notification domain.Notification) (do
 	return resp, nil
 }
 
+// claim 发送前把通知 CAS 为 SENDING 并且增加版本号，之后取消或者修改通知都会失败，
+// 发送结果也只会更新到 SENDING 的通知上。调用方已经改为 SENDING 的通知不再重复抢占
+func (d *sender) claim(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
+	if notification.Status == domain.SendStatusSending {
+		return notification, nil
+	}
+	return d.repo.ClaimSending(ctx, notification)
+}
+
+// deadlinePassedError 超过计划发送结束时间的通知不再发送，也不重试
+func (d *sender) deadlinePassedError(notification domain.Notification) error {
+	return fmt.Errorf("%w: 计划发送结束时间为 %s", errs.ErrDeadlinePassed, notification.ScheduledETime.Format(time.DateTime))
+}
+
+// checkFrequencyCap 检查接收者的发送频率上限，没有配置频率上限服务时所有接收者都可以发送
+func (d *sender) checkFrequencyCap(ctx context.Context, notification domain.Notification) frequencycap.Result {
+	if d.frequencyCap == nil {
+		return frequencycap.Result{Allowed: notification.Receivers}
+	}
+	return d.frequencyCap.Check(ctx, notification)
+}
+
+// deferNotification 接收者超过发送频率上限时，把通知重新放回待发送状态，推迟到可以发送的时间，
+// 额度不归还，也不回调
+func (d *sender) deferNotification(ctx context.Context, notification domain.Notification, until time.Time) (domain.SendResponse, error) {
+	notification.Status = domain.SendStatusPending
+	notification.DeferSendTime(until)
+	if err := d.repo.MarkDeferred(ctx, notification); err != nil {
+		return domain.SendResponse{}, err
+	}
+	return domain.SendResponse{
+		NotificationID: notification.ID,
+		Status:         domain.SendStatusPending,
+	}, nil
+}
+
+// send 只发送给没有超过频率上限的接收者，超过上限的接收者记录为发送失败
+func (d *sender) send(ctx context.Context, notification domain.Notification, capResult frequencycap.Result) (domain.SendResponse, error) {
+	if len(capResult.Capped) == 0 {
+		return d.sendWithFailover(ctx, notification)
+	}
+	if len(capResult.Allowed) == 0 {
+		return domain.SendResponse{ReceiverResults: capResult.Capped}, fmt.Errorf("%w", errs.ErrReceiverFrequencyCapped)
+	}
+	allowed := notification
+	allowed.Receivers = capResult.Allowed
+	resp, err := d.sendWithFailover(ctx, allowed)
+	if err != nil && len(resp.ReceiverResults) == 0 {
+		resp.ReceiverResults = domain.NewReceiverResults(allowed.Receivers, domain.SendStatusFailed, "", "", err.Error())
+	}
+	resp.ReceiverResults = append(resp.ReceiverResults, capResult.Capped...)
+	if err == nil {
+		resp.Status = domain.AggregateSendStatus(resp.ReceiverResults)
+	}
+	return resp, err
+}
+
+// sendWithFailover 在通知的渠道上发送，失败时如果业务方开启了跨渠道降级，按优先级依次尝试其他渠道，
+// 发送成功时返回的结果中带有实际使用的渠道，全部失败时返回原渠道的发送结果。
+// 降级发送前把额度转到目标渠道，目标渠道额度不足时跳过该渠道，发送失败时再把额度转回原渠道，
+// 所以额度最终扣减在实际发送的渠道上
+func (d *sender) sendWithFailover(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	resp, err := d.channel.Send(ctx, notification)
+	if err == nil {
+		resp.SentChannel = notification.Channel
+		return resp, nil
+	}
+	for _, fallback := range d.failoverNotifications(ctx, notification) {
+		if terr := d.repo.TransferQuota(ctx, notification, fallback.Channel); terr != nil {
+			d.logger.Warn("跨渠道降级扣减额度失败",
+				elog.Any("notificationID", notification.ID),
+				elog.String("channel", fallback.Channel.String()),
+				elog.FieldErr(terr),
+			)
+			continue
+		}
+		fresp, ferr := d.channel.Send(ctx, fallback)
+		if ferr == nil {
+			fresp.SentChannel = fallback.Channel
+			return fresp, nil
+		}
+		d.logger.Warn("跨渠道降级发送失败",
+			elog.Any("notificationID", notification.ID),
+			elog.String("channel", fallback.Channel.String()),
+			elog.FieldErr(ferr),
+		)
+		if terr := d.repo.TransferQuota(ctx, fallback, notification.Channel); terr != nil {
+			d.logger.Error("跨渠道降级发送失败，额度转回原渠道失败",
+				elog.Any("notificationID", notification.ID),
+				elog.String("channel", fallback.Channel.String()),
+				elog.FieldErr(terr),
+			)
+		}
+	}
+	return resp, err
+}
+
+// channelConfig 获取业务方的渠道配置，获取失败时视为没有配置
+func (d *sender) channelConfig(ctx context.Context, bizID int64) *domain.ChannelConfig {
+	cfg, err := d.configSvc.GetByID(ctx, bizID)
+	if err != nil {
+		d.logger.Warn("获取业务配置失败", elog.Int64("bizID", bizID), elog.FieldErr(err))
+		return nil
+	}
+	return cfg.ChannelConfig
+}
+
+// retryChannelConfig 获取重试使用的渠道配置，所有接收者都超过发送频率上限，
+// 都是无效的接收者，或者已经超过计划发送结束时间时不重试
+func (d *sender) retryChannelConfig(ctx context.Context, bizID int64, err error) *domain.ChannelConfig {
+	if errors.Is(err, errs.ErrReceiverFrequencyCapped) ||
+		errors.Is(err, errs.ErrInvalidReceiver) ||
+		errors.Is(err, errs.ErrDeadlinePassed) {
+		return nil
+	}
+	return d.channelConfig(ctx, bizID)
+}
+
+// reportReceiverFaults 上报因为接收者本身的问题发送失败的结果，用于自动退订，上报失败不影响发送
+func (d *sender) reportReceiverFaults(ctx context.Context, notification domain.Notification, resp domain.SendResponse) {
+	if d.suppressionSvc == nil {
+		return
+	}
+	ch := resp.SentChannel
+	if ch == "" {
+		ch = notification.Channel
+	}
+	err := d.suppressionSvc.ReportReceiverFaults(ctx, notification.BizID, ch, resp.ReceiverResults)
+	if err != nil {
+		d.logger.Warn("上报无效的接收者失败", elog.Any("notificationID", notification.ID), elog.FieldErr(err))
+	}
+}
+
+// failoverNotifications 按业务方的渠道配置构造降级到其他渠道的通知，模版替换为目标渠道的模版。
+// 不同渠道的接收者类型不同，接收者使用业务方为目标渠道指定的接收者，没有指定接收者的渠道不会降级
+func (d *sender) failoverNotifications(ctx context.Context, notification domain.Notification) []domain.Notification {
+	if len(notification.FailoverReceivers) == 0 {
+		return nil
+	}
+	cfg := d.channelConfig(ctx, notification.BizID)
+	if cfg == nil {
+		return nil
+	}
+	items := cfg.FailoverChannels(notification.Channel)
+	res := make([]domain.Notification, 0, len(items))
+	for i := range items {
+		templateID, ok := items[i].Templates[notification.Template.ID]
+		if !ok {
+			continue
+		}
+		ch := domain.Channel(items[i].Channel)
+		receivers := notification.FailoverReceivers[ch]
+		if len(receivers) == 0 {
+			continue
+		}
+		fallback := notification
+		fallback.Channel = ch
+		fallback.Receivers = receivers
+		// 单独指定的语言以原渠道的接收者为键，目标渠道的接收者都使用通知的语言
+		fallback.ReceiverLocales = nil
+		fallback.Template.ID = templateID
+		// 目标渠道使用模版当前发布的版本
+		fallback.Template.VersionID = 0
+		res = append(res, fallback)
+	}
+	return res
+}
+
+// buildResponse 根据渠道的发送结果确定通知的发送状态以及每个接收者的发送结果
+func (d *sender) buildResponse(notification domain.Notification, sendResp domain.SendResponse, err error) domain.SendResponse {
+	resp := domain.SendResponse{
+		NotificationID:  notification.ID,
+		ReceiverResults: sendResp.ReceiverResults,
+		SentChannel:     sendResp.SentChannel,
+	}
+	if err != nil {
+		resp.Status = domain.SendStatusFailed
+		resp.Error = domain.NewSendError(err)
+		if len(resp.ReceiverResults) == 0 {
+			resp.ReceiverResults = domain.NewReceiverResults(notification.Receivers, domain.SendStatusFailed, "", "", err.Error())
+		}
+		return resp
+	}
+
+	resp.Status = domain.SendStatusSucceeded
+	if sendResp.Status == domain.SendStatusPartialSuccess {
+		resp.Status = domain.SendStatusPartialSuccess
+	}
+	// 供应商没有返回每个接收者的结果，视为全部发送成功
+	if len(resp.ReceiverResults) == 0 {
+		resp.ReceiverResults = domain.NewReceiverResults(notification.Receivers, domain.SendStatusSucceeded, "", "", "")
+	}
+	return resp
+}
+
 // BatchSend 批量发送通知
 func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
 	if len(notifications) == 0 {
 		return nil, nil
 	}
 
+	claimed := make([]domain.Notification, 0, len(notifications))
+	for i := range notifications {
+		n, err := d.claim(ctx, notifications[i])
+		if err != nil {
+			// 已经被取消、修改或者被其他节点抢先发送的通知跳过，其他错误留到下一轮调度
+			d.logger.Warn("抢占通知失败，跳过发送", elog.Any("notificationID", notifications[i].ID), elog.FieldErr(err))
+			continue
+		}
+		claimed = append(claimed, n)
+	}
+	notifications = claimed
+	if len(notifications) == 0 {
+		return nil, nil
+	}
+
 	// 并发发送通知
-	var succeedMu, failedMu sync.Mutex
+	var succeedMu, failedMu, deferredMu sync.Mutex
 	var succeed, failed []domain.SendResponse
+	// 发送失败的原因，记录到发送尝试中
+	failedErrs := make(map[uint64]error)
+	// 接收者超过发送频率上限，需要推迟发送的通知
+	var deferred []domain.Notification
 
 	var wg sync.WaitGroup
 	wg.Add(len(notifications))
@@ -100,20 +357,29 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 		n := notifications[i]
 		err := d.taskPool.Submit(ctx, pool.TaskFunc(func(ctx context.Context) error {
 			defer wg.Done()
-			_, err := d.channel.Send(ctx, n)
-			if err != nil {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusFailed,
+			var sendResp domain.SendResponse
+			var err error
+			if n.IsDeadlinePassed(time.Now()) {
+				err = d.deadlinePassedError(n)
+			} else {
+				capResult := d.checkFrequencyCap(ctx, n)
+				if capResult.Deferred() {
+					n.DeferSendTime(capResult.DeferUntil)
+					deferredMu.Lock()
+					deferred = append(deferred, n)
+					deferredMu.Unlock()
+					return nil
 				}
+				sendResp, err = d.send(ctx, n, capResult)
+			}
+			resp := d.buildResponse(n, sendResp, err)
+			d.reportReceiverFaults(ctx, n, resp)
+			if err != nil {
 				failedMu.Lock()
 				failed = append(failed, resp)
+				failedErrs[n.ID] = err
 				failedMu.Unlock()
 			} else {
-				resp := domain.SendResponse{
-					NotificationID: n.ID,
-					Status:         domain.SendStatusSucceeded,
-				}
 				succeedMu.Lock()
 				succeed = append(succeed, resp)
 				succeedMu.Unlock()
@@ -151,19 +417,58 @@ func (d *sender) BatchSend(ctx context.Context, notifications []domain.Notificat
 	}
 
 	succeedNotifications := d.getUpdatedNotifications(succeed, notificationsMap)
-	failedNotifications := d.getUpdatedNotifications(failed, notificationsMap)
+	failedNotifications, retryingNotifications := d.scheduleRetries(ctx,
+		d.getUpdatedNotifications(failed, notificationsMap), failedErrs)
 
 	// 更新发送状态
 	err = d.batchUpdateStatus(ctx, succeedNotifications, failedNotifications)
 	if err != nil {
 		return nil, err
 	}
+	retrying := make(map[uint64]struct{}, len(retryingNotifications))
+	for i := range retryingNotifications {
+		if err = d.repo.MarkRetrying(ctx, retryingNotifications[i]); err != nil {
+			return nil, fmt.Errorf("更新通知重试状态失败: %w", err)
+		}
+		retrying[retryingNotifications[i].ID] = struct{}{}
+	}
+	for i := range failed {
+		if _, ok := retrying[failed[i].NotificationID]; ok {
+			failed[i].Status = domain.SendStatusRetrying
+		}
+	}
+
+	pending := make([]domain.SendResponse, 0, len(deferred))
+	for i := range deferred {
+		deferred[i].Status = domain.SendStatusPending
+		if err = d.repo.MarkDeferred(ctx, deferred[i]); err != nil {
+			return nil, fmt.Errorf("推迟发送通知失败: %w", err)
+		}
+		pending = append(pending, domain.SendResponse{NotificationID: deferred[i].ID, Status: domain.SendStatusPending})
+	}
 
 	// 得到准确的发送结果，发起回调，发送成功和失败都应该回调
 	_ = d.callbackSvc.SendCallbackByNotifications(ctx, append(succeedNotifications, failedNotifications...))
 
 	// 合并结果并返回
-	return append(succeed, failed...), nil
+	return append(append(succeed, failed...), pending...), nil
+}
+
+// scheduleRetries 按渠道重试策略把发送失败的通知分为最终失败的和等待重试的
+func (d *sender) scheduleRetries(ctx context.Context, notifications []domain.Notification,
+	failedErrs map[uint64]error,
+) (failed, retrying []domain.Notification) {
+	for i := range notifications {
+		n := notifications[i]
+		err := failedErrs[n.ID]
+		n.SetNextRetryTimeAndStatus(d.retryChannelConfig(ctx, n.BizID, err), err.Error())
+		if n.Status == domain.SendStatusRetrying {
+			retrying = append(retrying, n)
+		} else {
+			failed = append(failed, n)
+		}
+	}
+	return failed, retrying
 }
 
 // getUpdatedNotifications 获取更新字段后的实体
@@ -172,6 +477,9 @@ func (d *sender) getUpdatedNotifications(responses []domain.SendResponse, notifi
 	for i := range responses {
 		if n, ok := notificationsMap[responses[i].NotificationID]; ok {
 			n.Status = responses[i].Status
+			n.ReceiverResults = responses[i].ReceiverResults
+			n.SentChannel = responses[i].SentChannel
+			n.Error = domain.NewSendError(responses[i].Error)
 			notifications = append(notifications, n)
 		}
 	}
@@ -0,0 +1,580 @@
+//go:build unit
+
+package sender
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/retry"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	channelmocks "gitee.com/flycash/notification-platform/internal/service/channel/mocks"
+	configmocks "gitee.com/flycash/notification-platform/internal/service/config/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
+	frequencycapmocks "gitee.com/flycash/notification-platform/internal/service/frequencycap/mocks"
+	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
+	suppressionmocks "gitee.com/flycash/notification-platform/internal/service/suppression/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSender_SendFailover(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     100,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Template:  domain.Template{ID: 10, VersionID: 11},
+		FailoverReceivers: map[domain.Channel][]string{
+			domain.ChannelEmail: {"user-1@example.com"},
+			domain.ChannelInApp: {"user-1"},
+		},
+	}
+	channelConfig := func(failover bool) *domain.ChannelConfig {
+		return &domain.ChannelConfig{
+			Failover: failover,
+			Channels: []domain.ChannelItem{
+				{Channel: domain.ChannelSMS.String(), Priority: 1, Enabled: true},
+				{Channel: domain.ChannelEmail.String(), Priority: 3, Enabled: true, Templates: map[int64]int64{10: 30}},
+				{Channel: domain.ChannelInApp.String(), Priority: 2, Enabled: true, Templates: map[int64]int64{10: 20}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		// notification 为空时发送上面的通知
+		notification domain.Notification
+		// quotaErr 转移额度到该渠道时返回的错误
+		quotaErr map[domain.Channel]error
+		mock     func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService)
+		check    func(t *testing.T, resp domain.SendResponse, repo *fakeRepo)
+	}{
+		{
+			name: "原渠道发送成功",
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				ch.EXPECT().Send(gomock.Any(), claimed(notification)).Return(domain.SendResponse{NotificationID: 1}, nil)
+				return ch, configmocks.NewMockBusinessConfigService(ctrl)
+			},
+			check: func(t *testing.T, resp domain.SendResponse, repo *fakeRepo) {
+				assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+				assert.Equal(t, domain.ChannelSMS, repo.marked.SentChannel)
+				assert.Empty(t, repo.transfers)
+			},
+		},
+		{
+			name: "按优先级降级到站内信",
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				ch.EXPECT().Send(gomock.Any(), claimed(notification)).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+					Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig(true)}, nil)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+						assert.Equal(t, domain.ChannelInApp, n.Channel)
+						assert.Equal(t, domain.Template{ID: 20}, n.Template)
+						assert.Equal(t, []string{"user-1"}, n.Receivers)
+						return domain.SendResponse{NotificationID: 1}, nil
+					})
+				return ch, configSvc
+			},
+			check: func(t *testing.T, resp domain.SendResponse, repo *fakeRepo) {
+				assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+				assert.Equal(t, domain.ChannelInApp, resp.SentChannel)
+				assert.Equal(t, domain.ChannelInApp, repo.marked.SentChannel)
+				assert.Equal(t, domain.ChannelSMS, repo.marked.Channel)
+				// 额度从短信转到站内信
+				assert.Equal(t, []string{"SMS->IN_APP"}, repo.transfers)
+			},
+		},
+		{
+			name:     "目标渠道额度不足时跳过",
+			quotaErr: map[domain.Channel]error{domain.ChannelInApp: errs.ErrNoQuota},
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				ch.EXPECT().Send(gomock.Any(), claimed(notification)).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+					Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig(true)}, nil)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+						assert.Equal(t, domain.ChannelEmail, n.Channel)
+						assert.Equal(t, []string{"user-1@example.com"}, n.Receivers)
+						return domain.SendResponse{NotificationID: 1}, nil
+					})
+				return ch, configSvc
+			},
+			check: func(t *testing.T, resp domain.SendResponse, repo *fakeRepo) {
+				assert.Equal(t, domain.ChannelEmail, resp.SentChannel)
+				assert.Equal(t, []string{"SMS->EMAIL"}, repo.transfers)
+			},
+		},
+		{
+			name: "没有指定目标渠道的接收者时不降级",
+			notification: domain.Notification{
+				ID:        1,
+				BizID:     100,
+				Channel:   domain.ChannelSMS,
+				Receivers: []string{"13800138000"},
+				Template:  domain.Template{ID: 10, VersionID: 11},
+			},
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				// 只有重试获取一次配置
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+					Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig(true)}, nil)
+				return ch, configSvc
+			},
+			check: func(t *testing.T, resp domain.SendResponse, repo *fakeRepo) {
+				assert.Equal(t, domain.SendStatusFailed, resp.Status)
+				assert.Empty(t, repo.transfers)
+			},
+		},
+		{
+			name: "所有渠道都失败",
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed).Times(3)
+				// 降级和重试各获取一次配置
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+					Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig(true)}, nil).Times(2)
+				return ch, configSvc
+			},
+			check: func(t *testing.T, resp domain.SendResponse, repo *fakeRepo) {
+				assert.Equal(t, domain.SendStatusFailed, resp.Status)
+				assert.Equal(t, domain.SendStatusFailed, repo.marked.Status)
+				assert.Empty(t, repo.marked.SentChannel)
+				// 降级失败后额度转回原渠道
+				assert.Equal(t, []string{"SMS->IN_APP", "IN_APP->SMS", "SMS->EMAIL", "EMAIL->SMS"}, repo.transfers)
+			},
+		},
+		{
+			name: "没有开启降级",
+			mock: func(ctrl *gomock.Controller) (*channelmocks.MockChannel, *configmocks.MockBusinessConfigService) {
+				ch := channelmocks.NewMockChannel(ctrl)
+				configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+				ch.EXPECT().Send(gomock.Any(), claimed(notification)).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+				configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+					Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig(false)}, nil).Times(2)
+				return ch, configSvc
+			},
+			check: func(t *testing.T, resp domain.SendResponse, _ *fakeRepo) {
+				assert.Equal(t, domain.SendStatusFailed, resp.Status)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ch, configSvc := tc.mock(ctrl)
+			repo := &fakeRepo{quotaErr: tc.quotaErr}
+			s := NewSender(repo, configSvc, &fakeCallbackService{}, ch, nil, nil, nil)
+			n := tc.notification
+			if n.ID == 0 {
+				n = notification
+			}
+			resp, err := s.Send(t.Context(), n)
+			require.NoError(t, err)
+			tc.check(t, resp, repo)
+		})
+	}
+}
+
+func TestSender_SendRetry(t *testing.T) {
+	t.Parallel()
+
+	channelConfig := &domain.ChannelConfig{
+		Channels: []domain.ChannelItem{
+			{Channel: domain.ChannelSMS.String(), Priority: 1, Enabled: true},
+		},
+		RetryPolicy: &retry.Config{
+			Type: "fixed",
+			FixedInterval: &retry.FixedIntervalConfig{
+				MaxRetries: 2,
+				Interval:   time.Minute,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		retryCount int32
+		check      func(t *testing.T, resp domain.SendResponse, marked domain.Notification)
+	}{
+		{
+			name:       "按重试策略等待重试",
+			retryCount: 0,
+			check: func(t *testing.T, resp domain.SendResponse, marked domain.Notification) {
+				assert.Equal(t, domain.SendStatusRetrying, resp.Status)
+				assert.Equal(t, domain.SendStatusRetrying, marked.Status)
+				assert.Equal(t, int32(1), marked.RetryCount)
+				assert.Greater(t, marked.NextRetryTime, time.Now().UnixMilli())
+				require.Len(t, marked.Attempts, 1)
+				assert.Equal(t, int32(1), marked.Attempts[0].Attempt)
+				assert.Equal(t, domain.SendStatusRetrying, marked.Attempts[0].Status)
+			},
+		},
+		{
+			name:       "重试次数用完后最终失败",
+			retryCount: 2,
+			check: func(t *testing.T, resp domain.SendResponse, marked domain.Notification) {
+				assert.Equal(t, domain.SendStatusFailed, resp.Status)
+				assert.Equal(t, domain.SendStatusFailed, marked.Status)
+				assert.Equal(t, int32(2), marked.RetryCount)
+				assert.Zero(t, marked.NextRetryTime)
+				require.Len(t, marked.Attempts, 1)
+				assert.Equal(t, int32(3), marked.Attempts[0].Attempt)
+				assert.Equal(t, domain.SendStatusFailed, marked.Attempts[0].Status)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notification := domain.Notification{
+				ID:         1,
+				BizID:      100,
+				Channel:    domain.ChannelSMS,
+				Receivers:  []string{"user-1"},
+				Template:   domain.Template{ID: 10, VersionID: 11},
+				RetryCount: tc.retryCount,
+			}
+			ch := channelmocks.NewMockChannel(ctrl)
+			ch.EXPECT().Send(gomock.Any(), claimed(notification)).Return(domain.SendResponse{}, errs.ErrSendNotificationFailed)
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+				Return(domain.BusinessConfig{ID: 100, ChannelConfig: channelConfig}, nil).AnyTimes()
+
+			repo := &fakeRepo{}
+			s := NewSender(repo, configSvc, &fakeCallbackService{}, ch, nil, nil, nil)
+			resp, err := s.Send(t.Context(), notification)
+			require.NoError(t, err)
+			tc.check(t, resp, repo.marked)
+		})
+	}
+}
+
+func TestSender_SendFrequencyCap(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     100,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"user-1", "user-2"},
+		Template:  domain.Template{ID: 10, VersionID: 11},
+	}
+	capped := func(receiver string) domain.ReceiverResult {
+		return domain.ReceiverResult{
+			Receiver: receiver,
+			Status:   domain.SendStatusFailed,
+			Code:     domain.ReceiverResultCodeFrequencyCapped,
+		}
+	}
+	deferUntil := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		capResult frequencycap.Result
+		mock      func(ch *channelmocks.MockChannel)
+		check     func(t *testing.T, resp domain.SendResponse, marked domain.Notification)
+	}{
+		{
+			name:      "只发送给没有超过上限的接收者",
+			capResult: frequencycap.Result{Allowed: []string{"user-1"}, Capped: []domain.ReceiverResult{capped("user-2")}},
+			mock: func(ch *channelmocks.MockChannel) {
+				ch.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+						assert.Equal(t, []string{"user-1"}, n.Receivers)
+						return domain.SendResponse{
+							NotificationID:  1,
+							ReceiverResults: []domain.ReceiverResult{{Receiver: "user-1", Status: domain.SendStatusSucceeded}},
+						}, nil
+					})
+			},
+			check: func(t *testing.T, resp domain.SendResponse, marked domain.Notification) {
+				assert.Equal(t, domain.SendStatusPartialSuccess, resp.Status)
+				assert.Equal(t, domain.SendStatusPartialSuccess, marked.Status)
+				require.Len(t, marked.ReceiverResults, 2)
+				assert.Equal(t, capped("user-2"), marked.ReceiverResults[1])
+			},
+		},
+		{
+			name: "所有接收者都超过上限时失败并且不重试",
+			capResult: frequencycap.Result{Capped: []domain.ReceiverResult{
+				capped("user-1"), capped("user-2"),
+			}},
+			mock: func(_ *channelmocks.MockChannel) {},
+			check: func(t *testing.T, resp domain.SendResponse, marked domain.Notification) {
+				assert.Equal(t, domain.SendStatusFailed, resp.Status)
+				assert.Equal(t, domain.SendStatusFailed, marked.Status)
+				assert.Equal(t, []domain.ReceiverResult{capped("user-1"), capped("user-2")}, marked.ReceiverResults)
+				assert.Zero(t, marked.RetryCount)
+			},
+		},
+		{
+			name:      "推迟发送",
+			capResult: frequencycap.Result{Allowed: notification.Receivers, DeferUntil: deferUntil},
+			mock:      func(_ *channelmocks.MockChannel) {},
+			check: func(t *testing.T, resp domain.SendResponse, marked domain.Notification) {
+				assert.Equal(t, domain.SendStatusPending, resp.Status)
+				assert.Equal(t, domain.SendStatusPending, marked.Status)
+				assert.Equal(t, deferUntil.UnixMilli(), marked.ScheduledSTime.UnixMilli())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ch := channelmocks.NewMockChannel(ctrl)
+			tc.mock(ch)
+			configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+			configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+				Return(domain.BusinessConfig{ID: 100}, nil).AnyTimes()
+			frequencyCap := frequencycapmocks.NewMockService(ctrl)
+			frequencyCap.EXPECT().Check(gomock.Any(), claimed(notification)).Return(tc.capResult)
+
+			repo := &fakeRepo{}
+			s := NewSender(repo, configSvc, &fakeCallbackService{}, ch, nil, frequencyCap, nil)
+			resp, err := s.Send(t.Context(), notification)
+			require.NoError(t, err)
+			tc.check(t, resp, repo.marked)
+		})
+	}
+}
+
+func TestSender_SendInvalidReceiver(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     100,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800000000"},
+		Template:  domain.Template{ID: 10, VersionID: 11},
+	}
+	results := []domain.ReceiverResult{{
+		Receiver:  "13800000000",
+		Status:    domain.SendStatusFailed,
+		Code:      "isv.MOBILE_NUMBER_ILLEGAL",
+		Provider:  "aliyun",
+		ErrorKind: domain.ProviderErrorKindReceiver,
+	}}
+	ch := channelmocks.NewMockChannel(ctrl)
+	ch.EXPECT().Send(gomock.Any(), claimed(notification)).
+		Return(domain.SendResponse{ReceiverResults: results}, fmt.Errorf("%w: %w", errs.ErrInvalidReceiver, errs.ErrSendNotificationFailed))
+	// 配置了重试也不会重试
+	configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+	configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+		Return(domain.BusinessConfig{ID: 100, ChannelConfig: &domain.ChannelConfig{
+			RetryPolicy: &retry.Config{
+				Type:          "fixed",
+				FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 2, Interval: time.Minute},
+			},
+		}}, nil).AnyTimes()
+	suppressionSvc := suppressionmocks.NewMockService(ctrl)
+	suppressionSvc.EXPECT().ReportReceiverFaults(gomock.Any(), int64(100), domain.ChannelSMS, results).Return(nil)
+
+	repo := &fakeRepo{}
+	s := NewSender(repo, configSvc, &fakeCallbackService{}, ch, nil, nil, suppressionSvc)
+	resp, err := s.Send(t.Context(), notification)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusFailed, resp.Status)
+	assert.Equal(t, domain.SendStatusFailed, repo.marked.Status)
+	assert.Zero(t, repo.marked.RetryCount)
+	assert.Equal(t, results, repo.marked.ReceiverResults)
+	// 错误码随通知一起保存
+	require.Error(t, resp.Error)
+	assert.Equal(t, domain.SendErrorCodeReceiverInvalid, domain.NewSendError(resp.Error).Code)
+	require.NotNil(t, repo.marked.Error)
+	assert.Equal(t, domain.SendErrorCodeReceiverInvalid, repo.marked.Error.Code)
+}
+
+func TestSender_SendDeadlinePassed(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 超过计划发送结束时间的通知不会调用渠道发送，也不会重试
+	ch := channelmocks.NewMockChannel(ctrl)
+	configSvc := configmocks.NewMockBusinessConfigService(ctrl)
+	configSvc.EXPECT().GetByID(gomock.Any(), int64(100)).
+		Return(domain.BusinessConfig{ID: 100, ChannelConfig: &domain.ChannelConfig{
+			RetryPolicy: &retry.Config{
+				Type:          "fixed",
+				FixedInterval: &retry.FixedIntervalConfig{MaxRetries: 2, Interval: time.Minute},
+			},
+		}}, nil).AnyTimes()
+
+	now := time.Now()
+	notification := domain.Notification{
+		ID:             1,
+		BizID:          100,
+		Channel:        domain.ChannelSMS,
+		Receivers:      []string{"13800000000"},
+		Template:       domain.Template{ID: 10, VersionID: 11},
+		ScheduledSTime: now.Add(-time.Hour),
+		ScheduledETime: now.Add(-time.Minute),
+	}
+	repo := &fakeRepo{}
+	s := NewSender(repo, configSvc, &fakeCallbackService{}, ch, nil, nil, nil)
+	resp, err := s.Send(t.Context(), notification)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusFailed, resp.Status)
+	assert.ErrorIs(t, resp.Error, errs.ErrDeadlinePassed)
+	assert.Equal(t, domain.SendStatusFailed, repo.marked.Status)
+	assert.Zero(t, repo.marked.RetryCount)
+	require.NotNil(t, repo.marked.Error)
+	assert.Equal(t, domain.SendErrorCodeDeadlinePassed, repo.marked.Error.Code)
+}
+
+func TestSender_SendClaim(t *testing.T) {
+	t.Parallel()
+
+	notification := domain.Notification{
+		ID:        1,
+		BizID:     100,
+		Channel:   domain.ChannelSMS,
+		Receivers: []string{"13800138000"},
+		Status:    domain.SendStatusPending,
+		Version:   1,
+	}
+
+	t.Run("抢占后发送", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ch := channelmocks.NewMockChannel(ctrl)
+		ch.EXPECT().Send(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n domain.Notification) (domain.SendResponse, error) {
+				assert.Equal(t, domain.SendStatusSending, n.Status)
+				assert.Equal(t, 2, n.Version)
+				return domain.SendResponse{NotificationID: 1}, nil
+			})
+		repo := &fakeRepo{}
+		s := NewSender(repo, configmocks.NewMockBusinessConfigService(ctrl), &fakeCallbackService{}, ch, nil, nil, nil)
+		resp, err := s.Send(t.Context(), notification)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	})
+
+	t.Run("已经被取消或者修改的通知不发送", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := &fakeRepo{claimErr: errs.ErrNotificationVersionMismatch}
+		s := NewSender(repo, configmocks.NewMockBusinessConfigService(ctrl), &fakeCallbackService{},
+			channelmocks.NewMockChannel(ctrl), nil, nil, nil)
+		_, err := s.Send(t.Context(), notification)
+		assert.ErrorIs(t, err, errs.ErrNotificationVersionMismatch)
+
+		resps, err := s.BatchSend(t.Context(), []domain.Notification{notification})
+		require.NoError(t, err)
+		assert.Empty(t, resps)
+		assert.Empty(t, repo.marked)
+	})
+
+	t.Run("发送成功前已经被超时任务标记为失败", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ch := channelmocks.NewMockChannel(ctrl)
+		ch.EXPECT().Send(gomock.Any(), gomock.Any()).Return(domain.SendResponse{NotificationID: 1}, nil)
+		repo := &fakeRepo{markErr: fmt.Errorf("%w, id 1", errs.ErrNotificationVersionMismatch)}
+		s := NewSender(repo, configmocks.NewMockBusinessConfigService(ctrl), &fakeCallbackService{}, ch, nil, nil, nil)
+		// 消息已经发出去了，返回真实的发送结果，不当作系统错误
+		resp, err := s.Send(t.Context(), notification)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SendStatusSucceeded, resp.Status)
+	})
+}
+
+// fakeRepo 只记录发送后更新的通知以及跨渠道降级时的额度转移
+type fakeRepo struct {
+	repository.NotificationRepository
+	marked    domain.Notification
+	transfers []string
+	quotaErr  map[domain.Channel]error
+	claimErr  error
+	// markErr 发送成功或者失败后更新状态时返回的错误
+	markErr error
+}
+
+func (f *fakeRepo) ClaimSending(_ context.Context, notification domain.Notification) (domain.Notification, error) {
+	if f.claimErr != nil {
+		return domain.Notification{}, f.claimErr
+	}
+	return claimed(notification), nil
+}
+
+// claimed 抢占成功后的通知
+func claimed(n domain.Notification) domain.Notification {
+	n.Status = domain.SendStatusSending
+	n.Version++
+	return n
+}
+
+func (f *fakeRepo) TransferQuota(_ context.Context, notification domain.Notification, to domain.Channel) error {
+	if err := f.quotaErr[to]; err != nil {
+		return err
+	}
+	f.transfers = append(f.transfers, fmt.Sprintf("%s->%s", notification.Channel, to))
+	return nil
+}
+
+func (f *fakeRepo) MarkSuccess(_ context.Context, notification domain.Notification) error {
+	f.marked = notification
+	return f.markErr
+}
+
+func (f *fakeRepo) MarkFailed(_ context.Context, notification domain.Notification) error {
+	f.marked = notification
+	return f.markErr
+}
+
+func (f *fakeRepo) MarkRetrying(_ context.Context, notification domain.Notification) error {
+	f.marked = notification
+	return nil
+}
+
+func (f *fakeRepo) MarkDeferred(_ context.Context, notification domain.Notification) error {
+	f.marked = notification
+	return nil
+}
+
+type fakeCallbackService struct {
+	callback.Service
+}
+
+func (f *fakeCallbackService) SendCallbackByNotification(_ context.Context, _ domain.Notification) error {
+	return nil
+}
@@ -76,17 +76,21 @@ func (o *TracingSender) BatchSend(ctx context.Context, notifications []domain.No
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 	} else {
-		// 记录成功和失败的数量
-		var succeeded, failed int
+		// 记录成功、部分成功和失败的数量
+		var succeeded, partialSucceeded, failed int
 		for _, resp := range responses {
-			if resp.Status == domain.SendStatusSucceeded {
+			switch resp.Status {
+			case domain.SendStatusSucceeded:
 				succeeded++
-			} else {
+			case domain.SendStatusPartialSuccess:
+				partialSucceeded++
+			default:
 				failed++
 			}
 		}
 		span.SetAttributes(
 			attribute.Int("notification.succeeded", succeeded),
+			attribute.Int("notification.partial_succeeded", partialSucceeded),
 			attribute.Int("notification.failed", failed),
 		)
 	}
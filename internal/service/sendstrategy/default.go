@@ -10,27 +10,33 @@ import (
 	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 )
 
 // DefaultSendStrategy 延迟发送策略
 type DefaultSendStrategy struct {
-	repo      repository.NotificationRepository
-	configSvc configsvc.BusinessConfigService
-	logger    *elog.Component
+	repo       repository.NotificationRepository
+	configSvc  configsvc.BusinessConfigService
+	quietHours quiethours.Service
+	logger     *elog.Component
 }
 
 // NewDefaultStrategy 创建延迟发送策略
-func NewDefaultStrategy(repo repository.NotificationRepository, configSvc configsvc.BusinessConfigService) *DefaultSendStrategy {
+func NewDefaultStrategy(repo repository.NotificationRepository,
+	configSvc configsvc.BusinessConfigService,
+	quietHours quiethours.Service,
+) *DefaultSendStrategy {
 	return &DefaultSendStrategy{
-		repo:      repo,
-		configSvc: configSvc,
-		logger:    elog.DefaultLogger,
+		repo:       repo,
+		configSvc:  configSvc,
+		quietHours: quietHours,
+		logger:     elog.DefaultLogger,
 	}
 }
 
 // Send 单条发送通知
 func (s *DefaultSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
-	notification.SetSendTime()
+	s.setSendTime(ctx, &notification)
 	// 创建通知记录
 	created, err := s.create(ctx, notification)
 	if err != nil {
@@ -43,6 +49,14 @@ func (s *DefaultSendStrategy) Send(ctx context.Context, notification domain.Noti
 	}, nil
 }
 
+// setSendTime 计算发送时间，开始发送时落在免打扰时段内的推迟到时段结束之后
+func (s *DefaultSendStrategy) setSendTime(ctx context.Context, notification *domain.Notification) {
+	notification.SetSendTime()
+	if t, ok := s.quietHours.NextAllowedTime(ctx, *notification, notification.ScheduledSTime); ok {
+		notification.DeferSendTime(t)
+	}
+}
+
 func (s *DefaultSendStrategy) create(ctx context.Context, notification domain.Notification) (domain.Notification, error) {
 	if !s.needCreateCallbackLog(ctx, notification) {
 		return s.repo.CreateWithCallbackLog(ctx, notification)
@@ -66,7 +80,7 @@ func (s *DefaultSendStrategy) BatchSend(ctx context.Context, notifications []dom
 	}
 
 	for i := range notifications {
-		notifications[i].SetSendTime()
+		s.setSendTime(ctx, &notifications[i])
 	}
 
 	// 创建通知记录
@@ -46,10 +46,11 @@ func (s *ImmediateSendStrategy) Send(ctx context.Context, notification domain.No
 		return domain.SendResponse{}, fmt.Errorf("获取通知失败: %w", err)
 	}
 
-	if found.Status == domain.SendStatusSucceeded {
+	if found.Status == domain.SendStatusSucceeded || found.Status == domain.SendStatusPartialSuccess {
 		return domain.SendResponse{
-			NotificationID: found.ID,
-			Status:         found.Status,
+			NotificationID:  found.ID,
+			Status:          found.Status,
+			ReceiverResults: found.ReceiverResults,
 		}, nil
 	}
 
@@ -0,0 +1,58 @@
+package sendstrategy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+)
+
+// RecurringSendStrategy 周期发送策略
+// 只保存周期通知，由周期调度器按照 cron 表达式把每一次发送生成为独立的通知
+type RecurringSendStrategy struct {
+	repo repository.RecurringNotificationRepository
+}
+
+// NewRecurringStrategy 创建周期发送策略
+func NewRecurringStrategy(repo repository.RecurringNotificationRepository) *RecurringSendStrategy {
+	return &RecurringSendStrategy{repo: repo}
+}
+
+// Send 单条发送通知，返回的通知ID是周期通知的ID
+func (s *RecurringSendStrategy) Send(ctx context.Context, notification domain.Notification) (domain.SendResponse, error) {
+	r, err := domain.NewRecurringNotification(notification)
+	if err != nil {
+		return domain.SendResponse{}, err
+	}
+	created, err := s.repo.Create(ctx, r)
+	if errors.Is(err, errs.ErrNotificationDuplicate) {
+		// 业务方重试，返回已经创建的周期通知
+		created, err = s.repo.GetByKey(ctx, notification.BizID, notification.Key)
+	}
+	if err != nil {
+		return domain.SendResponse{}, fmt.Errorf("创建周期通知失败: %w", err)
+	}
+	return domain.SendResponse{
+		NotificationID: created.ID,
+		Status:         domain.SendStatusPending,
+	}, nil
+}
+
+// BatchSend 批量发送通知，其中每个通知的发送策略必须相同
+func (s *RecurringSendStrategy) BatchSend(ctx context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
+	if len(notifications) == 0 {
+		return nil, fmt.Errorf("%w: 通知列表不能为空", errs.ErrInvalidParameter)
+	}
+	responses := make([]domain.SendResponse, 0, len(notifications))
+	for i := range notifications {
+		resp, err := s.Send(ctx, notifications[i])
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
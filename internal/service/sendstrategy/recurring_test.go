@@ -0,0 +1,96 @@
+//go:build unit
+
+package sendstrategy
+
+import (
+	"context"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurringSendStrategy_Send(t *testing.T) {
+	t.Parallel()
+
+	newNotification := func() domain.Notification {
+		return domain.Notification{
+			BizID:     1,
+			Key:       "weekly",
+			Receivers: []string{"13800000000"},
+			Channel:   domain.ChannelSMS,
+			Template:  domain.Template{ID: 1, VersionID: 1},
+			SendStrategyConfig: domain.SendStrategyConfig{
+				Type: domain.SendStrategyRecurring,
+				Cron: "0 9 * * 1",
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		repo    *fakeRecurringRepo
+		wantID  uint64
+		wantErr error
+	}{
+		{
+			name:   "创建周期通知",
+			repo:   &fakeRecurringRepo{createdID: 100},
+			wantID: 100,
+		},
+		{
+			name: "重复请求返回已经创建的周期通知",
+			repo: &fakeRecurringRepo{
+				createErr: errs.ErrNotificationDuplicate,
+				existing:  map[string]uint64{"weekly": 200},
+			},
+			wantID: 200,
+		},
+		{
+			name:    "创建失败",
+			repo:    &fakeRecurringRepo{createErr: assert.AnError},
+			wantErr: assert.AnError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := NewRecurringStrategy(tc.repo).Send(t.Context(), newNotification())
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, resp.NotificationID)
+			assert.Equal(t, domain.SendStatusPending, resp.Status)
+		})
+	}
+}
+
+type fakeRecurringRepo struct {
+	repository.RecurringNotificationRepository
+	createdID uint64
+	createErr error
+	existing  map[string]uint64
+}
+
+func (f *fakeRecurringRepo) Create(_ context.Context, r domain.RecurringNotification) (domain.RecurringNotification, error) {
+	if f.createErr != nil {
+		return domain.RecurringNotification{}, f.createErr
+	}
+	r.ID = f.createdID
+	return r, nil
+}
+
+func (f *fakeRecurringRepo) GetByKey(_ context.Context, _ int64, key string) (domain.RecurringNotification, error) {
+	id, ok := f.existing[key]
+	if !ok {
+		return domain.RecurringNotification{}, errs.ErrNotificationNotFound
+	}
+	return domain.RecurringNotification{ID: id}, nil
+}
@@ -24,16 +24,19 @@ type SendStrategy interface {
 type Dispatcher struct {
 	immediate       *ImmediateSendStrategy
 	defaultStrategy *DefaultSendStrategy
+	recurring       *RecurringSendStrategy
 }
 
 // NewDispatcher 创建通知发送分发器
 func NewDispatcher(
 	immediate *ImmediateSendStrategy,
 	defaultStrategy *DefaultSendStrategy,
+	recurring *RecurringSendStrategy,
 ) SendStrategy {
 	return &Dispatcher{
 		immediate:       immediate,
 		defaultStrategy: defaultStrategy,
+		recurring:       recurring,
 	}
 }
 
@@ -54,8 +57,12 @@ func (d *Dispatcher) BatchSend(ctx context.Context, ns []domain.Notification) ([
 }
 
 func (d *Dispatcher) selectStrategy(not domain.Notification) SendStrategy {
-	if not.SendStrategyConfig.Type == domain.SendStrategyImmediate {
+	switch not.SendStrategyConfig.Type {
+	case domain.SendStrategyImmediate:
 		return d.immediate
+	case domain.SendStrategyRecurring:
+		return d.recurring
+	default:
+		return d.defaultStrategy
 	}
-	return d.defaultStrategy
 }
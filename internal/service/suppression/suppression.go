@@ -0,0 +1,150 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
+)
+
+const maxPageSize = 100
+
+// Service 退订名单服务，接收者退订之后不再给他发送匹配渠道和业务类型的通知
+//
+//go:generate mockgen -source=./suppression.go -destination=./mocks/suppression.mock.go -package=suppressionmocks -typed Service
+type Service interface {
+	// Add 添加退订记录，已经存在时更新退订原因
+	Add(ctx context.Context, s domain.Suppression) error
+	// Remove 删除退订记录，记录不存在时返回 errs.ErrSuppressionNotFound
+	Remove(ctx context.Context, s domain.Suppression) error
+	// List 按时间倒序分页查询退订记录，receiver 为空时查询所有接收者，同时返回符合条件的总数
+	List(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]domain.Suppression, int64, error)
+	// Filter 按通知的渠道和模版的业务类型过滤退订的接收者，
+	// 返回没有退订的接收者，以及退订的接收者状态为 SUPPRESSED 的发送结果
+	Filter(ctx context.Context, n domain.Notification) (allowed []string, suppressed []domain.ReceiverResult, err error)
+	// ReportReceiverFaults 统计因为接收者本身的问题(例如空号)发送失败的次数，
+	// 统计窗口内达到阈值之后自动把接收者加入该渠道的退订名单
+	ReportReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, results []domain.ReceiverResult) error
+}
+
+// AutoSuppressConfig 自动退订的配置
+type AutoSuppressConfig struct {
+	// Threshold 统计窗口内失败多少次之后自动退订，小于等于 0 时不自动退订
+	Threshold int64 `yaml:"threshold"`
+	// Window 统计窗口，从第一次失败开始计算
+	Window time.Duration `yaml:"window"`
+}
+
+// DefaultAutoSuppressConfig 30 天内失败 3 次自动退订
+func DefaultAutoSuppressConfig() AutoSuppressConfig {
+	const days = 30
+	return AutoSuppressConfig{Threshold: 3, Window: days * 24 * time.Hour}
+}
+
+type service struct {
+	repo        repository.SuppressionRepository
+	templateSvc manage.ChannelTemplateService
+	autoCfg     AutoSuppressConfig
+}
+
+func NewService(repo repository.SuppressionRepository, templateSvc manage.ChannelTemplateService, autoCfg AutoSuppressConfig) Service {
+	return &service{repo: repo, templateSvc: templateSvc, autoCfg: autoCfg}
+}
+
+func (s *service) Add(ctx context.Context, sp domain.Suppression) error {
+	if err := sp.Validate(); err != nil {
+		return err
+	}
+	return s.repo.Add(ctx, sp)
+}
+
+func (s *service) Remove(ctx context.Context, sp domain.Suppression) error {
+	if err := sp.Validate(); err != nil {
+		return err
+	}
+	return s.repo.Remove(ctx, sp)
+}
+
+func (s *service) List(ctx context.Context, bizID int64, receiver string, offset, limit int) ([]domain.Suppression, int64, error) {
+	if offset < 0 || limit <= 0 || limit > maxPageSize {
+		return nil, 0, fmt.Errorf("%w: offset = %d, limit = %d", errs.ErrInvalidParameter, offset, limit)
+	}
+	return s.repo.List(ctx, bizID, receiver, offset, limit)
+}
+
+// Filter 法规要求必须遵守退订，所以查询失败时返回错误，不能继续发送
+func (s *service) Filter(ctx context.Context, n domain.Notification) (allowed []string, suppressed []domain.ReceiverResult, err error) {
+	found, err := s.repo.FindByReceivers(ctx, n.BizID, n.Receivers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询退订名单失败: %w", err)
+	}
+	if len(found) == 0 {
+		return n.Receivers, nil, nil
+	}
+	tmpl, err := s.templateSvc.GetTemplateByID(ctx, n.Template.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询模版失败: %w", err)
+	}
+
+	allowed = make([]string, 0, len(n.Receivers))
+	for _, receiver := range n.Receivers {
+		if !s.isSuppressed(found[receiver], n.Channel, tmpl.BusinessType) {
+			allowed = append(allowed, receiver)
+			continue
+		}
+		suppressed = append(suppressed, domain.ReceiverResult{
+			Receiver: receiver,
+			Status:   domain.SendStatusSuppressed,
+			Code:     domain.ReceiverResultCodeSuppressed,
+			Message:  "接收者已退订",
+		})
+	}
+	return allowed, suppressed, nil
+}
+
+func (s *service) isSuppressed(suppressions []domain.Suppression, channel domain.Channel, businessType domain.BusinessType) bool {
+	for i := range suppressions {
+		if suppressions[i].Matches(channel, businessType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) ReportReceiverFaults(ctx context.Context, bizID int64, channel domain.Channel, results []domain.ReceiverResult) error {
+	if s.autoCfg.Threshold <= 0 {
+		return nil
+	}
+	for i := range results {
+		res := results[i]
+		if res.Status != domain.SendStatusFailed || !res.ErrorKind.IsReceiverFault() {
+			continue
+		}
+		cnt, err := s.repo.IncrReceiverFaults(ctx, bizID, channel, res.Receiver, s.autoCfg.Window)
+		if err != nil {
+			return fmt.Errorf("统计接收者发送失败次数失败: %w", err)
+		}
+		if cnt < s.autoCfg.Threshold {
+			continue
+		}
+		// 只退订这个渠道，接收者在其他渠道上可能是正常的
+		err = s.repo.Add(ctx, domain.Suppression{
+			BizID:    bizID,
+			Channel:  channel,
+			Receiver: res.Receiver,
+			Reason:   fmt.Sprintf("供应商 %s 返回错误码 %s %d 次，自动退订", res.Provider, res.Code, cnt),
+		})
+		if err != nil {
+			return fmt.Errorf("自动退订失败: %w", err)
+		}
+		// 重新订阅之后重新计数
+		if err = s.repo.ResetReceiverFaults(ctx, bizID, channel, res.Receiver); err != nil {
+			return fmt.Errorf("清空接收者发送失败次数失败: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+//go:build unit
+
+package suppression
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	templatemocks "gitee.com/flycash/notification-platform/internal/service/template/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_Filter(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(100)
+	suppressions := map[string][]domain.Suppression{
+		// 退订了营销短信
+		"13800000001": {{BizID: bizID, Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypePromotion, Receiver: "13800000001"}},
+		// 退订了所有渠道的所有通知
+		"13800000002": {{BizID: bizID, Receiver: "13800000002"}},
+		// 只退订了邮件
+		"13800000003": {{BizID: bizID, Channel: domain.ChannelEmail, Receiver: "13800000003"}},
+	}
+	receivers := []string{"13800000001", "13800000002", "13800000003", "13800000004"}
+
+	testCases := []struct {
+		name           string
+		businessType   domain.BusinessType
+		repoErr        error
+		wantAllowed    []string
+		wantSuppressed []string
+		wantErr        bool
+	}{
+		{
+			name:           "营销短信",
+			businessType:   domain.BusinessTypePromotion,
+			wantAllowed:    []string{"13800000003", "13800000004"},
+			wantSuppressed: []string{"13800000001", "13800000002"},
+		},
+		{
+			name:           "验证码短信",
+			businessType:   domain.BusinessTypeVerificationCode,
+			wantAllowed:    []string{"13800000001", "13800000003", "13800000004"},
+			wantSuppressed: []string{"13800000002"},
+		},
+		{
+			name:         "查询退订名单失败不能发送",
+			businessType: domain.BusinessTypePromotion,
+			repoErr:      errors.New("mock db error"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			templateSvc := templatemocks.NewMockChannelTemplateService(ctrl)
+			templateSvc.EXPECT().GetTemplateByID(gomock.Any(), int64(10)).
+				Return(domain.ChannelTemplate{ID: 10, BusinessType: tc.businessType}, nil).AnyTimes()
+			repo := &fakeSuppressionRepo{suppressions: suppressions, err: tc.repoErr}
+
+			n := domain.Notification{BizID: bizID, Channel: domain.ChannelSMS, Receivers: receivers, Template: domain.Template{ID: 10}}
+			allowed, suppressed, err := NewService(repo, templateSvc, DefaultAutoSuppressConfig()).Filter(t.Context(), n)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAllowed, allowed)
+			require.Len(t, suppressed, len(tc.wantSuppressed))
+			for i := range suppressed {
+				assert.Equal(t, tc.wantSuppressed[i], suppressed[i].Receiver)
+				assert.Equal(t, domain.SendStatusSuppressed, suppressed[i].Status)
+				assert.Equal(t, domain.ReceiverResultCodeSuppressed, suppressed[i].Code)
+			}
+		})
+	}
+}
+
+func TestService_ReportReceiverFaults(t *testing.T) {
+	t.Parallel()
+
+	const bizID = int64(100)
+	repo := &fakeSuppressionRepo{faults: make(map[string]int64)}
+	svc := NewService(repo, nil, AutoSuppressConfig{Threshold: 2, Window: time.Hour})
+
+	results := []domain.ReceiverResult{
+		{Receiver: "13800000001", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindReceiver},
+		// 供应商的问题和发送成功的接收者不统计
+		{Receiver: "13800000002", Status: domain.SendStatusFailed, Code: "isv.AMOUNT_NOT_ENOUGH", Provider: "aliyun", ErrorKind: domain.ProviderErrorKindProvider},
+		{Receiver: "13800000003", Status: domain.SendStatusSucceeded, Code: "OK", Provider: "aliyun"},
+	}
+
+	// 第一次失败没有达到阈值
+	require.NoError(t, svc.ReportReceiverFaults(t.Context(), bizID, domain.ChannelSMS, results))
+	assert.Empty(t, repo.added)
+	assert.Equal(t, map[string]int64{"13800000001": 1}, repo.faults)
+
+	// 第二次失败自动退订短信渠道，并且重新计数
+	require.NoError(t, svc.ReportReceiverFaults(t.Context(), bizID, domain.ChannelSMS, results))
+	require.Len(t, repo.added, 1)
+	assert.Equal(t, bizID, repo.added[0].BizID)
+	assert.Equal(t, domain.ChannelSMS, repo.added[0].Channel)
+	assert.Equal(t, domain.BusinessType(0), repo.added[0].BusinessType)
+	assert.Equal(t, "13800000001", repo.added[0].Receiver)
+	assert.Empty(t, repo.faults)
+
+	// 没有开启自动退订
+	disabled := &fakeSuppressionRepo{faults: make(map[string]int64)}
+	require.NoError(t, NewService(disabled, nil, AutoSuppressConfig{}).
+		ReportReceiverFaults(t.Context(), bizID, domain.ChannelSMS, results))
+	assert.Empty(t, disabled.faults)
+}
+
+type fakeSuppressionRepo struct {
+	repository.SuppressionRepository
+	suppressions map[string][]domain.Suppression
+	err          error
+
+	// faults 键为接收者
+	faults map[string]int64
+	added  []domain.Suppression
+}
+
+func (f *fakeSuppressionRepo) Add(_ context.Context, s domain.Suppression) error {
+	f.added = append(f.added, s)
+	return nil
+}
+
+func (f *fakeSuppressionRepo) IncrReceiverFaults(_ context.Context, _ int64, _ domain.Channel, receiver string, _ time.Duration) (int64, error) {
+	f.faults[receiver]++
+	return f.faults[receiver], nil
+}
+
+func (f *fakeSuppressionRepo) ResetReceiverFaults(_ context.Context, _ int64, _ domain.Channel, receiver string) error {
+	delete(f.faults, receiver)
+	return nil
+}
+
+func (f *fakeSuppressionRepo) FindByReceivers(_ context.Context, _ int64, receivers []string) (map[string][]domain.Suppression, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := make(map[string][]domain.Suppression)
+	for _, receiver := range receivers {
+		if found, ok := f.suppressions[receiver]; ok {
+			res[receiver] = found
+		}
+	}
+	return res, nil
+}
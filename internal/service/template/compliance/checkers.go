@@ -0,0 +1,176 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+const (
+	// 单条短信最多 70 个字，超过之后按长短信拆分，每条 67 个字
+	smsSingleSegmentLength = 70
+	smsMultiSegmentLength  = 67
+)
+
+var (
+	urlHostPattern     = regexp.MustCompile(`(?i)(?:https?://)?((?:[a-z0-9-]+\.)+[a-z]{2,})/`)
+	placeholderPattern = regexp.MustCompile(`\$\{[^}]+\}`)
+)
+
+// SensitiveWordChecker 检查签名和内容中的敏感词，不区分大小写
+type SensitiveWordChecker struct {
+	words    []string
+	blocking bool
+}
+
+func NewSensitiveWordChecker(words []string, blocking bool) *SensitiveWordChecker {
+	lowered := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			lowered = append(lowered, strings.ToLower(w))
+		}
+	}
+	return &SensitiveWordChecker{words: lowered, blocking: blocking}
+}
+
+func (c *SensitiveWordChecker) Check(_ context.Context, _ domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	text := strings.ToLower(version.Signature + "\n" + version.Content)
+	var found []string
+	for _, w := range c.words {
+		if strings.Contains(text, w) {
+			found = append(found, w)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return domain.ComplianceViolations{{
+		Rule:     domain.ComplianceRuleSensitiveWord,
+		Blocking: c.blocking,
+		Message:  fmt.Sprintf("包含敏感词：%s", strings.Join(found, "、")),
+	}}
+}
+
+// ShortURLChecker 检查内容中的短链接，只认配置的短链接域名
+type ShortURLChecker struct {
+	hosts    map[string]struct{}
+	blocking bool
+}
+
+func NewShortURLChecker(hosts []string, blocking bool) *ShortURLChecker {
+	m := make(map[string]struct{}, len(hosts))
+	for _, h := range hosts {
+		m[strings.ToLower(strings.TrimSpace(h))] = struct{}{}
+	}
+	return &ShortURLChecker{hosts: m, blocking: blocking}
+}
+
+func (c *ShortURLChecker) Check(_ context.Context, _ domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	var found []string
+	for _, match := range urlHostPattern.FindAllStringSubmatch(version.Content, -1) {
+		host := strings.ToLower(match[1])
+		if _, ok := c.hosts[host]; ok {
+			found = append(found, host)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return domain.ComplianceViolations{{
+		Rule:     domain.ComplianceRuleShortURL,
+		Blocking: c.blocking,
+		Message:  fmt.Sprintf("包含短链接：%s，请使用完整的链接", strings.Join(found, "、")),
+	}}
+}
+
+// SignatureChecker 短信模版必须有签名，其他渠道不检查
+type SignatureChecker struct {
+	blocking bool
+}
+
+func NewSignatureChecker(blocking bool) *SignatureChecker {
+	return &SignatureChecker{blocking: blocking}
+}
+
+func (c *SignatureChecker) Check(_ context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	if !template.Channel.IsSMS() || strings.TrimSpace(version.Signature) != "" {
+		return nil
+	}
+	return domain.ComplianceViolations{{
+		Rule:     domain.ComplianceRuleSignature,
+		Blocking: c.blocking,
+		Message:  "短信模版缺少签名",
+	}}
+}
+
+// SMSLengthChecker 按照"【签名】内容"计算短信长度和计费条数，变量的长度发送时才知道，不计算在内。
+// 超过最多条数时不合规，拆分成多条时只提醒审核人
+type SMSLengthChecker struct {
+	maxSegments int
+	blocking    bool
+}
+
+func NewSMSLengthChecker(maxSegments int, blocking bool) *SMSLengthChecker {
+	return &SMSLengthChecker{maxSegments: maxSegments, blocking: blocking}
+}
+
+func (c *SMSLengthChecker) Check(_ context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	if !template.Channel.IsSMS() {
+		return nil
+	}
+	length := utf8.RuneCountInString("【" + version.Signature + "】" + placeholderPattern.ReplaceAllString(version.Content, ""))
+	segments := SMSSegments(length)
+	switch {
+	case segments > c.maxSegments:
+		return domain.ComplianceViolations{{
+			Rule:     domain.ComplianceRuleSMSLength,
+			Blocking: c.blocking,
+			Message:  fmt.Sprintf("短信长度 %d 字（不含变量），按 %d 条计费，超过了 %d 条的上限", length, segments, c.maxSegments),
+		}}
+	case segments > 1:
+		return domain.ComplianceViolations{{
+			Rule:    domain.ComplianceRuleSMSLength,
+			Message: fmt.Sprintf("短信长度 %d 字（不含变量），按 %d 条计费", length, segments),
+		}}
+	default:
+		return nil
+	}
+}
+
+// SMSSegments 计算 length 个字的短信按几条计费
+func SMSSegments(length int) int {
+	if length <= smsSingleSegmentLength {
+		return 1
+	}
+	return (length + smsMultiSegmentLength - 1) / smsMultiSegmentLength
+}
+
+// OptOutChecker 营销模版必须告诉接收者怎么退订
+type OptOutChecker struct {
+	phrases  []string
+	blocking bool
+}
+
+func NewOptOutChecker(phrases []string, blocking bool) *OptOutChecker {
+	return &OptOutChecker{phrases: phrases, blocking: blocking}
+}
+
+func (c *OptOutChecker) Check(_ context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	if template.BusinessType != domain.BusinessTypePromotion {
+		return nil
+	}
+	for _, p := range c.phrases {
+		if strings.Contains(version.Content, p) {
+			return nil
+		}
+	}
+	return domain.ComplianceViolations{{
+		Rule:     domain.ComplianceRuleOptOut,
+		Blocking: c.blocking,
+		Message:  fmt.Sprintf("营销模版缺少退订方式，请在内容中加上：%s", strings.Join(c.phrases, " 或 ")),
+	}}
+}
@@ -0,0 +1,74 @@
+package compliance
+
+import (
+	"context"
+	"slices"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+)
+
+// Checker 模版内容合规检查，在提交内部审核和供应商审核之前调用，
+// 尽量在提交之前发现会被供应商驳回的问题
+//
+//go:generate mockgen -source=./compliance.go -destination=./mocks/compliance.mock.go -package=compliancemocks -typed Checker
+type Checker interface {
+	// Check 返回所有不合规的地方，没有问题时返回空
+	Check(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations
+}
+
+// Config 内置检查规则的配置，零值不做任何检查
+type Config struct {
+	// SensitiveWords 敏感词词典，出现在签名或者内容中就不合规
+	SensitiveWords []string `yaml:"sensitiveWords"`
+	// ShortURLHosts 短链接服务的域名，供应商普遍不接受短链接
+	ShortURLHosts []string `yaml:"shortURLHosts"`
+	// RequireSignature 短信模版必须有签名
+	RequireSignature bool `yaml:"requireSignature"`
+	// MaxSMSSegments 短信最多计费条数，0 表示不检查长度
+	MaxSMSSegments int `yaml:"maxSMSSegments"`
+	// OptOutPhrases 营销模版必须包含其中一个退订用语，例如"拒收请回复R"
+	OptOutPhrases []string `yaml:"optOutPhrases"`
+	// WarnOnly 只在审核内容中标注、不阻止提交的规则
+	WarnOnly []domain.ComplianceRule `yaml:"warnOnly"`
+}
+
+// NewChecker 按照配置组合内置的检查规则
+func NewChecker(cfg Config) Checker {
+	blocking := func(rule domain.ComplianceRule) bool {
+		return !slices.Contains(cfg.WarnOnly, rule)
+	}
+	var checkers []Checker
+	if len(cfg.SensitiveWords) > 0 {
+		checkers = append(checkers, NewSensitiveWordChecker(cfg.SensitiveWords, blocking(domain.ComplianceRuleSensitiveWord)))
+	}
+	if len(cfg.ShortURLHosts) > 0 {
+		checkers = append(checkers, NewShortURLChecker(cfg.ShortURLHosts, blocking(domain.ComplianceRuleShortURL)))
+	}
+	if cfg.RequireSignature {
+		checkers = append(checkers, NewSignatureChecker(blocking(domain.ComplianceRuleSignature)))
+	}
+	if cfg.MaxSMSSegments > 0 {
+		checkers = append(checkers, NewSMSLengthChecker(cfg.MaxSMSSegments, blocking(domain.ComplianceRuleSMSLength)))
+	}
+	if len(cfg.OptOutPhrases) > 0 {
+		checkers = append(checkers, NewOptOutChecker(cfg.OptOutPhrases, blocking(domain.ComplianceRuleOptOut)))
+	}
+	return NewChain(checkers...)
+}
+
+// Chain 依次执行所有检查，汇总结果
+type Chain struct {
+	checkers []Checker
+}
+
+func NewChain(checkers ...Checker) *Chain {
+	return &Chain{checkers: checkers}
+}
+
+func (c *Chain) Check(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	var res domain.ComplianceViolations
+	for _, checker := range c.checkers {
+		res = append(res, checker.Check(ctx, template, version)...)
+	}
+	return res
+}
@@ -0,0 +1,135 @@
+//go:build unit
+
+package compliance
+
+import (
+	"strings"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	cfg := Config{
+		SensitiveWords:   []string{"博彩", "VIP"},
+		ShortURLHosts:    []string{"t.cn", "bit.ly"},
+		RequireSignature: true,
+		MaxSMSSegments:   2,
+		OptOutPhrases:    []string{"拒收请回复R", "回T退订"},
+	}
+	sms := domain.ChannelTemplate{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypeNotification}
+	promotion := domain.ChannelTemplate{Channel: domain.ChannelSMS, BusinessType: domain.BusinessTypePromotion}
+
+	testCases := []struct {
+		name      string
+		cfg       Config
+		template  domain.ChannelTemplate
+		version   domain.ChannelTemplateVersion
+		wantRules []domain.ComplianceRule
+		wantErr   bool
+	}{
+		{
+			name:     "合规",
+			cfg:      cfg,
+			template: promotion,
+			version:  domain.ChannelTemplateVersion{Signature: "通知平台", Content: "您的会员积分即将过期，详情见 https://example.com/points 拒收请回复R"},
+		},
+		{
+			name:      "敏感词不区分大小写",
+			cfg:       cfg,
+			template:  sms,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: "恭喜您成为vip用户"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleSensitiveWord},
+			wantErr:   true,
+		},
+		{
+			name:      "短链接",
+			cfg:       cfg,
+			template:  sms,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: "点击 http://t.cn/A6abc 查看订单，完整链接 https://example.com/a 不受影响"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleShortURL},
+			wantErr:   true,
+		},
+		{
+			name:      "短信缺少签名",
+			cfg:       cfg,
+			template:  sms,
+			version:   domain.ChannelTemplateVersion{Content: "您的验证码是${code}"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleSignature},
+			wantErr:   true,
+		},
+		{
+			name:     "邮件不检查签名和长度",
+			cfg:      cfg,
+			template: domain.ChannelTemplate{Channel: domain.ChannelEmail, BusinessType: domain.BusinessTypeNotification},
+			version:  domain.ChannelTemplateVersion{Content: strings.Repeat("长", 500)},
+		},
+		{
+			name:      "短信拆分成多条只标注",
+			cfg:       cfg,
+			template:  sms,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: strings.Repeat("长", 70) + "${name}"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleSMSLength},
+		},
+		{
+			name:      "短信超过最多条数",
+			cfg:       cfg,
+			template:  sms,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: strings.Repeat("长", 140)},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleSMSLength},
+			wantErr:   true,
+		},
+		{
+			name:      "营销模版缺少退订方式",
+			cfg:       cfg,
+			template:  promotion,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: "双十一全场五折"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleOptOut},
+			wantErr:   true,
+		},
+		{
+			name: "配置为只标注的规则不阻止提交",
+			cfg: Config{
+				OptOutPhrases: cfg.OptOutPhrases,
+				WarnOnly:      []domain.ComplianceRule{domain.ComplianceRuleOptOut},
+			},
+			template:  promotion,
+			version:   domain.ChannelTemplateVersion{Signature: "通知平台", Content: "双十一全场五折"},
+			wantRules: []domain.ComplianceRule{domain.ComplianceRuleOptOut},
+		},
+		{
+			name:     "没有配置时不检查",
+			template: promotion,
+			version:  domain.ChannelTemplateVersion{Content: "博彩 t.cn/abc"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			violations := NewChecker(tc.cfg).Check(t.Context(), tc.template, tc.version)
+			var rules []domain.ComplianceRule
+			for _, v := range violations {
+				rules = append(rules, v.Rule)
+			}
+			assert.Equal(t, tc.wantRules, rules)
+			if tc.wantErr {
+				assert.ErrorIs(t, violations.Err(), errs.ErrTemplateNonCompliant)
+			} else {
+				assert.NoError(t, violations.Err())
+			}
+		})
+	}
+}
+
+func TestSMSSegments(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 1, SMSSegments(70))
+	assert.Equal(t, 2, SMSSegments(71))
+	assert.Equal(t, 2, SMSSegments(134))
+	assert.Equal(t, 3, SMSSegments(135))
+}
@@ -5,17 +5,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	"github.com/ecodeclub/ekit/slice"
 )
 
+// maxReasonLength 拒绝原因、回滚原因字段的长度上限
+const maxReasonLength = 512
+
 // ChannelTemplateService 提供模板管理的服务接口
 //
 //go:generate mockgen -source=./manage.go -destination=../mocks/manage.mock.go -package=templatemocks -typed ChannelTemplateService
@@ -25,8 +34,10 @@ type ChannelTemplateService interface {
 	// GetTemplatesByOwner 获取指定所有者的模板列表
 	GetTemplatesByOwner(ctx context.Context, ownerID int64, ownerType domain.OwnerType) ([]domain.ChannelTemplate, error)
 
-	// GetTemplateByIDAndProviderInfo 根据模板ID和供应商信息获取模板
-	GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error)
+	// GetTemplateByIDAndProviderInfo 根据模板ID和供应商信息获取模板，Versions 中只有 versionID 指定的版本，
+	// versionID 为 0 时使用活跃版本。版本的内容是按照降级规则找到的第一个在该供应商审核通过的 locale 的内容，
+	// 都没有时使用默认内容
+	GetTemplateByIDAndProviderInfo(ctx context.Context, templateID, versionID int64, locale, providerName string, channel domain.Channel) (domain.ChannelTemplate, error)
 
 	// GetTemplateByID 根据ID获取模板
 	GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error)
@@ -37,9 +48,15 @@ type ChannelTemplateService interface {
 	// UpdateTemplate 更新模板
 	UpdateTemplate(ctx context.Context, template domain.ChannelTemplate) error
 
-	// PublishTemplate 发布模板
+	// PublishTemplate 发布模板，同时结束正在进行的 A/B 测试
 	PublishTemplate(ctx context.Context, templateID, versionID int64) error
 
+	// RollbackTemplate 把活跃版本回滚到之前审核通过的版本，记录操作人和原因，同时结束正在进行的 A/B 测试
+	RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64, reason string) error
+
+	// SetVersionWeights 按权重在多个审核通过的版本之间分配流量做 A/B 测试，weights 为空时结束 A/B 测试
+	SetVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error
+
 	// 模版版本相关方法
 
 	// ForkVersion 基于已有版本创建模版版本
@@ -48,6 +65,18 @@ type ChannelTemplateService interface {
 	// UpdateVersion 更新模板版本
 	UpdateVersion(ctx context.Context, version domain.ChannelTemplateVersion) error
 
+	// SaveLocale 创建或者更新版本某种语言的本地化内容，占位符必须和版本的默认内容一致
+	SaveLocale(ctx context.Context, locale domain.ChannelTemplateLocale) (domain.ChannelTemplateLocale, error)
+
+	// DeleteLocale 删除版本某种语言的本地化内容
+	DeleteLocale(ctx context.Context, versionID int64, locale string) error
+
+	// GetVersionHistory 获取模版的所有版本、审核结果以及活跃版本的切换记录
+	GetVersionHistory(ctx context.Context, templateID int64) (domain.TemplateVersionHistory, error)
+
+	// DiffVersions 比较同一个模版的两个版本的签名、内容和供应商
+	DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error)
+
 	// SubmitForInternalReview 提交内部审核
 	SubmitForInternalReview(ctx context.Context, versionID int64) error
 
@@ -71,7 +100,9 @@ type templateService struct {
 	repo        repository.ChannelTemplateRepository
 	providerSvc providersvc.Service
 	auditSvc    audit.Service
+	checker     compliance.Checker
 	smsClients  map[string]client.Client
+	fallbacks   domain.LocaleFallbacks
 }
 
 // NewChannelTemplateService 创建模板服务实例
@@ -79,13 +110,17 @@ func NewChannelTemplateService(
 	repo repository.ChannelTemplateRepository,
 	providerSvc providersvc.Service,
 	auditSvc audit.Service,
+	checker compliance.Checker,
 	smsClients map[string]client.Client,
+	fallbacks domain.LocaleFallbacks,
 ) ChannelTemplateService {
 	return &templateService{
 		repo:        repo,
 		providerSvc: providerSvc,
 		auditSvc:    auditSvc,
+		checker:     checker,
 		smsClients:  smsClients,
+		fallbacks:   fallbacks,
 	}
 }
 
@@ -109,7 +144,7 @@ func (t *templateService) GetTemplatesByOwner(ctx context.Context, ownerID int64
 	return templates, nil
 }
 
-func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
+func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID, versionID int64, locale, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
 	// 1. 获取模板基本信息
 	template, err := t.repo.GetTemplateByID(ctx, templateID)
 	if err != nil {
@@ -120,31 +155,43 @@ func (t *templateService) GetTemplateByIDAndProviderInfo(ctx context.Context, te
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d", errs.ErrTemplateNotFound, templateID)
 	}
 
-	// 2. 获取指定的版本信息
-	version, err := t.repo.GetTemplateVersionByID(ctx, template.ActiveVersionID)
+	// 2. 获取指定的版本信息，A/B 测试时通知上记录的版本不一定是活跃版本
+	if versionID == 0 {
+		versionID = template.ActiveVersionID
+	}
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
 	if err != nil {
 		return domain.ChannelTemplate{}, err
 	}
 
+	if version.ChannelTemplateID != templateID {
+		return domain.ChannelTemplate{}, fmt.Errorf("%w: templateID=%d, versionID=%d", errs.ErrTemplateAndVersionMisMatch, templateID, versionID)
+	}
+
 	if version.AuditStatus != domain.AuditStatusApproved {
 		return domain.ChannelTemplate{}, fmt.Errorf("%w: versionID=%d", errs.ErrTemplateVersionNotApprovedByPlatform, version.ID)
 	}
 
-	// 3. 获取指定供应商信息
-	providers, err := t.repo.GetProviderByNameAndChannel(ctx, templateID, version.ID, providerName, channel)
-	if err != nil {
-		return domain.ChannelTemplate{}, err
-	}
+	// 3. 按照降级规则找到第一个在指定供应商审核通过的语言，最后是默认内容
+	for _, candidate := range t.fallbacks.Chain(locale) {
+		if candidate != "" && version.GetLocale(candidate) == nil {
+			continue
+		}
+		providers, err1 := t.repo.GetProviderByNameAndChannel(ctx, templateID, version.ID, candidate, providerName, channel)
+		if err1 != nil {
+			return domain.ChannelTemplate{}, err1
+		}
+		if len(providers) == 0 {
+			continue
+		}
 
-	if len(providers) == 0 {
-		return domain.ChannelTemplate{}, fmt.Errorf("%w: providerName=%s, channel=%s", errs.ErrProviderNotFound, providerName, channel)
+		// 4. 组装完整模板
+		localized := version.Localize(candidate)
+		localized.Providers = providers
+		template.Versions = []domain.ChannelTemplateVersion{localized}
+		return template, nil
 	}
-
-	// 4. 组装完整模板
-	version.Providers = providers
-	template.Versions = []domain.ChannelTemplateVersion{version}
-
-	return template, nil
+	return domain.ChannelTemplate{}, fmt.Errorf("%w: providerName=%s, channel=%s, locale=%s", errs.ErrProviderNotFound, providerName, channel, locale)
 }
 
 func (t *templateService) GetTemplateByID(ctx context.Context, templateID int64) (domain.ChannelTemplate, error) {
@@ -273,8 +320,145 @@ func (t *templateService) PublishTemplate(ctx context.Context, templateID, versi
 	return nil
 }
 
+func (t *templateService) RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64, reason string) error {
+	if templateID <= 0 || versionID <= 0 {
+		return fmt.Errorf("%w: 模板ID和版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if operatorID <= 0 {
+		return fmt.Errorf("%w: 操作人ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if strings.TrimSpace(reason) == "" || utf8.RuneCountInString(reason) > maxReasonLength {
+		return fmt.Errorf("%w: 回滚原因不能为空，并且不能超过%d个字", errs.ErrInvalidParameter, maxReasonLength)
+	}
+
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if template.ActiveVersionID == 0 {
+		return fmt.Errorf("%w: 模版还没有发布，不能回滚", errs.ErrInvalidParameter)
+	}
+	if template.ActiveVersionID == versionID {
+		return fmt.Errorf("%w: 版本 %d 已经是活跃版本", errs.ErrInvalidParameter, versionID)
+	}
+
+	// 和发布一样，目标版本必须通过内部审核并且至少有一个供应商审核通过
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if version.ChannelTemplateID != templateID {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidParameter, errs.ErrTemplateAndVersionMisMatch)
+	}
+	if version.AuditStatus != domain.AuditStatusApproved {
+		return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByPlatform, versionID)
+	}
+	providers, err := t.repo.GetApprovedProvidersByTemplateIDAndVersionID(ctx, templateID, versionID)
+	if err != nil {
+		return err
+	}
+	if len(providers) == 0 {
+		return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByProvider, versionID)
+	}
+
+	err = t.repo.RollbackTemplateActiveVersion(ctx, domain.TemplatePublishRecord{
+		TemplateID:    templateID,
+		FromVersionID: template.ActiveVersionID,
+		ToVersionID:   versionID,
+		Action:        domain.TemplatePublishActionRollback,
+		OperatorID:    operatorID,
+		Reason:        reason,
+	})
+	if err != nil {
+		return fmt.Errorf("回滚模版失败: %w", err)
+	}
+	return nil
+}
+
+func (t *templateService) SetVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error {
+	if templateID <= 0 {
+		return fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	if len(weights) == 0 {
+		return t.repo.SetTemplateVersionWeights(ctx, templateID, nil)
+	}
+	if err := domain.ValidateVersionWeights(weights); err != nil {
+		return err
+	}
+
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	if !template.HasPublished() {
+		return fmt.Errorf("%w: 模版还没有发布，不能做 A/B 测试", errs.ErrInvalidParameter)
+	}
+	// 参与的版本必须和发布一样，通过内部审核并且至少有一个供应商审核通过
+	for _, w := range weights {
+		version := template.GetVersion(w.VersionID)
+		if version == nil {
+			return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateAndVersionMisMatch, w.VersionID)
+		}
+		if version.AuditStatus != domain.AuditStatusApproved {
+			return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByPlatform, w.VersionID)
+		}
+		if !slices.ContainsFunc(version.Providers, func(p domain.ChannelTemplateProvider) bool {
+			return p.AuditStatus == domain.AuditStatusApproved
+		}) {
+			return fmt.Errorf("%w: %w: 版本ID %d", errs.ErrInvalidParameter, errs.ErrTemplateVersionNotApprovedByProvider, w.VersionID)
+		}
+	}
+
+	if err = t.repo.SetTemplateVersionWeights(ctx, templateID, weights); err != nil {
+		return fmt.Errorf("设置 A/B 测试权重失败: %w", err)
+	}
+	return nil
+}
+
 // 模版版本相关方法
 
+func (t *templateService) GetVersionHistory(ctx context.Context, templateID int64) (domain.TemplateVersionHistory, error) {
+	if templateID <= 0 {
+		return domain.TemplateVersionHistory{}, fmt.Errorf("%w: 模板ID必须大于0", errs.ErrInvalidParameter)
+	}
+	template, err := t.repo.GetTemplateByID(ctx, templateID)
+	if err != nil {
+		return domain.TemplateVersionHistory{}, err
+	}
+	records, err := t.repo.GetPublishRecordsByTemplateID(ctx, templateID)
+	if err != nil {
+		return domain.TemplateVersionHistory{}, err
+	}
+	versions := template.Versions
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].ID > versions[j].ID
+	})
+	return domain.TemplateVersionHistory{
+		TemplateID:      template.ID,
+		ActiveVersionID: template.ActiveVersionID,
+		Versions:        versions,
+		PublishRecords:  records,
+	}, nil
+}
+
+func (t *templateService) DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error) {
+	if fromVersionID <= 0 || toVersionID <= 0 {
+		return domain.TemplateVersionDiff{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	from, err := t.repo.GetTemplateVersionByID(ctx, fromVersionID)
+	if err != nil {
+		return domain.TemplateVersionDiff{}, err
+	}
+	to, err := t.repo.GetTemplateVersionByID(ctx, toVersionID)
+	if err != nil {
+		return domain.TemplateVersionDiff{}, err
+	}
+	if from.ChannelTemplateID != to.ChannelTemplateID {
+		return domain.TemplateVersionDiff{}, fmt.Errorf("%w: 只能比较同一个模版的版本", errs.ErrInvalidParameter)
+	}
+	return from.Diff(to), nil
+}
+
 func (t *templateService) ForkVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error) {
 	return t.repo.ForkTemplateVersion(ctx, versionID)
 }
@@ -285,6 +469,12 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 		return fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
 	}
 
+	// 模版内容中的占位符必须合法
+	parsed, err := render.Parse(version.Content)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrInvalidParameter, err)
+	}
+
 	// 获取当前版本
 	currentVersion, err := t.repo.GetTemplateVersionByID(ctx, version.ID)
 	if err != nil {
@@ -296,6 +486,13 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 		return fmt.Errorf("%w: %w: 只有待审核或拒绝状态的版本可以修改", errs.ErrUpdateTemplateVersionFailed, errs.ErrInvalidOperation)
 	}
 
+	// 已有的本地化内容的占位符必须和新内容一致，否则按语言发送时参数对不上
+	for i := range currentVersion.Locales {
+		if err = checkLocalePlaceholders(parsed, currentVersion.Locales[i]); err != nil {
+			return err
+		}
+	}
+
 	// 允许更新部分字段
 	updateVersion := domain.ChannelTemplateVersion{
 		ID:        version.ID,
@@ -313,6 +510,91 @@ func (t *templateService) UpdateVersion(ctx context.Context, version domain.Chan
 	return nil
 }
 
+func (t *templateService) SaveLocale(ctx context.Context, locale domain.ChannelTemplateLocale) (domain.ChannelTemplateLocale, error) {
+	if locale.TemplateVersionID <= 0 {
+		return domain.ChannelTemplateLocale{}, fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	normalized, err := domain.NormalizeLocale(locale.Locale)
+	if err != nil {
+		return domain.ChannelTemplateLocale{}, err
+	}
+	if normalized == "" {
+		return domain.ChannelTemplateLocale{}, fmt.Errorf("%w: 语言不能为空", errs.ErrInvalidParameter)
+	}
+	locale.Locale = normalized
+
+	version, err := t.repo.GetTemplateVersionByID(ctx, locale.TemplateVersionID)
+	if err != nil {
+		return domain.ChannelTemplateLocale{}, err
+	}
+	// 和修改版本一样，只有PENDING或REJECTED状态的版本才能修改本地化内容
+	if version.AuditStatus != domain.AuditStatusPending && version.AuditStatus != domain.AuditStatusRejected {
+		return domain.ChannelTemplateLocale{}, fmt.Errorf("%w: 只有待审核或拒绝状态的版本可以修改本地化内容", errs.ErrInvalidOperation)
+	}
+	parsed, err := render.Parse(version.Content)
+	if err != nil {
+		return domain.ChannelTemplateLocale{}, fmt.Errorf("%w: %w", errs.ErrInvalidParameter, err)
+	}
+	if err = checkLocalePlaceholders(parsed, locale); err != nil {
+		return domain.ChannelTemplateLocale{}, err
+	}
+
+	// 本地化内容需要单独提交给默认内容关联的每个供应商审核
+	locale.TemplateID = version.ChannelTemplateID
+	providers := slice.Map(version.Providers, func(_ int, src domain.ChannelTemplateProvider) domain.ChannelTemplateProvider {
+		return domain.ChannelTemplateProvider{
+			TemplateID:        version.ChannelTemplateID,
+			TemplateVersionID: version.ID,
+			ProviderID:        src.ProviderID,
+			ProviderName:      src.ProviderName,
+			ProviderChannel:   src.ProviderChannel,
+			Locale:            locale.Locale,
+			AuditStatus:       domain.AuditStatusPending,
+		}
+	})
+	saved, err := t.repo.SaveTemplateLocale(ctx, locale, providers)
+	if err != nil {
+		return domain.ChannelTemplateLocale{}, fmt.Errorf("保存本地化内容失败: %w", err)
+	}
+	return saved, nil
+}
+
+// checkLocalePlaceholders 本地化内容的占位符必须和版本的默认内容一致
+func checkLocalePlaceholders(parsed *render.Template, locale domain.ChannelTemplateLocale) error {
+	localized, err := render.Parse(locale.Content)
+	if err != nil {
+		return fmt.Errorf("%w: 语言 %s: %w", errs.ErrInvalidParameter, locale.Locale, err)
+	}
+	want, got := parsed.Placeholders(), localized.Placeholders()
+	sort.Strings(want)
+	sort.Strings(got)
+	if !slices.Equal(want, got) {
+		return fmt.Errorf("%w: 语言 %s 的占位符 %v 和默认内容的占位符 %v 不一致", errs.ErrInvalidParameter, locale.Locale, got, want)
+	}
+	return nil
+}
+
+func (t *templateService) DeleteLocale(ctx context.Context, versionID int64, locale string) error {
+	if versionID <= 0 {
+		return fmt.Errorf("%w: 版本ID必须大于0", errs.ErrInvalidParameter)
+	}
+	normalized, err := domain.NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+	if normalized == "" {
+		return fmt.Errorf("%w: 语言不能为空", errs.ErrInvalidParameter)
+	}
+	version, err := t.repo.GetTemplateVersionByID(ctx, versionID)
+	if err != nil {
+		return err
+	}
+	if version.AuditStatus != domain.AuditStatusPending && version.AuditStatus != domain.AuditStatusRejected {
+		return fmt.Errorf("%w: 只有待审核或拒绝状态的版本可以修改本地化内容", errs.ErrInvalidOperation)
+	}
+	return t.repo.DeleteTemplateLocale(ctx, versionID, normalized)
+}
+
 func (t *templateService) BatchUpdateVersionAuditStatus(ctx context.Context, versions []domain.ChannelTemplateVersion) error {
 	if len(versions) == 0 {
 		return nil
@@ -350,7 +632,13 @@ func (t *templateService) SubmitForInternalReview(ctx context.Context, versionID
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
 	}
 
-	content, err := t.getJSONAuditContent(template, version, providers)
+	// 合规检查，默认内容和本地化内容都要检查，不合规直接驳回，其余问题标注在审核内容中
+	violations := t.check(ctx, template, version)
+	if err = violations.Err(); err != nil {
+		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
+	}
+
+	content, err := t.getJSONAuditContent(template, version, providers, violations.Warnings())
 	if err != nil {
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForInternalReviewFailed, err)
 	}
@@ -383,7 +671,9 @@ func (t *templateService) SubmitForInternalReview(ctx context.Context, versionID
 	return nil
 }
 
-func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, version domain.ChannelTemplateVersion, providers []domain.ChannelTemplateProvider) (string, error) {
+func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, version domain.ChannelTemplateVersion,
+	providers []domain.ChannelTemplateProvider, warnings []domain.ComplianceViolation,
+) (string, error) {
 	content := domain.AuditContent{
 		OwnerID:      template.OwnerID,
 		OwnerType:    template.OwnerType.String(),
@@ -395,8 +685,16 @@ func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, v
 		Signature:    version.Signature,
 		Content:      version.Content,
 		Remark:       version.Remark,
-		ProviderNames: slice.Map(providers, func(_ int, src domain.ChannelTemplateProvider) string {
-			return src.ProviderName
+		ProviderNames: slice.FilterMap(providers, func(_ int, src domain.ChannelTemplateProvider) (string, bool) {
+			return src.ProviderName, src.Locale == ""
+		}),
+		ComplianceWarnings: warnings,
+		Locales: slice.Map(version.Locales, func(_ int, src domain.ChannelTemplateLocale) domain.AuditLocaleContent {
+			return domain.AuditLocaleContent{
+				Locale:    src.Locale,
+				Signature: src.Signature,
+				Content:   src.Content,
+			}
 		}),
 	}
 	b, err := json.Marshal(content)
@@ -406,6 +704,19 @@ func (t *templateService) getJSONAuditContent(template domain.ChannelTemplate, v
 	return string(b), nil
 }
 
+// check 检查默认内容和所有本地化内容，本地化内容的问题带上语言
+func (t *templateService) check(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion) domain.ComplianceViolations {
+	violations := t.checker.Check(ctx, template, version)
+	for i := range version.Locales {
+		locale := version.Locales[i].Locale
+		for _, v := range t.checker.Check(ctx, template, version.Localize(locale)) {
+			v.Message = fmt.Sprintf("[%s] %s", locale, v.Message)
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
 // 供应商相关方法
 
 func (t *templateService) BatchSubmitForProviderReview(ctx context.Context, versionIDs []int64) error {
@@ -434,18 +745,61 @@ func (t *templateService) submitForProviderReview(ctx context.Context, versionID
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
 	}
 
+	// 提交之前再检查一次，合规规则可能在内部审核之后有变化。不合规的直接标记为拒绝，不浪费供应商的审核。
+	// 每种语言的内容作为单独的模版提交，分别检查
+	complianceErr := t.checker.Check(ctx, template, version).Err()
+	localeErrs := map[string]error{"": complianceErr}
 	for i := range providers {
-		if providers[i].AuditStatus == domain.AuditStatusPending ||
-			providers[i].AuditStatus == domain.AuditStatusRejected {
-			_ = t.submit(ctx, template, version, providers[i])
+		if providers[i].AuditStatus != domain.AuditStatusPending &&
+			providers[i].AuditStatus != domain.AuditStatusRejected {
+			continue
+		}
+		locale := providers[i].Locale
+		if locale != "" && version.GetLocale(locale) == nil {
+			continue
+		}
+		localized := version.Localize(locale)
+		localeErr, ok := localeErrs[locale]
+		if !ok {
+			localeErr = t.checker.Check(ctx, template, localized).Err()
+			localeErrs[locale] = localeErr
+		}
+		if localeErr != nil {
+			_ = t.rejectNonCompliant(ctx, providers[i], localeErr)
+			continue
 		}
+		_ = t.submit(ctx, template, localized, providers[i])
+	}
+	return complianceErr
+}
+
+func (t *templateService) rejectNonCompliant(ctx context.Context, provider domain.ChannelTemplateProvider, complianceErr error) error {
+	reason := []rune(complianceErr.Error())
+	if len(reason) > maxReasonLength {
+		reason = reason[:maxReasonLength]
+	}
+	err := t.repo.UpdateTemplateProviderAuditInfo(ctx, domain.ChannelTemplateProvider{
+		ID:           provider.ID,
+		AuditStatus:  domain.AuditStatusRejected,
+		RejectReason: string(reason),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: 更新供应商关联失败: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
 	}
 	return nil
 }
 
 func (t *templateService) submit(ctx context.Context, template domain.ChannelTemplate, version domain.ChannelTemplateVersion, provider domain.ChannelTemplateProvider) error {
-	// 当前仅支持SMS渠道
+	// 邮件、站内信没有供应商侧审核，内部审核通过即视为供应商审核通过
 	if provider.ProviderChannel != domain.ChannelSMS {
+		err := t.repo.UpdateTemplateProviderAuditInfo(ctx, domain.ChannelTemplateProvider{
+			ID:                       provider.ID,
+			AuditStatus:              domain.AuditStatusApproved,
+			LastReviewSubmissionTime: time.Now().Unix(),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: 更新供应商关联失败: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
+		}
 		return nil
 	}
 	// 获取对应的SMS客户端
@@ -454,9 +808,13 @@ func (t *templateService) submit(ctx context.Context, template domain.ChannelTem
 		return fmt.Errorf("%w: %w", errs.ErrSubmitVersionForProviderReviewFailed, err)
 	}
 
-	// 构建供应商审核请求并调用
+	// 构建供应商审核请求并调用，本地化内容在供应商侧是单独的模版，名称带上语言
+	templateName := version.Name
+	if provider.Locale != "" {
+		templateName = version.Name + "-" + provider.Locale
+	}
 	resp, err := cli.CreateTemplate(client.CreateTemplateReq{
-		TemplateName:    version.Name,
+		TemplateName:    templateName,
 		TemplateContent: t.replacePlaceholders(version.Content, provider),
 		TemplateType:    client.TemplateType(template.BusinessType),
 		Remark:          version.Remark,
@@ -194,6 +194,83 @@ func (c *MockChannelTemplateServiceCreateTemplateCall) DoAndReturn(f func(contex
 	return c
 }
 
+// DeleteLocale mocks base method.
+func (m *MockChannelTemplateService) DeleteLocale(ctx context.Context, versionID int64, locale string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLocale", ctx, versionID, locale)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLocale indicates an expected call of DeleteLocale.
+func (mr *MockChannelTemplateServiceMockRecorder) DeleteLocale(ctx, versionID, locale any) *MockChannelTemplateServiceDeleteLocaleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLocale", reflect.TypeOf((*MockChannelTemplateService)(nil).DeleteLocale), ctx, versionID, locale)
+	return &MockChannelTemplateServiceDeleteLocaleCall{Call: call}
+}
+
+// MockChannelTemplateServiceDeleteLocaleCall wrap *gomock.Call
+type MockChannelTemplateServiceDeleteLocaleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceDeleteLocaleCall) Return(arg0 error) *MockChannelTemplateServiceDeleteLocaleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceDeleteLocaleCall) Do(f func(context.Context, int64, string) error) *MockChannelTemplateServiceDeleteLocaleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceDeleteLocaleCall) DoAndReturn(f func(context.Context, int64, string) error) *MockChannelTemplateServiceDeleteLocaleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DiffVersions mocks base method.
+func (m *MockChannelTemplateService) DiffVersions(ctx context.Context, fromVersionID, toVersionID int64) (domain.TemplateVersionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffVersions", ctx, fromVersionID, toVersionID)
+	ret0, _ := ret[0].(domain.TemplateVersionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffVersions indicates an expected call of DiffVersions.
+func (mr *MockChannelTemplateServiceMockRecorder) DiffVersions(ctx, fromVersionID, toVersionID any) *MockChannelTemplateServiceDiffVersionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffVersions", reflect.TypeOf((*MockChannelTemplateService)(nil).DiffVersions), ctx, fromVersionID, toVersionID)
+	return &MockChannelTemplateServiceDiffVersionsCall{Call: call}
+}
+
+// MockChannelTemplateServiceDiffVersionsCall wrap *gomock.Call
+type MockChannelTemplateServiceDiffVersionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceDiffVersionsCall) Return(arg0 domain.TemplateVersionDiff, arg1 error) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceDiffVersionsCall) Do(f func(context.Context, int64, int64) (domain.TemplateVersionDiff, error)) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceDiffVersionsCall) DoAndReturn(f func(context.Context, int64, int64) (domain.TemplateVersionDiff, error)) *MockChannelTemplateServiceDiffVersionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ForkVersion mocks base method.
 func (m *MockChannelTemplateService) ForkVersion(ctx context.Context, versionID int64) (domain.ChannelTemplateVersion, error) {
 	m.ctrl.T.Helper()
@@ -313,18 +390,18 @@ func (c *MockChannelTemplateServiceGetTemplateByIDCall) DoAndReturn(f func(conte
 }
 
 // GetTemplateByIDAndProviderInfo mocks base method.
-func (m *MockChannelTemplateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID int64, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
+func (m *MockChannelTemplateService) GetTemplateByIDAndProviderInfo(ctx context.Context, templateID, versionID int64, locale, providerName string, channel domain.Channel) (domain.ChannelTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTemplateByIDAndProviderInfo", ctx, templateID, providerName, channel)
+	ret := m.ctrl.Call(m, "GetTemplateByIDAndProviderInfo", ctx, templateID, versionID, locale, providerName, channel)
 	ret0, _ := ret[0].(domain.ChannelTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTemplateByIDAndProviderInfo indicates an expected call of GetTemplateByIDAndProviderInfo.
-func (mr *MockChannelTemplateServiceMockRecorder) GetTemplateByIDAndProviderInfo(ctx, templateID, providerName, channel any) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (mr *MockChannelTemplateServiceMockRecorder) GetTemplateByIDAndProviderInfo(ctx, templateID, versionID, locale, providerName, channel any) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByIDAndProviderInfo", reflect.TypeOf((*MockChannelTemplateService)(nil).GetTemplateByIDAndProviderInfo), ctx, templateID, providerName, channel)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByIDAndProviderInfo", reflect.TypeOf((*MockChannelTemplateService)(nil).GetTemplateByIDAndProviderInfo), ctx, templateID, versionID, locale, providerName, channel)
 	return &MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall{Call: call}
 }
 
@@ -340,13 +417,13 @@ func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Return(ar
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Do(f func(context.Context, int64, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) Do(f func(context.Context, int64, int64, string, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) DoAndReturn(f func(context.Context, int64, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
+func (c *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall) DoAndReturn(f func(context.Context, int64, int64, string, string, domain.Channel) (domain.ChannelTemplate, error)) *MockChannelTemplateServiceGetTemplateByIDAndProviderInfoCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -390,6 +467,45 @@ func (c *MockChannelTemplateServiceGetTemplatesByOwnerCall) DoAndReturn(f func(c
 	return c
 }
 
+// GetVersionHistory mocks base method.
+func (m *MockChannelTemplateService) GetVersionHistory(ctx context.Context, templateID int64) (domain.TemplateVersionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionHistory", ctx, templateID)
+	ret0, _ := ret[0].(domain.TemplateVersionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionHistory indicates an expected call of GetVersionHistory.
+func (mr *MockChannelTemplateServiceMockRecorder) GetVersionHistory(ctx, templateID any) *MockChannelTemplateServiceGetVersionHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionHistory", reflect.TypeOf((*MockChannelTemplateService)(nil).GetVersionHistory), ctx, templateID)
+	return &MockChannelTemplateServiceGetVersionHistoryCall{Call: call}
+}
+
+// MockChannelTemplateServiceGetVersionHistoryCall wrap *gomock.Call
+type MockChannelTemplateServiceGetVersionHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceGetVersionHistoryCall) Return(arg0 domain.TemplateVersionHistory, arg1 error) *MockChannelTemplateServiceGetVersionHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceGetVersionHistoryCall) Do(f func(context.Context, int64) (domain.TemplateVersionHistory, error)) *MockChannelTemplateServiceGetVersionHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceGetVersionHistoryCall) DoAndReturn(f func(context.Context, int64) (domain.TemplateVersionHistory, error)) *MockChannelTemplateServiceGetVersionHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // PublishTemplate mocks base method.
 func (m *MockChannelTemplateService) PublishTemplate(ctx context.Context, templateID, versionID int64) error {
 	m.ctrl.T.Helper()
@@ -428,6 +544,121 @@ func (c *MockChannelTemplateServicePublishTemplateCall) DoAndReturn(f func(conte
 	return c
 }
 
+// RollbackTemplate mocks base method.
+func (m *MockChannelTemplateService) RollbackTemplate(ctx context.Context, templateID, versionID, operatorID int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTemplate", ctx, templateID, versionID, operatorID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackTemplate indicates an expected call of RollbackTemplate.
+func (mr *MockChannelTemplateServiceMockRecorder) RollbackTemplate(ctx, templateID, versionID, operatorID, reason any) *MockChannelTemplateServiceRollbackTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTemplate", reflect.TypeOf((*MockChannelTemplateService)(nil).RollbackTemplate), ctx, templateID, versionID, operatorID, reason)
+	return &MockChannelTemplateServiceRollbackTemplateCall{Call: call}
+}
+
+// MockChannelTemplateServiceRollbackTemplateCall wrap *gomock.Call
+type MockChannelTemplateServiceRollbackTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceRollbackTemplateCall) Return(arg0 error) *MockChannelTemplateServiceRollbackTemplateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceRollbackTemplateCall) Do(f func(context.Context, int64, int64, int64, string) error) *MockChannelTemplateServiceRollbackTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceRollbackTemplateCall) DoAndReturn(f func(context.Context, int64, int64, int64, string) error) *MockChannelTemplateServiceRollbackTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveLocale mocks base method.
+func (m *MockChannelTemplateService) SaveLocale(ctx context.Context, locale domain.ChannelTemplateLocale) (domain.ChannelTemplateLocale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLocale", ctx, locale)
+	ret0, _ := ret[0].(domain.ChannelTemplateLocale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveLocale indicates an expected call of SaveLocale.
+func (mr *MockChannelTemplateServiceMockRecorder) SaveLocale(ctx, locale any) *MockChannelTemplateServiceSaveLocaleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLocale", reflect.TypeOf((*MockChannelTemplateService)(nil).SaveLocale), ctx, locale)
+	return &MockChannelTemplateServiceSaveLocaleCall{Call: call}
+}
+
+// MockChannelTemplateServiceSaveLocaleCall wrap *gomock.Call
+type MockChannelTemplateServiceSaveLocaleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceSaveLocaleCall) Return(arg0 domain.ChannelTemplateLocale, arg1 error) *MockChannelTemplateServiceSaveLocaleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceSaveLocaleCall) Do(f func(context.Context, domain.ChannelTemplateLocale) (domain.ChannelTemplateLocale, error)) *MockChannelTemplateServiceSaveLocaleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceSaveLocaleCall) DoAndReturn(f func(context.Context, domain.ChannelTemplateLocale) (domain.ChannelTemplateLocale, error)) *MockChannelTemplateServiceSaveLocaleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetVersionWeights mocks base method.
+func (m *MockChannelTemplateService) SetVersionWeights(ctx context.Context, templateID int64, weights []domain.TemplateVersionWeight) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVersionWeights", ctx, templateID, weights)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVersionWeights indicates an expected call of SetVersionWeights.
+func (mr *MockChannelTemplateServiceMockRecorder) SetVersionWeights(ctx, templateID, weights any) *MockChannelTemplateServiceSetVersionWeightsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersionWeights", reflect.TypeOf((*MockChannelTemplateService)(nil).SetVersionWeights), ctx, templateID, weights)
+	return &MockChannelTemplateServiceSetVersionWeightsCall{Call: call}
+}
+
+// MockChannelTemplateServiceSetVersionWeightsCall wrap *gomock.Call
+type MockChannelTemplateServiceSetVersionWeightsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelTemplateServiceSetVersionWeightsCall) Return(arg0 error) *MockChannelTemplateServiceSetVersionWeightsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelTemplateServiceSetVersionWeightsCall) Do(f func(context.Context, int64, []domain.TemplateVersionWeight) error) *MockChannelTemplateServiceSetVersionWeightsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelTemplateServiceSetVersionWeightsCall) DoAndReturn(f func(context.Context, int64, []domain.TemplateVersionWeight) error) *MockChannelTemplateServiceSetVersionWeightsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SubmitForInternalReview mocks base method.
 func (m *MockChannelTemplateService) SubmitForInternalReview(ctx context.Context, versionID int64) error {
 	m.ctrl.T.Helper()
@@ -13,6 +13,7 @@ import (
 	clientv1 "gitee.com/flycash/notification-platform/api/proto/gen/client/v1"
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/pkg/retry"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	configmocks "gitee.com/flycash/notification-platform/internal/service/config/mocks"
 	callbackioc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/callback"
 	"gitee.com/flycash/notification-platform/internal/test/integration/testgrpc"
@@ -101,6 +102,16 @@ func (s *NotificationCallbackServiceTestSuite) createTestNotification(bizID int6
 	}
 }
 
+// markSuccess 模拟发送流程，只有发送中的通知才能标记为发送成功
+func (s *NotificationCallbackServiceTestSuite) markSuccess(t *testing.T, app *callbackioc.Service, notification domain.Notification) {
+	t.Helper()
+	err := s.db.Model(&dao.Notification{}).
+		Where("id = ?", notification.ID).
+		Update("status", domain.SendStatusSending.String()).Error
+	assert.NoError(t, err)
+	assert.NoError(t, app.NotificationRepo.MarkSuccess(t.Context(), notification))
+}
+
 func (s *NotificationCallbackServiceTestSuite) newService(ctrl *gomock.Controller) (*callbackioc.Service, *configmocks.MockBusinessConfigService) {
 	cfg := configmocks.NewMockBusinessConfigService(ctrl)
 	return callbackioc.Init(cfg), cfg
@@ -165,8 +176,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallback() {
 				assert.NoError(t, err)
 
 				// 将通知标记为发送成功，这会将回调日志状态从INIT改为PENDING
-				err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-				assert.NoError(t, err)
+				s.markSuccess(t, app, result)
 
 				// 查询回调日志
 				logs, err := app.Repo.FindByNotificationIDs(context.Background(), []uint64{result.ID})
@@ -290,8 +300,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotification()
 				assert.NoError(t, err)
 
 				// 将通知标记为发送成功，这会将回调日志状态从INIT改为PENDING
-				err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-				assert.NoError(t, err)
+				s.markSuccess(t, app, result)
 
 				// 查询回调日志确认状态
 				logs, err := app.Repo.FindByNotificationIDs(context.Background(), []uint64{result.ID})
@@ -417,8 +426,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotification()
 				assert.NoError(t, err)
 
 				// 将通知标记为发送成功，这会将回调日志状态从INIT改为PENDING
-				err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-				assert.NoError(t, err)
+				s.markSuccess(t, app, result)
 
 				// 获取完整的通知对象
 				return domain.Notification{
@@ -481,8 +489,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotification()
 				assert.NoError(t, err)
 
 				// 将通知标记为发送成功，这会将回调日志状态从INIT改为PENDING
-				err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-				assert.NoError(t, err)
+				s.markSuccess(t, app, result)
 
 				// 获取完整的通知对象
 				return domain.Notification{
@@ -596,8 +603,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotifications()
 					assert.NoError(t, err)
 
 					// 将通知标记为发送成功，这会将回调日志状态从INIT改为PENDING
-					err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-					assert.NoError(t, err)
+					s.markSuccess(t, app, result)
 
 					// 添加到通知列表
 					notifications = append(notifications, domain.Notification{
@@ -679,8 +685,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotifications()
 				assert.NoError(t, err)
 
 				// 将第一个通知标记为发送成功
-				err = app.NotificationRepo.MarkSuccess(context.Background(), result1)
-				assert.NoError(t, err)
+				s.markSuccess(t, app, result1)
 
 				notifications = append(notifications, domain.Notification{
 					ID:             result1.ID,
@@ -861,8 +866,7 @@ func (s *NotificationCallbackServiceTestSuite) TestSendCallbackByNotifications()
 					assert.NoError(t, err)
 
 					// 将通知标记为发送成功
-					err = app.NotificationRepo.MarkSuccess(context.Background(), result)
-					assert.NoError(t, err)
+					s.markSuccess(t, app, result)
 
 					notifications = append(notifications, domain.Notification{
 						ID:             result.ID,
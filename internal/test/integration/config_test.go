@@ -82,9 +82,9 @@ func (s *BusinessConfigTestSuite) createTestConfig() domain.BusinessConfig {
 		},
 		RateLimit: 2000,
 		Quota: &domain.QuotaConfig{
-			Monthly: domain.MonthlyConfig{
-				SMS:   100,
-				EMAIL: 100,
+			Monthly: map[domain.Channel]int32{
+				domain.ChannelSMS:   100,
+				domain.ChannelEmail: 100,
 			},
 		},
 		CallbackConfig: &domain.CallbackConfig{
@@ -133,9 +133,9 @@ func (s *BusinessConfigTestSuite) createTestConfigList() []domain.BusinessConfig
 			},
 			RateLimit: 2000,
 			Quota: &domain.QuotaConfig{
-				Monthly: domain.MonthlyConfig{
-					SMS:   100,
-					EMAIL: 100,
+				Monthly: map[domain.Channel]int32{
+					domain.ChannelSMS:   100,
+					domain.ChannelEmail: 100,
 				},
 			},
 			CallbackConfig: &domain.CallbackConfig{
@@ -182,9 +182,9 @@ func (s *BusinessConfigTestSuite) createTestConfigList() []domain.BusinessConfig
 			},
 			RateLimit: 2000,
 			Quota: &domain.QuotaConfig{
-				Monthly: domain.MonthlyConfig{
-					SMS:   100,
-					EMAIL: 100,
+				Monthly: map[domain.Channel]int32{
+					domain.ChannelSMS:   100,
+					domain.ChannelEmail: 100,
 				},
 			},
 			CallbackConfig: &domain.CallbackConfig{
@@ -231,9 +231,9 @@ func (s *BusinessConfigTestSuite) createTestConfigList() []domain.BusinessConfig
 			},
 			RateLimit: 2000,
 			Quota: &domain.QuotaConfig{
-				Monthly: domain.MonthlyConfig{
-					SMS:   100,
-					EMAIL: 100,
+				Monthly: map[domain.Channel]int32{
+					domain.ChannelSMS:   100,
+					domain.ChannelEmail: 100,
 				},
 			},
 			Ctime: 1744274114000,
@@ -270,9 +270,9 @@ func (s *BusinessConfigTestSuite) createTestConfigList() []domain.BusinessConfig
 			},
 			RateLimit: 2000,
 			Quota: &domain.QuotaConfig{
-				Monthly: domain.MonthlyConfig{
-					SMS:   100,
-					EMAIL: 100,
+				Monthly: map[domain.Channel]int32{
+					domain.ChannelSMS:   100,
+					domain.ChannelEmail: 100,
 				},
 			},
 			Ctime: 1744274114000,
@@ -367,9 +367,9 @@ func (s *BusinessConfigTestSuite) TestServiceSaveConfig() {
 				},
 				RateLimit: 6000,
 				Quota: &domain.QuotaConfig{
-					Monthly: domain.MonthlyConfig{
-						SMS:   200,
-						EMAIL: 200,
+					Monthly: map[domain.Channel]int32{
+						domain.ChannelSMS:   200,
+						domain.ChannelEmail: 200,
 					},
 				},
 				CallbackConfig: &domain.CallbackConfig{
@@ -416,9 +416,9 @@ func (s *BusinessConfigTestSuite) TestServiceSaveConfig() {
 					},
 					RateLimit: 6000,
 					Quota: &domain.QuotaConfig{
-						Monthly: domain.MonthlyConfig{
-							SMS:   200,
-							EMAIL: 200,
+						Monthly: map[domain.Channel]int32{
+							domain.ChannelSMS:   200,
+							domain.ChannelEmail: 200,
 						},
 					},
 					CallbackConfig: &domain.CallbackConfig{
@@ -511,9 +511,9 @@ func (s *BusinessConfigTestSuite) TestServiceGetByID() {
 				},
 				RateLimit: 2000,
 				Quota: &domain.QuotaConfig{
-					Monthly: domain.MonthlyConfig{
-						SMS:   100,
-						EMAIL: 100,
+					Monthly: map[domain.Channel]int32{
+						domain.ChannelSMS:   100,
+						domain.ChannelEmail: 100,
 					},
 				},
 				CallbackConfig: &domain.CallbackConfig{
@@ -0,0 +1,169 @@
+//go:build e2e
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
+	inboxioc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/inbox"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestInboxServiceSuite(t *testing.T) {
+	suite.Run(t, new(InboxServiceTestSuite))
+}
+
+type InboxServiceTestSuite struct {
+	suite.Suite
+	db  *egorm.Component
+	svc inboxsvc.Service
+}
+
+func (s *InboxServiceTestSuite) SetupSuite() {
+	s.db = testioc.InitDBAndTables()
+	s.svc = inboxioc.Init()
+}
+
+func (s *InboxServiceTestSuite) TearDownTest() {
+	s.db.Exec("TRUNCATE TABLE `inbox_messages`")
+}
+
+func (s *InboxServiceTestSuite) saveMessages(bizID int64, receiver string, notificationIDs ...uint64) {
+	msgs := make([]domain.InboxMessage, 0, len(notificationIDs))
+	for _, id := range notificationIDs {
+		msgs = append(msgs, domain.InboxMessage{
+			BizID:          bizID,
+			NotificationID: id,
+			Receiver:       receiver,
+			Title:          "标题",
+			Content:        "内容",
+			Status:         domain.InboxMessageStatusUnread,
+		})
+	}
+	require.NoError(s.T(), s.svc.Save(context.Background(), msgs))
+}
+
+func (s *InboxServiceTestSuite) TestSave() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const bizID, receiver = int64(1), "user-1"
+	s.saveMessages(bizID, receiver, 1, 2)
+	// 同一通知同一接收者重复保存不会产生新的站内信
+	s.saveMessages(bizID, receiver, 1)
+
+	msgs, total, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, msgs, 2)
+	// 按创建倒序
+	assert.Equal(t, uint64(2), msgs[0].NotificationID)
+	assert.Equal(t, uint64(1), msgs[1].NotificationID)
+	for _, msg := range msgs {
+		assert.NotZero(t, msg.ID)
+		assert.Equal(t, domain.InboxMessageStatusUnread, msg.Status)
+		assert.NotZero(t, msg.Ctime)
+	}
+
+	// 其他接收者看不到
+	_, total, err = s.svc.List(ctx, bizID, "user-2", false, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+}
+
+func (s *InboxServiceTestSuite) TestMarkReadAndUnread() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const bizID, receiver = int64(2), "user-1"
+	s.saveMessages(bizID, receiver, 1, 2, 3)
+
+	cnt, err := s.svc.UnreadCount(ctx, bizID, receiver)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), cnt)
+
+	msgs, _, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+	require.NoError(t, s.svc.MarkRead(ctx, bizID, receiver, []uint64{msgs[0].ID}))
+
+	cnt, err = s.svc.UnreadCount(ctx, bizID, receiver)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), cnt)
+
+	unread, total, err := s.svc.List(ctx, bizID, receiver, true, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	for _, msg := range unread {
+		assert.NotEqual(t, msgs[0].ID, msg.ID)
+	}
+
+	// ids 为空时全部标记为已读
+	require.NoError(t, s.svc.MarkRead(ctx, bizID, receiver, nil))
+	cnt, err = s.svc.UnreadCount(ctx, bizID, receiver)
+	require.NoError(t, err)
+	assert.Zero(t, cnt)
+
+	read, _, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+	for _, msg := range read {
+		assert.True(t, msg.Status.IsRead())
+		assert.NotZero(t, msg.ReadTime)
+	}
+
+	require.NoError(t, s.svc.MarkUnread(ctx, bizID, receiver, []uint64{msgs[1].ID}))
+	cnt, err = s.svc.UnreadCount(ctx, bizID, receiver)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cnt)
+
+	assert.ErrorIs(t, s.svc.MarkUnread(ctx, bizID, receiver, nil), errs.ErrInvalidParameter)
+}
+
+func (s *InboxServiceTestSuite) TestDelete() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const bizID, receiver = int64(3), "user-1"
+	s.saveMessages(bizID, receiver, 1, 2)
+	msgs, _, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+
+	// 不能删除其他接收者的站内信
+	require.NoError(t, s.svc.Delete(ctx, bizID, "user-2", []uint64{msgs[0].ID}))
+	_, total, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+
+	require.NoError(t, s.svc.Delete(ctx, bizID, receiver, []uint64{msgs[0].ID}))
+	left, total, err := s.svc.List(ctx, bizID, receiver, false, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, msgs[1].ID, left[0].ID)
+
+	assert.ErrorIs(t, s.svc.Delete(ctx, bizID, receiver, nil), errs.ErrInvalidParameter)
+}
+
+func (s *InboxServiceTestSuite) TestList_InvalidParameter() {
+	t := s.T()
+	ctx := context.Background()
+
+	_, _, err := s.svc.List(ctx, 0, "user-1", false, 0, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	_, _, err = s.svc.List(ctx, 1, "", false, 0, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	_, _, err = s.svc.List(ctx, 1, "user-1", false, -1, 10)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	_, _, err = s.svc.List(ctx, 1, "user-1", false, 0, 101)
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
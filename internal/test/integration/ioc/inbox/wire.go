@@ -0,0 +1,21 @@
+//go:build wireinject
+
+package inbox
+
+import (
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/google/wire"
+)
+
+func Init() inboxsvc.Service {
+	wire.Build(
+		testioc.BaseSet,
+		repository.NewInboxRepository,
+		inboxsvc.NewService,
+		dao.NewInboxDAO,
+	)
+	return nil
+}
@@ -0,0 +1,24 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package inbox
+
+import (
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
+	"gitee.com/flycash/notification-platform/internal/test/ioc"
+)
+
+// Injectors from wire.go:
+
+func Init() inbox.Service {
+	v := ioc.InitDBAndTables()
+	inboxDAO := dao.NewInboxDAO(v)
+	inboxRepository := repository.NewInboxRepository(inboxDAO)
+	service := inbox.NewService(inboxRepository)
+	return service
+}
@@ -3,11 +3,14 @@
 package notification
 
 import (
+	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/google/wire"
 )
@@ -27,6 +30,10 @@ func Init() *Service {
 		repository.NewNotificationRepository,
 		notification.NewNotificationService,
 		dao.NewNotificationDAO,
+		// 这里只测试通知的存储，修改通知时才会用到模版和退订
+		wire.InterfaceValue(new(manage.ChannelTemplateService), manage.ChannelTemplateService(nil)),
+		wire.InterfaceValue(new(suppression.Service), suppression.Service(nil)),
+		wire.Value(domain.LocaleFallbacks{}),
 
 		repository.NewQuotaRepositoryV2,
 
@@ -7,11 +7,14 @@
 package notification
 
 import (
+	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"gitee.com/flycash/notification-platform/internal/service/template/manage"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
 )
 
@@ -23,20 +26,29 @@ func Init() *Service {
 	cmdable := ioc.InitRedis()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
+	channelTemplateService := _wireChannelTemplateServiceValue
+	service := _wireServiceValue
+	localeFallbacks := _wireLocaleFallbacksValue
+	notificationService := notification.NewNotificationService(notificationRepository, channelTemplateService, service, localeFallbacks)
 	quotaRepository := repository.NewQuotaRepositoryV2(quotaCache)
 	callbackLogDAO := dao.NewCallbackLogDAO(v)
 	callbackLogRepository := repository.NewCallbackLogRepository(notificationRepository, callbackLogDAO)
-	notificationService := &Service{
-		Svc:             service,
+	service2 := &Service{
+		Svc:             notificationService,
 		QuotaCache:      quotaCache,
 		Repo:            notificationRepository,
 		QuotaRepo:       quotaRepository,
 		CallbackLogRepo: callbackLogRepository,
 	}
-	return notificationService
+	return service2
 }
 
+var (
+	_wireChannelTemplateServiceValue = manage.ChannelTemplateService(nil)
+	_wireServiceValue                = suppression.Service(nil)
+	_wireLocaleFallbacksValue        = domain.LocaleFallbacks{}
+)
+
 // wire.go:
 
 type Service struct {
@@ -5,14 +5,19 @@ package ioc
 import (
 	"time"
 
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/gotomicro/ego/core/econf"
 
 	grpcapi "gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
+	templateevt "gitee.com/flycash/notification-platform/internal/event/template"
 	prodioc "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
@@ -21,6 +26,7 @@ import (
 	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	configsvc "gitee.com/flycash/notification-platform/internal/service/config"
+	inboxsvc "gitee.com/flycash/notification-platform/internal/service/inbox"
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
@@ -28,8 +34,10 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
 	"github.com/google/wire"
 )
@@ -57,6 +65,7 @@ var (
 		repository.NewNotificationRepository,
 		dao.NewNotificationDAO,
 		notificationsvc.NewSendingTimeoutTask,
+		prodioc.InitRetryTask,
 	)
 	txNotificationSvcSet = wire.NewSet(
 		notificationsvc.NewTxNotificationService,
@@ -68,12 +77,19 @@ var (
 		newChannel,
 		newTaskPool,
 		sender.NewSender,
+		frequencycap.NewService,
+		redis.NewFrequencyCapCache,
 	)
 	sendNotificationSvcSet = wire.NewSet(
 		notificationsvc.NewSendService,
+		notificationsvc.NewPreviewService,
 		sendstrategy.NewDispatcher,
 		sendstrategy.NewImmediateStrategy,
 		sendstrategy.NewDefaultStrategy,
+		sendstrategy.NewRecurringStrategy,
+		quiethours.NewService,
+		repository.NewRecurringNotificationRepository,
+		dao.NewRecurringNotificationDAO,
 	)
 	callbackSvcSet = wire.NewSet(
 		callback.NewService,
@@ -90,17 +106,74 @@ var (
 	)
 	templateSvcSet = wire.NewSet(
 		templatesvc.NewChannelTemplateService,
+		compliance.NewChecker,
+		wire.Value(compliance.Config{}),
+		wire.Value(domain.LocaleFallbacks{}),
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
 	)
-	schedulerSet = wire.NewSet(scheduler.NewScheduler)
-	quotaSvcSet  = wire.NewSet(
+	inboxSvcSet = wire.NewSet(
+		inboxsvc.NewService,
+		repository.NewInboxRepository,
+		dao.NewInboxDAO,
+	)
+	receiptSvcSet = wire.NewSet(
+		receipt.NewService,
+		receipt.NewSyncTask,
+		repository.NewDeliveryReceiptRepository,
+		dao.NewDeliveryReceiptDAO,
+	)
+	schedulerSet = wire.NewSet(
+		scheduler.NewScheduler,
+		scheduler.NewRecurringScheduler,
+	)
+	quotaSvcSet = wire.NewSet(
 		quota.NewService,
 		quota.NewQuotaMonthlyResetCron,
 		repository.NewQuotaRepository,
-		dao.NewQuotaDAO)
+		dao.NewQuotaDAO,
+		grpcapi.NewQuotaServer)
+	suppressionSvcSet = wire.NewSet(
+		suppression.NewService,
+		suppression.DefaultAutoSuppressConfig,
+		repository.NewSuppressionRepository,
+		dao.NewSuppressionDAO,
+		redis.NewSuppressionCache,
+		grpcapi.NewSuppressionServer)
+	auditSvcSet = wire.NewSet(
+		auditsvc.NewService,
+		wire.Value(auditsvc.Config{}),
+		repository.NewAuditRepository,
+		dao.NewAuditDAO,
+		newKafkaProducer,
+		prodioc.InitAuditResultProducer,
+		newAuditResultConsumer,
+		grpcapi.NewAuditServer,
+	)
 )
 
+func newKafkaProducer() *kafka.Producer {
+	return testioc.InitProducer("notification-platform")
+}
+
+func newAuditResultConsumer(svc templatesvc.ChannelTemplateService) *templateevt.AuditResultConsumer {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  "localhost:9092",
+		"group.id":           "notification-platform-audit",
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": "false",
+	})
+	if err != nil {
+		panic(err)
+	}
+	const batchSize = 10
+	c, err := templateevt.NewAuditResultConsumer(svc, consumer, batchSize, time.Second)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
 func newTaskPool() pool.TaskPool {
 	type Config struct {
 		InitGo           int           `yaml:"initGo"`
@@ -151,7 +224,7 @@ func newSMSSelectorBuilder(
 			clients[k],
 		))
 	}
-	return sequential.NewSelectorBuilder(providers)
+	return sequential.NewSelectorBuilder(providers, nil)
 }
 
 func InitGrpcServer(clients map[string]client.Client) *testioc.App {
@@ -179,17 +252,26 @@ func InitGrpcServer(clients map[string]client.Client) *testioc.App {
 		templateSvcSet,
 
 		// 审计服务
-		auditsvc.NewService,
+		auditSvcSet,
 
 		// 事务通知服务
 		txNotificationSvcSet,
 
+		// 站内信服务
+		inboxSvcSet,
+
 		// 调度器
 		schedulerSet,
 
 		// 额度控制服务
 		quotaSvcSet,
 
+		// 退订名单服务
+		suppressionSvcSet,
+
+		// 供应商回执服务
+		receiptSvcSet,
+
 		// GRPC服务器
 		grpcapi.NewServer,
 		prodioc.InitGrpc,
@@ -7,10 +7,9 @@
 package ioc
 
 import (
-	"time"
-
 	"gitee.com/flycash/notification-platform/internal/api/grpc"
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/event/template"
 	ioc2 "gitee.com/flycash/notification-platform/internal/ioc"
 	"gitee.com/flycash/notification-platform/internal/repository"
 	"gitee.com/flycash/notification-platform/internal/repository/cache/local"
@@ -19,6 +18,8 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/channel"
 	"gitee.com/flycash/notification-platform/internal/service/config"
+	"gitee.com/flycash/notification-platform/internal/service/frequencycap"
+	"gitee.com/flycash/notification-platform/internal/service/inbox"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/notification/callback"
 	"gitee.com/flycash/notification-platform/internal/service/provider"
@@ -26,15 +27,21 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/provider/sequential"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/quiethours"
 	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"gitee.com/flycash/notification-platform/internal/service/receipt"
 	"gitee.com/flycash/notification-platform/internal/service/scheduler"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	"gitee.com/flycash/notification-platform/internal/service/sendstrategy"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/ecodeclub/ekit/pool"
 	"github.com/google/wire"
 	"github.com/gotomicro/ego/core/econf"
+	"time"
 )
 
 // Injectors from wire.go:
@@ -45,15 +52,28 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	cmdable := ioc2.InitRedisCmd()
 	quotaCache := redis.NewQuotaCache(cmdable)
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
-	service := notification.NewNotificationService(notificationRepository)
 	channelTemplateDAO := dao.NewChannelTemplateDAO(v)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
 	string2 := ioc2.InitProviderEncryptKey()
 	providerDAO := dao.NewProviderDAO(v, string2)
 	providerRepository := repository.NewProviderRepository(providerDAO)
-	manageService := manage.NewProviderService(providerRepository)
-	auditService := audit.NewService()
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, manageService, auditService, clients)
+	service := manage.NewProviderService(providerRepository)
+	auditDAO := dao.NewAuditDAO(v)
+	auditRepository := repository.NewAuditRepository(auditDAO)
+	producer := newKafkaProducer()
+	resultCallbackEventProducer := ioc2.InitAuditResultProducer(producer)
+	auditConfig := _wireConfigValue
+	auditService := audit.NewService(auditRepository, resultCallbackEventProducer, auditConfig)
+	complianceConfig := _wireComplianceConfigValue
+	checker := compliance.NewChecker(complianceConfig)
+	localeFallbacks := _wireLocaleFallbacksValue
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, service, auditService, checker, clients, localeFallbacks)
+	suppressionDAO := dao.NewSuppressionDAO(v)
+	suppressionCache := redis.NewSuppressionCache(cmdable)
+	suppressionRepository := repository.NewSuppressionRepository(suppressionDAO, suppressionCache)
+	autoSuppressConfig := suppression.DefaultAutoSuppressConfig()
+	suppressionService := suppression.NewService(suppressionRepository, channelTemplateService, autoSuppressConfig)
+	notificationService := notification.NewNotificationService(notificationRepository, channelTemplateService, suppressionService, localeFallbacks)
 	businessConfigDAO := dao.NewBusinessConfigDAO(v)
 	redisClient := ioc2.InitRedisClient()
 	cache := ioc2.InitGoCache()
@@ -66,26 +86,46 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	callbackService := callback.NewService(businessConfigService, callbackLogRepository)
 	channel := newChannel(channelTemplateService, clients)
 	taskPool := newTaskPool()
-	notificationSender := sender.NewSender(notificationRepository, businessConfigService, callbackService, channel, taskPool)
+	frequencyCapCache := redis.NewFrequencyCapCache(cmdable)
+	frequencycapService := frequencycap.NewService(businessConfigService, channelTemplateService, frequencyCapCache)
+	notificationSender := sender.NewSender(notificationRepository, businessConfigService, callbackService, channel, taskPool, frequencycapService, suppressionService)
 	immediateSendStrategy := sendstrategy.NewImmediateStrategy(notificationRepository, notificationSender)
-	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService)
-	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy)
-	sendService := notification.NewSendService(channelTemplateService, service, sendStrategy)
+	quiethoursService := quiethours.NewService(businessConfigService, channelTemplateService)
+	defaultSendStrategy := sendstrategy.NewDefaultStrategy(notificationRepository, businessConfigService, quiethoursService)
+	recurringNotificationDAO := dao.NewRecurringNotificationDAO(v)
+	recurringNotificationRepository := repository.NewRecurringNotificationRepository(recurringNotificationDAO)
+	recurringSendStrategy := sendstrategy.NewRecurringStrategy(recurringNotificationRepository)
+	sendStrategy := sendstrategy.NewDispatcher(immediateSendStrategy, defaultSendStrategy, recurringSendStrategy)
+	sendService := notification.NewSendService(channelTemplateService, notificationService, sendStrategy, suppressionService)
 	txNotificationDAO := dao.NewTxNotificationDAO(v)
 	txNotificationRepository := repository.NewTxNotificationRepository(txNotificationDAO)
 	dlockClient := ioc2.InitDistributedLock(redisClient)
-	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender)
-	notificationServer := grpc.NewServer(service, sendService, txNotificationService, channelTemplateService)
+	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, businessConfigService, notificationRepository, dlockClient, notificationSender, suppressionService)
+	inboxDAO := dao.NewInboxDAO(v)
+	inboxRepository := repository.NewInboxRepository(inboxDAO)
+	inboxService := inbox.NewService(inboxRepository)
+	previewService := notification.NewPreviewService(channelTemplateService, channel)
+	notificationServer := grpc.NewServer(notificationService, sendService, txNotificationService, channelTemplateService, inboxService, previewService, localeFallbacks)
+	quotaDAO := dao.NewQuotaDAO(v)
+	quotaRepository := repository.NewQuotaRepository(quotaDAO, quotaCache)
+	quotaService := quota.NewService(quotaRepository)
+	quotaServer := grpc.NewQuotaServer(quotaService)
+	suppressionServer := grpc.NewSuppressionServer(suppressionService)
+	auditServer := grpc.NewAuditServer(auditService)
 	component := ioc2.InitEtcdClient()
-	egrpcComponent := ioc2.InitGrpc(notificationServer, component)
+	egrpcComponent := ioc2.InitGrpc(notificationServer, quotaServer, suppressionServer, auditServer, component)
 	asyncRequestResultCallbackTask := callback.NewAsyncRequestResultCallbackTask(dlockClient, callbackService)
-	notificationScheduler := scheduler.NewScheduler(service, notificationSender, dlockClient)
+	notificationScheduler := scheduler.NewScheduler(notificationService, notificationSender, quiethoursService, dlockClient)
 	sendingTimeoutTask := notification.NewSendingTimeoutTask(dlockClient, notificationRepository)
 	txCheckTask := notification.NewTxCheckTask(txNotificationRepository, businessConfigService, dlockClient)
-	v2 := ioc2.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask)
-	quotaDAO := dao.NewQuotaDAO(v)
-	quotaRepository := repository.NewQuotaRepository(quotaDAO)
-	quotaService := quota.NewService(quotaRepository)
+	deliveryReceiptDAO := dao.NewDeliveryReceiptDAO(v)
+	deliveryReceiptRepository := repository.NewDeliveryReceiptRepository(deliveryReceiptDAO)
+	receiptService := receipt.NewService(deliveryReceiptRepository, notificationRepository, callbackService, clients)
+	syncTask := receipt.NewSyncTask(dlockClient, receiptService)
+	retryTask := ioc2.InitRetryTask(notificationRepository, notificationSender, quiethoursService, dlockClient)
+	recurringScheduler := scheduler.NewRecurringScheduler(recurringNotificationRepository, sendStrategy, dlockClient)
+	auditResultConsumer := newAuditResultConsumer(channelTemplateService)
+	v2 := ioc2.InitTasks(asyncRequestResultCallbackTask, notificationScheduler, sendingTimeoutTask, txCheckTask, syncTask, retryTask, recurringScheduler, auditResultConsumer)
 	monthlyResetCron := quota.NewQuotaMonthlyResetCron(businessConfigRepository, quotaService)
 	v3 := ioc2.Crons(monthlyResetCron, businessConfigRepository)
 	app := &ioc.App{
@@ -96,10 +136,10 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 		CallbackLogRepo:     callbackLogRepository,
 		ConfigSvc:           businessConfigService,
 		ConfigRepo:          businessConfigRepository,
-		NotificationSvc:     service,
+		NotificationSvc:     notificationService,
 		SendNotificationSvc: sendService,
 		NotificationRepo:    notificationRepository,
-		ProviderSvc:         manageService,
+		ProviderSvc:         service,
 		ProviderRepo:        providerRepository,
 		QuotaSvc:            quotaService,
 		QuotaRepo:           quotaRepository,
@@ -111,25 +151,57 @@ func InitGrpcServer(clients map[string]client.Client) *ioc.App {
 	return app
 }
 
+var (
+	_wireConfigValue           = audit.Config{}
+	_wireComplianceConfigValue = compliance.Config{}
+	_wireLocaleFallbacksValue  = domain.LocaleFallbacks{}
+)
+
 // wire.go:
 
 var (
 	BaseSet              = wire.NewSet(ioc2.InitDB, ioc2.InitDistributedLock, ioc2.InitEtcdClient, ioc2.InitIDGenerator, ioc2.InitRedisClient, ioc2.InitGoCache, ioc2.InitRedisCmd, local.NewLocalCache, redis.NewCache)
 	configSvcSet         = wire.NewSet(config.NewBusinessConfigService, repository.NewBusinessConfigRepository, dao.NewBusinessConfigDAO)
-	notificationSvcSet   = wire.NewSet(redis.NewQuotaCache, notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, notification.NewSendingTimeoutTask)
+	notificationSvcSet   = wire.NewSet(redis.NewQuotaCache, notification.NewNotificationService, repository.NewNotificationRepository, dao.NewNotificationDAO, notification.NewSendingTimeoutTask, ioc2.InitRetryTask)
 	txNotificationSvcSet = wire.NewSet(notification.NewTxNotificationService, repository.NewTxNotificationRepository, dao.NewTxNotificationDAO, notification.NewTxCheckTask)
 	senderSvcSet         = wire.NewSet(
 		newChannel,
-		newTaskPool, sender.NewSender,
+		newTaskPool, sender.NewSender, frequencycap.NewService, redis.NewFrequencyCapCache,
 	)
-	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy)
+	sendNotificationSvcSet = wire.NewSet(notification.NewSendService, notification.NewPreviewService, sendstrategy.NewDispatcher, sendstrategy.NewImmediateStrategy, sendstrategy.NewDefaultStrategy, sendstrategy.NewRecurringStrategy, quiethours.NewService, repository.NewRecurringNotificationRepository, dao.NewRecurringNotificationDAO)
 	callbackSvcSet         = wire.NewSet(callback.NewService, repository.NewCallbackLogRepository, dao.NewCallbackLogDAO, callback.NewAsyncRequestResultCallbackTask)
 	providerSvcSet         = wire.NewSet(manage.NewProviderService, repository.NewProviderRepository, dao.NewProviderDAO, ioc2.InitProviderEncryptKey)
-	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO)
-	schedulerSet           = wire.NewSet(scheduler.NewScheduler)
-	quotaSvcSet            = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO)
+	templateSvcSet         = wire.NewSet(manage2.NewChannelTemplateService, compliance.NewChecker, wire.Value(compliance.Config{}), wire.Value(domain.LocaleFallbacks{}), repository.NewChannelTemplateRepository, dao.NewChannelTemplateDAO)
+	inboxSvcSet            = wire.NewSet(inbox.NewService, repository.NewInboxRepository, dao.NewInboxDAO)
+	receiptSvcSet          = wire.NewSet(receipt.NewService, receipt.NewSyncTask, repository.NewDeliveryReceiptRepository, dao.NewDeliveryReceiptDAO)
+	schedulerSet           = wire.NewSet(scheduler.NewScheduler, scheduler.NewRecurringScheduler)
+	quotaSvcSet            = wire.NewSet(quota.NewService, quota.NewQuotaMonthlyResetCron, repository.NewQuotaRepository, dao.NewQuotaDAO, grpc.NewQuotaServer)
+	suppressionSvcSet      = wire.NewSet(suppression.NewService, suppression.DefaultAutoSuppressConfig, repository.NewSuppressionRepository, dao.NewSuppressionDAO, redis.NewSuppressionCache, grpc.NewSuppressionServer)
+	auditSvcSet            = wire.NewSet(audit.NewService, wire.Value(audit.Config{}), repository.NewAuditRepository, dao.NewAuditDAO, newKafkaProducer, ioc2.InitAuditResultProducer, newAuditResultConsumer, grpc.NewAuditServer)
 )
 
+func newKafkaProducer() *kafka.Producer {
+	return ioc.InitProducer("notification-platform")
+}
+
+func newAuditResultConsumer(svc manage2.ChannelTemplateService) *template.AuditResultConsumer {
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers":  "localhost:9092",
+		"group.id":           "notification-platform-audit",
+		"auto.offset.reset":  "earliest",
+		"enable.auto.commit": "false",
+	})
+	if err != nil {
+		panic(err)
+	}
+	const batchSize = 10
+	c, err := template.NewAuditResultConsumer(svc, consumer, batchSize, time.Second)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
 func newTaskPool() pool.TaskPool {
 	type Config struct {
 		InitGo           int           `yaml:"initGo"`
@@ -165,6 +237,7 @@ func newSMSSelectorBuilder(
 	templateSvc manage2.ChannelTemplateService,
 	clients map[string]client.Client,
 ) *sequential.SelectorBuilder {
+
 	providers := make([]provider.Provider, 0, len(clients))
 	for k := range clients {
 		providers = append(providers, sms.NewSMSProvider(
@@ -173,5 +246,5 @@ func newSMSSelectorBuilder(
 			clients[k],
 		))
 	}
-	return sequential.NewSelectorBuilder(providers)
+	return sequential.NewSelectorBuilder(providers, nil)
 }
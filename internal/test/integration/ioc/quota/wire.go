@@ -0,0 +1,23 @@
+//go:build wireinject
+
+package quota
+
+import (
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	quotasvc "gitee.com/flycash/notification-platform/internal/service/quota"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/google/wire"
+)
+
+func Init() quotasvc.Service {
+	wire.Build(
+		testioc.BaseSet,
+		redis.NewQuotaCache,
+		repository.NewQuotaRepository,
+		dao.NewQuotaDAO,
+		quotasvc.NewService,
+	)
+	return nil
+}
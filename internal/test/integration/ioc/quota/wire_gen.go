@@ -0,0 +1,27 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package quota
+
+import (
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"gitee.com/flycash/notification-platform/internal/service/quota"
+	"gitee.com/flycash/notification-platform/internal/test/ioc"
+)
+
+// Injectors from wire.go:
+
+func Init() quota.Service {
+	db := ioc.InitDBAndTables()
+	quotaDAO := dao.NewQuotaDAO(db)
+	cmdable := ioc.InitRedis()
+	quotaCache := redis.NewQuotaCache(cmdable)
+	quotaRepository := repository.NewQuotaRepository(quotaDAO, quotaCache)
+	service := quota.NewService(quotaRepository)
+	return service
+}
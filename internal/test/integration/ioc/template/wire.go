@@ -5,6 +5,7 @@ package template
 import (
 	"time"
 
+	"gitee.com/flycash/notification-platform/internal/domain"
 	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
 	templateevt "gitee.com/flycash/notification-platform/internal/event/template"
 	"gitee.com/flycash/notification-platform/internal/repository"
@@ -12,6 +13,7 @@ import (
 	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
 	providersvc "gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
@@ -37,6 +39,9 @@ func Init(
 	wire.Build(
 		testioc.BaseSet,
 		templatesvc.NewChannelTemplateService,
+		compliance.NewChecker,
+		wire.Value(compliance.Config{}),
+		wire.Value(domain.LocaleFallbacks{}),
 		repository.NewChannelTemplateRepository,
 		dao.NewChannelTemplateDAO,
 
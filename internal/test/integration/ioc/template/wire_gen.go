@@ -7,8 +7,7 @@
 package template
 
 import (
-	"time"
-
+	"gitee.com/flycash/notification-platform/internal/domain"
 	audit2 "gitee.com/flycash/notification-platform/internal/event/audit"
 	"gitee.com/flycash/notification-platform/internal/event/template"
 	"gitee.com/flycash/notification-platform/internal/repository"
@@ -16,18 +15,23 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/audit"
 	"gitee.com/flycash/notification-platform/internal/service/provider/manage"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
+	"gitee.com/flycash/notification-platform/internal/service/template/compliance"
 	manage2 "gitee.com/flycash/notification-platform/internal/service/template/manage"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"time"
 )
 
 // Injectors from wire.go:
 
 func Init(providerSvc manage.Service, auditSvc audit.Service, clients map[string]client.Client, producer *kafka.Producer, consumer *kafka.Consumer, batchSize int, batchTimeout time.Duration) (*Service, error) {
-	db := ioc.InitDBAndTables()
-	channelTemplateDAO := dao.NewChannelTemplateDAO(db)
+	v := ioc.InitDBAndTables()
+	channelTemplateDAO := dao.NewChannelTemplateDAO(v)
 	channelTemplateRepository := repository.NewChannelTemplateRepository(channelTemplateDAO)
-	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, providerSvc, auditSvc, clients)
+	config := _wireConfigValue
+	checker := compliance.NewChecker(config)
+	localeFallbacks := _wireLocaleFallbacksValue
+	channelTemplateService := manage2.NewChannelTemplateService(channelTemplateRepository, providerSvc, auditSvc, checker, clients, localeFallbacks)
 	auditResultConsumer, err := template.NewAuditResultConsumer(channelTemplateService, consumer, batchSize, batchTimeout)
 	if err != nil {
 		return nil, err
@@ -45,6 +49,11 @@ func Init(providerSvc manage.Service, auditSvc audit.Service, clients map[string
 	return service, nil
 }
 
+var (
+	_wireConfigValue          = compliance.Config{}
+	_wireLocaleFallbacksValue = domain.LocaleFallbacks{}
+)
+
 // wire.go:
 
 type Service struct {
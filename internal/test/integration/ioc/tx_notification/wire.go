@@ -9,6 +9,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/config"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
 	"github.com/google/wire"
 )
@@ -18,7 +19,7 @@ type App struct {
 	Task *notification.TxCheckTask
 }
 
-func InitTxNotificationService(configSvc config.BusinessConfigService, sender sender.NotificationSender) *App {
+func InitTxNotificationService(configSvc config.BusinessConfigService, sender sender.NotificationSender, suppressionSvc suppression.Service) *App {
 	wire.Build(
 		testioc.BaseSet,
 		dao.NewTxNotificationDAO,
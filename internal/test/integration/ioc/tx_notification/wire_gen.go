@@ -13,12 +13,13 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/config"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
+	"gitee.com/flycash/notification-platform/internal/service/suppression"
 	"gitee.com/flycash/notification-platform/internal/test/ioc"
 )
 
 // Injectors from wire.go:
 
-func InitTxNotificationService(configSvc config.BusinessConfigService, sender2 sender.NotificationSender) *App {
+func InitTxNotificationService(configSvc config.BusinessConfigService, sender2 sender.NotificationSender, suppressionSvc suppression.Service) *App {
 	v := ioc.InitDBAndTables()
 	txNotificationDAO := dao.NewTxNotificationDAO(v)
 	txNotificationRepository := repository.NewTxNotificationRepository(txNotificationDAO)
@@ -28,7 +29,7 @@ func InitTxNotificationService(configSvc config.BusinessConfigService, sender2 s
 	notificationRepository := repository.NewNotificationRepository(notificationDAO, quotaCache)
 	client := ioc.InitRedisClient()
 	dlockClient := ioc.InitDistributedLock(client)
-	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, configSvc, notificationRepository, dlockClient, sender2)
+	txNotificationService := notification.NewTxNotificationService(txNotificationRepository, configSvc, notificationRepository, dlockClient, sender2, suppressionSvc)
 	txCheckTask := notification.NewTask(txNotificationRepository, configSvc, dlockClient)
 	app := &App{
 		Svc:  txNotificationService,
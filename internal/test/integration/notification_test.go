@@ -13,6 +13,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
 	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
 
 	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
@@ -48,6 +49,7 @@ func (s *NotificationServiceTestSuite) TearDownTest() {
 	s.db.Exec("TRUNCATE TABLE `notifications`")
 	s.db.Exec("TRUNCATE TABLE `quotas`")
 	s.db.Exec("TRUNCATE TABLE `callback_logs`")
+	s.db.Exec("TRUNCATE TABLE `notification_receiver_results`")
 }
 
 // 创建测试用的通知对象
@@ -349,6 +351,12 @@ func (s *NotificationServiceTestSuite) TestRepositoryBatchUpdateStatusSucceededO
 	require.NoError(t, err)
 	require.Len(t, createdNotifications, len(notifications))
 
+	// 发送前先抢占为发送中，只有发送中的通知才能更新为终态
+	for i := range createdNotifications {
+		createdNotifications[i], err = s.repo.ClaimSending(ctx, createdNotifications[i])
+		require.NoError(t, err)
+	}
+
 	// 记录初始版本号
 	initialVersions := make(map[uint64]int)
 	for _, n := range createdNotifications {
@@ -389,7 +397,7 @@ func (s *NotificationServiceTestSuite) TestRepositoryBatchUpdateStatusSucceededO
 				// 验证其他通知状态未变
 				unchanged, err := s.repo.GetByID(ctx, createdNotifications[2].ID)
 				require.NoError(t, err)
-				assert.Equal(t, domain.SendStatusPending, unchanged.Status)
+				assert.Equal(t, domain.SendStatusSending, unchanged.Status)
 				assert.Equal(t, initialVersions[createdNotifications[2].ID], unchanged.Version)
 			},
 		},
@@ -784,6 +792,45 @@ func (s *NotificationServiceTestSuite) createTestQuota(t *testing.T, notificatio
 	return quota
 }
 
+func (s *NotificationServiceTestSuite) TestRepositoryQuotaExhaustedAndRefund() {
+	t := s.T()
+
+	bizID := int64(21)
+	first := s.createTestNotification(bizID)
+	require.NoError(t, s.quotaCache.CreateOrUpdate(t.Context(), domain.Quota{
+		BizID:   bizID,
+		Quota:   1,
+		Channel: first.Channel,
+	}))
+
+	created, err := s.repo.Create(t.Context(), first)
+	require.NoError(t, err)
+
+	// 额度用完后拒绝创建，且不会把额度扣成负数
+	_, err = s.repo.Create(t.Context(), s.createTestNotification(bizID))
+	assert.ErrorIs(t, err, errs.ErrNoQuota)
+	_, err = s.repo.BatchCreate(t.Context(), []domain.Notification{s.createTestNotification(bizID)})
+	assert.ErrorIs(t, err, errs.ErrNoQuota)
+	quota, err := s.quotaCache.Find(t.Context(), bizID, first.Channel)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), quota.Quota)
+
+	created, err = s.repo.ClaimSending(t.Context(), created)
+	require.NoError(t, err)
+	// 发送失败归还额度
+	created.Status = domain.SendStatusFailed
+	require.NoError(t, s.repo.MarkFailed(t.Context(), created))
+	quota, err = s.quotaCache.Find(t.Context(), bizID, first.Channel)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), quota.Quota)
+
+	// 已经不是 SENDING 的通知再批量标记为失败时不会重复归还额度
+	require.NoError(t, s.repo.BatchUpdateStatusSucceededOrFailed(t.Context(), nil, []domain.Notification{created}))
+	quota, err = s.quotaCache.Find(t.Context(), bizID, first.Channel)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), quota.Quota)
+}
+
 func (s *NotificationServiceTestSuite) TestRepositoryBatchCreateWithCallbackLog() {
 	t := s.T()
 
@@ -849,6 +896,8 @@ func (s *NotificationServiceTestSuite) TestRepositoryMarkFailed() {
 	require.NoError(t, err)
 	assert.Equal(t, initQuota-1, updatedQuota.Quota)
 
+	created, err = s.repo.ClaimSending(t.Context(), created)
+	require.NoError(t, err)
 	// 标记为失败
 	created.Status = domain.SendStatusFailed
 	err = s.repo.MarkFailed(t.Context(), created)
@@ -879,6 +928,8 @@ func (s *NotificationServiceTestSuite) TestRepositoryMarkSuccess() {
 	created, err := s.repo.CreateWithCallbackLog(t.Context(), notification)
 	require.NoError(t, err)
 
+	created, err = s.repo.ClaimSending(t.Context(), created)
+	require.NoError(t, err)
 	// 标记为成功
 	created.Status = domain.SendStatusSucceeded
 	err = s.repo.MarkSuccess(t.Context(), created)
@@ -902,6 +953,114 @@ func (s *NotificationServiceTestSuite) TestRepositoryMarkSuccess() {
 	assert.Equal(t, domain.CallbackLogStatusPending, logs[0].Status)
 }
 
+func (s *NotificationServiceTestSuite) TestRepositoryMarkPartialSuccess() {
+	t := s.T()
+
+	bizID := int64(19)
+	notification := s.createTestNotification(bizID)
+	notification.Receivers = []string{"13800138000", "13800138001"}
+	s.createTestQuota(t, notification)
+
+	created, err := s.repo.CreateWithCallbackLog(t.Context(), notification)
+	require.NoError(t, err)
+
+	created, err = s.repo.ClaimSending(t.Context(), created)
+	require.NoError(t, err)
+	// 标记为部分成功，并记录每个接收者的发送结果
+	created.Status = domain.SendStatusPartialSuccess
+	created.ReceiverResults = []domain.ReceiverResult{
+		{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Message: "发送成功", Provider: "aliyun"},
+		{Receiver: "13800138001", Status: domain.SendStatusFailed, Code: "isv.MOBILE_NUMBER_ILLEGAL", Message: "非法手机号", Provider: "aliyun"},
+	}
+	err = s.repo.MarkSuccess(t.Context(), created)
+	require.NoError(t, err)
+
+	updated, err := s.repo.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusPartialSuccess, updated.Status)
+	assert.Equal(t, created.ReceiverResults, updated.ReceiverResults)
+
+	found, err := s.repo.GetByKeys(t.Context(), bizID, created.Key)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, created.ReceiverResults, found[0].ReceiverResults)
+
+	// 部分成功同样需要回调业务方
+	logs, err := s.callbackLogRepo.FindByNotificationIDs(t.Context(), []uint64{created.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, domain.CallbackLogStatusPending, logs[0].Status)
+}
+
+func (s *NotificationServiceTestSuite) TestRepositorySaveDeliveryReceipts() {
+	t := s.T()
+
+	bizID := int64(20)
+	notification := s.createTestNotification(bizID)
+	notification.Receivers = []string{"13800138000", "13800138001"}
+	s.createTestQuota(t, notification)
+
+	created, err := s.repo.CreateWithCallbackLog(t.Context(), notification)
+	require.NoError(t, err)
+	created, err = s.repo.ClaimSending(t.Context(), created)
+	require.NoError(t, err)
+	created.Status = domain.SendStatusSucceeded
+	created.ReceiverResults = []domain.ReceiverResult{
+		{Receiver: "13800138000", Status: domain.SendStatusSucceeded, Code: "OK", Provider: "aliyun", MessageID: "biz-1"},
+		{Receiver: "13800138001", Status: domain.SendStatusSucceeded, Code: "OK", Provider: "aliyun", MessageID: "biz-1"},
+	}
+	require.NoError(t, s.repo.MarkSuccess(t.Context(), created))
+	// 模拟发送成功后已经回调过业务方
+	require.NoError(t, s.db.Model(&dao.CallbackLog{}).
+		Where("notification_id = ?", created.ID).
+		Update("status", domain.CallbackLogStatusSuccess.String()).Error)
+
+	receiptRepo := repository.NewDeliveryReceiptRepository(dao.NewDeliveryReceiptDAO(s.db))
+	awaiting, err := receiptRepo.FindAwaiting(t.Context(), []string{"aliyun"}, 0,
+		time.Now().Add(-time.Minute).UnixMilli(), time.Now().Add(time.Minute).UnixMilli(), 10)
+	require.NoError(t, err)
+	require.Len(t, awaiting, 2)
+	assert.Equal(t, created.ID, awaiting[0].NotificationID)
+	assert.Equal(t, "biz-1", awaiting[0].MessageID)
+
+	// 只有一个接收者拿到回执，通知状态不变
+	ids, err := receiptRepo.Save(t.Context(), []domain.DeliveryReceipt{
+		{Provider: "aliyun", MessageID: "biz-1", Receiver: "13800138000", Delivered: true, Code: "DELIVERED", Message: "用户接收成功"},
+		// 匹配不到的回执会被忽略
+		{Provider: "aliyun", MessageID: "biz-2", Receiver: "13800138001", Delivered: true},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	updated, err := s.repo.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusSucceeded, updated.Status)
+
+	// 所有接收者都拿到回执，通知进入最终状态，并重新回调业务方
+	ids, err = receiptRepo.Save(t.Context(), []domain.DeliveryReceipt{
+		{Provider: "aliyun", MessageID: "biz-1", Receiver: "+8613800138001", Delivered: false, Code: "MK:0001", Message: "空号"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{created.ID}, ids)
+	updated, err = s.repo.GetByID(t.Context(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusPartialSuccess, updated.Status)
+	assert.Equal(t, []domain.ReceiverResult{
+		{Receiver: "13800138000", Status: domain.SendStatusDelivered, Code: "DELIVERED", Message: "用户接收成功", Provider: "aliyun", MessageID: "biz-1"},
+		{Receiver: "13800138001", Status: domain.SendStatusUndelivered, Code: "MK:0001", Message: "空号", Provider: "aliyun", MessageID: "biz-1"},
+	}, updated.ReceiverResults)
+	logs, err := s.callbackLogRepo.FindByNotificationIDs(t.Context(), []uint64{created.ID})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, domain.CallbackLogStatusPending, logs[0].Status)
+
+	// 重复的回执不会再次改变状态
+	ids, err = receiptRepo.Save(t.Context(), []domain.DeliveryReceipt{
+		{Provider: "aliyun", MessageID: "biz-1", Receiver: "13800138001", Delivered: true},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
 func (s *NotificationServiceTestSuite) TestRepositoryFindReadyNotifications() {
 	t := s.T()
 
@@ -981,7 +1140,7 @@ func (s *NotificationServiceTestSuite) TestRepositoryMarkTimeoutSendingAsFailed(
 	notification := s.createTestNotification(bizID)
 
 	// 设置配额
-	_ = s.createTestQuota(t, notification)
+	quota := s.createTestQuota(t, notification)
 
 	// 创建通知
 	created, err := s.repo.Create(t.Context(), notification)
@@ -1006,6 +1165,13 @@ func (s *NotificationServiceTestSuite) TestRepositoryMarkTimeoutSendingAsFailed(
 	updated, err := s.repo.GetByID(t.Context(), created.ID)
 	require.NoError(t, err)
 	assert.Equal(t, domain.SendStatusFailed, updated.Status)
+	require.NotNil(t, updated.Error)
+	assert.Equal(t, domain.SendErrorCodeSendingTimeout, updated.Error.Code)
+
+	// 超时标记为失败时归还额度
+	found, err := s.quotaCache.Find(t.Context(), bizID, notification.Channel)
+	require.NoError(t, err)
+	assert.Equal(t, quota, found.Quota)
 }
 
 func (s *NotificationServiceTestSuite) TestServiceFindReadyNotifications() {
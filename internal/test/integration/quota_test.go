@@ -0,0 +1,166 @@
+//go:build e2e
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
+	quotasvc "gitee.com/flycash/notification-platform/internal/service/quota"
+	quotaioc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/quota"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/ego-component/egorm"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestQuotaServiceSuite(t *testing.T) {
+	suite.Run(t, new(QuotaServiceTestSuite))
+}
+
+type QuotaServiceTestSuite struct {
+	suite.Suite
+	db    *egorm.Component
+	redis redis.Cmdable
+	svc   quotasvc.Service
+}
+
+func (s *QuotaServiceTestSuite) SetupSuite() {
+	s.db = testioc.InitDBAndTables()
+	s.redis = testioc.InitRedis()
+	s.svc = quotaioc.Init()
+}
+
+func (s *QuotaServiceTestSuite) TearDownTest() {
+	s.db.Exec("TRUNCATE TABLE `quotas`")
+	s.db.Exec("TRUNCATE TABLE `quota_ledgers`")
+	s.redis.Del(context.Background(), "quota:7001:SMS", "quota:7001:EMAIL", "quota:7001:IN_APP",
+		"quota:daily_limit:7001:SMS", "quota:daily_limit:7001:IN_APP")
+}
+
+func (s *QuotaServiceTestSuite) TestResetAdjustAndListLedgers() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const bizID = int64(7001)
+	err := s.svc.ResetQuota(ctx, domain.BusinessConfig{
+		ID:    bizID,
+		Quota: &domain.QuotaConfig{Monthly: map[domain.Channel]int32{domain.ChannelSMS: 100, domain.ChannelEmail: 50}},
+	})
+	require.NoError(t, err)
+
+	quota, err := s.svc.GetQuota(ctx, bizID, domain.ChannelSMS)
+	require.NoError(t, err)
+	assert.Equal(t, int32(100), quota.Quota)
+
+	// 充值
+	quota, err = s.svc.AdjustQuota(ctx, bizID, domain.ChannelSMS, 20, "活动补充额度", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, int32(120), quota.Quota)
+
+	// 扣减
+	quota, err = s.svc.AdjustQuota(ctx, bizID, domain.ChannelSMS, -30, "误充值扣回", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, int32(90), quota.Quota)
+
+	// 扣减超过剩余额度，额度和流水都不变
+	_, err = s.svc.AdjustQuota(ctx, bizID, domain.ChannelSMS, -91, "扣减过多", "admin")
+	assert.ErrorIs(t, err, errs.ErrNoQuota)
+	quota, err = s.svc.GetQuota(ctx, bizID, domain.ChannelSMS)
+	require.NoError(t, err)
+	assert.Equal(t, int32(90), quota.Quota)
+
+	// 参数错误
+	_, err = s.svc.AdjustQuota(ctx, bizID, domain.ChannelSMS, 0, "无变化", "admin")
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+	_, err = s.svc.AdjustQuota(ctx, bizID, domain.ChannelSMS, 10, "", "admin")
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+
+	ledgers, total, err := s.svc.ListLedgers(ctx, bizID, domain.ChannelSMS, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, ledgers, 3)
+	// 按时间倒序
+	s.assertLedger(t, domain.QuotaLedger{
+		BizID: bizID, Channel: domain.ChannelSMS, Type: domain.QuotaLedgerTypeAdjust,
+		Delta: -30, Balance: 90, Reason: "误充值扣回", Operator: "admin",
+	}, ledgers[0])
+	s.assertLedger(t, domain.QuotaLedger{
+		BizID: bizID, Channel: domain.ChannelSMS, Type: domain.QuotaLedgerTypeAdjust,
+		Delta: 20, Balance: 120, Reason: "活动补充额度", Operator: "admin",
+	}, ledgers[1])
+	s.assertLedger(t, domain.QuotaLedger{
+		BizID: bizID, Channel: domain.ChannelSMS, Type: domain.QuotaLedgerTypeReset,
+		Delta: 100, Balance: 100, Reason: "重置额度", Operator: "system",
+	}, ledgers[2])
+
+	// 不指定渠道时查询所有渠道
+	_, total, err = s.svc.ListLedgers(ctx, bizID, "", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), total)
+
+	// 再次重置，变化量为重置前后的差值
+	err = s.svc.ResetQuota(ctx, domain.BusinessConfig{
+		ID:    bizID,
+		Quota: &domain.QuotaConfig{Monthly: map[domain.Channel]int32{domain.ChannelSMS: 100, domain.ChannelEmail: 50}},
+	})
+	require.NoError(t, err)
+	ledgers, _, err = s.svc.ListLedgers(ctx, bizID, domain.ChannelSMS, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, ledgers, 1)
+	s.assertLedger(t, domain.QuotaLedger{
+		BizID: bizID, Channel: domain.ChannelSMS, Type: domain.QuotaLedgerTypeReset,
+		Delta: 10, Balance: 100, Reason: "重置额度", Operator: "system",
+	}, ledgers[0])
+}
+
+func (s *QuotaServiceTestSuite) TestResetChannelQuotas() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	const bizID = int64(7001)
+	err := s.svc.ResetQuota(ctx, domain.BusinessConfig{
+		ID: bizID,
+		Quota: &domain.QuotaConfig{
+			Monthly: map[domain.Channel]int32{domain.ChannelSMS: 100, domain.ChannelInApp: 1000},
+			Daily:   map[domain.Channel]int32{domain.ChannelSMS: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	quota, err := s.svc.GetQuota(ctx, bizID, domain.ChannelSMS)
+	require.NoError(t, err)
+	assert.Equal(t, int32(100), quota.Quota)
+	assert.Equal(t, int32(10), quota.DailyLimit)
+
+	quota, err = s.svc.GetQuota(ctx, bizID, domain.ChannelInApp)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1000), quota.Quota)
+	assert.Zero(t, quota.DailyLimit)
+
+	// 没有配置额度的渠道
+	_, err = s.svc.GetQuota(ctx, bizID, domain.ChannelEmail)
+	assert.Error(t, err)
+
+	// 不支持的渠道
+	err = s.svc.ResetQuota(ctx, domain.BusinessConfig{
+		ID:    bizID,
+		Quota: &domain.QuotaConfig{Monthly: map[domain.Channel]int32{"FAX": 100}},
+	})
+	assert.ErrorIs(t, err, errs.ErrInvalidParameter)
+}
+
+func (s *QuotaServiceTestSuite) assertLedger(t *testing.T, expected, actual domain.QuotaLedger) {
+	t.Helper()
+	assert.NotZero(t, actual.ID)
+	assert.NotZero(t, actual.Ctime)
+	actual.ID, actual.Ctime = 0, 0
+	assert.Equal(t, expected, actual)
+}
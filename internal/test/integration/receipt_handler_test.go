@@ -0,0 +1,195 @@
+//go:build e2e
+
+package integration
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	receiptmocks "gitee.com/flycash/notification-platform/internal/service/receipt/mocks"
+	receiptweb "gitee.com/flycash/notification-platform/internal/web/receipt"
+	"github.com/ecodeclub/ekit/iox"
+	"github.com/ecodeclub/ekit/net/httpx/httptestx"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/gotomicro/ego/server/egin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReceiptHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptHandlerTestSuite))
+}
+
+type ReceiptHandlerTestSuite struct {
+	suite.Suite
+}
+
+const testReceiptToken = "receipt-token"
+
+func (s *ReceiptHandlerTestSuite) newGinServer(handler *receiptweb.Handler) *egin.Component {
+	econf.Set("server", map[string]any{"contextTimeout": "1s"})
+	server := egin.Load("server").Build()
+	handler.PublicRoutes(server.Engine)
+	return server
+}
+
+func (s *ReceiptHandlerTestSuite) TestHandler_HandleAliyunReports() {
+	t := s.T()
+
+	testCases := []struct {
+		name       string
+		newSvcFunc func(ctrl *gomock.Controller) *receiptmocks.MockService
+		req        any
+		wantResp   receiptweb.AliyunResp
+	}{
+		{
+			name: "处理成功",
+			newSvcFunc: func(ctrl *gomock.Controller) *receiptmocks.MockService {
+				svc := receiptmocks.NewMockService(ctrl)
+				svc.EXPECT().HandleReceipts(gomock.Any(), []domain.DeliveryReceipt{
+					{Provider: "aliyun", MessageID: "biz-1", Receiver: "13800138000", Delivered: true, Code: "DELIVERED", Message: "用户接收成功"},
+					{Provider: "aliyun", MessageID: "biz-1", Receiver: "13800138001", Delivered: false, Code: "MK:0001", Message: "空号"},
+				}).Return(nil)
+				return svc
+			},
+			req: []receiptweb.AliyunReport{
+				{PhoneNumber: "13800138000", Success: true, ErrCode: "DELIVERED", ErrMsg: "用户接收成功", BizID: "biz-1"},
+				{PhoneNumber: "13800138001", Success: false, ErrCode: "MK:0001", ErrMsg: "空号", BizID: "biz-1"},
+			},
+			wantResp: receiptweb.AliyunResp{Code: 0, Msg: "成功"},
+		},
+		{
+			name: "参数错误",
+			newSvcFunc: func(ctrl *gomock.Controller) *receiptmocks.MockService {
+				return receiptmocks.NewMockService(ctrl)
+			},
+			req:      map[string]any{"phone_number": "13800138000"},
+			wantResp: receiptweb.AliyunResp{Code: 1, Msg: "参数错误"},
+		},
+		{
+			name: "处理失败",
+			newSvcFunc: func(ctrl *gomock.Controller) *receiptmocks.MockService {
+				svc := receiptmocks.NewMockService(ctrl)
+				svc.EXPECT().HandleReceipts(gomock.Any(), gomock.Any()).Return(errors.New("mock db error"))
+				return svc
+			},
+			req: []receiptweb.AliyunReport{
+				{PhoneNumber: "13800138000", Success: true, BizID: "biz-1"},
+			},
+			wantResp: receiptweb.AliyunResp{Code: 1, Msg: "系统错误"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodPost, "/receipts/sms/aliyun/"+testReceiptToken, iox.NewJSONReader(tc.req))
+			require.NoError(t, err)
+			req.Header.Set("content-type", "application/json")
+
+			recorder := httptestx.NewJSONResponseRecorder[receiptweb.AliyunResp]()
+			server := s.newGinServer(receiptweb.NewHandler(tc.newSvcFunc(ctrl), receiptweb.Config{Token: testReceiptToken}))
+			server.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, tc.wantResp, recorder.MustScan())
+		})
+	}
+}
+
+func (s *ReceiptHandlerTestSuite) TestHandler_HandleTencentCloudReports() {
+	t := s.T()
+
+	testCases := []struct {
+		name       string
+		newSvcFunc func(ctrl *gomock.Controller) *receiptmocks.MockService
+		req        any
+		wantResp   receiptweb.TencentCloudResp
+	}{
+		{
+			name: "处理成功",
+			newSvcFunc: func(ctrl *gomock.Controller) *receiptmocks.MockService {
+				svc := receiptmocks.NewMockService(ctrl)
+				svc.EXPECT().HandleReceipts(gomock.Any(), []domain.DeliveryReceipt{
+					{Provider: "tencentcloud", MessageID: "sid-1", Receiver: "13800138000", Delivered: true, Code: "DELIVRD", Message: "用户短信送达成功"},
+					{Provider: "tencentcloud", MessageID: "sid-2", Receiver: "13800138001", Delivered: false, Code: "MN:0001", Message: "空号"},
+				}).Return(nil)
+				return svc
+			},
+			req: []receiptweb.TencentCloudReport{
+				{NationCode: "86", Mobile: "13800138000", ReportStatus: "SUCCESS", ErrMsg: "DELIVRD", Description: "用户短信送达成功", SID: "sid-1"},
+				{NationCode: "86", Mobile: "13800138001", ReportStatus: "FAIL", ErrMsg: "MN:0001", Description: "空号", SID: "sid-2"},
+			},
+			wantResp: receiptweb.TencentCloudResp{Result: 0, ErrMsg: "OK"},
+		},
+		{
+			name: "处理失败",
+			newSvcFunc: func(ctrl *gomock.Controller) *receiptmocks.MockService {
+				svc := receiptmocks.NewMockService(ctrl)
+				svc.EXPECT().HandleReceipts(gomock.Any(), gomock.Any()).Return(errors.New("mock db error"))
+				return svc
+			},
+			req: []receiptweb.TencentCloudReport{
+				{NationCode: "86", Mobile: "13800138000", ReportStatus: "SUCCESS", SID: "sid-1"},
+			},
+			wantResp: receiptweb.TencentCloudResp{Result: 1, ErrMsg: "系统错误"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodPost, "/receipts/sms/tencentcloud/"+testReceiptToken, iox.NewJSONReader(tc.req))
+			require.NoError(t, err)
+			req.Header.Set("content-type", "application/json")
+
+			recorder := httptestx.NewJSONResponseRecorder[receiptweb.TencentCloudResp]()
+			server := s.newGinServer(receiptweb.NewHandler(tc.newSvcFunc(ctrl), receiptweb.Config{Token: testReceiptToken}))
+			server.ServeHTTP(recorder, req)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, tc.wantResp, recorder.MustScan())
+		})
+	}
+}
+
+func (s *ReceiptHandlerTestSuite) TestHandler_CheckToken() {
+	t := s.T()
+
+	testCases := []struct {
+		name  string
+		path  string
+		token string
+	}{
+		{name: "阿里云口令错误", path: "/receipts/sms/aliyun/wrong-token", token: testReceiptToken},
+		{name: "腾讯云口令错误", path: "/receipts/sms/tencentcloud/wrong-token", token: testReceiptToken},
+		{name: "没有口令", path: "/receipts/sms/aliyun", token: testReceiptToken},
+		{name: "没有配置口令", path: "/receipts/sms/aliyun/", token: ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 口令不对时不会处理回执
+			req, err := http.NewRequest(http.MethodPost, tc.path, iox.NewJSONReader([]receiptweb.AliyunReport{
+				{PhoneNumber: "13800138000", Success: true, BizID: "biz-1"},
+			}))
+			require.NoError(t, err)
+			req.Header.Set("content-type", "application/json")
+
+			recorder := httptest.NewRecorder()
+			server := s.newGinServer(receiptweb.NewHandler(receiptmocks.NewMockService(ctrl), receiptweb.Config{Token: tc.token}))
+			server.ServeHTTP(recorder, req)
+
+			assert.NotEqual(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+//go:build e2e
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
+	shardingStr "gitee.com/flycash/notification-platform/internal/pkg/sharding"
+	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/dao"
+	"gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
+	"gitee.com/flycash/notification-platform/internal/service/notification"
+	quiethoursmocks "gitee.com/flycash/notification-platform/internal/service/quiethours/mocks"
+	sendermocks "gitee.com/flycash/notification-platform/internal/service/sender/mocks"
+	shardingIoc "gitee.com/flycash/notification-platform/internal/test/integration/ioc/sharding"
+	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
+	"github.com/ecodeclub/ekit/syncx"
+	"github.com/ego-component/egorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ShardingNotificationRetryTaskSuite struct {
+	suite.Suite
+	repo repository.NotificationRepository
+	nstr shardingStr.ShardingStrategy
+	dbs  *syncx.Map[string, *egorm.Component]
+}
+
+func (s *ShardingNotificationRetryTaskSuite) SetupSuite() {
+	s.dbs = shardingIoc.InitDbs()
+	s.repo = repository.NewNotificationRepository(sharding.NewNotificationTask(s.dbs), nil)
+	s.nstr, _ = shardingIoc.InitNotificationSharding()
+}
+
+func (s *ShardingNotificationRetryTaskSuite) TestHandleRetry() {
+	t := s.T()
+	const bizID = int64(40002)
+	now := time.Now()
+	dst := s.nstr.Shard(bizID, "retry_due")
+	gormDB, ok := s.dbs.Load(dst.DB)
+	require.True(t, ok)
+
+	newRetrying := func(id uint64, key string, nextRetryTime time.Time) dao.Notification {
+		return dao.Notification{
+			ID:                id,
+			BizID:             bizID,
+			Key:               key,
+			Receivers:         `["+8613812345678"]`,
+			Channel:           domain.ChannelSMS.String(),
+			TemplateID:        5001,
+			TemplateVersionID: 2,
+			TemplateParams:    `{"code":"123456"}`,
+			Status:            domain.SendStatusRetrying.String(),
+			RetryCount:        1,
+			NextRetryTime:     nextRetryTime.UnixMilli(),
+			Version:           1,
+			Ctime:             now.UnixMilli(),
+			Utime:             now.UnixMilli(),
+		}
+	}
+	due := newRetrying(177677855699636301, "retry_due", now.Add(-time.Minute))
+	quiet := newRetrying(177677855699636302, "retry_quiet", now.Add(-time.Minute))
+	notDue := newRetrying(177677855699636303, "retry_not_due", now.Add(time.Hour))
+	err := gormDB.WithContext(t.Context()).Table(dst.Table).
+		Where("biz_id = ?", bizID).Delete(&dao.Notification{}).Error
+	require.NoError(t, err)
+	for _, n := range []dao.Notification{due, quiet, notDue} {
+		require.NoError(t, gormDB.WithContext(t.Context()).Table(dst.Table).Create(&n).Error)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	// 落在免打扰时段内的重试推迟到一小时之后
+	quietEnd := now.Add(time.Hour)
+	quietHours := quiethoursmocks.NewMockService(ctrl)
+	quietHours.EXPECT().NextAllowedTime(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification, t time.Time) (time.Time, bool) {
+			if n.ID == quiet.ID {
+				return quietEnd, true
+			}
+			return t, false
+		}).AnyTimes()
+	var sent []uint64
+	notificationSender := sendermocks.NewMockNotificationSender(ctrl)
+	notificationSender.EXPECT().BatchSend(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, notifications []domain.Notification) ([]domain.SendResponse, error) {
+			for i := range notifications {
+				sent = append(sent, notifications[i].ID)
+			}
+			return nil, nil
+		})
+
+	redisClient := testioc.InitRedisClient()
+	task := notification.NewRetryTask(testioc.InitDistributedLock(redisClient), s.repo, notificationSender,
+		quietHours, loopjob.NewResourceSemaphore(1), s.nstr, 100)
+	// 分片循环任务会把分片放到 ctx 中
+	err = task.HandleRetry(shardingStr.CtxWithDst(t.Context(), dst))
+	require.NoError(t, err)
+
+	assert.Contains(t, sent, due.ID)
+	assert.NotContains(t, sent, quiet.ID)
+	assert.NotContains(t, sent, notDue.ID)
+
+	var deferred dao.Notification
+	err = gormDB.WithContext(t.Context()).Table(dst.Table).Where("id = ?", quiet.ID).First(&deferred).Error
+	require.NoError(t, err)
+	assert.Equal(t, domain.SendStatusRetrying.String(), deferred.Status)
+	assert.Equal(t, quietEnd.UnixMilli(), deferred.NextRetryTime)
+}
+
+func TestShardingNotificationRetryTask(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ShardingNotificationRetryTaskSuite))
+}
@@ -655,7 +655,7 @@ func (s *ShardingNotificationSuite) TestMarkSuccess() {
 		TemplateID:        5001,
 		TemplateVersionID: 2,
 		TemplateParams:    `{"order_id":"20230425001","amount":"299.00"}`,
-		Status:            "PENDING",
+		Status:            "SENDING", // 只有发送中的通知才能更新为终态
 		ScheduledSTime:    1735660800,
 		ScheduledETime:    1735747200,
 		Version:           1,
@@ -708,7 +708,7 @@ func (s *ShardingNotificationSuite) TestMarkFailed() {
 		TemplateID:        5001,
 		TemplateVersionID: 2,
 		TemplateParams:    `{"order_id":"20230425001","amount":"299.00"}`,
-		Status:            "PENDING",
+		Status:            "SENDING", // 只有发送中的通知才能更新为终态
 		ScheduledSTime:    1735660800,
 		ScheduledETime:    1735747200,
 		Version:           1,
@@ -744,7 +744,7 @@ func (s *ShardingNotificationSuite) TestBatchUpdateStatusSucceededOrFailed() {
 		TemplateID:        5001,
 		TemplateVersionID: 2,
 		TemplateParams:    `{"order_id":"20230425001","amount":"299.00"}`,
-		Status:            "PENDING",
+		Status:            "SENDING", // 只有发送中的通知才能更新为终态
 		ScheduledSTime:    1735660800,
 		ScheduledETime:    1735747200,
 		Version:           1,
@@ -761,7 +761,7 @@ func (s *ShardingNotificationSuite) TestBatchUpdateStatusSucceededOrFailed() {
 		TemplateID:        5001,
 		TemplateVersionID: 2,
 		TemplateParams:    `{"order_id":"20230425002","amount":"199.00"}`,
-		Status:            "PENDING",
+		Status:            "SENDING", // 只有发送中的通知才能更新为终态
 		ScheduledSTime:    1735660800,
 		ScheduledETime:    1735747200,
 		Version:           1,
@@ -778,7 +778,7 @@ func (s *ShardingNotificationSuite) TestBatchUpdateStatusSucceededOrFailed() {
 		TemplateID:        5001,
 		TemplateVersionID: 2,
 		TemplateParams:    `{"order_id":"20230425003","amount":"399.00"}`,
-		Status:            "PENDING",
+		Status:            "SENDING", // 只有发送中的通知才能更新为终态
 		ScheduledSTime:    1735660800,
 		ScheduledETime:    1735747200,
 		Version:           1,
@@ -799,12 +799,14 @@ func (s *ShardingNotificationSuite) TestBatchUpdateStatusSucceededOrFailed() {
 	failedNotifications := []dao.Notification{createdFailed1}
 
 	// Call the batch update method
-	err = s.shardingDAO.BatchUpdateStatusSucceededOrFailed(
+	failedIDs, err := s.shardingDAO.BatchUpdateStatusSucceededOrFailed(
 		t.Context(),
 		successNotifications,
 		failedNotifications,
 	)
 	require.NoError(t, err)
+	// 只有实际标记为失败的通知需要归还额度
+	assert.Equal(t, []uint64{createdFailed1.ID}, failedIDs)
 
 	retrieved1, err := s.shardingDAO.GetByID(t.Context(), createdSuccess1.ID)
 	require.NoError(t, err)
@@ -9,6 +9,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/pkg/loopjob"
 	shardingStr "gitee.com/flycash/notification-platform/internal/pkg/sharding"
 	"gitee.com/flycash/notification-platform/internal/repository"
+	"gitee.com/flycash/notification-platform/internal/repository/cache/redis"
 	"gitee.com/flycash/notification-platform/internal/repository/dao"
 	"gitee.com/flycash/notification-platform/internal/repository/dao/sharding"
 	"gitee.com/flycash/notification-platform/internal/service/notification"
@@ -33,7 +34,8 @@ func (s *ShardingNotificationTimeoutTaskSuite) SetupSuite() {
 	dbs := shardingIoc.InitDbs()
 	s.taskDao = sharding.NewNotificationTask(dbs)
 
-	repo := repository.NewNotificationRepository(s.taskDao, nil)
+	// 超时标记为失败时要归还额度
+	repo := repository.NewNotificationRepository(s.taskDao, redis.NewQuotaCache(testioc.InitRedis()))
 	redisClient := testioc.InitRedisClient()
 	lockClient := testioc.InitDistributedLock(redisClient)
 	nstr, _ := shardingIoc.InitNotificationSharding()
@@ -88,6 +90,7 @@ func (s *ShardingNotificationTimeoutTaskSuite) TestRepositoryMarkTimeoutSendingA
 	// 验证通知状态是否已更新为失败
 	require.NoError(t, err)
 	assert.Equal(t, domain.SendStatusFailed.String(), res.Status)
+	assert.Equal(t, domain.SendErrorCodeSendingTimeout.String(), res.ErrorCode)
 }
 
 func TestShardingNotificationTimeoutTask(t *testing.T) {
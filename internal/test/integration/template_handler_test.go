@@ -10,8 +10,11 @@ import (
 	"time"
 
 	"gitee.com/flycash/notification-platform/internal/domain"
+	"gitee.com/flycash/notification-platform/internal/errs"
 	auditevt "gitee.com/flycash/notification-platform/internal/event/audit"
+	"gitee.com/flycash/notification-platform/internal/pkg/render"
 	auditmocks "gitee.com/flycash/notification-platform/internal/service/audit/mocks"
+	notificationmocks "gitee.com/flycash/notification-platform/internal/service/notification/mocks"
 	providermocks "gitee.com/flycash/notification-platform/internal/service/provider/mocks"
 	"gitee.com/flycash/notification-platform/internal/service/provider/sms/client"
 	smsmocks "gitee.com/flycash/notification-platform/internal/service/provider/sms/client/mocks"
@@ -182,7 +185,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ListTemplates() {
 				})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.ListTemplatesReq{
@@ -284,7 +287,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_CreateTemplate() {
 					},
 				}, nil)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.CreateTemplateReq{
@@ -390,7 +393,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateTemplate() {
 				})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.UpdateTemplateReq{
@@ -409,7 +412,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateTemplate() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.UpdateTemplateReq{
@@ -1422,7 +1425,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 				err = svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.PublishTemplateReq{
@@ -1439,7 +1442,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.PublishTemplateReq{
@@ -1480,6 +1483,92 @@ func (s *TemplateHandlerTestSuite) TestHandler_PublishTemplate() {
 	}
 }
 
+func (s *TemplateHandlerTestSuite) TestHandler_Preview() {
+	t := s.T()
+
+	testCases := []struct {
+		name           string
+		newHandlerFunc func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler
+		req            templateweb.PreviewReq
+		wantCode       int
+		wantResp       test.Result[templateweb.PreviewResp]
+	}{
+		{
+			name: "预览成功",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
+				t.Helper()
+				previewSvc := notificationmocks.NewMockPreviewService(ctrl)
+				previewSvc.EXPECT().Preview(gomock.Any(), int64(1), int64(10), int64(0), map[string]string{"code": "123456"}).
+					Return(domain.Preview{
+						TemplateID:   10,
+						VersionID:    2,
+						Channel:      domain.ChannelSMS,
+						Signature:    "通知平台",
+						Content:      "您的验证码是123456",
+						Placeholders: []string{"code"},
+						ProviderName: "aliyun",
+					}, nil)
+				return templateweb.NewHandler(nil, previewSvc, nil, nil)
+			},
+			req: templateweb.PreviewReq{
+				BizID:      1,
+				TemplateID: 10,
+				Params:     map[string]string{"code": "123456"},
+			},
+			wantCode: 200,
+			wantResp: test.Result[templateweb.PreviewResp]{
+				Data: templateweb.PreviewResp{
+					TemplateID:   10,
+					VersionID:    2,
+					Channel:      domain.ChannelSMS.String(),
+					Signature:    "通知平台",
+					Content:      "您的验证码是123456",
+					Placeholders: []string{"code"},
+					ProviderName: "aliyun",
+				},
+			},
+		},
+		{
+			name: "参数错误",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
+				t.Helper()
+				previewSvc := notificationmocks.NewMockPreviewService(ctrl)
+				previewSvc.EXPECT().Preview(gomock.Any(), int64(1), int64(10), int64(0), gomock.Any()).
+					Return(domain.Preview{}, fmt.Errorf("%w: 缺少模版参数: code", errs.ErrInvalidParameter))
+				return templateweb.NewHandler(nil, previewSvc, nil, nil)
+			},
+			req: templateweb.PreviewReq{
+				BizID:      1,
+				TemplateID: 10,
+			},
+			wantCode: 200,
+			wantResp: test.Result[templateweb.PreviewResp]{
+				Code: templateweb.InvalidParameter.Code,
+				Msg:  fmt.Errorf("%w: 缺少模版参数: code", errs.ErrInvalidParameter).Error(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodPost,
+				"/templates/preview", iox.NewJSONReader(tc.req))
+			req.Header.Set("content-type", "application/json")
+			require.NoError(t, err)
+
+			recorder := test.NewJSONResponseRecorder[templateweb.PreviewResp]()
+			server := s.newGinServer(tc.newHandlerFunc(t, ctrl))
+			server.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.wantCode, recorder.Code)
+			assert.Equal(t, tc.wantResp, recorder.MustScan())
+		})
+	}
+}
+
 func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 	t := s.T()
 
@@ -1530,7 +1619,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 				err = svc.Repo.UpdateTemplateVersion(t.Context(), version)
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.ForkVersionReq{
@@ -1562,7 +1651,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_ForkVersion() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.ForkVersionReq{
@@ -1657,7 +1746,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 				require.NoError(t, err)
 				require.Len(t, templateFromDB.Versions, 1)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1687,7 +1776,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1703,6 +1792,28 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 			},
 			after: func(t *testing.T, expected templateweb.UpdateVersionReq, ctrl *gomock.Controller) {},
 		},
+		{
+			name: "模版内容占位符非法",
+			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
+				t.Helper()
+				svc, _, _, _ := s.newService(ctrl)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
+				return handler
+			},
+			req: templateweb.UpdateVersionReq{
+				VersionID: 1,
+				Name:      "更新后的版本名称",
+				Signature: "更新后的签名",
+				Content:   "更新后的内容${code",
+				Remark:    "更新后的备注信息",
+			},
+			wantCode: 200,
+			wantResp: test.Result[any]{
+				Code: templateweb.InvalidParameter.Code,
+				Msg:  fmt.Errorf("%w: %w: 占位符 %q 缺少结束符", errs.ErrInvalidParameter, render.ErrMalformedTemplate, "${code").Error(),
+			},
+			after: func(t *testing.T, expected templateweb.UpdateVersionReq, ctrl *gomock.Controller) {},
+		},
 		{
 			name: "更新已审核通过的版本",
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) *templateweb.Handler {
@@ -1741,7 +1852,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_UpdateVersion() {
 				err = svc.Repo.BatchUpdateTemplateVersionAuditInfo(t.Context(), []domain.ChannelTemplateVersion{version})
 				require.NoError(t, err)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler
 			},
 			req: templateweb.UpdateVersionReq{
@@ -1836,7 +1947,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				// 模拟审核服务
 				auditSvc.EXPECT().CreateAudit(gomock.Any(), gomock.Any()).Return(1, nil)
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1861,7 +1972,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 			newHandlerFunc: func(t *testing.T, ctrl *gomock.Controller) (*templateweb.Handler, int64) {
 				t.Helper()
 				svc, _, _, _ := s.newService(ctrl)
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler, 0
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1915,7 +2026,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 
 				// 第二次提交不需要mock审核服务，因为应该会在版本状态检查时就失败
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -1970,7 +2081,7 @@ func (s *TemplateHandlerTestSuite) TestHandler_SubmitForInternalReview() {
 				// 模拟审核服务返回错误
 				auditSvc.EXPECT().CreateAudit(gomock.Any(), gomock.Any()).Return(0, fmt.Errorf("模拟审核服务错误"))
 
-				handler := templateweb.NewHandler(svc.Svc)
+				handler := templateweb.NewHandler(svc.Svc, nil, nil, nil)
 				return handler, templateFromDB.Versions[0].ID
 			},
 			req: templateweb.SubmitForInternalReviewReq{
@@ -19,6 +19,7 @@ import (
 	"gitee.com/flycash/notification-platform/internal/service/notification"
 	"gitee.com/flycash/notification-platform/internal/service/sender"
 	sendermocks "gitee.com/flycash/notification-platform/internal/service/sender/mocks"
+	suppressionmocks "gitee.com/flycash/notification-platform/internal/service/suppression/mocks"
 	"gitee.com/flycash/notification-platform/internal/test/integration/ioc/tx_notification"
 	"gitee.com/flycash/notification-platform/internal/test/integration/testgrpc"
 	testioc "gitee.com/flycash/notification-platform/internal/test/ioc"
@@ -212,7 +213,7 @@ func (s *TxNotificationServiceTestSuite) TestPrepare() {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			configSvc := tc.configSvc(t, ctrl)
-			app := tx_notification.InitTxNotificationService(configSvc, nil)
+			app := tx_notification.InitTxNotificationService(configSvc, nil, s.noSuppression(ctrl))
 			id, err := app.Svc.Prepare(ctx, tc.input)
 			require.NoError(t, err)
 			tc.after(t, now, id)
@@ -220,6 +221,16 @@ func (s *TxNotificationServiceTestSuite) TestPrepare() {
 	}
 }
 
+// noSuppression 所有接收者都没有退订
+func (s *TxNotificationServiceTestSuite) noSuppression(ctrl *gomock.Controller) *suppressionmocks.MockService {
+	svc := suppressionmocks.NewMockService(ctrl)
+	svc.EXPECT().Filter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, n domain.Notification) ([]string, []domain.ReceiverResult, error) {
+			return n.Receivers, nil, nil
+		}).AnyTimes()
+	return svc
+}
+
 func (s *TxNotificationServiceTestSuite) TestCommit() {
 	testcases := []struct {
 		name      string
@@ -301,7 +312,7 @@ func (s *TxNotificationServiceTestSuite) TestCommit() {
 
 			configSvc := tc.configSvc(t, ctrl)
 			senderSvc := tc.sender(t, ctrl)
-			svc := tx_notification.InitTxNotificationService(configSvc, senderSvc)
+			svc := tx_notification.InitTxNotificationService(configSvc, senderSvc, s.noSuppression(ctrl))
 			err := svc.Svc.Commit(ctx, bizID, key)
 			hasError := tc.checkErr(t, err)
 			if !hasError {
@@ -388,7 +399,7 @@ func (s *TxNotificationServiceTestSuite) TestCancel() {
 			bizID, key := tc.before(t)
 
 			configSvc := tc.configSvc(t, ctrl)
-			svc := tx_notification.InitTxNotificationService(configSvc, nil)
+			svc := tx_notification.InitTxNotificationService(configSvc, nil, s.noSuppression(ctrl))
 			err := svc.Svc.Cancel(ctx, bizID, key)
 			hasError := tc.checkErr(t, err)
 			if !hasError {
@@ -474,7 +485,7 @@ func (s *TxNotificationServiceTestSuite) TestCheckBackTask() {
 	require.NoError(t, err)
 	s.mockNotifications()
 
-	txSvc := tx_notification.InitTxNotificationService(configSvc, nil)
+	txSvc := tx_notification.InitTxNotificationService(configSvc, nil, s.noSuppression(ctrl))
 
 	// 初始化注册中心
 	etcdClient := testioc.InitEtcdClient()
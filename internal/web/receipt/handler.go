@@ -0,0 +1,112 @@
+package receipt
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"gitee.com/flycash/notification-platform/internal/domain"
+	receiptsvc "gitee.com/flycash/notification-platform/internal/service/receipt"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/ecodeclub/ginx"
+	"github.com/gin-gonic/gin"
+	"github.com/gotomicro/ego/core/elog"
+)
+
+const (
+	providerAliyun       = "aliyun"
+	providerTencentCloud = "tencentcloud"
+)
+
+var _ ginx.Handler = &Handler{}
+
+// Config 回执接口的配置
+type Config struct {
+	// Token 供应商推送回执的地址 /receipts/sms/{供应商}/{token} 中的口令，
+	// 阿里云和腾讯云的短信回执都没有签名，只能通过只有供应商知道的地址防止伪造回执
+	Token string `yaml:"token"`
+}
+
+// Handler 接收供应商推送的回执，应答格式由供应商决定，所以不使用 ginx 的统一包装
+type Handler struct {
+	svc    receiptsvc.Service
+	token  string
+	logger *elog.Component
+}
+
+func NewHandler(svc receiptsvc.Service, cfg Config) *Handler {
+	return &Handler{
+		svc:    svc,
+		token:  cfg.Token,
+		logger: elog.DefaultLogger.With(elog.FieldComponent("receipt")),
+	}
+}
+
+func (h *Handler) PrivateRoutes(_ *gin.Engine) {
+}
+
+func (h *Handler) PublicRoutes(server *gin.Engine) {
+	g := server.Group("/receipts/sms")
+	g.POST("/"+providerAliyun+"/:token", h.checkToken, h.HandleAliyunReports)
+	g.POST("/"+providerTencentCloud+"/:token", h.checkToken, h.HandleTencentCloudReports)
+}
+
+// checkToken 地址中的口令不对时拒绝请求，没有配置口令时拒绝所有请求
+func (h *Handler) checkToken(ctx *gin.Context) {
+	token := ctx.Param("token")
+	if h.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+		h.logger.Warn("回执地址中的口令错误", elog.String("path", ctx.FullPath()), elog.String("ip", ctx.ClientIP()))
+		ctx.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	ctx.Next()
+}
+
+// HandleAliyunReports 处理阿里云推送的短信状态报告
+func (h *Handler) HandleAliyunReports(ctx *gin.Context) {
+	var reports []AliyunReport
+	if err := ctx.ShouldBindJSON(&reports); err != nil {
+		ctx.JSON(http.StatusOK, AliyunResp{Code: 1, Msg: "参数错误"})
+		return
+	}
+	receipts := slice.Map(reports, func(_ int, src AliyunReport) domain.DeliveryReceipt {
+		return domain.DeliveryReceipt{
+			Provider:  providerAliyun,
+			MessageID: src.BizID,
+			Receiver:  src.PhoneNumber,
+			Delivered: src.Success,
+			Code:      src.ErrCode,
+			Message:   src.ErrMsg,
+		}
+	})
+	if err := h.svc.HandleReceipts(ctx.Request.Context(), receipts); err != nil {
+		h.logger.Error("处理阿里云短信回执失败", elog.FieldErr(err))
+		ctx.JSON(http.StatusOK, AliyunResp{Code: 1, Msg: "系统错误"})
+		return
+	}
+	ctx.JSON(http.StatusOK, AliyunResp{Code: 0, Msg: "成功"})
+}
+
+// HandleTencentCloudReports 处理腾讯云推送的短信下发状态
+func (h *Handler) HandleTencentCloudReports(ctx *gin.Context) {
+	var reports []TencentCloudReport
+	if err := ctx.ShouldBindJSON(&reports); err != nil {
+		ctx.JSON(http.StatusOK, TencentCloudResp{Result: 1, ErrMsg: "参数错误"})
+		return
+	}
+	receipts := slice.Map(reports, func(_ int, src TencentCloudReport) domain.DeliveryReceipt {
+		return domain.DeliveryReceipt{
+			Provider:  providerTencentCloud,
+			MessageID: src.SID,
+			Receiver:  src.Mobile,
+			Delivered: src.ReportStatus == "SUCCESS",
+			Code:      src.ErrMsg,
+			Message:   src.Description,
+		}
+	})
+	if err := h.svc.HandleReceipts(ctx.Request.Context(), receipts); err != nil {
+		h.logger.Error("处理腾讯云短信回执失败", elog.FieldErr(err))
+		ctx.JSON(http.StatusOK, TencentCloudResp{Result: 1, ErrMsg: "系统错误"})
+		return
+	}
+	ctx.JSON(http.StatusOK, TencentCloudResp{Result: 0, ErrMsg: "OK"})
+}
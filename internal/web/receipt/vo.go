@@ -0,0 +1,37 @@
+package receipt
+
+// AliyunReport 阿里云短信状态报告，参考 https://help.aliyun.com/zh/sms/developer-reference/configure-delivery-receipts-1
+type AliyunReport struct {
+	PhoneNumber string `json:"phone_number"` // 手机号码
+	SendTime    string `json:"send_time"`    // 发送时间
+	ReportTime  string `json:"report_time"`  // 状态报告时间
+	Success     bool   `json:"success"`      // 是否接收成功
+	ErrCode     string `json:"err_code"`     // 错误码
+	ErrMsg      string `json:"err_msg"`      // 错误信息
+	SmsSize     string `json:"sms_size"`     // 长短信拆分条数
+	BizID       string `json:"biz_id"`       // 发送回执ID
+	OutID       string `json:"out_id"`       // 外部流水扩展字段
+}
+
+// AliyunResp 阿里云要求的应答格式，code 为 0 表示接收成功，否则会重推
+type AliyunResp struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// TencentCloudReport 腾讯云短信下发状态，参考 https://cloud.tencent.com/document/product/382/52077
+type TencentCloudReport struct {
+	UserReceiveTime string `json:"user_receive_time"` // 用户实际接收时间
+	NationCode      string `json:"nationcode"`        // 国家码
+	Mobile          string `json:"mobile"`            // 手机号码
+	ReportStatus    string `json:"report_status"`     // 实际是否收到短信接收状态，SUCCESS 或 FAIL
+	ErrMsg          string `json:"errmsg"`            // 用户接收短信状态码错误信息
+	Description     string `json:"description"`       // 用户接收短信状态描述
+	SID             string `json:"sid"`               // 本次发送标识 ID，即发送时返回的 SerialNo
+}
+
+// TencentCloudResp 腾讯云要求的应答格式，result 为 0 表示接收成功
+type TencentCloudResp struct {
+	Result int    `json:"result"`
+	ErrMsg string `json:"errmsg"`
+}
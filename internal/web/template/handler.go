@@ -6,6 +6,9 @@ import (
 
 	"gitee.com/flycash/notification-platform/internal/domain"
 	"gitee.com/flycash/notification-platform/internal/errs"
+	"gitee.com/flycash/notification-platform/internal/pkg/textdiff"
+	auditsvc "gitee.com/flycash/notification-platform/internal/service/audit"
+	notificationsvc "gitee.com/flycash/notification-platform/internal/service/notification"
 	templatesvc "gitee.com/flycash/notification-platform/internal/service/template/manage"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/ecodeclub/ginx"
@@ -16,11 +19,16 @@ import (
 var _ ginx.Handler = &Handler{}
 
 type Handler struct {
-	svc templatesvc.ChannelTemplateService
+	svc             templatesvc.ChannelTemplateService
+	previewSvc      notificationsvc.PreviewService
+	auditSvc        auditsvc.Service
+	notificationSvc notificationsvc.Service
 }
 
-func NewHandler(svc templatesvc.ChannelTemplateService) *Handler {
-	return &Handler{svc: svc}
+func NewHandler(svc templatesvc.ChannelTemplateService, previewSvc notificationsvc.PreviewService,
+	auditSvc auditsvc.Service, notificationSvc notificationsvc.Service,
+) *Handler {
+	return &Handler{svc: svc, previewSvc: previewSvc, auditSvc: auditSvc, notificationSvc: notificationSvc}
 }
 
 func (h *Handler) PrivateRoutes(_ *gin.Engine) {
@@ -32,11 +40,28 @@ func (h *Handler) PublicRoutes(server *gin.Engine) {
 	g.POST("/create", ginx.B[CreateTemplateReq](h.CreateTemplate))
 	g.POST("/update", ginx.B[UpdateTemplateReq](h.UpdateTemplate))
 	g.POST("/publish", ginx.B[PublishTemplateReq](h.PublishTemplate))
+	g.POST("/rollback", ginx.B[RollbackTemplateReq](h.RollbackTemplate))
+	g.POST("/weights", ginx.B[SetVersionWeightsReq](h.SetVersionWeights))
+	g.POST("/preview", ginx.B[PreviewReq](h.Preview))
 
 	j := g.Group("/versions")
 	j.POST("/fork", ginx.B[ForkVersionReq](h.ForkVersion))
 	j.POST("/update", ginx.B[UpdateVersionReq](h.UpdateVersion))
+	j.POST("/locales/save", ginx.B[SaveLocaleReq](h.SaveLocale))
+	j.POST("/locales/delete", ginx.B[DeleteLocaleReq](h.DeleteLocale))
 	j.POST("/review/internal", ginx.B[SubmitForInternalReviewReq](h.SubmitForInternalReview))
+	j.POST("/history", ginx.B[GetVersionHistoryReq](h.GetVersionHistory))
+	j.POST("/diff", ginx.B[DiffVersionsReq](h.DiffVersions))
+	j.POST("/stats", ginx.B[GetVersionStatsReq](h.GetVersionStats))
+
+	// 内置的内部审核流程
+	a := g.Group("/audits")
+	a.POST("/get", ginx.B[GetAuditReq](h.GetAudit))
+	a.POST("/list", ginx.B[ListAuditsReq](h.ListAudits))
+	a.POST("/assign", ginx.B[AssignAuditReq](h.AssignAudit))
+	a.POST("/approve", ginx.B[ApproveAuditReq](h.ApproveAudit))
+	a.POST("/reject", ginx.B[RejectAuditReq](h.RejectAudit))
+	a.POST("/comment", ginx.B[CommentAuditReq](h.CommentAudit))
 }
 
 // ListTemplates 获取所有模版
@@ -69,6 +94,9 @@ func (h *Handler) toTemplateVO(src domain.ChannelTemplate) ChannelTemplate {
 		Versions: slice.Map(src.Versions, func(_ int, src domain.ChannelTemplateVersion) ChannelTemplateVersion {
 			return h.toVersionVO(src)
 		}),
+		VersionWeights: slice.Map(src.VersionWeights, func(_ int, src domain.TemplateVersionWeight) TemplateVersionWeight {
+			return TemplateVersionWeight{VersionID: src.VersionID, Weight: src.Weight}
+		}),
 	}
 }
 
@@ -91,6 +119,25 @@ func (h *Handler) toVersionVO(src domain.ChannelTemplateVersion) ChannelTemplate
 		Providers: slice.Map(src.Providers, func(_ int, src domain.ChannelTemplateProvider) ChannelTemplateProvider {
 			return h.toProviderVO(src)
 		}),
+		Locales: slice.Map(src.Locales, func(_ int, src domain.ChannelTemplateLocale) ChannelTemplateLocale {
+			return h.toLocaleVO(src)
+		}),
+	}
+}
+
+func (h *Handler) toLocaleVO(src domain.ChannelTemplateLocale) ChannelTemplateLocale {
+	return ChannelTemplateLocale{
+		ID:                src.ID,
+		TemplateID:        src.TemplateID,
+		TemplateVersionID: src.TemplateVersionID,
+		Locale:            src.Locale,
+		Signature:         src.Signature,
+		Content:           src.Content,
+		Ctime:             src.Ctime,
+		Utime:             src.Utime,
+		Providers: slice.Map(src.Providers, func(_ int, src domain.ChannelTemplateProvider) ChannelTemplateProvider {
+			return h.toProviderVO(src)
+		}),
 	}
 }
 
@@ -102,6 +149,7 @@ func (h *Handler) toProviderVO(src domain.ChannelTemplateProvider) ChannelTempla
 		ProviderID:               src.ProviderID,
 		ProviderName:             src.ProviderName,
 		ProviderChannel:          src.ProviderChannel.String(),
+		Locale:                   src.Locale,
 		RequestID:                src.RequestID,
 		ProviderTemplateID:       src.ProviderTemplateID,
 		AuditStatus:              src.AuditStatus.String(),
@@ -166,6 +214,57 @@ func (h *Handler) PublishTemplate(ctx *ginx.Context, req PublishTemplateReq) (gi
 	}, nil
 }
 
+// RollbackTemplate 把活跃版本回滚到之前审核通过的版本
+func (h *Handler) RollbackTemplate(ctx *ginx.Context, req RollbackTemplateReq) (ginx.Result, error) {
+	err := h.svc.RollbackTemplate(ctx.Request.Context(), req.TemplateID, req.VersionID, req.OperatorID, req.Reason)
+	if err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// SetVersionWeights 设置 A/B 测试各个版本的流量权重，权重为空时结束 A/B 测试
+func (h *Handler) SetVersionWeights(ctx *ginx.Context, req SetVersionWeightsReq) (ginx.Result, error) {
+	weights := slice.Map(req.Weights, func(_ int, src TemplateVersionWeight) domain.TemplateVersionWeight {
+		return domain.TemplateVersionWeight{VersionID: src.VersionID, Weight: src.Weight}
+	})
+	if err := h.svc.SetVersionWeights(ctx.Request.Context(), req.TemplateID, weights); err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// Preview 预览模版渲染后的内容以及会使用的供应商，不会真正发送
+func (h *Handler) Preview(ctx *ginx.Context, req PreviewReq) (ginx.Result, error) {
+	preview, err := h.previewSvc.Preview(ctx.Request.Context(), req.BizID, req.TemplateID, req.VersionID, req.Params)
+	if err != nil {
+		// 参数问题直接返回给模版作者，方便修正
+		if errors.Is(err, errs.ErrInvalidParameter) || errors.Is(err, errs.ErrTemplateVersionNotFound) {
+			return ginx.Result{
+				Code: InvalidParameter.Code,
+				Msg:  err.Error(),
+			}, nil
+		}
+		return systemErrorResult, err
+	}
+	return ginx.Result{
+		Data: PreviewResp{
+			TemplateID:   preview.TemplateID,
+			VersionID:    preview.VersionID,
+			Channel:      preview.Channel.String(),
+			Signature:    preview.Signature,
+			Title:        preview.Title,
+			Content:      preview.Content,
+			Placeholders: preview.Placeholders,
+			ProviderName: preview.ProviderName,
+		},
+	}, nil
+}
+
 // ForkVersion 拷贝模版版本
 func (h *Handler) ForkVersion(ctx *ginx.Context, req ForkVersionReq) (ginx.Result, error) {
 	version, err := h.svc.ForkVersion(ctx.Request.Context(), req.VersionID)
@@ -190,6 +289,13 @@ func (h *Handler) UpdateVersion(ctx *ginx.Context, req UpdateVersionReq) (ginx.R
 	}
 
 	if err := h.svc.UpdateVersion(ctx.Request.Context(), version); err != nil {
+		// 模版内容有问题直接返回给模版作者修改
+		if errors.Is(err, errs.ErrInvalidParameter) {
+			return ginx.Result{
+				Code: InvalidParameter.Code,
+				Msg:  err.Error(),
+			}, nil
+		}
 		if !errors.Is(err, errs.ErrTemplateVersionNotFound) {
 			return systemErrorResult, err
 		}
@@ -200,9 +306,42 @@ func (h *Handler) UpdateVersion(ctx *ginx.Context, req UpdateVersionReq) (ginx.R
 	}, nil
 }
 
+// SaveLocale 创建或者更新版本某种语言的本地化内容，和默认内容一起提交审核
+func (h *Handler) SaveLocale(ctx *ginx.Context, req SaveLocaleReq) (ginx.Result, error) {
+	locale, err := h.svc.SaveLocale(ctx.Request.Context(), domain.ChannelTemplateLocale{
+		TemplateVersionID: req.VersionID,
+		Locale:            req.Locale,
+		Signature:         req.Signature,
+		Content:           req.Content,
+	})
+	if err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Data: SaveLocaleResp{Locale: h.toLocaleVO(locale)},
+	}, nil
+}
+
+// DeleteLocale 删除版本某种语言的本地化内容
+func (h *Handler) DeleteLocale(ctx *ginx.Context, req DeleteLocaleReq) (ginx.Result, error) {
+	if err := h.svc.DeleteLocale(ctx.Request.Context(), req.VersionID, req.Locale); err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
 // SubmitForInternalReview 提交内部审核
 func (h *Handler) SubmitForInternalReview(ctx *ginx.Context, req SubmitForInternalReviewReq) (ginx.Result, error) {
 	if err := h.svc.SubmitForInternalReview(ctx.Request.Context(), req.VersionID); err != nil {
+		// 不合规的地方直接返回给模版作者修改
+		if errors.Is(err, errs.ErrTemplateNonCompliant) {
+			return ginx.Result{
+				Code: InvalidParameter.Code,
+				Msg:  err.Error(),
+			}, nil
+		}
 		return systemErrorResult, err
 	}
 
@@ -210,3 +349,208 @@ func (h *Handler) SubmitForInternalReview(ctx *ginx.Context, req SubmitForIntern
 		Msg: "OK",
 	}, nil
 }
+
+// GetVersionHistory 查询模版的所有版本、审核结果以及活跃版本的切换记录
+func (h *Handler) GetVersionHistory(ctx *ginx.Context, req GetVersionHistoryReq) (ginx.Result, error) {
+	history, err := h.svc.GetVersionHistory(ctx.Request.Context(), req.TemplateID)
+	if err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Data: GetVersionHistoryResp{
+			TemplateID:      history.TemplateID,
+			ActiveVersionID: history.ActiveVersionID,
+			Versions: slice.Map(history.Versions, func(_ int, src domain.ChannelTemplateVersion) ChannelTemplateVersion {
+				return h.toVersionVO(src)
+			}),
+			PublishRecords: slice.Map(history.PublishRecords, func(_ int, src domain.TemplatePublishRecord) TemplatePublishRecord {
+				return TemplatePublishRecord{
+					ID:            src.ID,
+					FromVersionID: src.FromVersionID,
+					ToVersionID:   src.ToVersionID,
+					Action:        src.Action.String(),
+					OperatorID:    src.OperatorID,
+					Reason:        src.Reason,
+					Ctime:         src.Ctime,
+				}
+			}),
+		},
+	}, nil
+}
+
+// DiffVersions 比较同一个模版的两个版本
+func (h *Handler) DiffVersions(ctx *ginx.Context, req DiffVersionsReq) (ginx.Result, error) {
+	diff, err := h.svc.DiffVersions(ctx.Request.Context(), req.FromVersionID, req.ToVersionID)
+	if err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Data: DiffVersionsResp{
+			FromVersionID:    diff.FromVersionID,
+			ToVersionID:      diff.ToVersionID,
+			FromSignature:    diff.FromSignature,
+			ToSignature:      diff.ToSignature,
+			SignatureChanged: diff.SignatureChanged,
+			Content: slice.Map(diff.Content, func(_ int, src textdiff.Line) DiffLine {
+				return DiffLine{Op: string(src.Op), Text: src.Text}
+			}),
+			ContentChanged:   diff.ContentChanged,
+			ProvidersAdded:   diff.ProvidersAdded,
+			ProvidersRemoved: diff.ProvidersRemoved,
+		},
+	}, nil
+}
+
+// GetVersionStats 按版本统计模版的发送结果，用于比较 A/B 测试中各个版本的效果
+func (h *Handler) GetVersionStats(ctx *ginx.Context, req GetVersionStatsReq) (ginx.Result, error) {
+	stats, err := h.notificationSvc.GetTemplateVersionStats(ctx.Request.Context(), req.TemplateID, req.StartTime, req.EndTime)
+	if err != nil {
+		return h.versionErrorResult(err)
+	}
+	return ginx.Result{
+		Data: GetVersionStatsResp{
+			TemplateID: req.TemplateID,
+			Versions: slice.Map(stats, func(_ int, src domain.TemplateVersionStats) VersionStats {
+				counts := make(map[string]int64, len(src.Counts))
+				var total int64
+				for status, cnt := range src.Counts {
+					counts[status.String()] = cnt
+					total += cnt
+				}
+				return VersionStats{
+					VersionID:     src.VersionID,
+					Counts:        counts,
+					Total:         total,
+					SuccessRate:   src.SuccessRate(),
+					DeliveredRate: src.DeliveredRate(),
+				}
+			}),
+		},
+	}, nil
+}
+
+// versionErrorResult 参数不对、版本不存在或者活跃版本被并发修改，直接返回给模版作者
+func (h *Handler) versionErrorResult(err error) (ginx.Result, error) {
+	if errors.Is(err, errs.ErrInvalidParameter) ||
+		errors.Is(err, errs.ErrTemplateNotFound) ||
+		errors.Is(err, errs.ErrTemplateVersionNotFound) ||
+		errors.Is(err, errs.ErrTemplateActiveVersionChanged) ||
+		errors.Is(err, errs.ErrTemplateLocaleNotFound) ||
+		errors.Is(err, errs.ErrInvalidOperation) {
+		return ginx.Result{
+			Code: InvalidParameter.Code,
+			Msg:  err.Error(),
+		}, nil
+	}
+	return systemErrorResult, err
+}
+
+// GetAudit 查询审核记录以及所有审核意见
+func (h *Handler) GetAudit(ctx *ginx.Context, req GetAuditReq) (ginx.Result, error) {
+	a, err := h.auditSvc.GetByID(ctx.Request.Context(), req.AuditID)
+	if err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Data: GetAuditResp{Audit: h.toAuditVO(a)},
+	}, nil
+}
+
+// ListAudits 分页查询分配给审核人的审核记录
+func (h *Handler) ListAudits(ctx *ginx.Context, req ListAuditsReq) (ginx.Result, error) {
+	audits, total, err := h.auditSvc.ListByReviewer(ctx.Request.Context(), req.ReviewerID,
+		domain.AuditStatus(req.Status), req.Offset, req.Limit)
+	if err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Data: ListAuditsResp{
+			Audits: slice.Map(audits, func(_ int, src domain.Audit) Audit {
+				return h.toAuditVO(src)
+			}),
+			Total: total,
+		},
+	}, nil
+}
+
+// AssignAudit 重新分配审核人
+func (h *Handler) AssignAudit(ctx *ginx.Context, req AssignAuditReq) (ginx.Result, error) {
+	if err := h.auditSvc.Assign(ctx.Request.Context(), req.AuditID, req.ReviewerID); err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// ApproveAudit 审核通过
+func (h *Handler) ApproveAudit(ctx *ginx.Context, req ApproveAuditReq) (ginx.Result, error) {
+	if err := h.auditSvc.Approve(ctx.Request.Context(), req.AuditID, req.ReviewerID, req.Comment); err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// RejectAudit 审核拒绝
+func (h *Handler) RejectAudit(ctx *ginx.Context, req RejectAuditReq) (ginx.Result, error) {
+	if err := h.auditSvc.Reject(ctx.Request.Context(), req.AuditID, req.ReviewerID, req.Reason); err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+// CommentAudit 添加审核意见
+func (h *Handler) CommentAudit(ctx *ginx.Context, req CommentAuditReq) (ginx.Result, error) {
+	c, err := h.auditSvc.Comment(ctx.Request.Context(), req.AuditID, req.ReviewerID, req.Content)
+	if err != nil {
+		return h.auditErrorResult(err)
+	}
+	return ginx.Result{
+		Data: CommentAuditResp{Comment: h.toAuditCommentVO(c)},
+	}, nil
+}
+
+// auditErrorResult 审核人操作不当的错误直接返回给审核人
+func (h *Handler) auditErrorResult(err error) (ginx.Result, error) {
+	if errors.Is(err, errs.ErrInvalidParameter) ||
+		errors.Is(err, errs.ErrAuditNotFound) ||
+		errors.Is(err, errs.ErrAuditAlreadyDecided) ||
+		errors.Is(err, errs.ErrAuditReviewerMismatch) {
+		return ginx.Result{
+			Code: InvalidParameter.Code,
+			Msg:  err.Error(),
+		}, nil
+	}
+	return systemErrorResult, err
+}
+
+func (h *Handler) toAuditVO(src domain.Audit) Audit {
+	return Audit{
+		ID:           src.ID,
+		ResourceID:   src.ResourceID,
+		ResourceType: string(src.ResourceType),
+		Content:      src.Content,
+		ReviewerID:   src.ReviewerID,
+		Status:       src.Status.String(),
+		RejectReason: src.RejectReason,
+		AuditTime:    src.AuditTime,
+		Ctime:        src.Ctime,
+		Utime:        src.Utime,
+		Comments: slice.Map(src.Comments, func(_ int, src domain.AuditComment) AuditComment {
+			return h.toAuditCommentVO(src)
+		}),
+	}
+}
+
+func (h *Handler) toAuditCommentVO(src domain.AuditComment) AuditComment {
+	return AuditComment{
+		ID:         src.ID,
+		ReviewerID: src.ReviewerID,
+		Content:    src.Content,
+		Ctime:      src.Ctime,
+	}
+}
@@ -5,11 +5,13 @@ import (
 )
 
 const (
-	SYSTEMERRORCODE = 506001
+	SYSTEMERRORCODE           = 506001
+	INVALIDPARAMETERERRORCODE = 406001
 )
 
 var (
-	SystemError = ErrorCode{Code: SYSTEMERRORCODE, Msg: "系统错误"}
+	SystemError      = ErrorCode{Code: SYSTEMERRORCODE, Msg: "系统错误"}
+	InvalidParameter = ErrorCode{Code: INVALIDPARAMETERERRORCODE, Msg: "参数错误"}
 
 	systemErrorResult = ginx.Result{
 		Code: SystemError.Code,
@@ -23,7 +23,14 @@ type ChannelTemplate struct {
 	Ctime           int64  `json:"ctime"`           // 创建时间
 	Utime           int64  `json:"utime"`           // 更新时间
 
-	Versions []ChannelTemplateVersion `json:"versions"` // 关联的所有版本
+	Versions       []ChannelTemplateVersion `json:"versions"`       // 关联的所有版本
+	VersionWeights []TemplateVersionWeight  `json:"versionWeights"` // A/B 测试各个版本的流量权重，为空表示没有 A/B 测试
+}
+
+// TemplateVersionWeight A/B 测试中一个版本的流量权重
+type TemplateVersionWeight struct {
+	VersionID int64 `json:"versionId"` // 版本ID，必须审核通过
+	Weight    int64 `json:"weight"`    // 权重，流量按权重比例分配
 }
 
 type ForkVersionReq struct {
@@ -51,7 +58,22 @@ type ChannelTemplateVersion struct {
 	Ctime                    int64  `json:"ctime"`                    // 创建时间
 	Utime                    int64  `json:"utime"`                    // 更新时间
 
-	Providers []ChannelTemplateProvider `json:"providers"` // 关联的所有供应商
+	Providers []ChannelTemplateProvider `json:"providers"` // 默认内容关联的所有供应商
+	Locales   []ChannelTemplateLocale   `json:"locales"`   // 本地化内容
+}
+
+// ChannelTemplateLocale 模版版本某种语言的本地化内容
+type ChannelTemplateLocale struct {
+	ID                int64  `json:"id"`                // 本地化内容ID
+	TemplateID        int64  `json:"templateId"`        // 模板ID
+	TemplateVersionID int64  `json:"templateVersionId"` // 模版版本ID
+	Locale            string `json:"locale"`            // BCP-47 语言标签，如 zh-HK
+	Signature         string `json:"signature"`         // 签名，为空时使用版本的签名
+	Content           string `json:"content"`           // 模板内容
+	Ctime             int64  `json:"ctime"`             // 创建时间
+	Utime             int64  `json:"utime"`             // 更新时间
+
+	Providers []ChannelTemplateProvider `json:"providers"` // 本地化内容在各个供应商的审核信息
 }
 
 // ChannelTemplateProvider 渠道模板供应商关联
@@ -62,6 +84,7 @@ type ChannelTemplateProvider struct {
 	ProviderID               int64  `json:"providerId"`               // 供应商ID
 	ProviderName             string `json:"providerName"`             // 供应商名称
 	ProviderChannel          string `json:"providerChannel"`          // 供应商渠道类型
+	Locale                   string `json:"locale"`                   // 本地化内容的语言，为空表示默认内容
 	RequestID                string `json:"requestId"`                // 审核请求ID
 	ProviderTemplateID       string `json:"providerTemplateId"`       // 供应商侧模板ID
 	AuditStatus              string `json:"auditStatus"`              // 审核状态
@@ -100,6 +123,91 @@ type PublishTemplateReq struct {
 	VersionID  int64 `json:"versionId"`  // 版本ID
 }
 
+// RollbackTemplateReq 回滚模板请求
+type RollbackTemplateReq struct {
+	TemplateID int64  `json:"templateId"` // 模板ID
+	VersionID  int64  `json:"versionId"`  // 回滚到的版本ID，必须审核通过
+	OperatorID int64  `json:"operatorId"` // 操作人ID
+	Reason     string `json:"reason"`     // 回滚原因
+}
+
+// GetVersionHistoryReq 查询版本历史请求
+type GetVersionHistoryReq struct {
+	TemplateID int64 `json:"templateId"` // 模板ID
+}
+
+// GetVersionHistoryResp 查询版本历史响应，都按时间倒序
+type GetVersionHistoryResp struct {
+	TemplateID      int64                    `json:"templateId"`      // 模板ID
+	ActiveVersionID int64                    `json:"activeVersionId"` // 活跃版本ID
+	Versions        []ChannelTemplateVersion `json:"versions"`        // 所有版本以及审核结果
+	PublishRecords  []TemplatePublishRecord  `json:"publishRecords"`  // 活跃版本的切换记录
+}
+
+// TemplatePublishRecord 活跃版本的切换记录
+type TemplatePublishRecord struct {
+	ID            int64  `json:"id"`            // 记录ID
+	FromVersionID int64  `json:"fromVersionId"` // 切换前的活跃版本ID
+	ToVersionID   int64  `json:"toVersionId"`   // 切换后的活跃版本ID
+	Action        string `json:"action"`        // PUBLISH-发布，ROLLBACK-回滚
+	OperatorID    int64  `json:"operatorId"`    // 操作人ID
+	Reason        string `json:"reason"`        // 回滚原因
+	Ctime         int64  `json:"ctime"`         // 操作时间
+}
+
+// DiffVersionsReq 比较版本请求
+type DiffVersionsReq struct {
+	FromVersionID int64 `json:"fromVersionId"` // 旧版本ID
+	ToVersionID   int64 `json:"toVersionId"`   // 新版本ID
+}
+
+// DiffVersionsResp 比较版本响应
+type DiffVersionsResp struct {
+	FromVersionID    int64      `json:"fromVersionId"`
+	ToVersionID      int64      `json:"toVersionId"`
+	FromSignature    string     `json:"fromSignature"`
+	ToSignature      string     `json:"toSignature"`
+	SignatureChanged bool       `json:"signatureChanged"`
+	Content          []DiffLine `json:"content"` // 按行比较的内容
+	ContentChanged   bool       `json:"contentChanged"`
+	ProvidersAdded   []string   `json:"providersAdded"`   // 新版本增加的供应商
+	ProvidersRemoved []string   `json:"providersRemoved"` // 新版本去掉的供应商
+}
+
+// DiffLine 内容差异中的一行
+type DiffLine struct {
+	Op   string `json:"op"`   // =-没有变化，+-新增，--删除
+	Text string `json:"text"` // 行内容
+}
+
+// SetVersionWeightsReq 设置 A/B 测试权重请求
+type SetVersionWeightsReq struct {
+	TemplateID int64                   `json:"templateId"` // 模板ID
+	Weights    []TemplateVersionWeight `json:"weights"`    // 至少两个版本，为空时结束 A/B 测试
+}
+
+// GetVersionStatsReq 查询版本发送统计请求
+type GetVersionStatsReq struct {
+	TemplateID int64 `json:"templateId"` // 模板ID
+	StartTime  int64 `json:"startTime"`  // 统计开始时间，毫秒，包含
+	EndTime    int64 `json:"endTime"`    // 统计结束时间，毫秒，不包含，最多统计31天
+}
+
+// GetVersionStatsResp 查询版本发送统计响应，按版本ID升序
+type GetVersionStatsResp struct {
+	TemplateID int64          `json:"templateId"`
+	Versions   []VersionStats `json:"versions"`
+}
+
+// VersionStats 一个版本的发送统计
+type VersionStats struct {
+	VersionID     int64            `json:"versionId"`
+	Counts        map[string]int64 `json:"counts"`        // 各个发送状态的通知数
+	Total         int64            `json:"total"`         // 通知总数，包括还在发送流程中的
+	SuccessRate   float64          `json:"successRate"`   // 发送成功率，供应商受理成功 / 有发送结果
+	DeliveredRate float64          `json:"deliveredRate"` // 送达率，回执确认送达 / 供应商受理成功
+}
+
 // UpdateVersionReq 更新模板版本请求
 type UpdateVersionReq struct {
 	VersionID int64  `json:"versionId"` // 版本ID
@@ -109,7 +217,125 @@ type UpdateVersionReq struct {
 	Remark    string `json:"remark"`    // 申请说明
 }
 
+// SaveLocaleReq 创建或者更新本地化内容请求
+type SaveLocaleReq struct {
+	VersionID int64  `json:"versionId"` // 版本ID
+	Locale    string `json:"locale"`    // BCP-47 语言标签，如 zh-HK
+	Signature string `json:"signature"` // 签名，为空时使用版本的签名
+	Content   string `json:"content"`   // 模板内容，占位符必须和版本的默认内容一致
+}
+
+// SaveLocaleResp 创建或者更新本地化内容响应
+type SaveLocaleResp struct {
+	Locale ChannelTemplateLocale `json:"locale"`
+}
+
+// DeleteLocaleReq 删除本地化内容请求
+type DeleteLocaleReq struct {
+	VersionID int64  `json:"versionId"` // 版本ID
+	Locale    string `json:"locale"`    // BCP-47 语言标签
+}
+
 // SubmitForInternalReviewReq 提交内部审核请求
 type SubmitForInternalReviewReq struct {
 	VersionID int64 `json:"versionId"` // 版本ID
 }
+
+// PreviewReq 预览模版请求
+type PreviewReq struct {
+	BizID      int64             `json:"bizId"`      // 业务ID
+	TemplateID int64             `json:"templateId"` // 模板ID
+	VersionID  int64             `json:"versionId"`  // 版本ID，不传时使用已发布的活跃版本
+	Params     map[string]string `json:"params"`     // 模板参数
+}
+
+// PreviewResp 预览模版响应
+type PreviewResp struct {
+	TemplateID   int64    `json:"templateId"`   // 模板ID
+	VersionID    int64    `json:"versionId"`    // 渲染使用的版本ID
+	Channel      string   `json:"channel"`      // 渠道类型
+	Signature    string   `json:"signature"`    // 签名
+	Title        string   `json:"title"`        // 标题，短信没有标题
+	Content      string   `json:"content"`      // 渲染后的内容
+	Placeholders []string `json:"placeholders"` // 模版声明的占位符
+	ProviderName string   `json:"providerName"` // 会使用的供应商，无可用供应商时为空
+}
+
+// Audit 内部审核记录
+type Audit struct {
+	ID           int64          `json:"id"`           // 审核记录ID
+	ResourceID   int64          `json:"resourceId"`   // 模版版本ID
+	ResourceType string         `json:"resourceType"` // 资源类型
+	Content      string         `json:"content"`      // 审核内容，JSON串
+	ReviewerID   int64          `json:"reviewerId"`   // 分配的审核人ID，0表示没有分配
+	Status       string         `json:"status"`       // 审核状态
+	RejectReason string         `json:"rejectReason"` // 拒绝原因
+	AuditTime    int64          `json:"auditTime"`    // 审核时间
+	Ctime        int64          `json:"ctime"`        // 创建时间
+	Utime        int64          `json:"utime"`        // 更新时间
+	Comments     []AuditComment `json:"comments"`     // 审核意见
+}
+
+// AuditComment 审核意见
+type AuditComment struct {
+	ID         int64  `json:"id"`         // 审核意见ID
+	ReviewerID int64  `json:"reviewerId"` // 审核人ID
+	Content    string `json:"content"`    // 审核意见
+	Ctime      int64  `json:"ctime"`      // 创建时间
+}
+
+// GetAuditReq 查询审核记录请求
+type GetAuditReq struct {
+	AuditID int64 `json:"auditId"` // 审核记录ID
+}
+
+// GetAuditResp 查询审核记录响应
+type GetAuditResp struct {
+	Audit Audit `json:"audit"`
+}
+
+// ListAuditsReq 查询审核人的审核记录请求
+type ListAuditsReq struct {
+	ReviewerID int64  `json:"reviewerId"` // 审核人ID
+	Status     string `json:"status"`     // 审核状态，为空时查询所有状态
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
+}
+
+// ListAuditsResp 查询审核人的审核记录响应
+type ListAuditsResp struct {
+	Audits []Audit `json:"audits"`
+	Total  int64   `json:"total"`
+}
+
+// AssignAuditReq 分配审核人请求
+type AssignAuditReq struct {
+	AuditID    int64 `json:"auditId"`    // 审核记录ID
+	ReviewerID int64 `json:"reviewerId"` // 新的审核人ID
+}
+
+// ApproveAuditReq 审核通过请求
+type ApproveAuditReq struct {
+	AuditID    int64  `json:"auditId"`    // 审核记录ID
+	ReviewerID int64  `json:"reviewerId"` // 审核人ID
+	Comment    string `json:"comment"`    // 审核意见，可以为空
+}
+
+// RejectAuditReq 审核拒绝请求
+type RejectAuditReq struct {
+	AuditID    int64  `json:"auditId"`    // 审核记录ID
+	ReviewerID int64  `json:"reviewerId"` // 审核人ID
+	Reason     string `json:"reason"`     // 拒绝原因
+}
+
+// CommentAuditReq 添加审核意见请求
+type CommentAuditReq struct {
+	AuditID    int64  `json:"auditId"`    // 审核记录ID
+	ReviewerID int64  `json:"reviewerId"` // 审核人ID
+	Content    string `json:"content"`    // 审核意见
+}
+
+// CommentAuditResp 添加审核意见响应
+type CommentAuditResp struct {
+	Comment AuditComment `json:"comment"`
+}
@@ -63,16 +63,26 @@ CREATE TABLE `notification_0`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','PARTIAL_SUCCESS','DELIVERED','UNDELIVERED','RETRYING','SUPPRESSED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
+    `sent_channel`        VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，跨渠道降级时与发送渠道不同',
+    `failover_receivers`  TEXT         COMMENT '跨渠道降级时目标渠道使用的接收者，JSON对象，键为渠道',
+    `retry_count`         INT          NOT NULL DEFAULT 0 COMMENT '已经重试的次数',
+    `next_retry_time`     BIGINT       NOT NULL DEFAULT 0 COMMENT '下一次重试的时间，毫秒',
+    `error_code`          VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '最近一次发送失败的错误码，发送成功时为空',
+    `error_message`       VARCHAR(512) NOT NULL DEFAULT '' COMMENT '最近一次发送失败的原因',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '发送使用的语言，为空时使用模版的默认内容',
+    `receiver_locales`    TEXT         COMMENT '单独指定语言的接收者，JSON对象，键为接收者',
     `ctime`               BIGINT       NOT NULL,
     `utime`               BIGINT       NOT NULL,
     PRIMARY KEY (`id`),
     UNIQUE INDEX `idx_biz_id_key` (`biz_id`, `key`),
     INDEX                 `idx_biz_id_status` (`biz_id`, `status`),
-    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`)
+    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`),
+    INDEX                 `idx_status_next_retry_time` (`status`, `next_retry_time`),
+    INDEX                 `idx_template_id_ctime` (`template_id`, `ctime`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='通知记录表';
 
 CREATE TABLE `notification_1`
@@ -85,16 +95,26 @@ CREATE TABLE `notification_1`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','PARTIAL_SUCCESS','DELIVERED','UNDELIVERED','RETRYING','SUPPRESSED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
+    `sent_channel`        VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，跨渠道降级时与发送渠道不同',
+    `failover_receivers`  TEXT         COMMENT '跨渠道降级时目标渠道使用的接收者，JSON对象，键为渠道',
+    `retry_count`         INT          NOT NULL DEFAULT 0 COMMENT '已经重试的次数',
+    `next_retry_time`     BIGINT       NOT NULL DEFAULT 0 COMMENT '下一次重试的时间，毫秒',
+    `error_code`          VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '最近一次发送失败的错误码，发送成功时为空',
+    `error_message`       VARCHAR(512) NOT NULL DEFAULT '' COMMENT '最近一次发送失败的原因',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '发送使用的语言，为空时使用模版的默认内容',
+    `receiver_locales`    TEXT         COMMENT '单独指定语言的接收者，JSON对象，键为接收者',
     `ctime`               BIGINT       NOT NULL,
     `utime`               BIGINT       NOT NULL,
     PRIMARY KEY (`id`),
     UNIQUE INDEX `idx_biz_id_key` (`biz_id`, `key`),
     INDEX                 `idx_biz_id_status` (`biz_id`, `status`),
-    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`)
+    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`),
+    INDEX                 `idx_status_next_retry_time` (`status`, `next_retry_time`),
+    INDEX                 `idx_template_id_ctime` (`template_id`, `ctime`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='通知记录表';
 
 CREATE TABLE `tx_notification_0`
@@ -172,16 +192,26 @@ CREATE TABLE `notification_0`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','PARTIAL_SUCCESS','DELIVERED','UNDELIVERED','RETRYING','SUPPRESSED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
+    `sent_channel`        VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，跨渠道降级时与发送渠道不同',
+    `failover_receivers`  TEXT         COMMENT '跨渠道降级时目标渠道使用的接收者，JSON对象，键为渠道',
+    `retry_count`         INT          NOT NULL DEFAULT 0 COMMENT '已经重试的次数',
+    `next_retry_time`     BIGINT       NOT NULL DEFAULT 0 COMMENT '下一次重试的时间，毫秒',
+    `error_code`          VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '最近一次发送失败的错误码，发送成功时为空',
+    `error_message`       VARCHAR(512) NOT NULL DEFAULT '' COMMENT '最近一次发送失败的原因',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '发送使用的语言，为空时使用模版的默认内容',
+    `receiver_locales`    TEXT         COMMENT '单独指定语言的接收者，JSON对象，键为接收者',
     `ctime`               BIGINT       NOT NULL,
     `utime`               BIGINT       NOT NULL,
     PRIMARY KEY (`id`),
     UNIQUE INDEX `idx_biz_id_key` (`biz_id`, `key`),
     INDEX                 `idx_biz_id_status` (`biz_id`, `status`),
-    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`)
+    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`),
+    INDEX                 `idx_status_next_retry_time` (`status`, `next_retry_time`),
+    INDEX                 `idx_template_id_ctime` (`template_id`, `ctime`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='通知记录表';
 
 CREATE TABLE `notification_1`
@@ -194,16 +224,26 @@ CREATE TABLE `notification_1`
     `template_id`         BIGINT       NOT NULL COMMENT '模板ID',
     `template_version_id` BIGINT       NOT NULL COMMENT '模板版本ID',
     `template_params`     TEXT         NOT NULL COMMENT '模版参数',
-    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED') DEFAULT 'PENDING' COMMENT '发送状态',
+    `status`              ENUM('PREPARE','CANCELED','PENDING','SENDING','SUCCEEDED','FAILED','PARTIAL_SUCCESS','DELIVERED','UNDELIVERED','RETRYING','SUPPRESSED') DEFAULT 'PENDING' COMMENT '发送状态',
     `scheduled_stime`     BIGINT       NOT NULL COMMENT '计划发送开始时间',
     `scheduled_etime`     BIGINT       NOT NULL COMMENT '计划发送结束时间',
     `version`             INT          NOT NULL DEFAULT 1 COMMENT '版本号，用于CAS操作',
+    `sent_channel`        VARCHAR(16)  NOT NULL DEFAULT '' COMMENT '实际发送成功的渠道，跨渠道降级时与发送渠道不同',
+    `failover_receivers`  TEXT         COMMENT '跨渠道降级时目标渠道使用的接收者，JSON对象，键为渠道',
+    `retry_count`         INT          NOT NULL DEFAULT 0 COMMENT '已经重试的次数',
+    `next_retry_time`     BIGINT       NOT NULL DEFAULT 0 COMMENT '下一次重试的时间，毫秒',
+    `error_code`          VARCHAR(32)  NOT NULL DEFAULT '' COMMENT '最近一次发送失败的错误码，发送成功时为空',
+    `error_message`       VARCHAR(512) NOT NULL DEFAULT '' COMMENT '最近一次发送失败的原因',
+    `locale`              VARCHAR(35)  NOT NULL DEFAULT '' COMMENT '发送使用的语言，为空时使用模版的默认内容',
+    `receiver_locales`    TEXT         COMMENT '单独指定语言的接收者，JSON对象，键为接收者',
     `ctime`               BIGINT       NOT NULL,
     `utime`               BIGINT       NOT NULL,
     PRIMARY KEY (`id`),
     UNIQUE INDEX `idx_biz_id_key` (`biz_id`, `key`),
     INDEX                 `idx_biz_id_status` (`biz_id`, `status`),
-    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`)
+    INDEX                 `idx_scheduled` (`scheduled_stime`, `scheduled_etime`, `status`),
+    INDEX                 `idx_status_next_retry_time` (`status`, `next_retry_time`),
+    INDEX                 `idx_template_id_ctime` (`template_id`, `ctime`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='通知记录表';
 
 